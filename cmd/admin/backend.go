@@ -0,0 +1,300 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+	"xyz-football-api/internal/api/handler"
+	"xyz-football-api/internal/apperror"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+	"xyz-football-api/internal/service"
+	"xyz-football-api/pkg/client"
+	"xyz-football-api/pkg/i18n"
+	"xyz-football-api/pkg/spreadsheet"
+)
+
+// backend adalah operasi yang dijalankan subcommand. localBackend memakai service langsung
+// di atas database, remoteBackend memanggil API lewat pkg/client. Keduanya mengembalikan
+// tipe pkg/client sehingga output kedua mode sama persis.
+type backend interface {
+	ListTeams(ctx context.Context) ([]client.Team, error)
+	CreateTeam(ctx context.Context, req client.TeamRequest) (*client.Team, error)
+	ImportPlayers(ctx context.Context, filename string, file io.Reader, dryRun bool) (*client.ImportResult, error)
+	ScheduleMatch(ctx context.Context, req client.CreateMatchRequest) (*client.Match, error)
+	ReportMatchResult(ctx context.Context, id uint, req client.ReportMatchResultRequest) error
+	Standings(ctx context.Context, competitionID uint) ([]client.Group, error)
+	CreateUser(ctx context.Context, req client.UserRequest) (*client.User, error)
+	ParkedEvents(ctx context.Context, limit int) ([]client.OutboxEvent, error)
+	RequeueEvents(ctx context.Context, ids []uint) (int64, error)
+}
+
+// remoteBackend menjalankan operasi lewat API
+type remoteBackend struct {
+	api *client.Client
+}
+
+// newRemoteBackend membuat backend yang memanggil API dengan client tertentu
+func newRemoteBackend(api *client.Client) *remoteBackend {
+	return &remoteBackend{api: api}
+}
+
+func (b *remoteBackend) ListTeams(ctx context.Context) ([]client.Team, error) {
+	return b.api.ListTeams(ctx)
+}
+
+func (b *remoteBackend) CreateTeam(ctx context.Context, req client.TeamRequest) (*client.Team, error) {
+	return b.api.CreateTeam(ctx, req)
+}
+
+func (b *remoteBackend) ImportPlayers(ctx context.Context, filename string, file io.Reader, dryRun bool) (*client.ImportResult, error) {
+	return b.api.ImportPlayers(ctx, filename, file, client.ImportOptions{DryRun: dryRun})
+}
+
+func (b *remoteBackend) ScheduleMatch(ctx context.Context, req client.CreateMatchRequest) (*client.Match, error) {
+	return b.api.CreateMatch(ctx, req)
+}
+
+func (b *remoteBackend) ReportMatchResult(ctx context.Context, id uint, req client.ReportMatchResultRequest) error {
+	return b.api.ReportMatchResult(ctx, id, req)
+}
+
+func (b *remoteBackend) Standings(ctx context.Context, competitionID uint) ([]client.Group, error) {
+	return b.api.GetGroups(ctx, competitionID)
+}
+
+func (b *remoteBackend) CreateUser(ctx context.Context, req client.UserRequest) (*client.User, error) {
+	return b.api.CreateUser(ctx, req)
+}
+
+func (b *remoteBackend) ParkedEvents(ctx context.Context, limit int) ([]client.OutboxEvent, error) {
+	return b.api.ListParkedEvents(ctx, limit)
+}
+
+func (b *remoteBackend) RequeueEvents(ctx context.Context, ids []uint) (int64, error) {
+	return b.api.RequeueEvents(ctx, ids...)
+}
+
+// localBackend menjalankan operasi langsung di database lewat service yang sama dengan API,
+// sehingga aturan bisnis dan event outbox tetap berlaku. Request divalidasi dengan struct
+// request REST seperti gRPC dan GraphQL. Pesan error diterjemahkan ke lang.
+type localBackend struct {
+	lang    string
+	teams   *service.TeamService
+	matches *service.MatchService
+	imports *service.ImportService
+	bracket *service.BracketService
+	users   *service.UserService
+	outbox  *service.OutboxService
+}
+
+// newLocalBackend membuat backend di atas repository. adminUsername adalah username admin
+// dari konfigurasi yang tidak boleh dipakai user baru.
+func newLocalBackend(repos *repository.Repositories, adminUsername, lang string) *localBackend {
+	bracket := service.NewBracketService(repos.Competitions, repos.Teams, repos.Outbox, repos.Transactor)
+	return &localBackend{
+		lang:    lang,
+		teams:   service.NewTeamService(repos.Teams, repos.Venues, repos.Transactor),
+		matches: service.NewMatchService(repos.Matches, repos.Teams, repos.Players, repos.Goals, repos.Venues, repos.Competitions, repos.Availabilities, bracket, repos.Transactor),
+		imports: service.NewImportService(repos.Teams, repos.Venues, repos.Players, repos.Transactor),
+		bracket: bracket,
+		users:   service.NewUserService(repos.Users, adminUsername),
+		outbox:  service.NewOutboxService(repos.Outbox),
+	}
+}
+
+func (b *localBackend) ListTeams(ctx context.Context) ([]client.Team, error) {
+	teams, err := b.teams.List()
+	if err != nil {
+		return nil, b.error(err)
+	}
+	var out []client.Team
+	return out, convert(teams, &out)
+}
+
+func (b *localBackend) CreateTeam(ctx context.Context, req client.TeamRequest) (*client.Team, error) {
+	// Body request API untuk team langsung di-bind ke model.Team
+	var team model.Team
+	if err := convert(req, &team); err != nil {
+		return nil, err
+	}
+	if err := handler.ValidateRequest(&team, "Data team tidak valid"); err != nil {
+		return nil, b.error(err)
+	}
+	if err := b.teams.Create(&team); err != nil {
+		return nil, b.error(err)
+	}
+	var out client.Team
+	return &out, convert(team, &out)
+}
+
+func (b *localBackend) ImportPlayers(ctx context.Context, filename string, file io.Reader, dryRun bool) (*client.ImportResult, error) {
+	format, err := spreadsheet.FormatFromFilename(filename)
+	if err != nil {
+		return nil, b.error(apperror.Validation("unsupported_format", "Format file harus CSV atau XLSX"))
+	}
+	table, err := spreadsheet.Read(format, file)
+	if err != nil {
+		return nil, b.error(apperror.Validation("invalid_file", "File tidak dapat dibaca sebagai CSV/XLSX"))
+	}
+
+	result, err := b.imports.ImportPlayers(table, dryRun)
+	if result == nil {
+		return nil, b.error(err)
+	}
+
+	out := &client.ImportResult{DryRun: dryRun, TotalRows: result.Rows, Imported: result.Imported}
+	for _, rowErr := range result.Errors {
+		out.Errors = append(out.Errors, client.ImportRowError{Row: rowErr.Line, Fields: b.fields(rowErr.Fields)})
+	}
+	if err != nil {
+		return out, b.error(err)
+	}
+	return out, nil
+}
+
+func (b *localBackend) ScheduleMatch(ctx context.Context, req client.CreateMatchRequest) (*client.Match, error) {
+	request := handler.CreateMatchRequest{
+		HomeTeamID:    req.HomeTeamID,
+		AwayTeamID:    req.AwayTeamID,
+		MatchDatetime: req.MatchDatetime.Format(time.RFC3339Nano),
+		VenueID:       req.VenueID,
+	}
+	if err := handler.ValidateRequest(&request, "Data match tidak valid"); err != nil {
+		return nil, b.error(err)
+	}
+	input, err := request.Input()
+	if err != nil {
+		return nil, b.error(err)
+	}
+
+	match, err := b.matches.Create(input)
+	if err != nil {
+		return nil, b.error(err)
+	}
+	var out client.Match
+	return &out, convert(match, &out)
+}
+
+func (b *localBackend) ReportMatchResult(ctx context.Context, id uint, req client.ReportMatchResultRequest) error {
+	request := handler.ReportMatchResultRequest{
+		HomeScore:  req.HomeScore,
+		AwayScore:  req.AwayScore,
+		Attendance: req.Attendance,
+		ExtraTime:  req.ExtraTime,
+		Goals:      make([]handler.GoalRequest, 0, len(req.Goals)),
+	}
+	if req.Penalties != nil {
+		request.Penalties = &handler.PenaltyShootout{Home: req.Penalties.Home, Away: req.Penalties.Away}
+	}
+	for _, g := range req.Goals {
+		request.Goals = append(request.Goals, handler.GoalRequest{PlayerID: g.PlayerID, GoalTime: g.GoalTime})
+	}
+	if err := handler.ValidateRequest(&request, "Data hasil match tidak valid"); err != nil {
+		return b.error(err)
+	}
+	return b.error(b.matches.ReportResult(id, request.Input()))
+}
+
+func (b *localBackend) Standings(ctx context.Context, competitionID uint) ([]client.Group, error) {
+	standings, err := b.bracket.Standings(competitionID)
+	if err != nil {
+		return nil, b.error(err)
+	}
+
+	groups := make([]client.Group, 0, len(standings))
+	for _, standing := range standings {
+		group := client.Group{GroupID: standing.Group.ID, Name: standing.Group.Name, Table: []client.StandingRow{}}
+		for _, row := range standing.Table {
+			group.Table = append(group.Table, client.StandingRow{
+				Position:       row.Position,
+				TeamID:         row.TeamID,
+				TeamName:       row.TeamName,
+				Played:         row.Played,
+				Won:            row.Won,
+				Drawn:          row.Drawn,
+				Lost:           row.Lost,
+				GoalsFor:       row.GoalsFor,
+				GoalsAgainst:   row.GoalsAgainst,
+				GoalDifference: row.GoalDifference,
+				Points:         row.Points,
+			})
+		}
+		if err := convert(standing.Group.Matches, &group.Matches); err != nil {
+			return nil, err
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+func (b *localBackend) CreateUser(ctx context.Context, req client.UserRequest) (*client.User, error) {
+	user, err := b.users.Create(req.Username, req.Password)
+	if err != nil {
+		return nil, b.error(err)
+	}
+	return &client.User{ID: user.ID, Username: user.Username, CreatedAt: user.CreatedAt}, nil
+}
+
+func (b *localBackend) ParkedEvents(ctx context.Context, limit int) ([]client.OutboxEvent, error) {
+	events, err := b.outbox.Parked(limit)
+	if err != nil {
+		return nil, b.error(err)
+	}
+	out := make([]client.OutboxEvent, len(events))
+	for i, e := range events {
+		out[i] = client.OutboxEvent{
+			ID:           e.ID,
+			EventID:      e.EventID,
+			EventType:    e.EventType,
+			OccurredAt:   e.OccurredAt,
+			Attempts:     e.Attempts,
+			LastError:    e.LastError,
+			FailingSince: e.FailingSince,
+			ParkedAt:     e.ParkedAt,
+			Payload:      json.RawMessage(e.Payload),
+			CreatedAt:    e.CreatedAt,
+		}
+	}
+	return out, nil
+}
+
+func (b *localBackend) RequeueEvents(ctx context.Context, ids []uint) (int64, error) {
+	requeued, err := b.outbox.Requeue(ids)
+	return requeued, b.error(err)
+}
+
+// error mengubah error domain menjadi *client.Error dengan pesan dalam bahasa backend
+// sehingga dicetak sama seperti error dari API. Error lain dikembalikan apa adanya.
+func (b *localBackend) error(err error) error {
+	var appErr *apperror.Error
+	if !errors.As(err, &appErr) {
+		return err
+	}
+	return &client.Error{
+		Code:    appErr.Code,
+		Message: i18n.Translate(b.lang, appErr.Message, appErr.Args...),
+		Fields:  b.fields(appErr.Fields),
+	}
+}
+
+// fields menerjemahkan detail field error domain
+func (b *localBackend) fields(fields []apperror.FieldError) []client.FieldError {
+	translated := make([]client.FieldError, 0, len(fields))
+	for _, f := range fields {
+		translated = append(translated, client.FieldError{Field: f.Field, Message: i18n.Translate(b.lang, f.Message, f.Args...)})
+	}
+	return translated
+}
+
+// convert menyalin nilai model ke tipe pkg/client lewat JSON. Tipe pkg/client mengikuti
+// bentuk JSON response API, sehingga hasilnya sama dengan yang diterima remoteBackend.
+func convert(in, out interface{}) error {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
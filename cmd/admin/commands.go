@@ -0,0 +1,388 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"xyz-football-api/pkg/client"
+)
+
+// session adalah dependency yang dipakai subcommand
+type session struct {
+	backend backend
+	out     *printer
+	stdin   io.Reader
+	stderr  io.Writer
+}
+
+// command adalah satu subcommand "<group> <action>"
+type command struct {
+	group  string
+	action string
+	run    func(ctx context.Context, s *session, args []string) error
+}
+
+// commands adalah semua subcommand yang tersedia
+var commands = []command{
+	{"teams", "list", runTeamsList},
+	{"teams", "create", runTeamsCreate},
+	{"players", "import", runPlayersImport},
+	{"matches", "schedule", runMatchesSchedule},
+	{"matches", "report-result", runMatchesReportResult},
+	{"standings", "show", runStandingsShow},
+	{"users", "create", runUsersCreate},
+	{"outbox", "parked", runOutboxParked},
+	{"outbox", "requeue", runOutboxRequeue},
+}
+
+// usageError berarti argumen command line tidak valid (exit code 2).
+// Pesan kosong berarti package flag sudah mencetak kesalahannya.
+type usageError struct {
+	message string
+}
+
+func (e usageError) Error() string {
+	return e.message
+}
+
+// usageErrorf membuat usageError dengan pesan terformat
+func usageErrorf(format string, args ...interface{}) error {
+	return usageError{message: fmt.Sprintf(format, args...)}
+}
+
+// errHelp dikembalikan saat user meminta bantuan dengan -h
+var errHelp = errors.New("help requested")
+
+// execute menjalankan subcommand group action dengan sisa argumen args
+func execute(ctx context.Context, s *session, group, action string, args []string) error {
+	for _, cmd := range commands {
+		if cmd.group == group && cmd.action == action {
+			return cmd.run(ctx, s, args)
+		}
+	}
+	return usageErrorf("unknown command %q", strings.TrimSpace(group+" "+action))
+}
+
+// newFlagSet membuat flag set untuk satu subcommand yang mencetak kesalahan ke stderr
+func (s *session) newFlagSet(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet("football-admin "+name, flag.ContinueOnError)
+	flags.SetOutput(s.stderr)
+	return flags
+}
+
+// parseFlags mem-parsing option subcommand. Argumen posisi tidak dipakai oleh subcommand mana pun.
+func parseFlags(flags *flag.FlagSet, args []string) error {
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return errHelp
+		}
+		return usageError{}
+	}
+	if flags.NArg() > 0 {
+		return usageErrorf("unexpected argument %q", flags.Arg(0))
+	}
+	return nil
+}
+
+// required memastikan option wajib sudah diisi
+func required(flags *flag.FlagSet, names ...string) error {
+	set := make(map[string]bool)
+	flags.Visit(func(f *flag.Flag) { set[f.Name] = true })
+	for _, name := range names {
+		if !set[name] {
+			return usageErrorf("-%s is required", name)
+		}
+	}
+	return nil
+}
+
+// isSet melaporkan apakah option diisi di command line
+func isSet(flags *flag.FlagSet, name string) bool {
+	found := false
+	flags.Visit(func(f *flag.Flag) { found = found || f.Name == name })
+	return found
+}
+
+// runTeamsList menjalankan "teams list"
+func runTeamsList(ctx context.Context, s *session, args []string) error {
+	if err := parseFlags(s.newFlagSet("teams list"), args); err != nil {
+		return err
+	}
+
+	teams, err := s.backend.ListTeams(ctx)
+	if err != nil {
+		return err
+	}
+	return s.out.teams(teams)
+}
+
+// runTeamsCreate menjalankan "teams create"
+func runTeamsCreate(ctx context.Context, s *session, args []string) error {
+	flags := s.newFlagSet("teams create")
+	name := flags.String("name", "", "team name (required)")
+	logo := flags.String("logo", "", "logo URL")
+	founded := flags.Int("founded", 0, "founded year")
+	address := flags.String("address", "", "headquarters address")
+	city := flags.String("city", "", "headquarters city")
+	venue := flags.Uint("venue", 0, "home venue ID")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if err := required(flags, "name"); err != nil {
+		return err
+	}
+
+	req := client.TeamRequest{Name: *name}
+	if isSet(flags, "logo") {
+		req.LogoURL = logo
+	}
+	if isSet(flags, "founded") {
+		req.FoundedYear = founded
+	}
+	if isSet(flags, "address") {
+		req.HeadquartersAddress = address
+	}
+	if isSet(flags, "city") {
+		req.HeadquartersCity = city
+	}
+	if isSet(flags, "venue") {
+		id := uint(*venue)
+		req.HomeVenueID = &id
+	}
+
+	team, err := s.backend.CreateTeam(ctx, req)
+	if err != nil {
+		return err
+	}
+	return s.out.teams([]client.Team{*team})
+}
+
+// runPlayersImport menjalankan "players import". Jika ada baris yang tidak valid,
+// detail per baris tetap dicetak sebelum error dikembalikan.
+func runPlayersImport(ctx context.Context, s *session, args []string) error {
+	flags := s.newFlagSet("players import")
+	path := flags.String("file", "", "CSV or XLSX file with columns team_id, name, position, jersey_number, ... (required)")
+	dryRun := flags.Bool("dry-run", false, "validate the file without saving")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if err := required(flags, "file"); err != nil {
+		return err
+	}
+
+	file, err := os.Open(*path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	result, err := s.backend.ImportPlayers(ctx, filepath.Base(*path), file, *dryRun)
+	if result != nil {
+		if printErr := s.out.importResult(result); printErr != nil {
+			return printErr
+		}
+	}
+	return err
+}
+
+// runMatchesSchedule menjalankan "matches schedule"
+func runMatchesSchedule(ctx context.Context, s *session, args []string) error {
+	flags := s.newFlagSet("matches schedule")
+	home := flags.Uint("home", 0, "home team ID (required)")
+	away := flags.Uint("away", 0, "away team ID (required)")
+	at := flags.String("at", "", "kickoff time in RFC 3339, e.g. 2024-08-17T19:00:00+07:00 (required)")
+	venue := flags.Uint("venue", 0, "venue ID (default: home venue of the home team)")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if err := required(flags, "home", "away", "at"); err != nil {
+		return err
+	}
+
+	kickoff, err := time.Parse(time.RFC3339, *at)
+	if err != nil {
+		return usageErrorf("-at must be an RFC 3339 time, e.g. 2024-08-17T19:00:00+07:00")
+	}
+	req := client.CreateMatchRequest{HomeTeamID: uint(*home), AwayTeamID: uint(*away), MatchDatetime: kickoff}
+	if isSet(flags, "venue") {
+		id := uint(*venue)
+		req.VenueID = &id
+	}
+
+	match, err := s.backend.ScheduleMatch(ctx, req)
+	if err != nil {
+		return err
+	}
+	return s.out.match(match)
+}
+
+// runMatchesReportResult menjalankan "matches report-result"
+func runMatchesReportResult(ctx context.Context, s *session, args []string) error {
+	var req client.ReportMatchResultRequest
+	req.Goals = []client.GoalRequest{}
+
+	flags := s.newFlagSet("matches report-result")
+	matchID := flags.Uint("match", 0, "match ID (required)")
+	score := flags.String("score", "", "final score as HOME-AWAY, e.g. 2-1 (required)")
+	attendance := flags.Int("attendance", 0, "number of spectators")
+	flags.BoolVar(&req.ExtraTime, "extra-time", false, "the deciding leg went to extra time (cup competitions)")
+	penalties := flags.String("penalties", "", "penalty shoot-out score as HOME-AWAY (cup competitions)")
+	flags.Func("goal", "goal as PLAYER_ID:MINUTE (repeat for every goal)", func(value string) error {
+		playerID, minute, ok := strings.Cut(value, ":")
+		id, idErr := strconv.ParseUint(playerID, 10, 32)
+		goalTime, timeErr := strconv.Atoi(minute)
+		if !ok || idErr != nil || timeErr != nil {
+			return errors.New("must be PLAYER_ID:MINUTE")
+		}
+		req.Goals = append(req.Goals, client.GoalRequest{PlayerID: uint(id), GoalTime: goalTime})
+		return nil
+	})
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if err := required(flags, "match", "score"); err != nil {
+		return err
+	}
+
+	var err error
+	if req.HomeScore, req.AwayScore, err = parseScore(*score); err != nil {
+		return usageErrorf("-score %v", err)
+	}
+	if isSet(flags, "attendance") {
+		req.Attendance = attendance
+	}
+	if isSet(flags, "penalties") {
+		var shootout client.PenaltyShootout
+		if shootout.Home, shootout.Away, err = parseScore(*penalties); err != nil {
+			return usageErrorf("-penalties %v", err)
+		}
+		req.Penalties = &shootout
+	}
+
+	if err := s.backend.ReportMatchResult(ctx, uint(*matchID), req); err != nil {
+		return err
+	}
+	return s.out.message(fmt.Sprintf("Result of match %d reported: %d-%d", *matchID, req.HomeScore, req.AwayScore))
+}
+
+// runStandingsShow menjalankan "standings show"
+func runStandingsShow(ctx context.Context, s *session, args []string) error {
+	flags := s.newFlagSet("standings show")
+	competitionID := flags.Uint("competition", 0, "ID of a group_knockout competition (required)")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if err := required(flags, "competition"); err != nil {
+		return err
+	}
+
+	groups, err := s.backend.Standings(ctx, uint(*competitionID))
+	if err != nil {
+		return err
+	}
+	return s.out.standings(groups)
+}
+
+// runUsersCreate menjalankan "users create". Password bisa dibaca dari stdin agar
+// tidak terlihat di riwayat shell atau daftar proses.
+func runUsersCreate(ctx context.Context, s *session, args []string) error {
+	flags := s.newFlagSet("users create")
+	username := flags.String("username", "", "username (required)")
+	password := flags.String("password", "", "password, at least 8 characters")
+	passwordStdin := flags.Bool("password-stdin", false, "read the password from the first line of stdin")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if err := required(flags, "username"); err != nil {
+		return err
+	}
+
+	switch {
+	case *passwordStdin && isSet(flags, "password"):
+		return usageErrorf("-password and -password-stdin cannot be used together")
+	case *passwordStdin:
+		line, err := readLine(s.stdin)
+		if err != nil {
+			return fmt.Errorf("failed to read password from stdin: %w", err)
+		}
+		*password = line
+	case !isSet(flags, "password"):
+		return usageErrorf("-password or -password-stdin is required")
+	}
+
+	user, err := s.backend.CreateUser(ctx, client.UserRequest{Username: *username, Password: *password})
+	if err != nil {
+		return err
+	}
+	return s.out.user(user)
+}
+
+// runOutboxParked menjalankan "outbox parked"
+func runOutboxParked(ctx context.Context, s *session, args []string) error {
+	flags := s.newFlagSet("outbox parked")
+	limit := flags.Int("limit", 50, "maximum number of events")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if *limit < 1 {
+		return usageErrorf("-limit must be at least 1")
+	}
+
+	events, err := s.backend.ParkedEvents(ctx, *limit)
+	if err != nil {
+		return err
+	}
+	return s.out.outboxEvents(events)
+}
+
+// runOutboxRequeue menjalankan "outbox requeue". Tanpa -id semua event yang diparkir
+// dikembalikan ke antrean.
+func runOutboxRequeue(ctx context.Context, s *session, args []string) error {
+	var ids []uint
+	flags := s.newFlagSet("outbox requeue")
+	flags.Func("id", "ID of a parked event (repeat for several events; default: all parked events)", func(value string) error {
+		id, err := strconv.ParseUint(value, 10, 32)
+		if err != nil || id == 0 {
+			return errors.New("must be a positive event ID")
+		}
+		ids = append(ids, uint(id))
+		return nil
+	})
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	requeued, err := s.backend.RequeueEvents(ctx, ids)
+	if err != nil {
+		return err
+	}
+	return s.out.message(fmt.Sprintf("%d outbox event(s) requeued", requeued))
+}
+
+// parseScore mem-parsing skor "HOME-AWAY"
+func parseScore(value string) (int, int, error) {
+	home, away, ok := strings.Cut(value, "-")
+	homeScore, homeErr := strconv.Atoi(strings.TrimSpace(home))
+	awayScore, awayErr := strconv.Atoi(strings.TrimSpace(away))
+	if !ok || homeErr != nil || awayErr != nil || homeScore < 0 || awayScore < 0 {
+		return 0, 0, fmt.Errorf("must be HOME-AWAY, e.g. 2-1 (got %q)", value)
+	}
+	return homeScore, awayScore, nil
+}
+
+// readLine membaca satu baris tanpa akhiran newline
+func readLine(r io.Reader) (string, error) {
+	line, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
@@ -0,0 +1,168 @@
+// Command football-admin adalah CLI untuk mengoperasikan liga tanpa menyusun request HTTP.
+// Secara default perintah dijalankan langsung di database (konfigurasi sama dengan server API);
+// dengan -remote perintah dikirim ke API yang sedang berjalan lewat pkg/client.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"xyz-football-api/config"
+	"xyz-football-api/internal/repository"
+	"xyz-football-api/pkg/client"
+	"xyz-football-api/pkg/database"
+	"xyz-football-api/pkg/i18n"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// usage adalah bantuan untuk football-admin
+const usage = `Usage: football-admin [options] <command> <action> [action options]
+
+Commands:
+  teams list                 List all teams
+  teams create               Create a team (-name NAME [-city CITY] [-founded YEAR] [-venue ID] ...)
+  players import             Import players from a CSV/XLSX file (-file FILE [-dry-run])
+  matches schedule           Schedule a match (-home ID -away ID -at RFC3339 [-venue ID])
+  matches report-result      Report a match result (-match ID -score 2-1 [-goal PLAYER_ID:MINUTE ...])
+  standings show             Show the group standings of a competition (-competition ID)
+  users create               Create a user that can log in to the API (-username NAME -password PASS)
+  outbox parked              List outbox events parked after failing to publish ([-limit N])
+  outbox requeue             Put parked outbox events back in the queue ([-id ID ...], default all)
+
+Without -remote the commands run directly against the database configured in .env
+(run "football-api migrate up" first). With -remote they are sent to a running API.
+Run "football-admin <command> <action> -h" for the options of an action.
+
+Options:
+`
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	code := run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Stderr)
+	stop()
+	os.Exit(code)
+}
+
+// options adalah option global football-admin
+type options struct {
+	remote   string
+	username string
+	password string
+	token    string
+	output   string
+	lang     string
+}
+
+// run mem-parsing option global, membuka backend, lalu menjalankan subcommand.
+// Mengembalikan exit code: 0 berhasil, 1 operasi gagal, 2 argumen tidak valid.
+func run(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	var opts options
+	flags := flag.NewFlagSet("football-admin", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprint(stderr, usage)
+		flags.PrintDefaults()
+	}
+	flags.StringVar(&opts.remote, "remote", os.Getenv("FOOTBALL_API_URL"), "base URL of the API; empty means direct database access (env FOOTBALL_API_URL)")
+	flags.StringVar(&opts.username, "user", os.Getenv("FOOTBALL_API_USERNAME"), "API username for -remote (env FOOTBALL_API_USERNAME)")
+	flags.StringVar(&opts.password, "password", os.Getenv("FOOTBALL_API_PASSWORD"), "API password for -remote (env FOOTBALL_API_PASSWORD)")
+	flags.StringVar(&opts.token, "token", os.Getenv("FOOTBALL_API_TOKEN"), "JWT for -remote instead of logging in (env FOOTBALL_API_TOKEN)")
+	flags.StringVar(&opts.output, "o", outputTable, "output format: table or json")
+	flags.StringVar(&opts.lang, "lang", i18n.DefaultLanguage, "language of error messages: id or en")
+
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if flags.NArg() < 2 {
+		flags.Usage()
+		return 2
+	}
+
+	out, err := newPrinter(stdout, opts.output)
+	if err != nil {
+		return report(stderr, err)
+	}
+	b, err := openBackend(opts)
+	if err != nil {
+		return report(stderr, err)
+	}
+
+	s := &session{backend: b, out: out, stdin: stdin, stderr: stderr}
+	return report(stderr, execute(ctx, s, flags.Arg(0), flags.Arg(1), flags.Args()[2:]))
+}
+
+// openBackend membuat remoteBackend jika -remote diisi, atau localBackend di atas database
+func openBackend(opts options) (backend, error) {
+	if opts.remote != "" {
+		api, err := client.New(client.Config{
+			BaseURL:  opts.remote,
+			Username: opts.username,
+			Password: opts.password,
+			Token:    opts.token,
+			Language: opts.lang,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return newRemoteBackend(api), nil
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	if err := database.InitDatabase(&cfg.Database); err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	// Log SQL tidak boleh tercampur dengan output (terutama -o json)
+	db := database.GetDB().Session(&gorm.Session{Logger: logger.Discard})
+	migrator, err := database.NewMigrator(db)
+	if err != nil {
+		return nil, err
+	}
+	if err := migrator.CheckSchema(); err != nil {
+		return nil, fmt.Errorf("%w. Run `football-api migrate up` first", err)
+	}
+
+	return newLocalBackend(repository.NewRepositories(db), cfg.Admin.Username, opts.lang), nil
+}
+
+// report mencetak err ke stderr dan mengembalikan exit code yang sesuai
+func report(stderr io.Writer, err error) int {
+	var usageErr usageError
+	var apiErr *client.Error
+	switch {
+	case err == nil, errors.Is(err, errHelp):
+		return 0
+	case errors.As(err, &usageErr):
+		if usageErr.message != "" {
+			fmt.Fprintf(stderr, "❌ %s\n", usageErr.message)
+		}
+		fmt.Fprintln(stderr, `Run "football-admin -h" for usage.`)
+		return 2
+	case errors.As(err, &apiErr):
+		if apiErr.Code != "" {
+			fmt.Fprintf(stderr, "❌ %s (%s)\n", apiErr.Message, apiErr.Code)
+		} else {
+			fmt.Fprintf(stderr, "❌ %s\n", apiErr.Message)
+		}
+		for _, field := range apiErr.Fields {
+			fmt.Fprintf(stderr, "   - %s: %s\n", field.Field, field.Message)
+		}
+		return 1
+	default:
+		fmt.Fprintf(stderr, "❌ %v\n", err)
+		return 1
+	}
+}
@@ -0,0 +1,327 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+	"xyz-football-api/config"
+	"xyz-football-api/internal/api"
+	"xyz-football-api/internal/event"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+	"xyz-football-api/internal/repository/memory"
+	"xyz-football-api/pkg/client"
+)
+
+var testConfig = &config.Config{
+	JWT:   config.JWTConfig{Secret: "test-secret", ExpirationHours: 1},
+	Admin: config.AdminConfig{Username: "admin", Password: "admin123"},
+}
+
+func TestMain(m *testing.M) {
+	log.SetOutput(io.Discard)
+	os.Exit(m.Run())
+}
+
+// testBackend adalah backend yang diuji beserta client API dan repository di atas database
+// yang sama, untuk menyiapkan data yang tidak punya subcommand (mis. kompetisi)
+type testBackend struct {
+	backend
+	api   *client.Client
+	repos *repository.Repositories
+}
+
+// newAPI menjalankan router di atas repos dan mengembalikan client yang login sebagai admin
+func newAPI(t *testing.T, repos *repository.Repositories) *client.Client {
+	t.Helper()
+	server := httptest.NewServer(api.SetupRouter(testConfig, repos, event.NewBus()))
+	t.Cleanup(server.Close)
+	c, err := client.New(client.Config{BaseURL: server.URL, Username: "admin", Password: "admin123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+// backends mengembalikan backend lokal dan remote, masing-masing di atas database in-memory kosong
+func backends(t *testing.T) map[string]testBackend {
+	t.Helper()
+	localRepos, remoteRepos := memory.NewRepositories(), memory.NewRepositories()
+	remote := newAPI(t, remoteRepos)
+	return map[string]testBackend{
+		"local":  {backend: newLocalBackend(localRepos, testConfig.Admin.Username, "id"), api: newAPI(t, localRepos), repos: localRepos},
+		"remote": {backend: newRemoteBackend(remote), api: remote, repos: remoteRepos},
+	}
+}
+
+// cli menjalankan football-admin di atas satu backend
+type cli struct {
+	t       *testing.T
+	backend backend
+}
+
+// run menjalankan "<output> <command> <action> [options]" dan mengembalikan stdout, stderr, dan exit code
+func (c cli) run(output string, args ...string) (string, string, int) {
+	c.t.Helper()
+	var stdout, stderr bytes.Buffer
+	out, err := newPrinter(&stdout, output)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	s := &session{backend: c.backend, out: out, stdin: strings.NewReader("rahasia123\n"), stderr: &stderr}
+	code := report(&stderr, execute(context.Background(), s, args[0], args[1], args[2:]))
+	return stdout.String(), stderr.String(), code
+}
+
+// ok menjalankan command yang harus berhasil
+func (c cli) ok(output string, args ...string) string {
+	c.t.Helper()
+	stdout, stderr, code := c.run(output, args...)
+	if code != 0 {
+		c.t.Fatalf("%v: exit %d, stderr = %s", args, code, stderr)
+	}
+	return stdout
+}
+
+// decodeOutput mem-parsing output JSON
+func decodeOutput[T any](t *testing.T, stdout string) T {
+	t.Helper()
+	var v T
+	if err := json.Unmarshal([]byte(stdout), &v); err != nil {
+		t.Fatalf("output bukan JSON: %v\n%s", err, stdout)
+	}
+	return v
+}
+
+func TestCommands(t *testing.T) {
+	dir := t.TempDir()
+	players := filepath.Join(dir, "players.csv")
+	if err := os.WriteFile(players, []byte("team_id,name,position,jersey_number\n1,Bambang,penyerang,9\n2,Andik,gelandang,7\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	invalidPlayers := filepath.Join(dir, "invalid.csv")
+	if err := os.WriteFile(invalidPlayers, []byte("team_id,name,position,jersey_number\n1,Budi,kiper,10\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, b := range backends(t) {
+		t.Run(name, func(t *testing.T) {
+			c := cli{t: t, backend: b.backend}
+
+			table := c.ok(outputTable, "teams", "create", "-name", "Persija", "-city", "Jakarta", "-founded", "1928")
+			if !strings.Contains(table, "Persija") || !strings.Contains(table, "1928") {
+				t.Errorf("teams create output = %q", table)
+			}
+			c.ok(outputTable, "teams", "create", "-name", "Persib")
+
+			teams := decodeOutput[[]client.Team](t, c.ok(outputJSON, "teams", "list"))
+			if len(teams) != 2 || teams[0].Name != "Persija" || *teams[0].HeadquartersCity != "Jakarta" {
+				t.Fatalf("teams = %+v", teams)
+			}
+
+			// Import yang ditolak tetap mencetak detail per baris
+			stdout, stderr, code := c.run(outputTable, "players", "import", "-file", invalidPlayers)
+			if code != 1 || !strings.Contains(stdout, "position") || !strings.Contains(stderr, "import_rejected") {
+				t.Errorf("invalid import: exit %d, stdout = %q, stderr = %q", code, stdout, stderr)
+			}
+			result := decodeOutput[client.ImportResult](t, c.ok(outputJSON, "players", "import", "-file", players))
+			if result.Imported != 2 {
+				t.Errorf("import result = %+v", result)
+			}
+
+			match := decodeOutput[client.Match](t, c.ok(outputJSON, "matches", "schedule",
+				"-home", "1", "-away", "2", "-at", "2024-08-17T19:00:00+07:00"))
+			if match.Status != client.MatchScheduled {
+				t.Fatalf("match = %+v", match)
+			}
+
+			args := []string{"matches", "report-result", "-match", uintString(match.ID), "-score", "1-0", "-attendance", "25000", "-goal", "3:12"}
+			if out := c.ok(outputTable, args...); !strings.Contains(out, "1-0") {
+				t.Errorf("report-result output = %q", out)
+			}
+			_, stderr, code = c.run(outputTable, args...)
+			if code != 1 || !strings.Contains(stderr, "match_already_reported") {
+				t.Errorf("second report: exit %d, stderr = %q", code, stderr)
+			}
+
+			user := decodeOutput[client.User](t, c.ok(outputJSON, "users", "create", "-username", "operator", "-password-stdin"))
+			if user.Username != "operator" {
+				t.Errorf("user = %+v", user)
+			}
+			_, stderr, code = c.run(outputTable, "users", "create", "-username", "wasit", "-password", "pendek")
+			if code != 1 || !strings.Contains(stderr, "Password minimal 8 karakter") {
+				t.Errorf("short password: exit %d, stderr = %q", code, stderr)
+			}
+
+			_, stderr, code = c.run(outputTable, "standings", "show", "-competition", "99")
+			if code != 1 || !strings.Contains(stderr, "competition_not_found") {
+				t.Errorf("unknown competition: exit %d, stderr = %q", code, stderr)
+			}
+		})
+	}
+}
+
+func TestStandings(t *testing.T) {
+	for name, b := range backends(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			var ids []uint
+			for _, team := range []string{"Persija", "Persib", "Arema", "Bali United"} {
+				created, err := b.backend.CreateTeam(ctx, client.TeamRequest{Name: team})
+				if err != nil {
+					t.Fatal(err)
+				}
+				ids = append(ids, created.ID)
+			}
+			competition, err := b.api.CreateCompetition(ctx, client.CreateCompetitionRequest{
+				Name: "Liga", Format: client.FormatGroupKnockout, StartDatetime: time.Date(2024, 8, 1, 19, 0, 0, 0, time.UTC),
+				GroupCount: 1, QualifiersPerGroup: 2, TeamIDs: ids,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := b.api.DrawCompetition(ctx, competition.ID, nil); err != nil {
+				t.Fatal(err)
+			}
+			competitionID := competition.ID
+
+			c := cli{t: t, backend: b.backend}
+			groups := decodeOutput[[]client.Group](t, c.ok(outputJSON, "standings", "show", "-competition", uintString(competitionID)))
+			if len(groups) != 1 || len(groups[0].Table) != 4 || groups[0].Table[0].Position != 1 || len(groups[0].Matches) != 6 {
+				t.Fatalf("groups = %+v", groups)
+			}
+
+			table := c.ok(outputTable, "standings", "show", "-competition", uintString(competitionID))
+			if !strings.Contains(table, "GROUP A") || !strings.Contains(table, "Bali United") {
+				t.Errorf("standings output = %q", table)
+			}
+		})
+	}
+}
+
+func TestRejectedInput(t *testing.T) {
+	for name, b := range backends(t) {
+		t.Run(name, func(t *testing.T) {
+			c := cli{t: t, backend: b.backend}
+			c.ok(outputTable, "teams", "create", "-name", "Persija")
+			c.ok(outputTable, "teams", "create", "-name", "Persib")
+			match := decodeOutput[client.Match](t, c.ok(outputJSON, "matches", "schedule", "-home", "1", "-away", "2", "-at", "2024-08-17T19:00:00+07:00"))
+			id := uintString(match.ID)
+
+			// Mode lokal memvalidasi input dengan aturan yang sama seperti API
+			tests := []struct {
+				name  string
+				args  []string
+				field string
+			}{
+				{"goal minute too late", []string{"-score", "1-0", "-goal", "7:500"}, "goals[0].goal_time"},
+				{"negative goal minute", []string{"-score", "1-0", "-goal", "7:-3"}, "goals[0].goal_time"},
+				{"negative attendance", []string{"-score", "0-0", "-attendance", "-10"}, "attendance"},
+			}
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					args := append([]string{"matches", "report-result", "-match", id}, tt.args...)
+					_, stderr, code := c.run(outputTable, args...)
+					if code != 1 || !strings.Contains(stderr, "validation_failed") || !strings.Contains(stderr, tt.field) {
+						t.Errorf("exit %d, stderr = %q, want validation_failed on %s", code, stderr, tt.field)
+					}
+				})
+			}
+
+			// Skor adu penalti negatif sudah ditolak parser flag, jadi backend dipanggil langsung
+			err := b.backend.ReportMatchResult(context.Background(), match.ID, client.ReportMatchResultRequest{
+				Goals: []client.GoalRequest{}, Penalties: &client.PenaltyShootout{Home: -1, Away: 3},
+			})
+			if client.ErrorCode(err) != "validation_failed" {
+				t.Errorf("negative penalties: err = %v, want validation_failed", err)
+			}
+
+			_, stderr, code := c.run(outputTable, "teams", "create", "-name", "")
+			if code != 1 || !strings.Contains(stderr, "validation_failed") {
+				t.Errorf("empty team name: exit %d, stderr = %q", code, stderr)
+			}
+		})
+	}
+}
+
+func TestOutbox(t *testing.T) {
+	for name, b := range backends(t) {
+		t.Run(name, func(t *testing.T) {
+			var rows []model.OutboxEvent
+			for _, id := range []string{"event-parkir-1", "event-parkir-2"} {
+				rows = append(rows, model.OutboxEvent{EventID: id, EventType: client.EventTeamDeleted, Payload: "{}", OccurredAt: time.Now().UTC()})
+			}
+			if err := b.repos.Outbox.Append(rows); err != nil {
+				t.Fatal(err)
+			}
+			for _, row := range rows {
+				if err := b.repos.Outbox.Park(row.ID, "payload tidak bisa diproses", time.Now().UTC()); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			c := cli{t: t, backend: b.backend}
+			table := c.ok(outputTable, "outbox", "parked")
+			if !strings.Contains(table, "event-parkir-2") || !strings.Contains(table, "payload tidak bisa diproses") {
+				t.Errorf("outbox parked output = %q", table)
+			}
+
+			if out := c.ok(outputTable, "outbox", "requeue", "-id", uintString(rows[0].ID)); !strings.Contains(out, "1 outbox event(s) requeued") {
+				t.Errorf("outbox requeue output = %q", out)
+			}
+			parked := decodeOutput[[]client.OutboxEvent](t, c.ok(outputJSON, "outbox", "parked"))
+			if len(parked) != 1 || parked[0].EventID != "event-parkir-2" {
+				t.Fatalf("parked = %+v", parked)
+			}
+
+			c.ok(outputTable, "outbox", "requeue")
+			if parked := decodeOutput[[]client.OutboxEvent](t, c.ok(outputJSON, "outbox", "parked")); len(parked) != 0 {
+				t.Errorf("parked setelah requeue = %+v", parked)
+			}
+		})
+	}
+}
+
+func TestUsageErrors(t *testing.T) {
+	c := cli{t: t, backend: newLocalBackend(memory.NewRepositories(), "admin", "id")}
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"unknown command", []string{"teams", "delete"}, `unknown command "teams delete"`},
+		{"missing option", []string{"teams", "create"}, "-name is required"},
+		{"unexpected argument", []string{"teams", "list", "extra"}, `unexpected argument "extra"`},
+		{"invalid time", []string{"matches", "schedule", "-home", "1", "-away", "2", "-at", "besok"}, "-at must be an RFC 3339 time"},
+		{"invalid score", []string{"matches", "report-result", "-match", "1", "-score", "2:1"}, "-score must be HOME-AWAY"},
+		{"missing password", []string{"users", "create", "-username", "operator"}, "-password or -password-stdin is required"},
+		{"invalid event ID", []string{"outbox", "requeue", "-id", "abc"}, "must be a positive event ID"},
+		{"negative penalties", []string{"matches", "report-result", "-match", "1", "-score", "1-1", "-penalties", "-1-3"}, "-penalties must be HOME-AWAY"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, stderr, code := c.run(outputTable, tt.args...)
+			if code != 2 || !strings.Contains(stderr, tt.want) {
+				t.Errorf("exit %d, stderr = %q, want exit 2 with %q", code, stderr, tt.want)
+			}
+		})
+	}
+
+	if _, err := newPrinter(io.Discard, "yaml"); report(io.Discard, err) != 2 {
+		t.Error("mode output tidak dikenal harus menjadi usage error")
+	}
+}
+
+func uintString(v uint) string {
+	return strconv.FormatUint(uint64(v), 10)
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+	"xyz-football-api/pkg/client"
+)
+
+// Mode output
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+// printer mencetak hasil subcommand sebagai tabel yang mudah dibaca atau JSON yang
+// bentuknya sama dengan response API (untuk diproses jq atau script lain)
+type printer struct {
+	w    io.Writer
+	json bool
+}
+
+// newPrinter membuat printer untuk mode output table atau json
+func newPrinter(w io.Writer, mode string) (*printer, error) {
+	switch mode {
+	case outputTable:
+		return &printer{w: w}, nil
+	case outputJSON:
+		return &printer{w: w, json: true}, nil
+	default:
+		return nil, usageErrorf("unknown output mode %q (use table or json)", mode)
+	}
+}
+
+// print mencetak v sebagai JSON, atau memanggil table jika mode output adalah tabel
+func (p *printer) print(v interface{}, table func(t *tabwriter.Writer)) error {
+	if p.json {
+		encoder := json.NewEncoder(p.w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(v)
+	}
+
+	t := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)
+	table(t)
+	return t.Flush()
+}
+
+// row menulis satu baris tabel dengan kolom dipisah tab
+func row(t *tabwriter.Writer, columns ...interface{}) {
+	values := make([]string, len(columns))
+	for i, column := range columns {
+		values[i] = fmt.Sprint(column)
+	}
+	fmt.Fprintln(t, strings.Join(values, "\t"))
+}
+
+// teams mencetak daftar team
+func (p *printer) teams(teams []client.Team) error {
+	if teams == nil {
+		teams = []client.Team{}
+	}
+	return p.print(teams, func(t *tabwriter.Writer) {
+		row(t, "ID", "NAME", "FOUNDED", "CITY", "HOME VENUE")
+		for _, team := range teams {
+			venue := "-"
+			if team.HomeVenue != nil {
+				venue = team.HomeVenue.Name
+			} else if team.HomeVenueID != nil {
+				venue = fmt.Sprintf("#%d", *team.HomeVenueID)
+			}
+			row(t, team.ID, team.Name, optional(team.FoundedYear), optional(team.HeadquartersCity), venue)
+		}
+	})
+}
+
+// importResult mencetak ringkasan import beserta kesalahan per baris
+func (p *printer) importResult(result *client.ImportResult) error {
+	return p.print(result, func(t *tabwriter.Writer) {
+		row(t, "ROWS", "IMPORTED", "DRY RUN")
+		row(t, result.TotalRows, result.Imported, result.DryRun)
+		if len(result.Errors) == 0 {
+			return
+		}
+		row(t)
+		row(t, "ROW", "FIELD", "ERROR")
+		for _, rowErr := range result.Errors {
+			for _, field := range rowErr.Fields {
+				row(t, rowErr.Row, field.Field, field.Message)
+			}
+		}
+	})
+}
+
+// match mencetak satu match
+func (p *printer) match(match *client.Match) error {
+	return p.print(match, func(t *tabwriter.Writer) {
+		row(t, "ID", "KICKOFF", "HOME", "AWAY", "VENUE", "STATUS")
+		venue := "-"
+		if match.Venue != nil {
+			venue = match.Venue.Name
+		}
+		row(t, match.ID, match.MatchDatetime.Format(time.RFC3339), teamName(match.HomeTeam, match.HomeTeamID),
+			teamName(match.AwayTeam, match.AwayTeamID), venue, match.Status)
+	})
+}
+
+// standings mencetak klasemen setiap grup, dipisah baris kosong
+func (p *printer) standings(groups []client.Group) error {
+	return p.print(groups, func(t *tabwriter.Writer) {
+		for i, group := range groups {
+			if i > 0 {
+				row(t)
+			}
+			row(t, "GROUP "+group.Name)
+			row(t, "POS", "TEAM", "P", "W", "D", "L", "GF", "GA", "GD", "PTS")
+			for _, r := range group.Table {
+				row(t, r.Position, r.TeamName, r.Played, r.Won, r.Drawn, r.Lost, r.GoalsFor, r.GoalsAgainst, r.GoalDifference, r.Points)
+			}
+		}
+	})
+}
+
+// user mencetak user yang baru dibuat
+func (p *printer) user(user *client.User) error {
+	return p.print(user, func(t *tabwriter.Writer) {
+		row(t, "ID", "USERNAME", "CREATED AT")
+		row(t, user.ID, user.Username, user.CreatedAt.Format(time.RFC3339))
+	})
+}
+
+// outboxEvents mencetak event outbox yang diparkir
+func (p *printer) outboxEvents(events []client.OutboxEvent) error {
+	if events == nil {
+		events = []client.OutboxEvent{}
+	}
+	return p.print(events, func(t *tabwriter.Writer) {
+		row(t, "ID", "EVENT", "TYPE", "ATTEMPTS", "PARKED AT", "LAST ERROR")
+		for _, e := range events {
+			parkedAt := "-"
+			if e.ParkedAt != nil {
+				parkedAt = e.ParkedAt.Format(time.RFC3339)
+			}
+			row(t, e.ID, e.EventID, e.EventType, e.Attempts, parkedAt, optional(e.LastError))
+		}
+	})
+}
+
+// message mencetak pesan konfirmasi untuk operasi yang tidak mengembalikan data
+func (p *printer) message(message string) error {
+	if p.json {
+		return p.print(map[string]string{"message": message}, nil)
+	}
+	_, err := fmt.Fprintln(p.w, message)
+	return err
+}
+
+// teamName mengembalikan nama team jika relasinya ikut dimuat, atau ID-nya
+func teamName(team *client.Team, id uint) string {
+	if team != nil && team.Name != "" {
+		return team.Name
+	}
+	return fmt.Sprintf("#%d", id)
+}
+
+// optional mengembalikan nilai pointer, atau "-" jika nil
+func optional[T any](v *T) string {
+	if v == nil {
+		return "-"
+	}
+	return fmt.Sprint(*v)
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+	"xyz-football-api/internal/event"
+	"xyz-football-api/pkg/database"
+
+	"google.golang.org/grpc"
+)
+
+// background adalah worker yang berjalan di goroutine sendiri sampai dihentikan
+type background struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// startBackground menjalankan run di goroutine baru. run harus kembali setelah ctx dibatalkan.
+func startBackground(run func(ctx context.Context)) *background {
+	ctx, cancel := context.WithCancel(context.Background())
+	b := &background{cancel: cancel, done: make(chan struct{})}
+	go func() {
+		defer close(b.done)
+		run(ctx)
+	}()
+	return b
+}
+
+// stop membatalkan worker lalu menunggu putaran yang sedang berjalan selesai, paling lama sampai ctx berakhir
+func (b *background) stop(ctx context.Context) error {
+	b.cancel()
+	select {
+	case <-b.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// servers adalah komponen yang dihentikan saat shutdown
+type servers struct {
+	bus      *event.Bus
+	http     *http.Server
+	grpc     *grpc.Server
+	outbox   *background
+	webhooks *background
+}
+
+// shutdown menghentikan semua komponen secara berurutan dalam batas timeout:
+//  1. bus ditutup sehingga koneksi WebSocket dan stream WatchMatch berakhir dan client menyambung ulang
+//  2. server HTTP dan gRPC berhenti menerima koneksi baru lalu menunggu request yang sedang berjalan
+//  3. dispatcher outbox, lalu worker webhook, berhenti setelah putaran yang sedang berjalan
+//  4. connection pool database ditutup
+//
+// Jika timeout terlewati, server dihentikan paksa. Event yang belum terkirim tetap tersimpan
+// di outbox dan antrean webhook sehingga dikirim setelah server berjalan lagi.
+func shutdown(s servers, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	s.bus.Close()
+	log.Println("✓ Live connections closed")
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		if err := s.http.Shutdown(ctx); err != nil {
+			log.Printf("❌ HTTP server did not drain in time, closing remaining connections: %v", err)
+			s.http.Close()
+			return
+		}
+		log.Println("✓ HTTP server stopped")
+	}()
+	go func() {
+		defer wg.Done()
+		stopped := make(chan struct{})
+		go func() {
+			s.grpc.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+			log.Println("✓ gRPC server stopped")
+		case <-ctx.Done():
+			log.Println("❌ gRPC server did not drain in time, closing remaining connections")
+			s.grpc.Stop()
+		}
+	}()
+	wg.Wait()
+
+	if err := s.outbox.stop(ctx); err != nil {
+		log.Printf("❌ Outbox dispatcher did not stop in time: %v", err)
+	} else {
+		log.Println("✓ Outbox dispatcher stopped")
+	}
+	if err := s.webhooks.stop(ctx); err != nil {
+		log.Printf("❌ Webhook worker did not stop in time: %v", err)
+	} else {
+		log.Println("✓ Webhook worker stopped")
+	}
+
+	if err := database.Close(); err != nil {
+		log.Printf("❌ Failed to close database: %v", err)
+		return
+	}
+	log.Println("✓ Database connections closed")
+}
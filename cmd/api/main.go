@@ -1,13 +1,32 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 	"xyz-football-api/config"
 	"xyz-football-api/internal/api"
+	"xyz-football-api/internal/event"
+	"xyz-football-api/internal/grpcapi"
+	"xyz-football-api/internal/repository"
+	"xyz-football-api/internal/service"
 	"xyz-football-api/pkg/database"
 )
 
+// @title XYZ Football API
+// @version 1.0.0
+// @description REST API untuk manajemen team, player, match, dan kompetisi sepak bola.
+// @description Semua endpoint kecuali /health, /login, /openapi.json, /docs, dan feed kalender memerlukan token JWT dari POST /login.
+// @description Pesan error mengikuti header Accept-Language (id atau en).
+// @securityDefinitions.bearer BearerAuth
+// @bearerFormat JWT
+// @description Token dari POST /login, dikirim sebagai header Authorization: Bearer <token>
 func main() {
 	// ASCII Art Banner
 	banner := `
@@ -22,6 +41,16 @@ func main() {
 	`
 	fmt.Println(banner)
 
+	// Subcommand migrate dan seed tidak menjalankan server
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		runMigrate(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "seed" {
+		runSeed(os.Args[2:])
+		return
+	}
+
 	// Load configuration
 	log.Println("⏳ Loading configuration...")
 	cfg, err := config.LoadConfig()
@@ -36,24 +65,84 @@ func main() {
 		log.Fatalf("❌ Failed to connect to database: %v", err)
 	}
 
-	// Run auto migration
-	log.Println("⏳ Running database migrations...")
-	if err := database.AutoMigrate(); err != nil {
-		log.Fatalf("❌ Failed to run migrations: %v", err)
+	// Pastikan skema database sudah up to date (tidak ada auto migration)
+	log.Println("⏳ Checking database schema...")
+	if err := database.CheckSchema(); err != nil {
+		log.Fatalf("❌ %v. Run `football-api migrate up` first", err)
 	}
+	log.Println("✓ Database schema is up to date")
 
 	// Setup router
 	log.Println("⏳ Setting up routes...")
-	router := api.SetupRouter(cfg, database.GetDB())
+	repos := repository.NewRepositories(database.GetDB())
+	bus := event.NewBus()
+	router := api.SetupRouter(cfg, repos, bus)
 	log.Println("✓ Routes configured successfully")
 
+	// Worker pengiriman webhook berjalan di background selama server hidup
+	webhookWorker := service.NewWebhookWorker(repos.Webhooks, &http.Client{Timeout: cfg.Webhook.Timeout}, cfg.Webhook.MaxAttempts)
+	webhooks := startBackground(func(ctx context.Context) { webhookWorker.Run(ctx, cfg.Webhook.PollInterval) })
+	log.Println("✓ Webhook worker started")
+
+	// Dispatcher meneruskan event dari outbox ke publisher urut ID; event yang terus gagal diparkir.
+	// Adapter message broker (event.BrokerPublisher) bisa ditambahkan ke daftar ini.
+	publishers := event.Publishers{service.NewWebhookService(repos.Webhooks), service.NewLiveFeed(repos.Matches, bus)}
+	if cfg.Outbox.LogEvents {
+		publishers = append(publishers, event.LogPublisher{})
+	}
+	outboxDispatcher := service.NewOutboxDispatcher(repos.Outbox, publishers, cfg.Outbox.ParkAfter)
+	outbox := startBackground(func(ctx context.Context) { outboxDispatcher.Run(ctx, cfg.Outbox.PollInterval) })
+	log.Println("✓ Outbox dispatcher started")
+
+	// SIGINT/SIGTERM maupun kegagalan server mana pun menghentikan proses lewat jalur shutdown yang sama
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	serverErrs := make(chan error, 2)
+
+	// Server gRPC berjalan di port terpisah dengan service, validasi, dan JWT yang sama seperti REST
+	grpcListener, err := net.Listen("tcp", fmt.Sprintf(":%s", cfg.GRPC.Port))
+	if err != nil {
+		log.Fatalf("❌ Failed to listen on gRPC port: %v", err)
+	}
+	grpcServer := grpcapi.NewServer(cfg, repos, bus)
+	go func() {
+		if err := grpcServer.Serve(grpcListener); err != nil {
+			serverErrs <- fmt.Errorf("gRPC server stopped: %w", err)
+		}
+	}()
+	log.Printf("✓ gRPC server is running on port %s", cfg.GRPC.Port)
+
 	// Start server
 	serverAddress := fmt.Sprintf(":%s", cfg.Server.Port)
+	httpServer := &http.Server{
+		Addr:              serverAddress,
+		Handler:           router,
+		ReadHeaderTimeout: cfg.Server.ReadHeaderTimeout,
+		ReadTimeout:       cfg.Server.ReadTimeout,
+		WriteTimeout:      cfg.Server.WriteTimeout,
+		IdleTimeout:       cfg.Server.IdleTimeout,
+	}
+	go func() {
+		if err := httpServer.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			serverErrs <- fmt.Errorf("failed to start server: %w", err)
+		}
+	}()
 	log.Printf("\n🚀 Server is running on http://localhost%s\n", serverAddress)
 	log.Println("📝 Press CTRL+C to stop the server")
 	log.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
 
-	if err := router.Run(serverAddress); err != nil {
-		log.Fatalf("❌ Failed to start server: %v", err)
+	// Tunggu SIGINT/SIGTERM atau kegagalan server, lalu selesaikan request yang sedang berjalan sebelum keluar
+	exitCode := 0
+	select {
+	case <-ctx.Done():
+		log.Printf("⏳ Shutting down (up to %s, press CTRL+C again to force)...", cfg.Server.ShutdownTimeout)
+	case err := <-serverErrs:
+		log.Printf("❌ %v", err)
+		exitCode = 1
 	}
+	// Sinyal berikutnya kembali ke perilaku default: proses langsung berhenti
+	stop()
+
+	shutdown(servers{bus: bus, http: httpServer, grpc: grpcServer, outbox: outbox, webhooks: webhooks}, cfg.Server.ShutdownTimeout)
+	log.Println("👋 Server stopped")
+	os.Exit(exitCode)
 }
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"xyz-football-api/config"
+	"xyz-football-api/pkg/database"
+)
+
+// migrateUsage adalah bantuan untuk subcommand migrate
+const migrateUsage = `Usage: football-api migrate <command> [options]
+
+Commands:
+  up                 Run all pending migrations
+  down [-steps N]    Roll back the last N applied migrations (default 1)
+  status             Show applied and pending migrations
+  create <name>      Create a new empty up/down migration pair for every dialect (-dir to override location)
+`
+
+// runMigrate menjalankan subcommand migrate up|down|status|create
+func runMigrate(args []string) {
+	if len(args) == 0 {
+		fmt.Print(migrateUsage)
+		os.Exit(2)
+	}
+
+	command, args := args[0], args[1:]
+	switch command {
+	case "create":
+		flags := flag.NewFlagSet("migrate create", flag.ExitOnError)
+		dir := flags.String("dir", database.MigrationsDir, "directory of migration files")
+		flags.Parse(args)
+		if flags.NArg() != 1 {
+			log.Fatal("❌ Usage: football-api migrate create [-dir DIR] <name>")
+		}
+
+		files, err := database.CreateMigration(*dir, flags.Arg(0))
+		if err != nil {
+			log.Fatalf("❌ Failed to create migration: %v", err)
+		}
+		for _, file := range files {
+			log.Printf("✓ Created %s", file)
+		}
+		return
+	case "up", "down", "status":
+	default:
+		fmt.Print(migrateUsage)
+		os.Exit(2)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("❌ Failed to load config: %v", err)
+	}
+
+	if err := database.InitDatabase(&cfg.Database); err != nil {
+		log.Fatalf("❌ Failed to connect to database: %v", err)
+	}
+
+	migrator, err := database.NewMigrator(database.GetDB())
+	if err != nil {
+		log.Fatalf("❌ Failed to load migrations: %v", err)
+	}
+
+	switch command {
+	case "up":
+		ran, err := migrator.Up()
+		if err != nil {
+			log.Fatalf("❌ %v", err)
+		}
+		log.Printf("✓ %d migration(s) applied", len(ran))
+	case "down":
+		flags := flag.NewFlagSet("migrate down", flag.ExitOnError)
+		steps := flags.Int("steps", 1, "number of migrations to roll back")
+		flags.Parse(args)
+		if *steps < 1 {
+			log.Fatal("❌ -steps must be at least 1")
+		}
+
+		rolledBack, err := migrator.Down(*steps)
+		if err != nil {
+			log.Fatalf("❌ %v", err)
+		}
+		log.Printf("✓ %d migration(s) rolled back", len(rolledBack))
+	case "status":
+		statuses, err := migrator.Status()
+		if err != nil {
+			log.Fatalf("❌ %v", err)
+		}
+
+		fmt.Printf("%-8s %-40s %-8s %s\n", "VERSION", "NAME", "STATUS", "APPLIED AT")
+		fmt.Println(strings.Repeat("-", 80))
+		for _, status := range statuses {
+			state, appliedAt := "pending", "-"
+			if status.Applied {
+				state, appliedAt = "applied", status.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%04d     %-40s %-8s %s\n", status.Version, status.Name, state, appliedAt)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"time"
+	"xyz-football-api/config"
+	"xyz-football-api/internal/repository"
+	"xyz-football-api/internal/service"
+	"xyz-football-api/pkg/database"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// runSeed menjalankan subcommand seed: membuat liga demo yang deterministik dari nilai seed.
+// Aman dijalankan ulang; data yang sudah ada dipakai ulang, bukan diduplikasi.
+func runSeed(args []string) {
+	flags := flag.NewFlagSet("seed", flag.ExitOnError)
+	seed := flags.Int64("seed", 1, "seed value; the same seed always generates the same league")
+	teams := flags.Int("teams", 10, "number of teams")
+	start := flags.String("start", "2024-08-03", "date of the first matchday (YYYY-MM-DD)")
+	played := flags.Int("played", -1, "number of matchdays with reported results (-1 = all)")
+	flags.Parse(args)
+
+	if *teams < service.MinSeedTeams || *teams > service.MaxSeedTeams {
+		log.Fatalf("❌ -teams must be between %d and %d", service.MinSeedTeams, service.MaxSeedTeams)
+	}
+	startDate, err := time.Parse(time.DateOnly, *start)
+	if err != nil {
+		log.Fatal("❌ -start must be a date in YYYY-MM-DD format")
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("❌ Failed to load config: %v", err)
+	}
+
+	if err := database.InitDatabase(&cfg.Database); err != nil {
+		log.Fatalf("❌ Failed to connect to database: %v", err)
+	}
+	if err := database.CheckSchema(); err != nil {
+		log.Fatalf("❌ %v. Run `football-api migrate up` first", err)
+	}
+
+	// Seed menjalankan ratusan insert; log SQL per query tidak berguna di sini
+	db := database.GetDB().Session(&gorm.Session{Logger: logger.Discard})
+	repos := repository.NewRepositories(db)
+
+	log.Printf("⏳ Seeding league (seed %d, %d teams)...", *seed, *teams)
+	result, err := service.NewSeedService(repos.Transactor).Seed(service.SeedOptions{
+		Seed:   *seed,
+		Teams:  *teams,
+		Start:  startDate,
+		Played: *played,
+	})
+	if err != nil {
+		log.Fatalf("❌ Failed to seed: %v", err)
+	}
+	log.Printf("✓ Created %d venue(s), %d team(s), %d player(s), %d match(es), %d result(s)",
+		result.Venues, result.Teams, result.Players, result.Matches, result.Results)
+}
@@ -0,0 +1,51 @@
+// Command openapi membuat spesifikasi OpenAPI 3 dari anotasi handler.
+//
+// Penggunaan (dari root module):
+//
+//	go run ./cmd/openapi -o internal/api/docs/openapi.json
+//
+// Dengan -check, command hanya membandingkan hasil generate dengan file output
+// dan keluar dengan status 1 jika berbeda (untuk CI).
+package main
+
+import (
+	"bytes"
+	"flag"
+	"log"
+	"os"
+	"xyz-football-api/internal/api/docs"
+	"xyz-football-api/pkg/openapi"
+)
+
+func main() {
+	root := flag.String("root", ".", "Root module (direktori berisi go.mod)")
+	output := flag.String("o", "internal/api/docs/openapi.json", "File output")
+	check := flag.Bool("check", false, "Hanya periksa apakah file output sudah up to date")
+	flag.Parse()
+	log.SetFlags(0)
+
+	doc, err := openapi.Generate(*root, docs.Sources)
+	if err != nil {
+		log.Fatalf("gagal generate OpenAPI: %v", err)
+	}
+	data, err := doc.MarshalIndent()
+	if err != nil {
+		log.Fatalf("gagal encode OpenAPI: %v", err)
+	}
+
+	if *check {
+		current, err := os.ReadFile(*output)
+		if err != nil {
+			log.Fatalf("gagal membaca %s: %v", *output, err)
+		}
+		if !bytes.Equal(current, data) {
+			log.Fatalf("%s tidak up to date, jalankan go generate ./internal/api/docs", *output)
+		}
+		return
+	}
+
+	if err := os.WriteFile(*output, data, 0o644); err != nil {
+		log.Fatalf("gagal menulis %s: %v", *output, err)
+	}
+	log.Printf("%d path ditulis ke %s", len(doc.Paths), *output)
+}
@@ -5,25 +5,55 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
 // Config menyimpan semua konfigurasi aplikasi
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	JWT      JWTConfig
-	Admin    AdminConfig
+	Server    ServerConfig
+	Database  DatabaseConfig
+	JWT       JWTConfig
+	Admin     AdminConfig
+	Webhook   WebhookConfig
+	Outbox    OutboxConfig
+	WebSocket WebSocketConfig
+	GraphQL   GraphQLConfig
+	GRPC      GRPCConfig
 }
 
-// ServerConfig berisi konfigurasi server
+// ServerConfig berisi konfigurasi server HTTP dan lifecycle proses
 type ServerConfig struct {
 	Port string
+	// ReadHeaderTimeout adalah batas waktu membaca header request
+	ReadHeaderTimeout time.Duration
+	// ReadTimeout adalah batas waktu membaca seluruh request termasuk body.
+	// Upload /import melepas batas ini per request.
+	ReadTimeout time.Duration
+	// WriteTimeout adalah batas waktu sejak header request dibaca sampai response selesai ditulis.
+	// Unduhan /export, PDF, dan feed iCalendar melepas batas ini per request; koneksi WebSocket
+	// memakai WS_WRITE_TIMEOUT per pesan.
+	WriteTimeout time.Duration
+	// IdleTimeout adalah batas waktu koneksi keep-alive menunggu request berikutnya
+	IdleTimeout time.Duration
+	// ShutdownTimeout adalah batas waktu menyelesaikan request yang sedang berjalan dan
+	// menghentikan worker setelah SIGINT/SIGTERM sebelum server dihentikan paksa
+	ShutdownTimeout time.Duration
 }
 
-// DatabaseConfig berisi konfigurasi database PostgreSQL
+// Driver database yang didukung
+const (
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+)
+
+// DatabaseConfig berisi konfigurasi database PostgreSQL atau SQLite
 type DatabaseConfig struct {
+	Driver string
+
+	// Host sampai Timezone hanya dipakai oleh driver postgres
 	Host     string
 	Port     string
 	User     string
@@ -31,6 +61,9 @@ type DatabaseConfig struct {
 	DBName   string
 	SSLMode  string
 	Timezone string
+
+	// SQLitePath adalah lokasi file database SQLite (":memory:" untuk database in-memory)
+	SQLitePath string
 }
 
 // JWTConfig berisi konfigurasi JSON Web Token
@@ -45,6 +78,55 @@ type AdminConfig struct {
 	Password string
 }
 
+// WebhookConfig berisi konfigurasi worker pengiriman webhook
+type WebhookConfig struct {
+	// PollInterval adalah jeda antar pemeriksaan antrean pengiriman
+	PollInterval time.Duration
+	// Timeout adalah batas waktu satu request ke URL penerima
+	Timeout time.Duration
+	// MaxAttempts adalah jumlah percobaan sebelum pengiriman ditandai failed
+	MaxAttempts int
+}
+
+// OutboxConfig berisi konfigurasi dispatcher outbox event
+type OutboxConfig struct {
+	// PollInterval adalah jeda antar pemeriksaan event yang belum dipublikasikan
+	PollInterval time.Duration
+	// LogEvents menambahkan publisher yang mencatat setiap event ke log
+	LogEvents bool
+	// ParkAfter adalah lama event terus gagal dipublikasikan sebelum diparkir dan dilewati
+	ParkAfter time.Duration
+}
+
+// WebSocketConfig berisi konfigurasi koneksi WebSocket /ws
+type WebSocketConfig struct {
+	// PingInterval adalah jeda heartbeat; koneksi ditutup jika client diam lebih dari dua kali interval ini
+	PingInterval time.Duration
+	// WriteTimeout adalah batas waktu menulis satu pesan ke client
+	WriteTimeout time.Duration
+	// BufferSize adalah jumlah event yang boleh tertunda per koneksi sebelum client dianggap terlalu lambat
+	BufferSize int
+	// AllowedOrigins adalah origin browser (mis. "https://app.example.com") yang boleh membuka koneksi
+	// selain origin API sendiri; "*" mengizinkan semua origin
+	AllowedOrigins []string
+}
+
+// GraphQLConfig berisi batas query endpoint /graphql. Nilai 0 menonaktifkan batas.
+type GraphQLConfig struct {
+	// MaxDepth adalah kedalaman selection maksimum satu operasi
+	MaxDepth int
+	// MaxComplexity adalah perkiraan jumlah field maksimum yang di-resolve satu operasi
+	MaxComplexity int
+}
+
+// GRPCConfig berisi konfigurasi server gRPC yang berjalan di port terpisah dari REST
+type GRPCConfig struct {
+	// Port adalah port server gRPC
+	Port string
+	// StreamBufferSize adalah jumlah update yang boleh tertunda per stream WatchMatch sebelum client dianggap terlalu lambat
+	StreamBufferSize int
+}
+
 // LoadConfig membaca file .env dan mengembalikan struktur Config
 func LoadConfig() (*Config, error) {
 	// Load .env file
@@ -58,18 +140,50 @@ func LoadConfig() (*Config, error) {
 		jwtExpHours = 24
 	}
 
+	webhookMaxAttempts, err := strconv.Atoi(getEnv("WEBHOOK_MAX_ATTEMPTS", "6"))
+	if err != nil || webhookMaxAttempts < 1 {
+		webhookMaxAttempts = 6
+	}
+
+	wsBufferSize, err := strconv.Atoi(getEnv("WS_BUFFER_SIZE", "64"))
+	if err != nil || wsBufferSize < 1 {
+		wsBufferSize = 64
+	}
+
+	graphQLMaxDepth, err := strconv.Atoi(getEnv("GRAPHQL_MAX_DEPTH", "10"))
+	if err != nil || graphQLMaxDepth < 0 {
+		graphQLMaxDepth = 10
+	}
+
+	graphQLMaxComplexity, err := strconv.Atoi(getEnv("GRAPHQL_MAX_COMPLEXITY", "10000"))
+	if err != nil || graphQLMaxComplexity < 0 {
+		graphQLMaxComplexity = 10000
+	}
+
+	grpcStreamBufferSize, err := strconv.Atoi(getEnv("GRPC_STREAM_BUFFER_SIZE", "64"))
+	if err != nil || grpcStreamBufferSize < 1 {
+		grpcStreamBufferSize = 64
+	}
+
 	config := &Config{
 		Server: ServerConfig{
-			Port: getEnv("SERVER_PORT", "8080"),
+			Port:              getEnv("SERVER_PORT", "8080"),
+			ReadHeaderTimeout: getDuration("SERVER_READ_HEADER_TIMEOUT", 10*time.Second),
+			ReadTimeout:       getDuration("SERVER_READ_TIMEOUT", 30*time.Second),
+			WriteTimeout:      getDuration("SERVER_WRITE_TIMEOUT", 60*time.Second),
+			IdleTimeout:       getDuration("SERVER_IDLE_TIMEOUT", 120*time.Second),
+			ShutdownTimeout:   getDuration("SERVER_SHUTDOWN_TIMEOUT", 30*time.Second),
 		},
 		Database: DatabaseConfig{
-			Host:     getEnv("DB_HOST", "localhost"),
-			Port:     getEnv("DB_PORT", "5432"),
-			User:     getEnv("DB_USER", "postgres"),
-			Password: getEnv("DB_PASSWORD", ""),
-			DBName:   getEnv("DB_NAME", "football_db"),
-			SSLMode:  getEnv("DB_SSLMODE", "disable"),
-			Timezone: getEnv("DB_TIMEZONE", "Asia/Jakarta"),
+			Driver:     getEnv("DB_DRIVER", DriverPostgres),
+			Host:       getEnv("DB_HOST", "localhost"),
+			Port:       getEnv("DB_PORT", "5432"),
+			User:       getEnv("DB_USER", "postgres"),
+			Password:   getEnv("DB_PASSWORD", ""),
+			DBName:     getEnv("DB_NAME", "football_db"),
+			SSLMode:    getEnv("DB_SSLMODE", "disable"),
+			Timezone:   getEnv("DB_TIMEZONE", "Asia/Jakarta"),
+			SQLitePath: getEnv("DB_SQLITE_PATH", "football.db"),
 		},
 		JWT: JWTConfig{
 			Secret:          getEnv("JWT_SECRET", "default_secret_change_this"),
@@ -79,11 +193,44 @@ func LoadConfig() (*Config, error) {
 			Username: getEnv("ADMIN_USERNAME", "admin"),
 			Password: getEnv("ADMIN_PASSWORD", "admin123"),
 		},
+		Webhook: WebhookConfig{
+			PollInterval: getDuration("WEBHOOK_POLL_INTERVAL", 5*time.Second),
+			Timeout:      getDuration("WEBHOOK_TIMEOUT", 10*time.Second),
+			MaxAttempts:  webhookMaxAttempts,
+		},
+		Outbox: OutboxConfig{
+			PollInterval: getDuration("OUTBOX_POLL_INTERVAL", time.Second),
+			LogEvents:    getEnv("EVENT_LOG", "false") == "true",
+			ParkAfter:    getDuration("OUTBOX_PARK_AFTER", 24*time.Hour),
+		},
+		WebSocket: WebSocketConfig{
+			PingInterval:   getDuration("WS_PING_INTERVAL", 30*time.Second),
+			WriteTimeout:   getDuration("WS_WRITE_TIMEOUT", 10*time.Second),
+			BufferSize:     wsBufferSize,
+			AllowedOrigins: getList("WS_ALLOWED_ORIGINS"),
+		},
+		GraphQL: GraphQLConfig{
+			MaxDepth:      graphQLMaxDepth,
+			MaxComplexity: graphQLMaxComplexity,
+		},
+		GRPC: GRPCConfig{
+			Port:             getEnv("GRPC_PORT", "9090"),
+			StreamBufferSize: grpcStreamBufferSize,
+		},
 	}
 
 	// Validasi konfigurasi penting
-	if config.Database.Password == "" {
-		return nil, fmt.Errorf("DB_PASSWORD tidak boleh kosong")
+	switch config.Database.Driver {
+	case DriverPostgres:
+		if config.Database.Password == "" {
+			return nil, fmt.Errorf("DB_PASSWORD tidak boleh kosong")
+		}
+	case DriverSQLite:
+		if config.Database.SQLitePath == "" {
+			return nil, fmt.Errorf("DB_SQLITE_PATH tidak boleh kosong")
+		}
+	default:
+		return nil, fmt.Errorf("DB_DRIVER tidak dikenal: %s (gunakan %s atau %s)", config.Database.Driver, DriverPostgres, DriverSQLite)
 	}
 
 	if config.JWT.Secret == "default_secret_change_this" {
@@ -108,3 +255,25 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+// getDuration membaca environment variable berformat durasi Go (mis. "5s", "1m")
+// atau mengembalikan nilai default jika kosong/tidak valid
+func getDuration(key string, defaultValue time.Duration) time.Duration {
+	value, err := time.ParseDuration(getEnv(key, ""))
+	if err != nil || value <= 0 {
+		return defaultValue
+	}
+	return value
+}
+
+// getList membaca environment variable berisi daftar yang dipisahkan koma.
+// Item kosong dibuang dan spasi di sekitar item dipangkas.
+func getList(key string) []string {
+	var items []string
+	for _, item := range strings.Split(getEnv(key, ""), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
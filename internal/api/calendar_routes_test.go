@@ -0,0 +1,151 @@
+package api_test
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"xyz-football-api/internal/api/handler"
+	"xyz-football-api/internal/model"
+)
+
+// calendarEvents memecah body iCalendar menjadi blok VEVENT per UID
+func calendarEvents(t *testing.T, body string) map[string]string {
+	t.Helper()
+
+	events := make(map[string]string)
+	for _, block := range strings.Split(body, "BEGIN:VEVENT\r\n")[1:] {
+		block = block[:strings.Index(block, "END:VEVENT")]
+		uid := strings.TrimPrefix(strings.SplitN(block, "\r\n", 2)[0], "UID:")
+		events[uid] = block
+	}
+	return events
+}
+
+func TestCalendarRoutes(t *testing.T) {
+	s := newTestServer(t)
+	venue := s.createVenue("Stadion Utama", 1000)
+	home := s.createTeam("Garuda FC")
+	away := s.createTeam("Elang FC")
+	other := s.createTeam("Rajawali FC")
+
+	first := s.createMatch(home.ID, away.ID, "2025-03-01T19:00:00+07:00")
+	second := s.createMatch(away.ID, home.ID, "2025-03-08T19:00:00+07:00")
+	s.createMatch(other.ID, away.ID, "2025-03-15T19:00:00+07:00")
+
+	w := s.request(http.MethodPost, "/calendar/token", nil)
+	expectStatus(t, w, http.StatusCreated)
+	issued := decode[handler.CalendarTokenResponse](t, w)
+	if issued.Token == "" || issued.TeamFeed != "/teams/{id}/fixtures.ics?token="+issued.Token {
+		t.Fatalf("response = %+v", issued)
+	}
+
+	t.Run("team feed", func(t *testing.T) {
+		w := s.requestWithToken(http.MethodGet, fmt.Sprintf("/teams/%d/fixtures.ics?token=%s", home.ID, issued.Token), nil, "")
+		expectStatus(t, w, http.StatusOK)
+		if got := w.Header().Get("Content-Type"); got != "text/calendar; charset=utf-8" {
+			t.Errorf("Content-Type = %q", got)
+		}
+
+		body := w.Body.String()
+		if !strings.Contains(body, "X-WR-CALNAME:Garuda FC\r\n") {
+			t.Errorf("nama kalender salah:\n%s", body)
+		}
+		events := calendarEvents(t, body)
+		if len(events) != 2 {
+			t.Fatalf("len(events) = %d, want 2:\n%s", len(events), body)
+		}
+		event := events[fmt.Sprintf("match-%d@xyz-football-api", first.ID)]
+		for _, want := range []string{"DTSTART:20250301T120000Z", "DTEND:20250301T140000Z", "SEQUENCE:0", "SUMMARY:Garuda FC vs Elang FC", "STATUS:CONFIRMED"} {
+			if !strings.Contains(event, want+"\r\n") {
+				t.Errorf("event tidak berisi %q:\n%s", want, event)
+			}
+		}
+	})
+
+	t.Run("reschedule and cancel update the same event", func(t *testing.T) {
+		w := s.request(http.MethodPatch, fmt.Sprintf("/matches/%d", first.ID), map[string]any{
+			"match_datetime": "2025-03-02T16:00:00+07:00",
+			"venue_id":       venue.ID,
+		})
+		expectStatus(t, w, http.StatusOK)
+		if got := decode[model.Match](t, w); got.ScheduleVersion != 1 || got.VenueID == nil || *got.VenueID != venue.ID {
+			t.Errorf("match = %+v", got)
+		}
+		w = s.request(http.MethodPatch, fmt.Sprintf("/matches/%d", second.ID), map[string]any{"status": "cancelled"})
+		expectStatus(t, w, http.StatusOK)
+
+		w = s.requestWithToken(http.MethodGet, fmt.Sprintf("/teams/%d/fixtures.ics?token=%s", home.ID, issued.Token), nil, "")
+		expectStatus(t, w, http.StatusOK)
+		events := calendarEvents(t, w.Body.String())
+
+		rescheduled := events[fmt.Sprintf("match-%d@xyz-football-api", first.ID)]
+		for _, want := range []string{"DTSTART:20250302T090000Z", "SEQUENCE:1", `LOCATION:Stadion Utama\, Jakarta`, "STATUS:CONFIRMED"} {
+			if !strings.Contains(rescheduled, want+"\r\n") {
+				t.Errorf("event tidak berisi %q:\n%s", want, rescheduled)
+			}
+		}
+		cancelled := events[fmt.Sprintf("match-%d@xyz-football-api", second.ID)]
+		if !strings.Contains(cancelled, "STATUS:CANCELLED\r\n") || !strings.Contains(cancelled, "SEQUENCE:1\r\n") {
+			t.Errorf("event batal salah:\n%s", cancelled)
+		}
+	})
+
+	t.Run("reschedule validation", func(t *testing.T) {
+		path := fmt.Sprintf("/matches/%d", first.ID)
+		expectStatus(t, s.request(http.MethodPatch, path, map[string]any{"status": "completed"}), http.StatusBadRequest)
+		expectStatus(t, s.request(http.MethodPatch, path, map[string]any{"match_datetime": "besok"}), http.StatusBadRequest)
+		expectStatus(t, s.request(http.MethodPatch, path, map[string]any{"venue_id": 999}), http.StatusBadRequest)
+		expectStatus(t, s.request(http.MethodPatch, "/matches/999", map[string]any{"status": "cancelled"}), http.StatusNotFound)
+	})
+
+	t.Run("competition feed", func(t *testing.T) {
+		competition := s.createCompetition(map[string]any{
+			"name":           "Piala Nusantara",
+			"format":         "cup",
+			"start_datetime": "2025-04-01T19:00:00Z",
+			"team_ids":       []uint{home.ID, away.ID},
+		})
+		expectStatus(t, s.request(http.MethodPost, fmt.Sprintf("/competitions/%d/draw", competition.ID), nil), http.StatusCreated)
+
+		w := s.requestWithToken(http.MethodGet, fmt.Sprintf("/competitions/%d/fixtures.ics?token=%s", competition.ID, issued.Token), nil, "")
+		expectStatus(t, w, http.StatusOK)
+		body := w.Body.String()
+		if !strings.Contains(body, "X-WR-CALNAME:Piala Nusantara\r\n") || len(calendarEvents(t, body)) != 1 {
+			t.Errorf("feed kompetisi salah:\n%s", body)
+		}
+
+		// Match kompetisi tidak bisa dibatalkan
+		var matchID uint
+		for _, round := range s.bracket(competition.ID).Rounds {
+			for _, tie := range round.Ties {
+				for _, match := range tie.Matches {
+					matchID = match.MatchID
+				}
+			}
+		}
+		w = s.request(http.MethodPatch, fmt.Sprintf("/matches/%d", matchID), map[string]any{"status": "cancelled"})
+		expectStatus(t, w, http.StatusBadRequest)
+
+		expectStatus(t, s.requestWithToken(http.MethodGet, "/competitions/999/fixtures.ics?token="+issued.Token, nil, ""), http.StatusNotFound)
+	})
+
+	t.Run("token required and revocable", func(t *testing.T) {
+		path := fmt.Sprintf("/teams/%d/fixtures.ics", home.ID)
+		expectStatus(t, s.requestWithToken(http.MethodGet, path, nil, ""), http.StatusUnauthorized)
+		expectStatus(t, s.requestWithToken(http.MethodGet, path+"?token=salah", nil, ""), http.StatusUnauthorized)
+		// Bearer JWT tidak berlaku untuk feed
+		expectStatus(t, s.request(http.MethodGet, path, nil), http.StatusUnauthorized)
+		expectStatus(t, s.requestWithToken(http.MethodGet, "/teams/999/fixtures.ics?token="+issued.Token, nil, ""), http.StatusNotFound)
+
+		// Token baru menggantikan token lama
+		w := s.request(http.MethodPost, "/calendar/token", nil)
+		expectStatus(t, w, http.StatusCreated)
+		rotated := decode[handler.CalendarTokenResponse](t, w).Token
+		expectStatus(t, s.requestWithToken(http.MethodGet, path+"?token="+issued.Token, nil, ""), http.StatusUnauthorized)
+		expectStatus(t, s.requestWithToken(http.MethodGet, path+"?token="+rotated, nil, ""), http.StatusOK)
+
+		expectStatus(t, s.request(http.MethodDelete, "/calendar/token", nil), http.StatusOK)
+		expectStatus(t, s.requestWithToken(http.MethodGet, path+"?token="+rotated, nil, ""), http.StatusUnauthorized)
+	})
+}
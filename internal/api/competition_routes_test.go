@@ -0,0 +1,253 @@
+package api_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"xyz-football-api/internal/api/handler"
+	"xyz-football-api/internal/model"
+)
+
+// createCompetition membuat kompetisi dengan tim-tim yang diberikan
+func (s *testServer) createCompetition(body map[string]any) model.Competition {
+	s.t.Helper()
+	w := s.request(http.MethodPost, "/competitions", body)
+	expectStatus(s.t, w, http.StatusCreated)
+	return decode[model.Competition](s.t, w)
+}
+
+// reportPenaltyWin melaporkan match knockout yang dimenangkan tim home lewat adu penalti
+func (s *testServer) reportPenaltyWin(matchID uint) {
+	s.t.Helper()
+	w := s.request(http.MethodPost, fmt.Sprintf("/matches/%d/result", matchID), map[string]any{
+		"home_score": 0,
+		"away_score": 0,
+		"extra_time": true,
+		"penalties":  map[string]int{"home": 4, "away": 3},
+		"goals":      []any{},
+	})
+	expectStatus(s.t, w, http.StatusOK)
+}
+
+// bracket mengambil bracket kompetisi
+func (s *testServer) bracket(competitionID uint) handler.BracketResponse {
+	s.t.Helper()
+	w := s.request(http.MethodGet, fmt.Sprintf("/competitions/%d/bracket", competitionID), nil)
+	expectStatus(s.t, w, http.StatusOK)
+	return decode[handler.BracketResponse](s.t, w)
+}
+
+func TestCupCompetitionRoutes(t *testing.T) {
+	s := newTestServer(t)
+	var teamIDs []uint
+	for _, name := range []string{"Garuda FC", "Elang FC", "Rajawali FC"} {
+		teamIDs = append(teamIDs, s.createTeam(name).ID)
+	}
+
+	competition := s.createCompetition(map[string]any{
+		"name":           "Piala Nusantara",
+		"format":         "cup",
+		"start_datetime": "2025-03-01T19:00:00Z",
+		"team_ids":       teamIDs,
+	})
+	if competition.Status != model.CompetitionStatusDraft || len(competition.Teams) != 3 {
+		t.Fatalf("competition = %+v", competition)
+	}
+
+	t.Run("list and get", func(t *testing.T) {
+		w := s.request(http.MethodGet, "/competitions", nil)
+		expectStatus(t, w, http.StatusOK)
+		if got := decode[[]model.Competition](t, w); len(got) != 1 {
+			t.Errorf("len(competitions) = %d, want 1", len(got))
+		}
+
+		w = s.request(http.MethodGet, fmt.Sprintf("/competitions/%d", competition.ID), nil)
+		expectStatus(t, w, http.StatusOK)
+		if got := decode[model.Competition](t, w); got.Name != "Piala Nusantara" {
+			t.Errorf("name = %q", got.Name)
+		}
+	})
+
+	w := s.request(http.MethodPost, fmt.Sprintf("/competitions/%d/draw", competition.ID), nil)
+	expectStatus(t, w, http.StatusCreated)
+	drawn := decode[handler.BracketResponse](t, w)
+	if len(drawn.Rounds) != 2 || len(drawn.Rounds[0].Ties) != 2 {
+		t.Fatalf("rounds = %+v", drawn.Rounds)
+	}
+
+	t.Run("draw twice", func(t *testing.T) {
+		w := s.request(http.MethodPost, fmt.Sprintf("/competitions/%d/draw", competition.ID), nil)
+		expectStatus(t, w, http.StatusBadRequest)
+	})
+
+	t.Run("groups on cup", func(t *testing.T) {
+		expectStatus(t, s.request(http.MethodGet, fmt.Sprintf("/competitions/%d/groups", competition.ID), nil), http.StatusBadRequest)
+		expectStatus(t, s.request(http.MethodGet, fmt.Sprintf("/competitions/%d/qualification", competition.ID), nil), http.StatusBadRequest)
+	})
+
+	t.Run("bye and progression", func(t *testing.T) {
+		// Seed 1 mendapat bye sehingga hanya satu match yang dijadwalkan di babak pertama
+		var firstRound []handler.BracketMatch
+		for _, tie := range drawn.Rounds[0].Ties {
+			if len(tie.Matches) == 0 && (tie.WinnerTeam == nil || tie.WinnerTeam.ID != teamIDs[0]) {
+				t.Errorf("tie %d tanpa match seharusnya bye untuk seed 1", tie.TieID)
+			}
+			firstRound = append(firstRound, tie.Matches...)
+		}
+		if len(firstRound) != 1 {
+			t.Fatalf("len(first round matches) = %d, want 1", len(firstRound))
+		}
+
+		w := s.request(http.MethodPost, fmt.Sprintf("/matches/%d/result", firstRound[0].MatchID), map[string]any{
+			"home_score": 0, "away_score": 0, "goals": []any{},
+		})
+		expectStatus(t, w, http.StatusBadRequest)
+
+		s.reportPenaltyWin(firstRound[0].MatchID)
+
+		final := s.bracket(competition.ID).Rounds[1].Ties[0]
+		if len(final.Matches) != 1 {
+			t.Fatalf("final matches = %+v", final.Matches)
+		}
+		s.reportPenaltyWin(final.Matches[0].MatchID)
+
+		w = s.request(http.MethodGet, fmt.Sprintf("/competitions/%d", competition.ID), nil)
+		expectStatus(t, w, http.StatusOK)
+		got := decode[model.Competition](t, w)
+		if got.Status != model.CompetitionStatusCompleted {
+			t.Errorf("status = %q, want completed", got.Status)
+		}
+		if got.ChampionTeamID == nil || *got.ChampionTeamID != final.Matches[0].HomeTeamID {
+			t.Errorf("champion_team_id = %v, want %d", got.ChampionTeamID, final.Matches[0].HomeTeamID)
+		}
+
+		w = s.request(http.MethodGet, fmt.Sprintf("/matches/%d/report", final.Matches[0].MatchID), nil)
+		expectStatus(t, w, http.StatusOK)
+		if report := decode[handler.MatchReportResponse](t, w); report.Round != "Final" {
+			t.Errorf("round = %q, want Final", report.Round)
+		}
+
+		// Jadwal final dan juara tercatat di outbox dalam transaksi hasil match
+		pending, err := s.repos.Outbox.FindPending(100)
+		if err != nil {
+			t.Fatal(err)
+		}
+		scheduled, completed := false, false
+		for _, e := range pending {
+			var data struct {
+				ID           uint        `json:"id"`
+				HomeTeam     model.Team  `json:"home_team"`
+				ChampionTeam *model.Team `json:"champion_team"`
+			}
+			if err := json.Unmarshal([]byte(e.Payload), &data); err != nil {
+				t.Fatal(err)
+			}
+			switch e.EventType {
+			case "match.scheduled":
+				scheduled = scheduled || (data.ID == final.Matches[0].MatchID && data.HomeTeam.ID == final.Matches[0].HomeTeamID)
+			case "competition.completed":
+				completed = data.ID == competition.ID && data.ChampionTeam != nil && data.ChampionTeam.ID == *got.ChampionTeamID
+			}
+		}
+		if !scheduled || !completed {
+			t.Errorf("outbox match.scheduled final = %v, competition.completed = %v", scheduled, completed)
+		}
+	})
+}
+
+func TestGroupKnockoutCompetitionRoutes(t *testing.T) {
+	s := newTestServer(t)
+	var teamIDs []uint
+	for _, name := range []string{"Garuda FC", "Elang FC", "Rajawali FC", "Merpati FC"} {
+		teamIDs = append(teamIDs, s.createTeam(name).ID)
+	}
+
+	competition := s.createCompetition(map[string]any{
+		"name":                 "Liga Pelajar",
+		"format":               "group_knockout",
+		"start_datetime":       "2025-03-01T19:00:00Z",
+		"group_count":          1,
+		"qualifiers_per_group": 2,
+		"team_ids":             teamIDs,
+	})
+
+	w := s.request(http.MethodPost, fmt.Sprintf("/competitions/%d/draw", competition.ID), nil)
+	expectStatus(t, w, http.StatusCreated)
+	groups := decode[[]handler.GroupResponse](t, w)
+	if len(groups) != 1 || groups[0].Name != "A" || len(groups[0].Table) != 4 || len(groups[0].Matches) != 6 {
+		t.Fatalf("groups = %+v", groups)
+	}
+
+	t.Run("qualification before results", func(t *testing.T) {
+		w := s.request(http.MethodGet, fmt.Sprintf("/competitions/%d/qualification", competition.ID), nil)
+		expectStatus(t, w, http.StatusOK)
+		got := decode[[]handler.QualificationResponse](t, w)
+		if len(got) != 1 || got[0].RemainingMatches != 6 || len(got[0].Teams) != 4 {
+			t.Fatalf("qualification = %+v", got)
+		}
+		for _, team := range got[0].Teams {
+			if team.Status == "qualified" || team.Status == "eliminated" {
+				t.Errorf("%s sudah %s sebelum ada hasil", team.TeamName, team.Status)
+			}
+		}
+	})
+
+	t.Run("group results create knockout", func(t *testing.T) {
+		for _, match := range groups[0].Matches {
+			w := s.request(http.MethodPost, fmt.Sprintf("/matches/%d/result", match.ID), map[string]any{
+				"home_score": 0, "away_score": 0, "goals": []any{},
+			})
+			expectStatus(t, w, http.StatusOK)
+		}
+
+		w := s.request(http.MethodGet, fmt.Sprintf("/competitions/%d/groups", competition.ID), nil)
+		expectStatus(t, w, http.StatusOK)
+		got := decode[[]handler.GroupResponse](t, w)
+		for _, row := range got[0].Table {
+			if row.Played != 3 || row.Points != 3 {
+				t.Errorf("%s: played = %d, points = %d", row.TeamName, row.Played, row.Points)
+			}
+		}
+
+		bracket := s.bracket(competition.ID)
+		if len(bracket.Rounds) != 1 || len(bracket.Rounds[0].Ties) != 1 {
+			t.Fatalf("rounds = %+v", bracket.Rounds)
+		}
+		final := bracket.Rounds[0].Ties[0]
+		if final.HomeTeam == nil || final.AwayTeam == nil || len(final.Matches) != 1 {
+			t.Fatalf("final = %+v", final)
+		}
+		if final.HomeTeam.ID != got[0].Table[0].TeamID || final.AwayTeam.ID != got[0].Table[1].TeamID {
+			t.Errorf("final = %s vs %s, want juara dan runner-up grup", final.HomeTeam.Name, final.AwayTeam.Name)
+		}
+	})
+}
+
+func TestCompetitionRoutesValidation(t *testing.T) {
+	s := newTestServer(t)
+	team := s.createTeam("Garuda FC")
+	other := s.createTeam("Elang FC")
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   any
+		want   int
+	}{
+		{"unknown format", http.MethodPost, "/competitions", map[string]any{"name": "X", "format": "league", "start_datetime": "2025-03-01T19:00:00Z", "team_ids": []uint{team.ID, other.ID}}, http.StatusBadRequest},
+		{"duplicate team", http.MethodPost, "/competitions", map[string]any{"name": "X", "format": "cup", "start_datetime": "2025-03-01T19:00:00Z", "team_ids": []uint{team.ID, team.ID}}, http.StatusBadRequest},
+		{"unknown team", http.MethodPost, "/competitions", map[string]any{"name": "X", "format": "cup", "start_datetime": "2025-03-01T19:00:00Z", "team_ids": []uint{team.ID, 999}}, http.StatusBadRequest},
+		{"away goals without two legs", http.MethodPost, "/competitions", map[string]any{"name": "X", "format": "cup", "away_goals_rule": true, "start_datetime": "2025-03-01T19:00:00Z", "team_ids": []uint{team.ID, other.ID}}, http.StatusBadRequest},
+		{"get invalid id", http.MethodGet, "/competitions/abc", nil, http.StatusBadRequest},
+		{"get unknown id", http.MethodGet, "/competitions/999", nil, http.StatusNotFound},
+		{"draw unknown id", http.MethodPost, "/competitions/999/draw", nil, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expectStatus(t, s.request(tt.method, tt.path, tt.body), tt.want)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+// Package docs berisi spesifikasi OpenAPI hasil generate dan halaman dokumentasi yang disajikan API.
+// Jalankan go generate ./internal/api/docs setelah mengubah anotasi handler atau struct request/response.
+package docs
+
+import (
+	_ "embed"
+	"xyz-football-api/pkg/openapi"
+)
+
+//go:generate go run ../../../cmd/openapi -root ../../.. -o openapi.json
+
+// Sources adalah file yang dibaca generator, relatif terhadap root module
+var Sources = openapi.Sources{
+	General: "cmd/api/main.go",
+	Dirs:    []string{"internal/api/handler"},
+}
+
+// Spec adalah dokumen OpenAPI 3 (JSON) yang disajikan di /openapi.json
+//
+//go:embed openapi.json
+var Spec []byte
+
+// Page adalah halaman dokumentasi interaktif yang disajikan di /docs
+//
+//go:embed index.html
+var Page []byte
@@ -0,0 +1,23 @@
+package docs_test
+
+import (
+	"bytes"
+	"testing"
+	"xyz-football-api/internal/api/docs"
+	"xyz-football-api/pkg/openapi"
+)
+
+// TestSpecUpToDate gagal jika anotasi handler berubah tanpa menjalankan go generate ./internal/api/docs
+func TestSpecUpToDate(t *testing.T) {
+	doc, err := openapi.Generate("../../..", docs.Sources)
+	if err != nil {
+		t.Fatalf("Generate error: %v", err)
+	}
+	data, err := doc.MarshalIndent()
+	if err != nil {
+		t.Fatalf("MarshalIndent error: %v", err)
+	}
+	if !bytes.Equal(data, docs.Spec) {
+		t.Error("openapi.json tidak up to date, jalankan go generate ./internal/api/docs")
+	}
+}
@@ -0,0 +1,99 @@
+package api_test
+
+import (
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+	"xyz-football-api/pkg/openapi"
+)
+
+// ginParam mencocokkan parameter path gin (:id) untuk diubah ke format OpenAPI ({id})
+var ginParam = regexp.MustCompile(`:(\w+)`)
+
+func TestOpenAPISpec(t *testing.T) {
+	s := newTestServer(t)
+
+	w := s.requestWithToken(http.MethodGet, "/openapi.json", nil, "")
+	expectStatus(t, w, http.StatusOK)
+	if got := w.Header().Get("Content-Type"); !strings.HasPrefix(got, "application/json") {
+		t.Errorf("Content-Type = %q", got)
+	}
+	spec := decode[openapi.Document](t, w)
+	if spec.OpenAPI != openapi.Version || spec.Info.Title == "" {
+		t.Fatalf("openapi = %q, info = %+v", spec.OpenAPI, spec.Info)
+	}
+
+	t.Run("every route is documented", func(t *testing.T) {
+		var missing []string
+		for _, route := range s.router.Routes() {
+			path := ginParam.ReplaceAllString(route.Path, "{$1}")
+			if spec.Paths[path][strings.ToLower(route.Method)] == nil {
+				missing = append(missing, route.Method+" "+path)
+			}
+		}
+		if len(missing) > 0 {
+			sort.Strings(missing)
+			t.Errorf("route tanpa anotasi @Router (jalankan go generate ./internal/api/docs):\n  %s", strings.Join(missing, "\n  "))
+		}
+	})
+
+	t.Run("every documented operation is routed", func(t *testing.T) {
+		routes := make(map[string]bool)
+		for _, route := range s.router.Routes() {
+			routes[strings.ToLower(route.Method)+" "+ginParam.ReplaceAllString(route.Path, "{$1}")] = true
+		}
+		for path, item := range spec.Paths {
+			for method := range item {
+				if !routes[method+" "+path] {
+					t.Errorf("%s %s ada di spesifikasi tetapi tidak terdaftar di router", strings.ToUpper(method), path)
+				}
+			}
+		}
+	})
+
+	t.Run("protected operations declare security", func(t *testing.T) {
+		public := map[string]bool{"/health": true, "/login": true, "/openapi.json": true, "/docs": true}
+		for path, item := range spec.Paths {
+			for method, op := range item {
+				if public[path] || strings.HasSuffix(path, ".ics") {
+					continue
+				}
+				if len(op.Security) == 0 {
+					t.Errorf("%s %s tidak memiliki @Security", strings.ToUpper(method), path)
+				}
+			}
+		}
+	})
+
+	t.Run("request schemas follow binding tags", func(t *testing.T) {
+		player := spec.Components.Schemas["handler.CreatePlayerRequest"]
+		if player == nil {
+			t.Fatal("schema handler.CreatePlayerRequest tidak ada")
+		}
+		jersey := player.Properties["jersey_number"]
+		if jersey == nil || jersey.Minimum == nil || *jersey.Minimum != 1 || jersey.Maximum == nil || *jersey.Maximum != 99 {
+			t.Errorf("jersey_number = %+v", jersey)
+		}
+		if got := player.Properties["position"].Enum; len(got) != 4 || got[3] != "penjaga gawang" {
+			t.Errorf("position enum = %v", got)
+		}
+		if !strings.Contains(strings.Join(player.Required, ","), "team_id") {
+			t.Errorf("required = %v", player.Required)
+		}
+	})
+}
+
+func TestDocsPage(t *testing.T) {
+	s := newTestServer(t)
+
+	w := s.requestWithToken(http.MethodGet, "/docs", nil, "")
+	expectStatus(t, w, http.StatusOK)
+	if got := w.Header().Get("Content-Type"); !strings.HasPrefix(got, "text/html") {
+		t.Errorf("Content-Type = %q", got)
+	}
+	if !strings.Contains(w.Body.String(), `fetch("/openapi.json")`) {
+		t.Error("halaman docs tidak memuat /openapi.json")
+	}
+}
@@ -0,0 +1,124 @@
+package api_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"xyz-football-api/internal/api/middleware"
+	"xyz-football-api/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestErrorResponses(t *testing.T) {
+	s := newTestServer(t)
+
+	t.Run("not found has code", func(t *testing.T) {
+		w := s.request(http.MethodGet, "/teams/999", nil)
+		expectStatus(t, w, http.StatusNotFound)
+		if got := decode[utils.ErrorResponse](t, w); got.Code != "team_not_found" || got.Error != "Team tidak ditemukan" {
+			t.Errorf("response = %+v", got)
+		}
+	})
+
+	t.Run("validation lists fields", func(t *testing.T) {
+		w := s.request(http.MethodPost, "/players", map[string]any{"position": "kiper", "jersey_number": 100})
+		expectStatus(t, w, http.StatusBadRequest)
+
+		got := decode[utils.ErrorResponse](t, w)
+		if got.Code != "validation_failed" {
+			t.Errorf("code = %q, want validation_failed", got.Code)
+		}
+		fields := make(map[string]string)
+		for _, f := range got.Fields {
+			fields[f.Field] = f.Message
+		}
+		want := map[string]string{
+			"name":          "wajib diisi",
+			"team_id":       "wajib diisi",
+			"position":      "harus salah satu dari: penyerang, gelandang, bertahan, penjaga gawang",
+			"jersey_number": "maksimal 99",
+		}
+		for field, msg := range want {
+			if fields[field] != msg {
+				t.Errorf("fields[%s] = %q, want %q", field, fields[field], msg)
+			}
+		}
+	})
+
+	t.Run("nested fields use json path", func(t *testing.T) {
+		home, away := s.createTeam("Garuda FC"), s.createTeam("Rajawali FC")
+		match := s.createMatch(home.ID, away.ID, "2024-08-17T19:00:00Z")
+
+		w := s.request(http.MethodPost, fmt.Sprintf("/matches/%d/result", match.ID), map[string]any{
+			"home_score": 1,
+			"away_score": 0,
+			"goals":      []any{map[string]any{"player_id": 1, "goal_time": 130}},
+		})
+		expectStatus(t, w, http.StatusBadRequest)
+		if got := decode[utils.ErrorResponse](t, w); len(got.Fields) != 1 || got.Fields[0].Field != "goals[0].goal_time" {
+			t.Errorf("fields = %+v, want goals[0].goal_time", got.Fields)
+		}
+	})
+
+	t.Run("english via accept-language", func(t *testing.T) {
+		w := s.requestLang(http.MethodGet, "/teams/999", nil, "en")
+		expectStatus(t, w, http.StatusNotFound)
+		if got := w.Header().Get("Content-Language"); got != "en" {
+			t.Errorf("Content-Language = %q, want en", got)
+		}
+		if got := decode[utils.ErrorResponse](t, w); got.Code != "team_not_found" || got.Error != "Team not found" {
+			t.Errorf("response = %+v", got)
+		}
+
+		w = s.requestLang(http.MethodPost, "/players", map[string]any{"position": "kiper", "jersey_number": 100}, "en")
+		expectStatus(t, w, http.StatusBadRequest)
+		got := decode[utils.ErrorResponse](t, w)
+		if got.Error != "Invalid player data" {
+			t.Errorf("error = %q", got.Error)
+		}
+		for _, f := range got.Fields {
+			if f.Field == "jersey_number" && f.Message != "must be at most 99" {
+				t.Errorf("fields[jersey_number] = %q", f.Message)
+			}
+		}
+	})
+
+	t.Run("unsupported language falls back to indonesian", func(t *testing.T) {
+		w := s.requestLang(http.MethodGet, "/teams/999", nil, "fr-FR")
+		expectStatus(t, w, http.StatusNotFound)
+		if got := errorMessage(t, w); got != "Team tidak ditemukan" {
+			t.Errorf("error = %q", got)
+		}
+	})
+
+	t.Run("malformed json", func(t *testing.T) {
+		w := s.request(http.MethodPost, "/teams", `{"name": 1}`)
+		expectStatus(t, w, http.StatusBadRequest)
+		if got := decode[utils.ErrorResponse](t, w); len(got.Fields) != 1 || got.Fields[0].Field != "name" {
+			t.Errorf("fields = %+v, want name", got.Fields)
+		}
+	})
+}
+
+func TestErrorHandlerHidesInternalErrors(t *testing.T) {
+	router := gin.New()
+	router.Use(middleware.ErrorHandler())
+	router.GET("/boom", func(c *gin.Context) {
+		_ = c.Error(errors.New(`pq: relation "teams" does not exist`))
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/boom", nil))
+
+	expectStatus(t, w, http.StatusInternalServerError)
+	if strings.Contains(w.Body.String(), "pq:") {
+		t.Errorf("response membocorkan error database: %s", w.Body.String())
+	}
+	if got := decode[utils.ErrorResponse](t, w); got.Code != "internal_error" {
+		t.Errorf("code = %q, want internal_error", got.Code)
+	}
+}
@@ -0,0 +1,238 @@
+package api_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"xyz-football-api/pkg/spreadsheet"
+)
+
+// readExport mem-parsing response export CSV/XLSX menjadi tabel
+func readExport(t *testing.T, w *httptest.ResponseRecorder, format spreadsheet.Format) *spreadsheet.Table {
+	t.Helper()
+
+	if got := w.Header().Get("Content-Type"); got != format.ContentType() {
+		t.Fatalf("Content-Type = %q, want %q", got, format.ContentType())
+	}
+	table, err := spreadsheet.Read(format, w.Body)
+	if err != nil {
+		t.Fatalf("gagal membaca export: %v", err)
+	}
+	return table
+}
+
+// columnValues mengambil nilai satu kolom dari semua baris
+func columnValues(table *spreadsheet.Table, column string) []string {
+	values := make([]string, len(table.Rows))
+	for i, row := range table.Rows {
+		values[i] = row.Get(column)
+	}
+	return values
+}
+
+func TestExportRoutes(t *testing.T) {
+	s := newTestServer(t)
+	venue := s.createVenue("Stadion Utama", 1000)
+	home := s.createTeam("Garuda FC")
+	away := s.createTeam("Elang FC")
+	striker := s.createPlayer(home.ID, "Budi Santoso", 9)
+	injured := s.createPlayer(home.ID, "Ahmad Dahlan", 11)
+	s.createPlayer(away.ID, "Rudi Hartono", 10)
+
+	played := s.createMatch(home.ID, away.ID, "2025-03-01T19:00:00+07:00")
+	s.createMatch(away.ID, home.ID, "2025-03-08T19:00:00+07:00")
+	expectStatus(t, s.request(http.MethodPost, fmt.Sprintf("/matches/%d/result", played.ID), map[string]any{
+		"home_score": 2,
+		"away_score": 0,
+		"attendance": 800,
+		"goals": []any{
+			map[string]any{"player_id": striker.ID, "goal_time": 12},
+			map[string]any{"player_id": striker.ID, "goal_time": 77},
+		},
+	}), http.StatusOK)
+	expectStatus(t, s.request(http.MethodPost, fmt.Sprintf("/players/%d/availability", injured.ID), map[string]any{
+		"status":     "injured",
+		"start_date": "2025-02-20",
+	}), http.StatusCreated)
+	_ = venue
+
+	t.Run("teams as csv by default", func(t *testing.T) {
+		w := s.request(http.MethodGet, "/export/teams", nil)
+		expectStatus(t, w, http.StatusOK)
+		if got := w.Header().Get("Content-Disposition"); got != `attachment; filename="teams.csv"` {
+			t.Errorf("Content-Disposition = %q", got)
+		}
+		table := readExport(t, w, spreadsheet.FormatCSV)
+		if got := strings.Join(columnValues(table, "name"), ","); got != "Garuda FC,Elang FC" {
+			t.Errorf("names = %s", got)
+		}
+	})
+
+	t.Run("format from accept header", func(t *testing.T) {
+		w := s.requestWithHeaders(http.MethodGet, "/export/teams", nil, map[string]string{
+			"Authorization": "Bearer " + s.token,
+			"Accept":        "application/x-ndjson",
+		})
+		expectStatus(t, w, http.StatusOK)
+		if got := w.Header().Get("Content-Type"); got != "application/x-ndjson" {
+			t.Fatalf("Content-Type = %q", got)
+		}
+
+		var lines []map[string]any
+		scanner := bufio.NewScanner(w.Body)
+		for scanner.Scan() {
+			var line map[string]any
+			if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+				t.Fatalf("baris bukan JSON: %s", scanner.Text())
+			}
+			lines = append(lines, line)
+		}
+		if len(lines) != 2 || lines[0]["name"] != "Garuda FC" || lines[0]["id"] != float64(home.ID) {
+			t.Errorf("lines = %v", lines)
+		}
+	})
+
+	t.Run("players as xlsx with list filters", func(t *testing.T) {
+		w := s.request(http.MethodGet, fmt.Sprintf("/export/players?format=xlsx&team_id=%d", home.ID), nil)
+		expectStatus(t, w, http.StatusOK)
+		table := readExport(t, w, spreadsheet.FormatXLSX)
+		if got := strings.Join(columnValues(table, "name"), ","); got != "Budi Santoso,Ahmad Dahlan" {
+			t.Errorf("names = %s", got)
+		}
+		if got := columnValues(table, "team_name"); got[0] != "Garuda FC" {
+			t.Errorf("team_name = %v", got)
+		}
+
+		w = s.request(http.MethodGet, fmt.Sprintf("/export/players?team_id=%d&available_on=2025-03-01", home.ID), nil)
+		expectStatus(t, w, http.StatusOK)
+		if got := strings.Join(columnValues(readExport(t, w, spreadsheet.FormatCSV), "name"), ","); got != "Budi Santoso" {
+			t.Errorf("available names = %s", got)
+		}
+	})
+
+	t.Run("matches filtered by status", func(t *testing.T) {
+		w := s.request(http.MethodGet, fmt.Sprintf("/export/matches?status=completed&team_id=%d", away.ID), nil)
+		expectStatus(t, w, http.StatusOK)
+		table := readExport(t, w, spreadsheet.FormatCSV)
+		if len(table.Rows) != 1 {
+			t.Fatalf("rows = %d, want 1", len(table.Rows))
+		}
+		row := table.Rows[0]
+		if row.Get("home_team") != "Garuda FC" || row.Get("home_score") != "2" || row.Get("match_result") != "home_win" || row.Get("attendance") != "800" {
+			t.Errorf("row = %v", row.Values)
+		}
+	})
+
+	t.Run("goals filtered by match", func(t *testing.T) {
+		w := s.request(http.MethodGet, fmt.Sprintf("/export/goals?match_id=%d", played.ID), nil)
+		expectStatus(t, w, http.StatusOK)
+		table := readExport(t, w, spreadsheet.FormatCSV)
+		if got := strings.Join(columnValues(table, "goal_time"), ","); got != "12,77" {
+			t.Errorf("goal_time = %s", got)
+		}
+		if got := columnValues(table, "player_name"); len(got) != 2 || got[0] != "Budi Santoso" {
+			t.Errorf("player_name = %v", got)
+		}
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		expectStatus(t, s.request(http.MethodGet, "/export/teams?format=pdf", nil), http.StatusBadRequest)
+		expectStatus(t, s.requestWithHeaders(http.MethodGet, "/export/teams", nil, map[string]string{
+			"Authorization": "Bearer " + s.token,
+			"Accept":        "application/pdf",
+		}), http.StatusNotAcceptable)
+		expectStatus(t, s.request(http.MethodGet, "/export/players?available_on=2025-03-01", nil), http.StatusBadRequest)
+		expectStatus(t, s.request(http.MethodGet, "/export/players?team_id=999", nil), http.StatusNotFound)
+		expectStatus(t, s.request(http.MethodGet, "/export/matches?status=postponed", nil), http.StatusBadRequest)
+		expectStatus(t, s.request(http.MethodGet, "/export/goals?match_id=abc", nil), http.StatusBadRequest)
+		expectStatus(t, s.request(http.MethodGet, "/export/goals?player_id=999", nil), http.StatusNotFound)
+
+		w := s.request(http.MethodGet, "/export/matches?status=postponed", nil)
+		if got := w.Header().Get("Content-Type"); !strings.HasPrefix(got, "application/json") {
+			t.Errorf("error Content-Type = %q, want application/json", got)
+		}
+	})
+}
+
+// slowServer menjalankan router di belakang http.Server dengan ReadTimeout dan WriteTimeout
+// sependek timeout. Setiap response baru selesai dikirim setelah timeout terlewati.
+func (s *testServer) slowServer(timeout time.Duration) *httptest.Server {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.router.ServeHTTP(w, r)
+		time.Sleep(2 * timeout)
+	}))
+	server.Config.ReadTimeout = timeout
+	server.Config.WriteTimeout = timeout
+	server.Start()
+	s.t.Cleanup(server.Close)
+	return server
+}
+
+// fetch mengirim request ber-token ke server dan membaca seluruh body response
+func (s *testServer) fetch(server *httptest.Server, method, path, contentType string, body io.Reader) (int, []byte, error) {
+	req, err := http.NewRequest(method, server.URL+path, body)
+	if err != nil {
+		return 0, nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+s.token)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	resp, err := server.Client().Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	return resp.StatusCode, data, err
+}
+
+func TestServerTimeouts(t *testing.T) {
+	s := newTestServer(t)
+	home := s.createTeam("Garuda FC")
+	away := s.createTeam("Elang FC")
+	match := s.createMatch(home.ID, away.ID, "2025-03-01T19:00:00+07:00")
+
+	const timeout = 100 * time.Millisecond
+	server := s.slowServer(timeout)
+
+	t.Run("regular endpoints keep the write timeout", func(t *testing.T) {
+		if _, _, err := s.fetch(server, http.MethodGet, "/teams", "", nil); err == nil {
+			t.Error("response yang melewati SERVER_WRITE_TIMEOUT harus terputus")
+		}
+	})
+
+	t.Run("downloads outlive the write timeout", func(t *testing.T) {
+		for _, path := range []string{"/export/teams", fmt.Sprintf("/matches/%d/report.pdf", match.ID)} {
+			status, body, err := s.fetch(server, http.MethodGet, path, "", nil)
+			if err != nil || status != http.StatusOK || len(body) == 0 {
+				t.Errorf("GET %s = %d, %d byte, %v", path, status, len(body), err)
+			}
+		}
+	})
+
+	t.Run("slow import upload outlives the read timeout", func(t *testing.T) {
+		pr, pw := io.Pipe()
+		form := multipart.NewWriter(pw)
+		go func() {
+			part, _ := form.CreateFormFile("file", "teams.csv")
+			part.Write([]byte("name,founded_year\n"))
+			time.Sleep(2 * timeout)
+			part.Write([]byte("Rajawali FC,2001\n"))
+			form.Close()
+			pw.Close()
+		}()
+
+		status, body, err := s.fetch(server, http.MethodPost, "/import/teams", form.FormDataContentType(), pr)
+		if err != nil || status != http.StatusCreated {
+			t.Fatalf("POST /import/teams = %d, %v: %s", status, err, body)
+		}
+	})
+}
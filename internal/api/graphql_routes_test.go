@@ -0,0 +1,522 @@
+package api_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+	"xyz-football-api/pkg/utils"
+)
+
+// graphQLResponse adalah body response /graphql
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string `json:"message"`
+		Extensions struct {
+			Code   string             `json:"code"`
+			Fields []utils.FieldError `json:"fields"`
+		} `json:"extensions"`
+	} `json:"errors"`
+}
+
+// graphQL mengirim query GraphQL dan mengembalikan response-nya dengan status yang diharapkan
+func (s *testServer) graphQL(lang string, status int, query string, variables map[string]any) graphQLResponse {
+	s.t.Helper()
+	w := s.requestLang(http.MethodPost, "/graphql", map[string]any{"query": query, "variables": variables}, lang)
+	expectStatus(s.t, w, status)
+	return decode[graphQLResponse](s.t, w)
+}
+
+// graphQLData mengirim query yang diharapkan sukses lalu mem-parsing data ke tipe T
+func graphQLData[T any](s *testServer, query string, variables map[string]any) T {
+	s.t.Helper()
+	resp := s.graphQL("id", http.StatusOK, query, variables)
+	if len(resp.Errors) > 0 {
+		s.t.Fatalf("errors = %+v", resp.Errors)
+	}
+	var data T
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		s.t.Fatalf("gagal decode data %s: %v", resp.Data, err)
+	}
+	return data
+}
+
+// reportResult mencatat hasil match dengan gol dari player yang diberikan
+func (s *testServer) reportResult(match model.Match, homeScore, awayScore int, scorers ...model.Player) {
+	s.t.Helper()
+	goals := make([]map[string]any, 0, len(scorers))
+	for i, player := range scorers {
+		goals = append(goals, map[string]any{"player_id": player.ID, "goal_time": 10 * (i + 1)})
+	}
+	w := s.request(http.MethodPost, fmt.Sprintf("/matches/%d/result", match.ID), map[string]any{
+		"home_score": homeScore,
+		"away_score": awayScore,
+		"goals":      goals,
+	})
+	expectStatus(s.t, w, http.StatusOK)
+}
+
+// callCounter menghitung pemanggilan method repository untuk memeriksa batching
+type callCounter struct {
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func (c *callCounter) add(method string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls[method]++
+}
+
+func (c *callCounter) get(method string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.calls[method]
+}
+
+type countingTeams struct {
+	repository.TeamRepository
+	counter *callCounter
+}
+
+func (r countingTeams) FindByIDs(ids []uint) ([]model.Team, error) {
+	r.counter.add("Teams.FindByIDs")
+	return r.TeamRepository.FindByIDs(ids)
+}
+
+type countingPlayers struct {
+	repository.PlayerRepository
+	counter *callCounter
+}
+
+func (r countingPlayers) FindByIDs(ids []uint) ([]model.Player, error) {
+	r.counter.add("Players.FindByIDs")
+	return r.PlayerRepository.FindByIDs(ids)
+}
+
+func (r countingPlayers) FindByTeamIDs(teamIDs []uint) ([]model.Player, error) {
+	r.counter.add("Players.FindByTeamIDs")
+	return r.PlayerRepository.FindByTeamIDs(teamIDs)
+}
+
+type countingMatches struct {
+	repository.MatchRepository
+	counter *callCounter
+}
+
+func (r countingMatches) FindByIDs(ids []uint) ([]model.Match, error) {
+	r.counter.add("Matches.FindByIDs")
+	return r.MatchRepository.FindByIDs(ids)
+}
+
+func (r countingMatches) FindByTeamIDs(teamIDs []uint) ([]model.Match, error) {
+	r.counter.add("Matches.FindByTeamIDs")
+	return r.MatchRepository.FindByTeamIDs(teamIDs)
+}
+
+type countingGoals struct {
+	repository.GoalRepository
+	counter *callCounter
+}
+
+func (r countingGoals) FindByMatchIDs(matchIDs []uint) ([]model.Goal, error) {
+	r.counter.add("Goals.FindByMatchIDs")
+	return r.GoalRepository.FindByMatchIDs(matchIDs)
+}
+
+func (r countingGoals) FindByPlayerIDs(playerIDs []uint) ([]model.Goal, error) {
+	r.counter.add("Goals.FindByPlayerIDs")
+	return r.GoalRepository.FindByPlayerIDs(playerIDs)
+}
+
+// graphQLLeague menyiapkan tiga team dengan dua player per team dan dua match yang sudah selesai
+type graphQLLeague struct {
+	teams   []model.Team
+	players map[uint][]model.Player
+	matches []model.Match
+}
+
+func (s *testServer) graphQLLeague() graphQLLeague {
+	s.t.Helper()
+	league := graphQLLeague{players: make(map[uint][]model.Player)}
+	for i, name := range []string{"Garuda FC", "Elang United", "Harimau FC"} {
+		team := s.createTeam(name)
+		league.teams = append(league.teams, team)
+		league.players[team.ID] = []model.Player{
+			s.createPlayer(team.ID, name+" Striker", 9),
+			s.createPlayer(team.ID, name+" Winger", 11+i),
+		}
+	}
+
+	garuda, elang, harimau := league.teams[0], league.teams[1], league.teams[2]
+	first := s.createMatch(garuda.ID, elang.ID, "2024-08-01T19:00:00Z")
+	second := s.createMatch(harimau.ID, garuda.ID, "2024-08-08T19:00:00Z")
+	s.createMatch(elang.ID, harimau.ID, "2024-08-15T19:00:00Z")
+	s.reportResult(first, 2, 1, league.players[garuda.ID][0], league.players[garuda.ID][1], league.players[elang.ID][0])
+	s.reportResult(second, 0, 1, league.players[garuda.ID][0])
+	league.matches = []model.Match{first, second}
+	return league
+}
+
+func TestGraphQLRequiresToken(t *testing.T) {
+	s := newTestServer(t)
+
+	w := s.requestWithToken(http.MethodPost, "/graphql", map[string]any{"query": "{ teams { totalCount } }"}, "")
+	expectStatus(t, w, http.StatusUnauthorized)
+}
+
+func TestGraphQLQueries(t *testing.T) {
+	s := newTestServer(t)
+	league := s.graphQLLeague()
+	garuda := league.teams[0]
+
+	t.Run("nested relations", func(t *testing.T) {
+		type team struct {
+			Name    string
+			Players []struct {
+				Name  string
+				Goals []struct{ GoalTime int }
+			}
+			Matches []struct {
+				Status   string
+				Result   string
+				HomeTeam struct{ Name string }
+				AwayTeam struct{ Name string }
+				Goals    []struct {
+					GoalTime int
+					Player   struct{ Name string }
+				}
+			}
+		}
+		data := graphQLData[struct{ Team team }](s, `query ($id: ID!) {
+			team(id: $id) {
+				name
+				players { name goals { goalTime } }
+				matches(status: COMPLETED, order: DESC) {
+					status result
+					homeTeam { name }
+					awayTeam { name }
+					goals { goalTime player { name } }
+				}
+			}
+		}`, map[string]any{"id": strconv.Itoa(int(garuda.ID))})
+
+		if data.Team.Name != "Garuda FC" || len(data.Team.Players) != 2 {
+			t.Fatalf("team = %+v", data.Team)
+		}
+		if got := data.Team.Players[0]; got.Name != "Garuda FC Striker" || len(got.Goals) != 2 {
+			t.Errorf("players terurut nomor punggung, striker punya 2 gol: %+v", data.Team.Players)
+		}
+		if len(data.Team.Matches) != 2 {
+			t.Fatalf("len(matches) = %d, want 2", len(data.Team.Matches))
+		}
+		latest := data.Team.Matches[0]
+		if latest.HomeTeam.Name != "Harimau FC" || latest.Result != "AWAY_WIN" || latest.Status != "COMPLETED" {
+			t.Errorf("order DESC, match terbaru = %+v", latest)
+		}
+		opener := data.Team.Matches[1]
+		if opener.AwayTeam.Name != "Elang United" || len(opener.Goals) != 3 || opener.Goals[2].Player.Name != "Elang United Striker" {
+			t.Errorf("match pembuka = %+v", opener)
+		}
+	})
+
+	t.Run("missing record is null", func(t *testing.T) {
+		data := graphQLData[map[string]any](s, `{ team(id: "999") { name } player(id: "999") { name } }`, nil)
+		if data["team"] != nil || data["player"] != nil {
+			t.Errorf("data = %v", data)
+		}
+	})
+
+	t.Run("filters and pagination", func(t *testing.T) {
+		type page struct {
+			Items       []struct{ ID, Name string }
+			TotalCount  int
+			HasNextPage bool
+		}
+		data := graphQLData[struct {
+			Teams   page
+			Players page
+			Matches page
+		}](s, `query ($teamId: ID) {
+			teams(first: 2, offset: 1) { items { id name } totalCount hasNextPage }
+			players(teamId: $teamId, position: "penyerang", first: 1) { items { id name } totalCount hasNextPage }
+			matches(teamId: $teamId, status: SCHEDULED) { items { id } totalCount hasNextPage }
+		}`, map[string]any{"teamId": strconv.Itoa(int(league.teams[1].ID))})
+
+		if len(data.Teams.Items) != 2 || data.Teams.Items[0].Name != "Elang United" || data.Teams.TotalCount != 3 || data.Teams.HasNextPage {
+			t.Errorf("teams = %+v", data.Teams)
+		}
+		if len(data.Players.Items) != 1 || data.Players.TotalCount != 2 || !data.Players.HasNextPage {
+			t.Errorf("players = %+v", data.Players)
+		}
+		if len(data.Matches.Items) != 1 || data.Matches.TotalCount != 1 {
+			t.Errorf("matches = %+v", data.Matches)
+		}
+
+		data = graphQLData[struct {
+			Teams   page
+			Players page
+			Matches page
+		}](s, `{ teams(name: "fc", first: 5) { items { name } totalCount hasNextPage } }`, nil)
+		if data.Teams.TotalCount != 2 || data.Teams.Items[0].Name != "Garuda FC" {
+			t.Errorf("filter nama = %+v", data.Teams)
+		}
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		resp := s.graphQL("en", http.StatusOK, `{ teams(first: 500) { totalCount } }`, nil)
+		if len(resp.Errors) != 1 || resp.Errors[0].Extensions.Code != "bad_request" || resp.Errors[0].Message != "Parameter first must be between 1 and 100" {
+			t.Errorf("errors = %+v", resp.Errors)
+		}
+		resp = s.graphQL("id", http.StatusOK, `{ team(id: "abc") { name } }`, nil)
+		if len(resp.Errors) != 1 || resp.Errors[0].Message != "ID team tidak valid" {
+			t.Errorf("errors = %+v", resp.Errors)
+		}
+	})
+
+	t.Run("parse and validation errors", func(t *testing.T) {
+		if resp := s.graphQL("id", http.StatusBadRequest, `{ teams {`, nil); len(resp.Errors) != 1 {
+			t.Errorf("parse errors = %+v", resp.Errors)
+		}
+		if resp := s.graphQL("id", http.StatusBadRequest, `{ teams { items { unknownField } } }`, nil); len(resp.Errors) != 1 {
+			t.Errorf("validation errors = %+v", resp.Errors)
+		}
+		resp := s.graphQL("en", http.StatusBadRequest, "  ", nil)
+		if len(resp.Errors) != 1 || resp.Errors[0].Extensions.Code != "invalid_body" {
+			t.Errorf("empty query errors = %+v", resp.Errors)
+		}
+	})
+
+	t.Run("introspection", func(t *testing.T) {
+		data := graphQLData[struct {
+			Schema struct{ MutationType struct{ Name string } } `json:"__schema"`
+		}](s, `{ __schema { mutationType { name } } }`, nil)
+		if data.Schema.MutationType.Name != "Mutation" {
+			t.Errorf("schema = %+v", data.Schema)
+		}
+	})
+}
+
+func TestGraphQLBatchesRelations(t *testing.T) {
+	repos := newTestRepositories(t)
+	counter := &callCounter{calls: make(map[string]int)}
+	repos.Teams = countingTeams{repos.Teams, counter}
+	repos.Players = countingPlayers{repos.Players, counter}
+	repos.Matches = countingMatches{repos.Matches, counter}
+	repos.Goals = countingGoals{repos.Goals, counter}
+
+	s := newTestServerWith(t, repos)
+	s.graphQLLeague()
+	clear(counter.calls)
+
+	data := graphQLData[struct {
+		Teams struct {
+			Items []struct {
+				Players []struct {
+					Goals []struct{ Match struct{ ID string } }
+				}
+				Matches []struct {
+					HomeTeam struct{ Name string }
+					Goals    []struct{ Player struct{ Name string } }
+				}
+			}
+		}
+	}](s, `{
+		teams(first: 3) {
+			items {
+				players { goals { match { id } } }
+				matches { homeTeam { name } goals { player { name } } }
+			}
+		}
+	}`, nil)
+
+	if len(data.Teams.Items) != 3 {
+		t.Fatalf("len(teams) = %d, want 3", len(data.Teams.Items))
+	}
+	// Setiap relasi diambil dengan satu query untuk semua item pada tingkat yang sama
+	for _, method := range []string{
+		"Players.FindByTeamIDs",
+		"Matches.FindByTeamIDs",
+		"Goals.FindByPlayerIDs",
+		"Goals.FindByMatchIDs",
+		"Matches.FindByIDs",
+		"Teams.FindByIDs",
+		"Players.FindByIDs",
+	} {
+		if got := counter.get(method); got != 1 {
+			t.Errorf("%s dipanggil %d kali, want 1", method, got)
+		}
+	}
+}
+
+func TestGraphQLMutations(t *testing.T) {
+	s := newTestServer(t)
+	home := s.createTeam("Garuda FC")
+	away := s.createTeam("Elang United")
+	awayScorer := s.createPlayer(away.ID, "Rudi Hartono", 9)
+
+	var playerID string
+	t.Run("create and update player", func(t *testing.T) {
+		type player struct {
+			ID           string
+			Name         string
+			Position     string
+			JerseyNumber int
+			Team         struct{ Name string }
+		}
+		created := graphQLData[struct{ CreatePlayer player }](s, `mutation ($input: CreatePlayerInput!) {
+			createPlayer(input: $input) { id name position jerseyNumber team { name } }
+		}`, map[string]any{"input": map[string]any{
+			"teamId":       strconv.Itoa(int(home.ID)),
+			"name":         "Budi Santoso",
+			"position":     "penyerang",
+			"jerseyNumber": 10,
+		}}).CreatePlayer
+		if created.Name != "Budi Santoso" || created.JerseyNumber != 10 || created.Team.Name != "Garuda FC" {
+			t.Fatalf("createPlayer = %+v", created)
+		}
+		playerID = created.ID
+
+		updated := graphQLData[struct{ UpdatePlayer player }](s, `mutation ($id: ID!) {
+			updatePlayer(id: $id, input: { position: "gelandang", jerseyNumber: 8 }) { name position jerseyNumber }
+		}`, map[string]any{"id": playerID}).UpdatePlayer
+		if updated.Name != "Budi Santoso" || updated.Position != "gelandang" || updated.JerseyNumber != 8 {
+			t.Errorf("updatePlayer = %+v", updated)
+		}
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		resp := s.graphQL("en", http.StatusOK, `mutation ($teamId: ID!) {
+			createPlayer(input: { teamId: $teamId, name: "X", position: "kiper", jerseyNumber: 100 }) { id }
+		}`, map[string]any{"teamId": strconv.Itoa(int(home.ID))})
+		if len(resp.Errors) != 1 {
+			t.Fatalf("errors = %+v", resp.Errors)
+		}
+		got := resp.Errors[0]
+		if got.Message != "Invalid player data" || got.Extensions.Code != "validation_failed" || len(got.Extensions.Fields) != 2 {
+			t.Fatalf("error = %+v", got)
+		}
+		if got.Extensions.Fields[0].Field != "position" || got.Extensions.Fields[1].Field != "jerseyNumber" {
+			t.Errorf("fields = %+v", got.Extensions.Fields)
+		}
+
+		resp = s.graphQL("id", http.StatusOK, `mutation ($teamId: ID!) {
+			createPlayer(input: { teamId: $teamId, name: "X", position: "bertahan", jerseyNumber: 8 }) { id }
+		}`, map[string]any{"teamId": strconv.Itoa(int(home.ID))})
+		if len(resp.Errors) != 1 || resp.Errors[0].Extensions.Code != "jersey_number_taken" {
+			t.Errorf("nomor punggung duplikat: errors = %+v", resp.Errors)
+		}
+
+		resp = s.graphQL("id", http.StatusOK, `mutation { deleteTeam(id: "999") }`, nil)
+		if len(resp.Errors) != 1 || resp.Errors[0].Extensions.Code != "team_not_found" {
+			t.Errorf("team tidak ada: errors = %+v", resp.Errors)
+		}
+	})
+
+	t.Run("schedule and report match", func(t *testing.T) {
+		created := graphQLData[struct{ CreateMatch struct{ ID, Status string } }](s, `mutation ($home: ID!, $away: ID!) {
+			createMatch(input: { homeTeamId: $home, awayTeamId: $away, matchDatetime: "2024-08-01T19:00:00Z" }) { id status }
+		}`, map[string]any{"home": strconv.Itoa(int(home.ID)), "away": strconv.Itoa(int(away.ID))}).CreateMatch
+		if created.Status != "SCHEDULED" {
+			t.Fatalf("createMatch = %+v", created)
+		}
+
+		resp := s.graphQL("en", http.StatusOK, `mutation ($id: ID!, $scorer: ID!) {
+			reportMatchResult(id: $id, input: { homeScore: 1, awayScore: 0, goals: [{ playerId: $scorer, goalTime: 0 }] }) { id }
+		}`, map[string]any{"id": created.ID, "scorer": playerID})
+		if len(resp.Errors) != 1 || len(resp.Errors[0].Extensions.Fields) != 1 || resp.Errors[0].Extensions.Fields[0].Field != "goals[0].goalTime" {
+			t.Fatalf("errors = %+v", resp.Errors)
+		}
+
+		reported := graphQLData[struct {
+			ReportMatchResult struct {
+				Result string
+				Goals  []struct {
+					GoalTime int
+					Player   struct{ Name string }
+				}
+			}
+		}](s, `mutation ($id: ID!, $home: ID!, $away: ID!) {
+			reportMatchResult(id: $id, input: { homeScore: 1, awayScore: 1, goals: [{ playerId: $away, goalTime: 80 }, { playerId: $home, goalTime: 12 }] }) {
+				result goals { goalTime player { name } }
+			}
+		}`, map[string]any{"id": created.ID, "home": playerID, "away": strconv.Itoa(int(awayScorer.ID))}).ReportMatchResult
+		if reported.Result != "DRAW" || len(reported.Goals) != 2 || reported.Goals[0].Player.Name != "Budi Santoso" {
+			t.Errorf("reportMatchResult = %+v", reported)
+		}
+
+		resp = s.graphQL("id", http.StatusOK, `mutation ($id: ID!) {
+			rescheduleMatch(id: $id, input: { matchDatetime: "2024-09-01T19:00:00Z" }) { id }
+		}`, map[string]any{"id": created.ID})
+		if len(resp.Errors) != 1 || resp.Errors[0].Extensions.Code != "match_already_reported" {
+			t.Errorf("reschedule match selesai: errors = %+v", resp.Errors)
+		}
+	})
+
+	t.Run("delete reflects in later fields", func(t *testing.T) {
+		team := s.createTeam("Harimau FC")
+		data := graphQLData[map[string]any](s, `mutation ($player: ID!, $team: ID!) {
+			before: updateTeam(id: $team, input: { name: "Harimau Muda" }) { name }
+			deleteTeam(id: $team)
+			deletePlayer(id: $player)
+		}`, map[string]any{"player": playerID, "team": strconv.Itoa(int(team.ID))})
+		if data["deleteTeam"] != true || data["deletePlayer"] != true {
+			t.Errorf("data = %v", data)
+		}
+
+		resp := graphQLData[map[string]any](s, `query ($id: ID!) { player(id: $id) { name } }`, map[string]any{"id": playerID})
+		if resp["player"] != nil {
+			t.Errorf("player terhapus masih dikembalikan: %v", resp)
+		}
+	})
+}
+
+func TestGraphQLQueryLimits(t *testing.T) {
+	s := newTestServer(t)
+
+	tests := []struct {
+		name    string
+		query   string
+		code    string
+		message string
+	}{
+		{
+			"too deep",
+			`{ teams { items { players { team { players { team { name } } } } } } }`,
+			"query_too_deep",
+			"Query depth 7 exceeds the limit of 6",
+		},
+		{
+			"too complex",
+			`{ teams(first: 100) { items { players { goals { id } } } } }`,
+			"query_too_complex",
+			"Query complexity 27701 exceeds the limit of 5000",
+		},
+		{
+			"fragments count toward limits",
+			`query { teams(first: 100) { ...items } } fragment items on TeamPage { items { players { goals { id } } } }`,
+			"query_too_complex",
+			"Query complexity 27701 exceeds the limit of 5000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := s.graphQL("en", http.StatusBadRequest, tt.query, nil)
+			if len(resp.Errors) != 1 || resp.Errors[0].Extensions.Code != tt.code || resp.Errors[0].Message != tt.message {
+				t.Errorf("errors = %+v", resp.Errors)
+			}
+		})
+	}
+
+	t.Run("variables count toward complexity", func(t *testing.T) {
+		query := `query ($n: Int) { teams(first: $n) { items { players { goals { id } } } } }`
+		s.graphQL("id", http.StatusOK, query, map[string]any{"n": 10})
+		s.graphQL("id", http.StatusBadRequest, query, map[string]any{"n": 100})
+	})
+}
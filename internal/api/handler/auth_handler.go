@@ -3,6 +3,7 @@ package handler
 import (
 	"net/http"
 	"xyz-football-api/config"
+	"xyz-football-api/internal/service"
 	"xyz-football-api/pkg/utils"
 
 	"github.com/gin-gonic/gin"
@@ -10,12 +11,13 @@ import (
 
 // AuthHandler menangani endpoint autentikasi
 type AuthHandler struct {
-	cfg *config.Config
+	cfg         *config.Config
+	userService *service.UserService
 }
 
 // NewAuthHandler membuat instance AuthHandler baru
-func NewAuthHandler(cfg *config.Config) *AuthHandler {
-	return &AuthHandler{cfg: cfg}
+func NewAuthHandler(cfg *config.Config, userService *service.UserService) *AuthHandler {
+	return &AuthHandler{cfg: cfg, userService: userService}
 }
 
 // LoginRequest merepresentasikan struktur request login
@@ -31,7 +33,8 @@ type LoginResponse struct {
 
 // Login menangani endpoint POST /login
 // @Summary Login untuk mendapatkan JWT token
-// @Description Endpoint untuk autentikasi menggunakan username dan password
+// @Description Endpoint untuk autentikasi menggunakan username dan password admin dari konfigurasi
+// @Description atau user yang dibuat lewat POST /users
 // @Tags Authentication
 // @Accept json
 // @Produce json
@@ -39,6 +42,7 @@ type LoginResponse struct {
 // @Success 200 {object} LoginResponse
 // @Failure 400 {object} utils.ErrorResponse
 // @Failure 401 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
 // @Router /login [post]
 func (h *AuthHandler) Login(c *gin.Context) {
 	var req LoginRequest
@@ -48,8 +52,17 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
-	// Validasi credentials dengan data dari config
-	if req.Username != h.cfg.Admin.Username || req.Password != h.cfg.Admin.Password {
+	// Validasi credentials dengan admin dari config, lalu dengan user di database
+	valid := req.Username == h.cfg.Admin.Username && req.Password == h.cfg.Admin.Password
+	if !valid && req.Username != h.cfg.Admin.Username {
+		var err error
+		valid, err = h.userService.Authenticate(req.Username, req.Password)
+		if err != nil {
+			abortWithError(c, err, "Gagal memeriksa credentials")
+			return
+		}
+	}
+	if !valid {
 		utils.RespondError(c, http.StatusUnauthorized, "Username atau password salah")
 		return
 	}
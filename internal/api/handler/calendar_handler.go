@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+	"xyz-football-api/internal/service"
+	"xyz-football-api/pkg/ical"
+	"xyz-football-api/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CalendarHandler menangani token feed kalender dan feed iCalendar jadwal match
+type CalendarHandler struct {
+	calendarService *service.CalendarService
+}
+
+// NewCalendarHandler membuat instance CalendarHandler baru
+func NewCalendarHandler(calendarService *service.CalendarService) *CalendarHandler {
+	return &CalendarHandler{calendarService: calendarService}
+}
+
+// CalendarTokenResponse berisi token kalender baru beserta template URL feed
+type CalendarTokenResponse struct {
+	Token string `json:"token"`
+	// TeamFeed dan CompetitionFeed adalah path feed; ganti {id} dengan ID team/kompetisi
+	TeamFeed        string `json:"team_feed"`
+	CompetitionFeed string `json:"competition_feed"`
+}
+
+// CreateToken menangani endpoint POST /calendar/token
+// @Summary Membuat token feed kalender
+// @Description Membuat token untuk URL feed fixtures.ics milik user yang login. Token lama langsung tidak berlaku.
+// @Description Token hanya ditampilkan sekali; simpan URL feed di aplikasi kalender.
+// @Tags Calendar
+// @Produce json
+// @Security BearerAuth
+// @Success 201 {object} CalendarTokenResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /calendar/token [post]
+func (h *CalendarHandler) CreateToken(c *gin.Context) {
+	token, err := h.calendarService.IssueToken(c.GetString("username"))
+	if err != nil {
+		abortWithError(c, err, "Gagal membuat token kalender")
+		return
+	}
+
+	utils.RespondSuccess(c, http.StatusCreated, CalendarTokenResponse{
+		Token:           token,
+		TeamFeed:        "/teams/{id}/fixtures.ics?token=" + token,
+		CompetitionFeed: "/competitions/{id}/fixtures.ics?token=" + token,
+	})
+}
+
+// RevokeToken menangani endpoint DELETE /calendar/token
+// @Summary Mencabut token feed kalender
+// @Description Semua URL feed yang memakai token milik user yang login berhenti berlaku.
+// @Tags Calendar
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /calendar/token [delete]
+func (h *CalendarHandler) RevokeToken(c *gin.Context) {
+	if err := h.calendarService.RevokeToken(c.GetString("username")); err != nil {
+		abortWithError(c, err, "Gagal mencabut token kalender")
+		return
+	}
+
+	utils.RespondMessage(c, http.StatusOK, "Token kalender berhasil dicabut")
+}
+
+// TeamFixtures menangani endpoint GET /teams/:id/fixtures.ics
+// @Summary Feed kalender jadwal team
+// @Description Kalender iCalendar (RFC 5545) berisi semua match team. Match yang dijadwal ulang memperbarui
+// @Description event yang sama; match yang dibatalkan dikirim dengan STATUS:CANCELLED.
+// @Description Diautentikasi dengan token dari POST /calendar/token, bukan header Authorization.
+// @Tags Calendar
+// @Produce text/calendar
+// @Param id path int true "Team ID"
+// @Param token query string true "Token kalender"
+// @Success 200 {file} file
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /teams/{id}/fixtures.ics [get]
+func (h *CalendarHandler) TeamFixtures(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "ID team tidak valid")
+		return
+	}
+
+	calendar, err := h.calendarService.TeamFixtures(uint(id))
+	if err != nil {
+		abortWithError(c, err, "Gagal membuat feed kalender")
+		return
+	}
+	respondCalendar(c, fmt.Sprintf("team-%d.ics", id), calendar)
+}
+
+// CompetitionFixtures menangani endpoint GET /competitions/:id/fixtures.ics
+// @Summary Feed kalender jadwal kompetisi
+// @Description Kalender iCalendar (RFC 5545) berisi semua match dalam satu kompetisi (satu musim turnamen).
+// @Description Diautentikasi dengan token dari POST /calendar/token, bukan header Authorization.
+// @Tags Calendar
+// @Produce text/calendar
+// @Param id path int true "Competition ID"
+// @Param token query string true "Token kalender"
+// @Success 200 {file} file
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /competitions/{id}/fixtures.ics [get]
+func (h *CalendarHandler) CompetitionFixtures(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "ID kompetisi tidak valid")
+		return
+	}
+
+	calendar, err := h.calendarService.CompetitionFixtures(uint(id))
+	if err != nil {
+		abortWithError(c, err, "Gagal membuat feed kalender")
+		return
+	}
+	respondCalendar(c, fmt.Sprintf("competition-%d.ics", id), calendar)
+}
+
+// respondCalendar mengirim kalender sebagai text/calendar. Kalender di-encode ke buffer dulu
+// sehingga error encode masih bisa dirender sebagai JSON.
+func respondCalendar(c *gin.Context, filename string, calendar *ical.Calendar) {
+	clearWriteDeadline(c)
+	var buf bytes.Buffer
+	if err := calendar.Encode(&buf); err != nil {
+		abortWithError(c, err, "Gagal membuat feed kalender")
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`inline; filename="%s"`, filename))
+	c.Header("Cache-Control", "no-cache")
+	c.Data(http.StatusOK, ical.ContentType, buf.Bytes())
+}
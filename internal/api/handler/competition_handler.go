@@ -0,0 +1,545 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+	"xyz-football-api/internal/apperror"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+	"xyz-football-api/internal/service"
+	"xyz-football-api/internal/tournament"
+	"xyz-football-api/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CompetitionHandler menangani endpoint kompetisi (cup dan fase grup + knockout)
+type CompetitionHandler struct {
+	competitionRepo repository.CompetitionRepository
+	teamRepo        repository.TeamRepository
+	bracket         *service.BracketService
+}
+
+// NewCompetitionHandler membuat instance CompetitionHandler baru
+func NewCompetitionHandler(
+	competitionRepo repository.CompetitionRepository,
+	teamRepo repository.TeamRepository,
+	bracket *service.BracketService,
+) *CompetitionHandler {
+	return &CompetitionHandler{
+		competitionRepo: competitionRepo,
+		teamRepo:        teamRepo,
+		bracket:         bracket,
+	}
+}
+
+// CreateCompetitionRequest adalah struct untuk request body create competition
+type CreateCompetitionRequest struct {
+	Name              string `json:"name" binding:"required"`
+	Format            string `json:"format" binding:"required,oneof=cup group_knockout"`
+	Seeding           string `json:"seeding" binding:"omitempty,oneof=seeded drawn"`
+	TwoLegged         bool   `json:"two_legged"`
+	AwayGoalsRule     bool   `json:"away_goals_rule"`
+	StartDatetime     string `json:"start_datetime" binding:"required"`
+	RoundIntervalDays int    `json:"round_interval_days" binding:"omitempty,min=1"`
+	LegIntervalDays   int    `json:"leg_interval_days" binding:"omitempty,min=1"`
+	// GroupCount dan QualifiersPerGroup wajib untuk format group_knockout
+	GroupCount         int `json:"group_count" binding:"omitempty,min=1,max=26"`
+	QualifiersPerGroup int `json:"qualifiers_per_group" binding:"omitempty,min=1"`
+	// TeamIDs diurutkan sesuai seed (elemen pertama = seed 1)
+	TeamIDs []uint `json:"team_ids" binding:"required,min=2"`
+}
+
+// DrawCompetitionRequest adalah struct untuk request body undian/pembuatan bracket
+type DrawCompetitionRequest struct {
+	// DrawSeed membuat hasil undian (seeding=drawn) dapat direproduksi
+	DrawSeed *int64 `json:"draw_seed"`
+}
+
+// GroupTableRow merepresentasikan satu baris klasemen grup dalam response
+type GroupTableRow struct {
+	Position int    `json:"position"`
+	TeamName string `json:"team_name"`
+	tournament.StandingRow
+}
+
+// GroupResponse merepresentasikan klasemen dan jadwal satu grup
+type GroupResponse struct {
+	GroupID uint            `json:"group_id"`
+	Name    string          `json:"name"`
+	Table   []GroupTableRow `json:"table"`
+	Matches []model.Match   `json:"matches"`
+}
+
+// QualificationTeam merepresentasikan skenario kualifikasi satu tim
+type QualificationTeam struct {
+	TeamName string `json:"team_name"`
+	tournament.Scenario
+}
+
+// QualificationResponse merepresentasikan skenario kualifikasi satu grup
+type QualificationResponse struct {
+	GroupName        string              `json:"group_name"`
+	RemainingMatches int                 `json:"remaining_matches"`
+	Teams            []QualificationTeam `json:"teams"`
+}
+
+// BracketMatch merepresentasikan satu leg dalam response bracket
+type BracketMatch struct {
+	MatchID       uint              `json:"match_id"`
+	Leg           int               `json:"leg"`
+	MatchDatetime time.Time         `json:"match_datetime"`
+	Status        model.MatchStatus `json:"status"`
+	HomeTeamID    uint              `json:"home_team_id"`
+	AwayTeamID    uint              `json:"away_team_id"`
+	HomeScore     int               `json:"home_score"`
+	AwayScore     int               `json:"away_score"`
+	ExtraTime     bool              `json:"extra_time"`
+	HomePenalties *int              `json:"home_penalties,omitempty"`
+	AwayPenalties *int              `json:"away_penalties,omitempty"`
+}
+
+// BracketTie merepresentasikan satu tie dalam response bracket
+type BracketTie struct {
+	TieID      uint           `json:"tie_id"`
+	Position   int            `json:"position"`
+	HomeTeam   *model.Team    `json:"home_team"`
+	AwayTeam   *model.Team    `json:"away_team"`
+	Aggregate  string         `json:"aggregate,omitempty"`
+	WinnerTeam *model.Team    `json:"winner_team,omitempty"`
+	Matches    []BracketMatch `json:"matches"`
+}
+
+// BracketRound merepresentasikan satu babak dalam response bracket
+type BracketRound struct {
+	Round int          `json:"round"`
+	Name  string       `json:"name"`
+	Ties  []BracketTie `json:"ties"`
+}
+
+// BracketResponse merepresentasikan response bracket kompetisi
+type BracketResponse struct {
+	Competition model.Competition `json:"competition"`
+	Rounds      []BracketRound    `json:"rounds"`
+}
+
+// CreateCompetition menangani endpoint POST /competitions
+// @Summary Membuat kompetisi baru
+// @Description Endpoint untuk membuat kompetisi cup (knockout) atau fase grup + knockout beserta daftar peserta.
+// @Description Urutan team_ids menentukan seed jika seeding=seeded.
+// @Tags Competitions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body CreateCompetitionRequest true "Competition Data"
+// @Success 201 {object} model.Competition
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /competitions [post]
+func (h *CompetitionHandler) CreateCompetition(c *gin.Context) {
+	var req CreateCompetitionRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		abortWithBindError(c, err, "Data kompetisi tidak valid")
+		return
+	}
+
+	startTime, err := time.Parse(time.RFC3339, req.StartDatetime)
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "Format start_datetime tidak valid (gunakan ISO 8601/RFC3339)")
+		return
+	}
+
+	if req.AwayGoalsRule && !req.TwoLegged {
+		utils.RespondError(c, http.StatusBadRequest, "Aturan gol tandang hanya berlaku untuk tie dua leg")
+		return
+	}
+
+	if model.CompetitionFormat(req.Format) == model.CompetitionFormatGroupKnockout {
+		if err := validateGroupSettings(req); err != nil {
+			abortWithError(c, err, "")
+			return
+		}
+	}
+
+	competition := model.Competition{
+		Name:              req.Name,
+		Format:            model.CompetitionFormat(req.Format),
+		Seeding:           model.CompetitionSeedingSeeded,
+		TwoLegged:         req.TwoLegged,
+		AwayGoalsRule:     req.AwayGoalsRule,
+		StartDatetime:     startTime,
+		RoundIntervalDays: 7,
+		LegIntervalDays:   3,
+		Status:            model.CompetitionStatusDraft,
+
+		GroupCount:         req.GroupCount,
+		QualifiersPerGroup: req.QualifiersPerGroup,
+	}
+	if req.Seeding != "" {
+		competition.Seeding = model.CompetitionSeeding(req.Seeding)
+	}
+	if req.RoundIntervalDays > 0 {
+		competition.RoundIntervalDays = req.RoundIntervalDays
+	}
+	if req.LegIntervalDays > 0 {
+		competition.LegIntervalDays = req.LegIntervalDays
+	}
+
+	// Validasi setiap team exists dan tidak duplikat
+	seen := make(map[uint]bool, len(req.TeamIDs))
+	for i, teamID := range req.TeamIDs {
+		if seen[teamID] {
+			abortWithError(c, apperror.Validationf("duplicate_team", "Team dengan ID %d terdaftar lebih dari sekali", teamID), "")
+			return
+		}
+		seen[teamID] = true
+
+		if _, err := h.teamRepo.FindByID(teamID); err != nil {
+			unknown := apperror.Validationf("unknown_team", "Team dengan ID %d tidak ditemukan", teamID)
+			abortWithError(c, lookupError(err, unknown), "Gagal mengambil data team")
+			return
+		}
+
+		competition.Teams = append(competition.Teams, model.CompetitionTeam{
+			TeamID: teamID,
+			Seed:   i + 1,
+		})
+	}
+
+	if err := h.competitionRepo.Create(&competition); err != nil {
+		abortWithError(c, err, "Gagal membuat kompetisi")
+		return
+	}
+
+	utils.RespondSuccess(c, http.StatusCreated, competition)
+}
+
+// GetAllCompetitions menangani endpoint GET /competitions
+// @Summary Mengambil semua kompetisi
+// @Description Endpoint untuk mengambil daftar semua kompetisi
+// @Tags Competitions
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} model.Competition
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /competitions [get]
+func (h *CompetitionHandler) GetAllCompetitions(c *gin.Context) {
+	competitions, err := h.competitionRepo.FindAll()
+	if err != nil {
+		abortWithError(c, err, "Gagal mengambil data kompetisi")
+		return
+	}
+
+	utils.RespondSuccess(c, http.StatusOK, competitions)
+}
+
+// GetCompetitionByID menangani endpoint GET /competitions/:id
+// @Summary Mengambil kompetisi berdasarkan ID
+// @Description Endpoint untuk mengambil detail kompetisi beserta peserta
+// @Tags Competitions
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Competition ID"
+// @Success 200 {object} model.Competition
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /competitions/{id} [get]
+func (h *CompetitionHandler) GetCompetitionByID(c *gin.Context) {
+	competition, ok := h.findCompetition(c)
+	if !ok {
+		return
+	}
+
+	utils.RespondSuccess(c, http.StatusOK, competition)
+}
+
+// DrawCompetition menangani endpoint POST /competitions/:id/draw
+// @Summary Melakukan undian kompetisi
+// @Description Untuk format cup: membuat bracket knockout berdasarkan seed atau undian,
+// @Description menjadwalkan match babak pertama dan memajukan tim yang mendapat bye.
+// @Description Untuk format group_knockout: membagi tim ke grup dan menjadwalkan round-robin setiap grup.
+// @Tags Competitions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Competition ID"
+// @Param body body DrawCompetitionRequest false "Draw Options"
+// @Success 201 {object} BracketResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /competitions/{id}/draw [post]
+func (h *CompetitionHandler) DrawCompetition(c *gin.Context) {
+	competition, ok := h.findCompetition(c)
+	if !ok {
+		return
+	}
+
+	var req DrawCompetitionRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			abortWithBindError(c, err, "Data undian tidak valid")
+			return
+		}
+	}
+
+	drawSeed := time.Now().UnixNano()
+	if req.DrawSeed != nil {
+		drawSeed = *req.DrawSeed
+	}
+
+	if err := h.bracket.Draw(competition.ID, drawSeed); err != nil {
+		abortWithError(c, err, "Gagal membuat bracket")
+		return
+	}
+
+	if competition.Format == model.CompetitionFormatGroupKnockout {
+		h.respondGroups(c, competition.ID, http.StatusCreated)
+		return
+	}
+
+	h.respondBracket(c, competition.ID, http.StatusCreated)
+}
+
+// GetBracket menangani endpoint GET /competitions/:id/bracket
+// @Summary Mengambil bracket kompetisi
+// @Description Endpoint untuk mengambil bracket knockout beserta skor agregat dan pemenang setiap tie
+// @Tags Competitions
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Competition ID"
+// @Success 200 {object} BracketResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /competitions/{id}/bracket [get]
+func (h *CompetitionHandler) GetBracket(c *gin.Context) {
+	competition, ok := h.findCompetition(c)
+	if !ok {
+		return
+	}
+
+	h.respondBracket(c, competition.ID, http.StatusOK)
+}
+
+// GetGroups menangani endpoint GET /competitions/:id/groups
+// @Summary Mengambil klasemen dan jadwal fase grup
+// @Description Endpoint untuk mengambil klasemen setiap grup (poin, selisih gol, gol) beserta jadwal match-nya
+// @Tags Competitions
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Competition ID"
+// @Success 200 {array} GroupResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /competitions/{id}/groups [get]
+func (h *CompetitionHandler) GetGroups(c *gin.Context) {
+	competition, ok := h.findCompetition(c)
+	if !ok {
+		return
+	}
+
+	if competition.Format != model.CompetitionFormatGroupKnockout {
+		utils.RespondError(c, http.StatusBadRequest, "Kompetisi ini tidak memiliki fase grup")
+		return
+	}
+
+	h.respondGroups(c, competition.ID, http.StatusOK)
+}
+
+// GetQualificationScenarios menangani endpoint GET /competitions/:id/qualification
+// @Summary Melihat skenario kualifikasi fase grup
+// @Description Endpoint untuk melihat posisi terbaik/terburuk yang masih mungkin dicapai setiap tim
+// @Description berdasarkan sisa pertandingan grup, serta status qualified/eliminated/in_contention
+// @Tags Competitions
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Competition ID"
+// @Success 200 {array} QualificationResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /competitions/{id}/qualification [get]
+func (h *CompetitionHandler) GetQualificationScenarios(c *gin.Context) {
+	competition, ok := h.findCompetition(c)
+	if !ok {
+		return
+	}
+
+	if competition.Format != model.CompetitionFormatGroupKnockout {
+		utils.RespondError(c, http.StatusBadRequest, "Kompetisi ini tidak memiliki fase grup")
+		return
+	}
+
+	groups, err := h.competitionRepo.FindGroups(competition.ID)
+	if err != nil {
+		abortWithError(c, err, "Gagal mengambil data grup")
+		return
+	}
+
+	response := make([]QualificationResponse, 0, len(groups))
+	for _, group := range groups {
+		table := tournament.GroupTable(group.TeamIDs(), group.Matches)
+
+		var remaining []model.Match
+		for _, m := range group.Matches {
+			if m.Status == model.MatchStatusScheduled {
+				remaining = append(remaining, m)
+			}
+		}
+
+		names := group.TeamNames()
+		qr := QualificationResponse{
+			GroupName:        group.Name,
+			RemainingMatches: len(remaining),
+			Teams:            []QualificationTeam{},
+		}
+		for _, scenario := range tournament.QualificationScenarios(table, remaining, competition.QualifiersPerGroup) {
+			qr.Teams = append(qr.Teams, QualificationTeam{
+				Scenario: scenario,
+				TeamName: names[scenario.TeamID],
+			})
+		}
+		response = append(response, qr)
+	}
+
+	utils.RespondSuccess(c, http.StatusOK, response)
+}
+
+// respondGroups menyusun dan mengirimkan klasemen serta jadwal setiap grup
+func (h *CompetitionHandler) respondGroups(c *gin.Context, competitionID uint, statusCode int) {
+	standings, err := h.bracket.GroupStandings(competitionID)
+	if err != nil {
+		abortWithError(c, err, "Gagal mengambil data grup")
+		return
+	}
+
+	response := make([]GroupResponse, 0, len(standings))
+	for _, standing := range standings {
+		gr := GroupResponse{
+			GroupID: standing.Group.ID,
+			Name:    standing.Group.Name,
+			Table:   make([]GroupTableRow, 0, len(standing.Table)),
+			Matches: standing.Group.Matches,
+		}
+		for _, row := range standing.Table {
+			gr.Table = append(gr.Table, GroupTableRow{
+				Position:    row.Position,
+				TeamName:    row.TeamName,
+				StandingRow: row.StandingRow,
+			})
+		}
+		response = append(response, gr)
+	}
+
+	utils.RespondSuccess(c, statusCode, response)
+}
+
+// respondBracket menyusun dan mengirimkan response bracket kompetisi
+func (h *CompetitionHandler) respondBracket(c *gin.Context, competitionID uint, statusCode int) {
+	competition, err := h.competitionRepo.FindByID(competitionID)
+	if err != nil {
+		abortWithError(c, err, "Gagal mengambil data kompetisi")
+		return
+	}
+
+	ties, err := h.competitionRepo.FindTies(competitionID)
+	if err != nil {
+		abortWithError(c, err, "Gagal mengambil data bracket")
+		return
+	}
+
+	totalRounds := 0
+	for _, tie := range ties {
+		if tie.Round > totalRounds {
+			totalRounds = tie.Round
+		}
+	}
+
+	response := BracketResponse{Competition: *competition, Rounds: []BracketRound{}}
+	for _, tie := range ties {
+		if len(response.Rounds) < tie.Round {
+			response.Rounds = append(response.Rounds, BracketRound{
+				Round: tie.Round,
+				Name:  tournament.RoundName(tie.Round, totalRounds),
+				Ties:  []BracketTie{},
+			})
+		}
+
+		bt := BracketTie{
+			TieID:      tie.ID,
+			Position:   tie.Position,
+			HomeTeam:   tie.HomeTeam,
+			AwayTeam:   tie.AwayTeam,
+			WinnerTeam: tie.WinnerTeam,
+			Matches:    []BracketMatch{},
+		}
+		for _, m := range tie.Matches {
+			if m.Status == model.MatchStatusCompleted {
+				home, away := tournament.Aggregate(tie, tie.Matches)
+				bt.Aggregate = fmt.Sprintf("%d-%d", home, away)
+			}
+			leg := 1
+			if m.Leg != nil {
+				leg = *m.Leg
+			}
+			bt.Matches = append(bt.Matches, BracketMatch{
+				MatchID:       m.ID,
+				Leg:           leg,
+				MatchDatetime: m.MatchDatetime,
+				Status:        m.Status,
+				HomeTeamID:    m.HomeTeamID,
+				AwayTeamID:    m.AwayTeamID,
+				HomeScore:     m.HomeScore,
+				AwayScore:     m.AwayScore,
+				ExtraTime:     m.ExtraTime,
+				HomePenalties: m.HomePenalties,
+				AwayPenalties: m.AwayPenalties,
+			})
+		}
+
+		round := &response.Rounds[tie.Round-1]
+		round.Ties = append(round.Ties, bt)
+	}
+
+	utils.RespondSuccess(c, statusCode, response)
+}
+
+// findCompetition mem-parsing :id dan mengambil kompetisi
+func (h *CompetitionHandler) findCompetition(c *gin.Context) (*model.Competition, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "ID kompetisi tidak valid")
+		return nil, false
+	}
+
+	competition, err := h.competitionRepo.FindByID(uint(id))
+	if err != nil {
+		abortWithError(c, lookupError(err, service.ErrCompetitionNotFound), "Gagal mengambil data kompetisi")
+		return nil, false
+	}
+
+	return competition, true
+}
+
+// validateGroupSettings memvalidasi pengaturan fase grup. Mengembalikan nil jika valid.
+func validateGroupSettings(req CreateCompetitionRequest) *apperror.Error {
+	if req.GroupCount < 1 || req.QualifiersPerGroup < 1 {
+		return apperror.Validation("invalid_group_settings", "group_count dan qualifiers_per_group wajib diisi untuk format group_knockout")
+	}
+	if len(req.TeamIDs) < req.GroupCount*2 {
+		return apperror.Validationf("invalid_group_settings", "Minimal %d tim dibutuhkan untuk %d grup", req.GroupCount*2, req.GroupCount)
+	}
+	smallestGroup := len(req.TeamIDs) / req.GroupCount
+	if req.QualifiersPerGroup >= smallestGroup {
+		return apperror.Validationf("invalid_group_settings", "qualifiers_per_group harus lebih kecil dari jumlah tim per grup (%d)", smallestGroup)
+	}
+	if req.GroupCount*req.QualifiersPerGroup < 2 {
+		return apperror.Validation("invalid_group_settings", "Minimal 2 tim harus lolos ke babak knockout")
+	}
+	return nil
+}
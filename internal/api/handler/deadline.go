@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// clearWriteDeadline melepas SERVER_WRITE_TIMEOUT untuk request ini. Dipakai endpoint unduhan
+// (export, PDF, iCalendar) yang response-nya bisa jauh lebih lama dari request API biasa.
+// Writer yang tidak mendukung deadline (mis. httptest.ResponseRecorder) diabaikan.
+func clearWriteDeadline(c *gin.Context) {
+	http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+}
+
+// clearReadDeadline melepas SERVER_READ_TIMEOUT untuk request ini sehingga upload file besar
+// tidak terputus. Harus dipanggil sebelum body request dibaca.
+func clearReadDeadline(c *gin.Context) {
+	http.NewResponseController(c.Writer).SetReadDeadline(time.Time{})
+}
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DocsHandler menyajikan spesifikasi OpenAPI dan halaman dokumentasi interaktif
+type DocsHandler struct {
+	spec []byte
+	page []byte
+}
+
+// NewDocsHandler membuat instance DocsHandler baru dari dokumen OpenAPI (JSON) dan halaman HTML
+func NewDocsHandler(spec, page []byte) *DocsHandler {
+	return &DocsHandler{spec: spec, page: page}
+}
+
+// Spec menangani endpoint GET /openapi.json
+// @Summary Spesifikasi OpenAPI 3
+// @Description Dokumen OpenAPI 3 yang di-generate dari anotasi handler (go generate ./internal/api/docs).
+// @Tags System
+// @Produce json
+// @Success 200 {object} map[string]interface{} "Dokumen OpenAPI 3"
+// @Router /openapi.json [get]
+func (h *DocsHandler) Spec(c *gin.Context) {
+	c.Data(http.StatusOK, "application/json; charset=utf-8", h.spec)
+}
+
+// Page menangani endpoint GET /docs
+// @Summary Dokumentasi API interaktif
+// @Description Halaman HTML yang membaca /openapi.json dan bisa mengirim request langsung dari browser.
+// @Tags System
+// @Produce html
+// @Success 200 {string} string "Halaman HTML"
+// @Router /docs [get]
+func (h *DocsHandler) Page(c *gin.Context) {
+	c.Data(http.StatusOK, "text/html; charset=utf-8", h.page)
+}
@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"regexp"
+	"strings"
+	"xyz-football-api/internal/apperror"
+	"xyz-football-api/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"gorm.io/gorm"
+)
+
+// oneOfPattern memecah parameter tag oneof; opsi yang mengandung spasi ditulis dalam kutip
+var oneOfPattern = regexp.MustCompile(`'[^']*'|\S+`)
+
+// init mendaftarkan nama tag json sebagai nama field di validator sehingga
+// detail error validasi memakai nama field yang sama dengan request body
+func init() {
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(func(f reflect.StructField) string {
+			name := strings.SplitN(f.Tag.Get("json"), ",", 2)[0]
+			if name == "-" {
+				return ""
+			}
+			return name
+		})
+	}
+}
+
+// abortWithError meneruskan err ke middleware ErrorHandler. Error selain *apperror.Error
+// dianggap kegagalan internal: failureMsg dikirim ke client dan err hanya dicatat di log.
+func abortWithError(c *gin.Context, err error, failureMsg string) {
+	var appErr *apperror.Error
+	if !errors.As(err, &appErr) {
+		err = apperror.Internal(failureMsg, err)
+	}
+	_ = c.Error(err)
+	c.Abort()
+}
+
+// lookupError mengubah error pencarian record: record tidak ada menjadi notFound,
+// error lain (misalnya koneksi database) dikembalikan apa adanya
+func lookupError(err error, notFound *apperror.Error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return notFound
+	}
+	return err
+}
+
+// bindError mengubah error binding request body menjadi error validasi dengan detail per field
+func bindError(err error, message string) *apperror.Error {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		fields := make([]apperror.FieldError, 0, len(validationErrs))
+		for _, fe := range validationErrs {
+			message, args := validationMessage(fe)
+			fields = append(fields, apperror.FieldError{Field: fieldPath(fe), Message: message, Args: args})
+		}
+		return apperror.Validation("validation_failed", message, fields...)
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return apperror.Validation("validation_failed", message, apperror.FieldError{
+			Field:   typeErr.Field,
+			Message: "tipe data harus %s",
+			Args:    []interface{}{typeErr.Type.String()},
+		})
+	}
+
+	return apperror.Validation("invalid_body", message)
+}
+
+// ValidateRequest memvalidasi request dengan aturan tag binding yang sama seperti request body REST.
+// Dipakai transport lain (GraphQL, gRPC) yang mengisi struct request REST dari input-nya sendiri;
+// hasilnya error validasi dengan detail per field memakai nama tag json.
+func ValidateRequest(req interface{}, message string) error {
+	if err := binding.Validator.ValidateStruct(req); err != nil {
+		return bindError(err, message)
+	}
+	return nil
+}
+
+// fieldPath mengembalikan path field tanpa nama struct, misalnya goals[0].player_id
+func fieldPath(fe validator.FieldError) string {
+	namespace := fe.Namespace()
+	if i := strings.Index(namespace, "."); i >= 0 {
+		return namespace[i+1:]
+	}
+	return namespace
+}
+
+// validationMessages adalah message ID untuk setiap tag validator; %s diisi parameter tag
+var validationMessages = map[string]string{
+	"required": "wajib diisi",
+	"min":      "minimal %s",
+	"max":      "maksimal %s",
+	"oneof":    "harus salah satu dari: %s",
+}
+
+// validationMessage mengubah tag validator menjadi message ID beserta argumennya
+func validationMessage(fe validator.FieldError) (string, []interface{}) {
+	message, ok := validationMessages[fe.Tag()]
+	if !ok {
+		return "tidak valid (%s)", []interface{}{fe.Tag()}
+	}
+
+	switch fe.Tag() {
+	case "required":
+		return message, nil
+	case "oneof":
+		options := oneOfPattern.FindAllString(fe.Param(), -1)
+		for i, option := range options {
+			options[i] = strings.Trim(option, "'")
+		}
+		return message, []interface{}{strings.Join(options, ", ")}
+	default:
+		return message, []interface{}{fe.Param()}
+	}
+}
+
+// abortWithBindError meneruskan error binding request body sebagai error validasi
+func abortWithBindError(c *gin.Context, err error, message string) {
+	abortWithError(c, bindError(err, message), message)
+}
+
+// translateFields menerjemahkan detail field error domain ke bahasa request
+func translateFields(c *gin.Context, fields []apperror.FieldError) []utils.FieldError {
+	translated := make([]utils.FieldError, 0, len(fields))
+	for _, f := range fields {
+		translated = append(translated, utils.FieldError{Field: f.Field, Message: utils.Translate(c, f.Message, f.Args...)})
+	}
+	return translated
+}
@@ -0,0 +1,221 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+	"xyz-football-api/internal/service"
+	"xyz-football-api/pkg/spreadsheet"
+	"xyz-football-api/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// exportFormats adalah format yang ditawarkan saat negosiasi header Accept; yang pertama adalah default
+var exportFormats = []spreadsheet.Format{spreadsheet.FormatCSV, spreadsheet.FormatJSONL, spreadsheet.FormatXLSX}
+
+// ExportHandler menangani endpoint export data dalam format CSV, JSON Lines, atau XLSX
+type ExportHandler struct {
+	exportService *service.ExportService
+}
+
+// NewExportHandler membuat instance ExportHandler baru
+func NewExportHandler(exportService *service.ExportService) *ExportHandler {
+	return &ExportHandler{exportService: exportService}
+}
+
+// ExportTeams menangani endpoint GET /export/teams
+// @Summary Export semua team
+// @Description Format dipilih dari parameter format atau header Accept (text/csv, application/x-ndjson,
+// @Description application/vnd.openxmlformats-officedocument.spreadsheetml.sheet). Default CSV.
+// @Description Kolom sama dengan kolom import sehingga file bisa di-import kembali.
+// @Tags Export
+// @Produce text/csv,application/x-ndjson,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security BearerAuth
+// @Param format query string false "Format file" Enums(csv, jsonl, xlsx)
+// @Success 200 {file} file
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 406 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /export/teams [get]
+func (h *ExportHandler) ExportTeams(c *gin.Context) {
+	h.stream(c, "teams", h.exportService.ExportTeams)
+}
+
+// ExportPlayers menangani endpoint GET /export/players
+// @Summary Export player
+// @Description Filter sama dengan GET /teams/{id}/players: team_id dan available_on (available_on membutuhkan team_id).
+// @Description Format dipilih dari parameter format atau header Accept. Default CSV.
+// @Tags Export
+// @Produce text/csv,application/x-ndjson,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security BearerAuth
+// @Param format query string false "Format file" Enums(csv, jsonl, xlsx)
+// @Param team_id query int false "Hanya player dari team ini"
+// @Param available_on query string false "Hanya player yang tersedia pada tanggal ini (YYYY-MM-DD)"
+// @Success 200 {file} file
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 406 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /export/players [get]
+func (h *ExportHandler) ExportPlayers(c *gin.Context) {
+	var filter service.PlayerExportFilter
+	var ok bool
+	if filter.TeamID, ok = queryID(c, "team_id"); !ok {
+		return
+	}
+	if value := c.Query("available_on"); value != "" {
+		date, err := time.Parse(dateLayout, value)
+		if err != nil {
+			utils.RespondError(c, http.StatusBadRequest, "Format available_on tidak valid (gunakan YYYY-MM-DD)")
+			return
+		}
+		filter.AvailableOn = &date
+	}
+
+	h.stream(c, "players", func(w spreadsheet.Writer) error {
+		return h.exportService.ExportPlayers(filter, w)
+	})
+}
+
+// ExportMatches menangani endpoint GET /export/matches
+// @Summary Export match
+// @Description Kolom match_result berisi enum yang sama dengan laporan match.
+// @Description Format dipilih dari parameter format atau header Accept. Default CSV.
+// @Tags Export
+// @Produce text/csv,application/x-ndjson,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security BearerAuth
+// @Param format query string false "Format file" Enums(csv, jsonl, xlsx)
+// @Param team_id query int false "Hanya match di mana team ini bermain (home atau away)"
+// @Param status query string false "Status match" Enums(scheduled, completed, cancelled)
+// @Success 200 {file} file
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 406 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /export/matches [get]
+func (h *ExportHandler) ExportMatches(c *gin.Context) {
+	filter := repository.MatchFilter{Status: model.MatchStatus(c.Query("status"))}
+	var ok bool
+	if filter.TeamID, ok = queryID(c, "team_id"); !ok {
+		return
+	}
+
+	h.stream(c, "matches", func(w spreadsheet.Writer) error {
+		return h.exportService.ExportMatches(filter, w)
+	})
+}
+
+// ExportGoals menangani endpoint GET /export/goals
+// @Summary Export goal
+// @Description Format dipilih dari parameter format atau header Accept. Default CSV.
+// @Tags Export
+// @Produce text/csv,application/x-ndjson,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security BearerAuth
+// @Param format query string false "Format file" Enums(csv, jsonl, xlsx)
+// @Param match_id query int false "Hanya goal dari match ini"
+// @Param player_id query int false "Hanya goal dari player ini"
+// @Success 200 {file} file
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 406 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /export/goals [get]
+func (h *ExportHandler) ExportGoals(c *gin.Context) {
+	var filter repository.GoalFilter
+	var ok bool
+	if filter.MatchID, ok = queryID(c, "match_id"); !ok {
+		return
+	}
+	if filter.PlayerID, ok = queryID(c, "player_id"); !ok {
+		return
+	}
+
+	h.stream(c, "goals", func(w spreadsheet.Writer) error {
+		return h.exportService.ExportGoals(filter, w)
+	})
+}
+
+// stream memilih format lalu menjalankan export langsung ke response.
+// Header response baru dikirim saat byte pertama ditulis, sehingga error validasi filter
+// masih dirender sebagai JSON biasa.
+func (h *ExportHandler) stream(c *gin.Context, dataset string, export func(spreadsheet.Writer) error) {
+	format, ok := exportFormat(c)
+	if !ok {
+		return
+	}
+	clearWriteDeadline(c)
+
+	out := &exportResponseWriter{c: c, format: format, filename: dataset + "." + string(format)}
+	w, err := spreadsheet.NewWriter(format, out)
+	if err != nil {
+		abortWithError(c, err, "Gagal mengekspor data")
+		return
+	}
+
+	if err := export(w); err != nil {
+		// Jika sebagian file sudah terkirim, error hanya dicatat di log
+		abortWithError(c, err, "Gagal mengekspor data")
+	}
+}
+
+// exportFormat membaca format dari parameter format, atau dari header Accept jika tidak diisi
+func exportFormat(c *gin.Context) (spreadsheet.Format, bool) {
+	if value := c.Query("format"); value != "" {
+		format := spreadsheet.Format(strings.ToLower(value))
+		if format.ContentType() == "" {
+			utils.RespondError(c, http.StatusBadRequest, "Format export tidak didukung (csv, jsonl, xlsx)")
+			return "", false
+		}
+		return format, true
+	}
+
+	offered := make([]string, len(exportFormats))
+	for i, format := range exportFormats {
+		offered[i] = format.ContentType()
+	}
+	format, err := spreadsheet.FormatFromContentType(c.NegotiateFormat(offered...))
+	if err != nil {
+		utils.RespondError(c, http.StatusNotAcceptable, "Format export tidak didukung (csv, jsonl, xlsx)")
+		return "", false
+	}
+	return format, true
+}
+
+// queryID membaca parameter query ID opsional; nil jika tidak diisi
+func queryID(c *gin.Context, name string) (*uint, bool) {
+	value := c.Query(name)
+	if value == "" {
+		return nil, true
+	}
+
+	id, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "Parameter %s tidak valid", name)
+		return nil, false
+	}
+	result := uint(id)
+	return &result, true
+}
+
+// exportResponseWriter menulis ke response dan mengirim header file saat penulisan pertama
+type exportResponseWriter struct {
+	c        *gin.Context
+	format   spreadsheet.Format
+	filename string
+	started  bool
+}
+
+func (w *exportResponseWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		w.started = true
+		w.c.Header("Content-Type", w.format.ContentType())
+		w.c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, w.filename))
+		w.c.Status(http.StatusOK)
+	}
+	return w.c.Writer.Write(p)
+}
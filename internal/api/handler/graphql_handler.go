@@ -0,0 +1,217 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"xyz-football-api/config"
+	"xyz-football-api/internal/apperror"
+	"xyz-football-api/internal/repository"
+	"xyz-football-api/internal/service"
+	"xyz-football-api/pkg/i18n"
+	"xyz-football-api/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/location"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+// GraphQLHandler menangani endpoint GraphQL di atas graph team, player, match, dan goal.
+// Mutation memakai service dan validasi yang sama dengan endpoint REST.
+type GraphQLHandler struct {
+	repos         *repository.Repositories
+	teamService   *service.TeamService
+	playerService *service.PlayerService
+	matchService  *service.MatchService
+	cfg           config.GraphQLConfig
+	schema        graphql.Schema
+}
+
+// NewGraphQLHandler membuat instance GraphQLHandler baru beserta schema-nya
+func NewGraphQLHandler(
+	repos *repository.Repositories,
+	teamService *service.TeamService,
+	playerService *service.PlayerService,
+	matchService *service.MatchService,
+	cfg config.GraphQLConfig,
+) *GraphQLHandler {
+	h := &GraphQLHandler{
+		repos:         repos,
+		teamService:   teamService,
+		playerService: playerService,
+		matchService:  matchService,
+		cfg:           cfg,
+	}
+
+	schema, err := h.newSchema()
+	if err != nil {
+		// Schema dibangun dari definisi statis; error berarti kesalahan program
+		panic(fmt.Sprintf("schema GraphQL tidak valid: %v", err))
+	}
+	h.schema = schema
+	return h
+}
+
+// GraphQLRequest adalah body request GraphQL
+type GraphQLRequest struct {
+	Query         string                 `json:"query" example:"{ teams(first: 5) { items { name players { name } } } }"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+// GraphQLResponse adalah response GraphQL: data hasil eksekusi dan/atau daftar error.
+// Error domain membawa extensions.code (sama dengan code REST) dan extensions.fields untuk error validasi.
+type GraphQLResponse struct {
+	Data   interface{}                `json:"data,omitempty"`
+	Errors []gqlerrors.FormattedError `json:"errors,omitempty" swaggertype:"array,object"`
+}
+
+// graphQLContextKey adalah key context untuk graphQLRequestContext
+type graphQLContextKey struct{}
+
+// graphQLRequestContext adalah state satu request GraphQL yang dibaca resolver dari context
+type graphQLRequestContext struct {
+	lang    string
+	loaders *graphQLLoaders
+	// internal berisi penyebab error internal; hanya dicatat di log, tidak dikirim ke client
+	internal []error
+}
+
+// requestContext mengambil state request dari context resolver
+func requestContext(ctx context.Context) *graphQLRequestContext {
+	return ctx.Value(graphQLContextKey{}).(*graphQLRequestContext)
+}
+
+// Query menangani endpoint POST /graphql
+// @Summary Menjalankan query atau mutation GraphQL
+// @Description Satu request bisa mengambil team, player, match, dan goal beserta relasinya.
+// @Description Query: team, teams, player, players, match, matches (filter dan pagination first/offset).
+// @Description Mutation: createTeam, updateTeam, deleteTeam, createPlayer, updatePlayer, deletePlayer,
+// @Description createMatch, rescheduleMatch, reportMatchResult dengan validasi yang sama seperti REST.
+// @Description Query yang melebihi batas kedalaman (GRAPHQL_MAX_DEPTH) atau kompleksitas (GRAPHQL_MAX_COMPLEXITY)
+// @Description ditolak dengan code query_too_deep atau query_too_complex. Schema bisa dibaca lewat introspection.
+// @Tags GraphQL
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body GraphQLRequest true "Query GraphQL"
+// @Success 200 {object} GraphQLResponse
+// @Failure 400 {object} GraphQLResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Router /graphql [post]
+func (h *GraphQLHandler) Query(c *gin.Context) {
+	lang := utils.Language(c)
+	c.Header("Content-Language", lang)
+
+	var req GraphQLRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.reject(c, lang, apperror.Validation("invalid_body", "Body request GraphQL tidak valid"))
+		return
+	}
+	if strings.TrimSpace(req.Query) == "" {
+		h.reject(c, lang, apperror.Validation("invalid_body", "Query GraphQL wajib diisi"))
+		return
+	}
+
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(req.Query), Name: "GraphQL request"}),
+	})
+	if err != nil {
+		c.JSON(http.StatusBadRequest, GraphQLResponse{Errors: gqlerrors.FormatErrors(err)})
+		return
+	}
+	if result := graphql.ValidateDocument(&h.schema, doc, nil); !result.IsValid {
+		c.JSON(http.StatusBadRequest, GraphQLResponse{Errors: result.Errors})
+		return
+	}
+	if err := checkQueryLimits(h.schema, doc, req.OperationName, req.Variables, h.cfg); err != nil {
+		h.reject(c, lang, err)
+		return
+	}
+
+	state := &graphQLRequestContext{lang: lang, loaders: newGraphQLLoaders(h.repos)}
+	result := graphql.Execute(graphql.ExecuteParams{
+		Schema:        h.schema,
+		AST:           doc,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       context.WithValue(c.Request.Context(), graphQLContextKey{}, state),
+	})
+	for _, err := range state.internal {
+		_ = c.Error(err)
+	}
+
+	c.JSON(http.StatusOK, GraphQLResponse{Data: result.Data, Errors: result.Errors})
+}
+
+// reject mengirim response 400 untuk request yang tidak dieksekusi
+func (h *GraphQLHandler) reject(c *gin.Context, lang string, err error) {
+	gqlErr := newGraphQLError(lang, err, "")
+	c.JSON(http.StatusBadRequest, GraphQLResponse{Errors: []gqlerrors.FormattedError{{
+		Message:    gqlErr.message,
+		Locations:  []location.SourceLocation{},
+		Extensions: gqlErr.extensions,
+	}}})
+}
+
+// graphQLError adalah error yang dikirim ke client dalam bahasa request.
+// Extensions dibaca library GraphQL dan ditampilkan di response.
+type graphQLError struct {
+	message    string
+	extensions map[string]interface{}
+}
+
+func (e *graphQLError) Error() string {
+	return e.message
+}
+
+// Extensions mengembalikan code dan detail field error
+func (e *graphQLError) Extensions() map[string]interface{} {
+	return e.extensions
+}
+
+// newGraphQLError menerjemahkan error domain ke bahasa request. Error selain *apperror.Error
+// dianggap kegagalan internal: failureMsg dikirim ke client, seperti abortWithError pada REST.
+func newGraphQLError(lang string, err error, failureMsg string) *graphQLError {
+	var appErr *apperror.Error
+	if !errors.As(err, &appErr) {
+		appErr = apperror.Internal(failureMsg, err)
+	}
+
+	extensions := map[string]interface{}{"code": appErr.Code}
+	if len(appErr.Fields) > 0 {
+		fields := make([]utils.FieldError, 0, len(appErr.Fields))
+		for _, f := range appErr.Fields {
+			fields = append(fields, utils.FieldError{Field: graphQLFieldPath(f.Field), Message: i18n.Translate(lang, f.Message, f.Args...)})
+		}
+		extensions["fields"] = fields
+	}
+	return &graphQLError{message: i18n.Translate(lang, appErr.Message, appErr.Args...), extensions: extensions}
+}
+
+// fail mengubah error resolver menjadi error GraphQL dan mencatat penyebab error internal untuk log
+func fail(ctx context.Context, err error, failureMsg string) error {
+	state := requestContext(ctx)
+	var appErr *apperror.Error
+	if !errors.As(err, &appErr) || appErr.Kind == apperror.KindInternal {
+		state.internal = append(state.internal, err)
+	}
+	return newGraphQLError(state.lang, err, failureMsg)
+}
+
+// graphQLFieldPath mengubah path field request REST (snake_case) menjadi nama field GraphQL (camelCase),
+// misalnya goals[0].player_id menjadi goals[0].playerId
+func graphQLFieldPath(path string) string {
+	parts := strings.Split(path, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
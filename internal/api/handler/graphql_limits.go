@@ -0,0 +1,165 @@
+package handler
+
+import (
+	"strconv"
+	"strings"
+	"xyz-football-api/config"
+	"xyz-football-api/internal/apperror"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// graphQLListSizes adalah perkiraan jumlah item relasi list yang tidak punya argumen first,
+// dipakai sebagai pengali saat menghitung kompleksitas query
+var graphQLListSizes = map[string]int{
+	"Team.players": 25,
+	"Player.goals": 10,
+	"Match.goals":  10,
+}
+
+// checkQueryLimits menolak operasi yang lebih dalam dari cfg.MaxDepth atau lebih kompleks dari
+// cfg.MaxComplexity sebelum dieksekusi. Setiap field bernilai 1; field list mengalikan biaya
+// sub-field dengan argumen first (atau perkiraan di graphQLListSizes). Field introspection
+// (__schema, __type, __typename) tidak dihitung. Batas bernilai 0 tidak diperiksa.
+func checkQueryLimits(schema graphql.Schema, doc *ast.Document, operationName string, variables map[string]interface{}, cfg config.GraphQLConfig) error {
+	analyzer := queryAnalyzer{
+		schema:    schema,
+		fragments: make(map[string]*ast.FragmentDefinition),
+		variables: variables,
+	}
+
+	var operation *ast.OperationDefinition
+	for _, definition := range doc.Definitions {
+		switch def := definition.(type) {
+		case *ast.FragmentDefinition:
+			analyzer.fragments[def.Name.Value] = def
+		case *ast.OperationDefinition:
+			if operationName == "" || def.Name != nil && def.Name.Value == operationName {
+				operation = def
+			}
+		}
+	}
+	// Operasi yang tidak ditemukan dilaporkan oleh executor
+	if operation == nil {
+		return nil
+	}
+
+	root := schema.QueryType()
+	if operation.Operation == ast.OperationTypeMutation {
+		root = schema.MutationType()
+	}
+	depth, complexity := analyzer.analyze(root, operation.SelectionSet)
+
+	if cfg.MaxDepth > 0 && depth > cfg.MaxDepth {
+		return apperror.Validationf("query_too_deep", "Kedalaman query %d melebihi batas %d", depth, cfg.MaxDepth)
+	}
+	if cfg.MaxComplexity > 0 && complexity > cfg.MaxComplexity {
+		return apperror.Validationf("query_too_complex", "Kompleksitas query %d melebihi batas %d", complexity, cfg.MaxComplexity)
+	}
+	return nil
+}
+
+// queryAnalyzer menghitung kedalaman dan kompleksitas operasi yang sudah lolos validasi
+type queryAnalyzer struct {
+	schema    graphql.Schema
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]interface{}
+}
+
+// analyze mengembalikan kedalaman dan kompleksitas selection set milik parent.
+// Fragment tidak menambah kedalaman; siklus fragment sudah ditolak validasi.
+func (a *queryAnalyzer) analyze(parent *graphql.Object, set *ast.SelectionSet) (depth, complexity int) {
+	if parent == nil || set == nil {
+		return 0, 0
+	}
+
+	for _, selection := range set.Selections {
+		var d, c int
+		switch sel := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(sel.Name.Value, "__") {
+				continue
+			}
+			def, ok := parent.Fields()[sel.Name.Value]
+			if !ok {
+				continue
+			}
+			childDepth, childComplexity := a.analyze(objectOf(def.Type), sel.SelectionSet)
+			d = childDepth + 1
+			c = 1 + childComplexity*a.multiplier(parent, def, sel)
+		case *ast.InlineFragment:
+			d, c = a.analyze(a.fragmentType(parent, sel.TypeCondition), sel.SelectionSet)
+		case *ast.FragmentSpread:
+			if fragment, ok := a.fragments[sel.Name.Value]; ok {
+				d, c = a.analyze(a.fragmentType(parent, fragment.TypeCondition), fragment.SelectionSet)
+			}
+		}
+		depth = max(depth, d)
+		complexity += c
+	}
+	return depth, complexity
+}
+
+// multiplier mengembalikan perkiraan jumlah item yang dihasilkan field
+func (a *queryAnalyzer) multiplier(parent *graphql.Object, def *graphql.FieldDefinition, field *ast.Field) int {
+	for _, arg := range def.Args {
+		if arg.Name() != "first" {
+			continue
+		}
+		first, _ := arg.DefaultValue.(int)
+		for _, given := range field.Arguments {
+			if given.Name.Value == "first" {
+				first = a.intValue(given.Value)
+			}
+		}
+		return max(first, 1)
+	}
+
+	if size, ok := graphQLListSizes[parent.Name()+"."+def.Name]; ok {
+		return size
+	}
+	return 1
+}
+
+// intValue membaca argumen Int dari literal atau variable
+func (a *queryAnalyzer) intValue(value ast.Value) int {
+	switch v := value.(type) {
+	case *ast.IntValue:
+		n, _ := strconv.Atoi(v.Value)
+		return n
+	case *ast.Variable:
+		switch n := a.variables[v.Name.Value].(type) {
+		case int:
+			return n
+		case float64:
+			return int(n)
+		}
+	}
+	return 0
+}
+
+// fragmentType mengembalikan tipe kondisi fragment, atau parent jika tidak ada kondisi
+func (a *queryAnalyzer) fragmentType(parent *graphql.Object, condition *ast.Named) *graphql.Object {
+	if condition == nil {
+		return parent
+	}
+	object, _ := a.schema.Type(condition.Name.Value).(*graphql.Object)
+	return object
+}
+
+// objectOf membuka pembungkus NonNull dan List lalu mengembalikan tipe object, atau nil untuk scalar dan enum
+func objectOf(typ graphql.Type) *graphql.Object {
+	for {
+		switch t := typ.(type) {
+		case *graphql.NonNull:
+			typ = t.OfType
+		case *graphql.List:
+			typ = t.OfType
+		case *graphql.Object:
+			return t
+		default:
+			return nil
+		}
+	}
+}
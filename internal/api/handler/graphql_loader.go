@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+)
+
+// batchLoader mengumpulkan key yang diminta resolver lalu mengambil semuanya dengan
+// satu panggilan fetch saat hasil pertama dibutuhkan (pola DataLoader). Executor GraphQL
+// menyelesaikan thunk secara breadth-first, sehingga setiap item dalam satu list sudah
+// mendaftarkan key-nya sebelum batch dijalankan dan relasi tidak memicu query N+1.
+// Hasil di-cache selama satu request; batchLoader tidak aman dipakai lintas goroutine.
+type batchLoader[K comparable, V any] struct {
+	fetch   func(keys []K) (map[K]V, error)
+	pending []K
+	queued  map[K]struct{}
+	results map[K]V
+	errs    map[K]error
+}
+
+// newBatchLoader membuat batchLoader dengan fungsi pengambil batch. Key yang tidak ada
+// di hasil fetch menghasilkan zero value (mis. nil untuk record yang sudah dihapus).
+func newBatchLoader[K comparable, V any](fetch func(keys []K) (map[K]V, error)) *batchLoader[K, V] {
+	return &batchLoader[K, V]{
+		fetch:   fetch,
+		queued:  make(map[K]struct{}),
+		results: make(map[K]V),
+		errs:    make(map[K]error),
+	}
+}
+
+// load mendaftarkan key dan mengembalikan thunk yang menghasilkan nilainya
+func (l *batchLoader[K, V]) load(key K) func() (V, error) {
+	_, loaded := l.results[key]
+	_, queued := l.queued[key]
+	if !loaded && !queued {
+		l.queued[key] = struct{}{}
+		l.pending = append(l.pending, key)
+	}
+
+	return func() (V, error) {
+		if _, queued := l.queued[key]; queued {
+			l.dispatch()
+		}
+		return l.results[key], l.errs[key]
+	}
+}
+
+// dispatch mengambil semua key yang tertunda dalam satu batch
+func (l *batchLoader[K, V]) dispatch() {
+	keys := l.pending
+	l.pending = nil
+	clear(l.queued)
+
+	values, err := l.fetch(keys)
+	for _, key := range keys {
+		l.results[key] = values[key]
+		if err != nil {
+			l.errs[key] = err
+		}
+	}
+}
+
+// graphQLLoaders adalah loader relasi untuk satu request GraphQL
+type graphQLLoaders struct {
+	teams       *batchLoader[uint, *model.Team]
+	players     *batchLoader[uint, *model.Player]
+	matches     *batchLoader[uint, *model.Match]
+	teamPlayers *batchLoader[uint, []model.Player]
+	teamMatches *batchLoader[uint, []model.Match]
+	matchGoals  *batchLoader[uint, []model.Goal]
+	playerGoals *batchLoader[uint, []model.Goal]
+}
+
+// newGraphQLLoaders membuat loader kosong di atas repository
+func newGraphQLLoaders(repos *repository.Repositories) *graphQLLoaders {
+	return &graphQLLoaders{
+		teams: newBatchLoader(func(ids []uint) (map[uint]*model.Team, error) {
+			teams, err := repos.Teams.FindByIDs(ids)
+			return indexByID(teams, func(t model.Team) uint { return t.ID }), err
+		}),
+		players: newBatchLoader(func(ids []uint) (map[uint]*model.Player, error) {
+			players, err := repos.Players.FindByIDs(ids)
+			return indexByID(players, func(p model.Player) uint { return p.ID }), err
+		}),
+		matches: newBatchLoader(func(ids []uint) (map[uint]*model.Match, error) {
+			matches, err := repos.Matches.FindByIDs(ids)
+			return indexByID(matches, func(m model.Match) uint { return m.ID }), err
+		}),
+		teamPlayers: newBatchLoader(func(teamIDs []uint) (map[uint][]model.Player, error) {
+			players, err := repos.Players.FindByTeamIDs(teamIDs)
+			return groupBy(players, func(p model.Player) []uint { return []uint{p.TeamID} }), err
+		}),
+		teamMatches: newBatchLoader(func(teamIDs []uint) (map[uint][]model.Match, error) {
+			matches, err := repos.Matches.FindByTeamIDs(teamIDs)
+			return groupBy(matches, func(m model.Match) []uint { return []uint{m.HomeTeamID, m.AwayTeamID} }), err
+		}),
+		matchGoals: newBatchLoader(func(matchIDs []uint) (map[uint][]model.Goal, error) {
+			goals, err := repos.Goals.FindByMatchIDs(matchIDs)
+			return groupBy(goals, func(g model.Goal) []uint { return []uint{g.MatchID} }), err
+		}),
+		playerGoals: newBatchLoader(func(playerIDs []uint) (map[uint][]model.Goal, error) {
+			goals, err := repos.Goals.FindByPlayerIDs(playerIDs)
+			return groupBy(goals, func(g model.Goal) []uint { return []uint{g.PlayerID} }), err
+		}),
+	}
+}
+
+// indexByID memetakan record berdasarkan ID-nya
+func indexByID[T any](records []T, id func(T) uint) map[uint]*T {
+	index := make(map[uint]*T, len(records))
+	for i := range records {
+		index[id(records[i])] = &records[i]
+	}
+	return index
+}
+
+// groupBy mengelompokkan record berdasarkan satu atau lebih key miliknya, dengan urutan record dipertahankan
+func groupBy[T any](records []T, keys func(T) []uint) map[uint][]T {
+	groups := make(map[uint][]T)
+	for _, record := range records {
+		for _, key := range keys(record) {
+			groups[key] = append(groups[key], record)
+		}
+	}
+	return groups
+}
@@ -0,0 +1,762 @@
+package handler
+
+import (
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"xyz-football-api/internal/apperror"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+
+	"github.com/graphql-go/graphql"
+)
+
+// Ukuran halaman query list GraphQL
+const (
+	graphQLDefaultPageSize = 20
+	graphQLMaxPageSize     = 100
+)
+
+// Arah urutan list match
+const (
+	sortAscending  = "asc"
+	sortDescending = "desc"
+)
+
+var matchStatusEnum = graphql.NewEnum(graphql.EnumConfig{
+	Name: "MatchStatus",
+	Values: graphql.EnumValueConfigMap{
+		"SCHEDULED": {Value: model.MatchStatusScheduled},
+		"COMPLETED": {Value: model.MatchStatusCompleted},
+		"CANCELLED": {Value: model.MatchStatusCancelled},
+	},
+})
+
+var matchResultEnum = graphql.NewEnum(graphql.EnumConfig{
+	Name: "MatchResult",
+	Values: graphql.EnumValueConfigMap{
+		"NOT_FINISHED":       {Value: model.MatchResultNotFinished},
+		"HOME_WIN":           {Value: model.MatchResultHomeWin},
+		"AWAY_WIN":           {Value: model.MatchResultAwayWin},
+		"HOME_WIN_PENALTIES": {Value: model.MatchResultHomeWinPenalties},
+		"AWAY_WIN_PENALTIES": {Value: model.MatchResultAwayWinPenalties},
+		"DRAW":               {Value: model.MatchResultDraw},
+	},
+})
+
+var sortOrderEnum = graphql.NewEnum(graphql.EnumConfig{
+	Name: "SortOrder",
+	Values: graphql.EnumValueConfigMap{
+		"ASC":  {Value: sortAscending},
+		"DESC": {Value: sortDescending},
+	},
+})
+
+// graphQLPage adalah source untuk tipe halaman (TeamPage, PlayerPage, MatchPage)
+type graphQLPage struct {
+	Items       interface{}
+	TotalCount  int64
+	HasNextPage bool
+}
+
+// newSchema membangun schema GraphQL. Relasi di-resolve lewat loader per request
+// sehingga satu query untuk banyak team/match tidak memicu query N+1.
+func (h *GraphQLHandler) newSchema() (graphql.Schema, error) {
+	var teamType, playerType, matchType, goalType *graphql.Object
+
+	teamType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Team",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":                  prop(graphql.NewNonNull(graphql.ID), func(t model.Team) interface{} { return idValue(t.ID) }),
+				"name":                prop(graphql.NewNonNull(graphql.String), func(t model.Team) interface{} { return t.Name }),
+				"logoUrl":             prop(graphql.String, func(t model.Team) interface{} { return t.LogoURL }),
+				"foundedYear":         prop(graphql.Int, func(t model.Team) interface{} { return t.FoundedYear }),
+				"headquartersAddress": prop(graphql.String, func(t model.Team) interface{} { return t.HeadquartersAddress }),
+				"headquartersCity":    prop(graphql.String, func(t model.Team) interface{} { return t.HeadquartersCity }),
+				"homeVenueId":         prop(graphql.ID, func(t model.Team) interface{} { return optionalID(t.HomeVenueID) }),
+				"createdAt":           prop(graphql.NewNonNull(graphql.DateTime), func(t model.Team) interface{} { return t.CreatedAt }),
+				"updatedAt":           prop(graphql.NewNonNull(graphql.DateTime), func(t model.Team) interface{} { return t.UpdatedAt }),
+				"players": {
+					Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(playerType))),
+					Description: "Roster team, terurut berdasarkan nomor punggung",
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						team := sourceOf[model.Team](p)
+						return load(p, requestContext(p.Context).loaders.teamPlayers, team.ID, "Gagal mengambil data players"), nil
+					},
+				},
+				"matches": {
+					Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(matchType))),
+					Description: "Match team sebagai home atau away, terurut berdasarkan waktu kick-off",
+					Args: graphql.FieldConfigArgument{
+						"status": {Type: matchStatusEnum},
+						"order":  {Type: sortOrderEnum, DefaultValue: sortAscending},
+						"first":  {Type: graphql.Int, DefaultValue: 10},
+					},
+					Resolve: h.resolveTeamMatches,
+				},
+			}
+		}),
+	})
+
+	playerType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Player",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":           prop(graphql.NewNonNull(graphql.ID), func(pl model.Player) interface{} { return idValue(pl.ID) }),
+				"name":         prop(graphql.NewNonNull(graphql.String), func(pl model.Player) interface{} { return pl.Name }),
+				"position":     prop(graphql.NewNonNull(graphql.String), func(pl model.Player) interface{} { return pl.Position }),
+				"jerseyNumber": prop(graphql.NewNonNull(graphql.Int), func(pl model.Player) interface{} { return pl.JerseyNumber }),
+				"heightCm":     prop(graphql.Int, func(pl model.Player) interface{} { return pl.HeightCm }),
+				"weightKg":     prop(graphql.Int, func(pl model.Player) interface{} { return pl.WeightKg }),
+				"teamId":       prop(graphql.NewNonNull(graphql.ID), func(pl model.Player) interface{} { return idValue(pl.TeamID) }),
+				"createdAt":    prop(graphql.NewNonNull(graphql.DateTime), func(pl model.Player) interface{} { return pl.CreatedAt }),
+				"updatedAt":    prop(graphql.NewNonNull(graphql.DateTime), func(pl model.Player) interface{} { return pl.UpdatedAt }),
+				"team": {
+					Type: teamType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						player := sourceOf[model.Player](p)
+						return load(p, requestContext(p.Context).loaders.teams, player.TeamID, "Gagal mengambil data team"), nil
+					},
+				},
+				"goals": {
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(goalType))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						player := sourceOf[model.Player](p)
+						return load(p, requestContext(p.Context).loaders.playerGoals, player.ID, "Gagal mengambil data player"), nil
+					},
+				},
+			}
+		}),
+	})
+
+	matchType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Match",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":            prop(graphql.NewNonNull(graphql.ID), func(m model.Match) interface{} { return idValue(m.ID) }),
+				"matchDatetime": prop(graphql.NewNonNull(graphql.DateTime), func(m model.Match) interface{} { return m.MatchDatetime }),
+				"status":        prop(graphql.NewNonNull(matchStatusEnum), func(m model.Match) interface{} { return m.Status }),
+				"result":        prop(graphql.NewNonNull(matchResultEnum), func(m model.Match) interface{} { return m.Result() }),
+				"homeScore":     prop(graphql.NewNonNull(graphql.Int), func(m model.Match) interface{} { return m.HomeScore }),
+				"awayScore":     prop(graphql.NewNonNull(graphql.Int), func(m model.Match) interface{} { return m.AwayScore }),
+				"extraTime":     prop(graphql.NewNonNull(graphql.Boolean), func(m model.Match) interface{} { return m.ExtraTime }),
+				"homePenalties": prop(graphql.Int, func(m model.Match) interface{} { return m.HomePenalties }),
+				"awayPenalties": prop(graphql.Int, func(m model.Match) interface{} { return m.AwayPenalties }),
+				"attendance":    prop(graphql.Int, func(m model.Match) interface{} { return m.Attendance }),
+				"homeTeamId":    prop(graphql.NewNonNull(graphql.ID), func(m model.Match) interface{} { return idValue(m.HomeTeamID) }),
+				"awayTeamId":    prop(graphql.NewNonNull(graphql.ID), func(m model.Match) interface{} { return idValue(m.AwayTeamID) }),
+				"venueId":       prop(graphql.ID, func(m model.Match) interface{} { return optionalID(m.VenueID) }),
+				"competitionId": prop(graphql.ID, func(m model.Match) interface{} { return optionalID(m.CompetitionID) }),
+				"createdAt":     prop(graphql.NewNonNull(graphql.DateTime), func(m model.Match) interface{} { return m.CreatedAt }),
+				"updatedAt":     prop(graphql.NewNonNull(graphql.DateTime), func(m model.Match) interface{} { return m.UpdatedAt }),
+				"homeTeam": {
+					Type: teamType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						match := sourceOf[model.Match](p)
+						return load(p, requestContext(p.Context).loaders.teams, match.HomeTeamID, "Gagal mengambil data team"), nil
+					},
+				},
+				"awayTeam": {
+					Type: teamType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						match := sourceOf[model.Match](p)
+						return load(p, requestContext(p.Context).loaders.teams, match.AwayTeamID, "Gagal mengambil data team"), nil
+					},
+				},
+				"goals": {
+					Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(goalType))),
+					Description: "Gol dalam match, terurut berdasarkan menit",
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						match := sourceOf[model.Match](p)
+						return load(p, requestContext(p.Context).loaders.matchGoals, match.ID, "Gagal mengambil data match"), nil
+					},
+				},
+			}
+		}),
+	})
+
+	goalType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Goal",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":        prop(graphql.NewNonNull(graphql.ID), func(g model.Goal) interface{} { return idValue(g.ID) }),
+				"goalTime":  prop(graphql.NewNonNull(graphql.Int), func(g model.Goal) interface{} { return g.GoalTime }),
+				"matchId":   prop(graphql.NewNonNull(graphql.ID), func(g model.Goal) interface{} { return idValue(g.MatchID) }),
+				"playerId":  prop(graphql.NewNonNull(graphql.ID), func(g model.Goal) interface{} { return idValue(g.PlayerID) }),
+				"createdAt": prop(graphql.NewNonNull(graphql.DateTime), func(g model.Goal) interface{} { return g.CreatedAt }),
+				"player": {
+					Type: playerType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						goal := sourceOf[model.Goal](p)
+						return load(p, requestContext(p.Context).loaders.players, goal.PlayerID, "Gagal mengambil data player"), nil
+					},
+				},
+				"match": {
+					Type: matchType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						goal := sourceOf[model.Goal](p)
+						return load(p, requestContext(p.Context).loaders.matches, goal.MatchID, "Gagal mengambil data match"), nil
+					},
+				},
+			}
+		}),
+	})
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"team": {
+				Type:    teamType,
+				Args:    graphql.FieldConfigArgument{"id": {Type: graphql.NewNonNull(graphql.ID)}},
+				Resolve: byID(func(l *graphQLLoaders) *batchLoader[uint, *model.Team] { return l.teams }, "ID team tidak valid", "Gagal mengambil data team"),
+			},
+			"teams": {
+				Type:        graphql.NewNonNull(pageType("TeamPage", teamType)),
+				Description: "Daftar team terurut berdasarkan ID",
+				Args: pageArgs(graphql.FieldConfigArgument{
+					"name": {Type: graphql.String, Description: "Bagian dari nama team"},
+					"city": {Type: graphql.String, Description: "Kota markas"},
+				}),
+				Resolve: h.resolveTeams,
+			},
+			"player": {
+				Type:    playerType,
+				Args:    graphql.FieldConfigArgument{"id": {Type: graphql.NewNonNull(graphql.ID)}},
+				Resolve: byID(func(l *graphQLLoaders) *batchLoader[uint, *model.Player] { return l.players }, "ID player tidak valid", "Gagal mengambil data player"),
+			},
+			"players": {
+				Type:        graphql.NewNonNull(pageType("PlayerPage", playerType)),
+				Description: "Daftar player terurut berdasarkan ID",
+				Args: pageArgs(graphql.FieldConfigArgument{
+					"teamId":   {Type: graphql.ID},
+					"position": {Type: graphql.String},
+				}),
+				Resolve: h.resolvePlayers,
+			},
+			"match": {
+				Type:    matchType,
+				Args:    graphql.FieldConfigArgument{"id": {Type: graphql.NewNonNull(graphql.ID)}},
+				Resolve: byID(func(l *graphQLLoaders) *batchLoader[uint, *model.Match] { return l.matches }, "ID match tidak valid", "Gagal mengambil data match"),
+			},
+			"matches": {
+				Type:        graphql.NewNonNull(pageType("MatchPage", matchType)),
+				Description: "Daftar match terurut berdasarkan waktu kick-off",
+				Args: pageArgs(graphql.FieldConfigArgument{
+					"teamId":        {Type: graphql.ID, Description: "Team yang bermain sebagai home atau away"},
+					"competitionId": {Type: graphql.ID},
+					"status":        {Type: matchStatusEnum},
+					"order":         {Type: sortOrderEnum, DefaultValue: sortAscending},
+				}),
+				Resolve: h.resolveMatches,
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query:    query,
+		Mutation: h.newMutationType(teamType, playerType, matchType),
+	})
+}
+
+// newMutationType membangun mutation untuk operasi tulis yang sama dengan endpoint REST
+func (h *GraphQLHandler) newMutationType(teamType, playerType, matchType *graphql.Object) *graphql.Object {
+	teamInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "TeamInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"name":                {Type: graphql.NewNonNull(graphql.String)},
+			"logoUrl":             {Type: graphql.String},
+			"foundedYear":         {Type: graphql.Int},
+			"headquartersAddress": {Type: graphql.String},
+			"headquartersCity":    {Type: graphql.String},
+			"homeVenueId":         {Type: graphql.ID},
+		},
+	})
+	createPlayerInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CreatePlayerInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"teamId":       {Type: graphql.NewNonNull(graphql.ID)},
+			"name":         {Type: graphql.NewNonNull(graphql.String)},
+			"position":     {Type: graphql.NewNonNull(graphql.String), Description: "penyerang, gelandang, bertahan, atau penjaga gawang"},
+			"jerseyNumber": {Type: graphql.NewNonNull(graphql.Int)},
+			"heightCm":     {Type: graphql.Int},
+			"weightKg":     {Type: graphql.Int},
+		},
+	})
+	updatePlayerInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name:        "UpdatePlayerInput",
+		Description: "Perubahan parsial player; field yang tidak diisi tidak diubah",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"name":         {Type: graphql.String},
+			"position":     {Type: graphql.String},
+			"jerseyNumber": {Type: graphql.Int},
+			"heightCm":     {Type: graphql.Int},
+			"weightKg":     {Type: graphql.Int},
+		},
+	})
+	createMatchInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CreateMatchInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"homeTeamId":    {Type: graphql.NewNonNull(graphql.ID)},
+			"awayTeamId":    {Type: graphql.NewNonNull(graphql.ID)},
+			"matchDatetime": {Type: graphql.NewNonNull(graphql.String), Description: "Waktu kick-off (RFC3339)"},
+			"venueId":       {Type: graphql.ID, Description: "Default: home venue milik home team"},
+		},
+	})
+	rescheduleMatchInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "RescheduleMatchInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"matchDatetime": {Type: graphql.String, Description: "Waktu kick-off (RFC3339)"},
+			"venueId":       {Type: graphql.ID},
+			"status":        {Type: matchStatusEnum, Description: "Hanya SCHEDULED atau CANCELLED"},
+		},
+	})
+	matchResultInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "MatchResultInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"homeScore":  {Type: graphql.NewNonNull(graphql.Int)},
+			"awayScore":  {Type: graphql.NewNonNull(graphql.Int)},
+			"attendance": {Type: graphql.Int},
+			"extraTime":  {Type: graphql.Boolean},
+			"penalties": {Type: graphql.NewInputObject(graphql.InputObjectConfig{
+				Name: "PenaltyShootoutInput",
+				Fields: graphql.InputObjectConfigFieldMap{
+					"home": {Type: graphql.NewNonNull(graphql.Int)},
+					"away": {Type: graphql.NewNonNull(graphql.Int)},
+				},
+			})},
+			"goals": {Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.NewInputObject(graphql.InputObjectConfig{
+				Name: "GoalInput",
+				Fields: graphql.InputObjectConfigFieldMap{
+					"playerId": {Type: graphql.NewNonNull(graphql.ID)},
+					"goalTime": {Type: graphql.NewNonNull(graphql.Int)},
+				},
+			}))))},
+		},
+	})
+
+	idArg := func(args graphql.FieldConfigArgument) graphql.FieldConfigArgument {
+		args["id"] = &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)}
+		return args
+	}
+
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "Mutation",
+		Fields: graphql.Fields{
+			"createTeam": {
+				Type: teamType,
+				Args: graphql.FieldConfigArgument{"input": {Type: graphql.NewNonNull(teamInput)}},
+				Resolve: h.mutation("Gagal membuat team", func(p graphql.ResolveParams) (interface{}, error) {
+					var team model.Team
+					if err := decodeInput(p.Args["input"], &team, "Data team tidak valid"); err != nil {
+						return nil, err
+					}
+					if err := h.teamService.Create(&team); err != nil {
+						return nil, err
+					}
+					return &team, nil
+				}),
+			},
+			"updateTeam": {
+				Type: teamType,
+				Args: idArg(graphql.FieldConfigArgument{"input": {Type: graphql.NewNonNull(teamInput)}}),
+				Resolve: h.mutation("Gagal memperbarui team", func(p graphql.ResolveParams) (interface{}, error) {
+					id, err := parseID(p.Args["id"], "ID team tidak valid")
+					if err != nil {
+						return nil, err
+					}
+					var changes model.Team
+					if err := decodeInput(p.Args["input"], &changes, "Data team tidak valid"); err != nil {
+						return nil, err
+					}
+					return h.teamService.Update(id, changes)
+				}),
+			},
+			"deleteTeam": {
+				Type: graphql.Boolean,
+				Args: idArg(graphql.FieldConfigArgument{}),
+				Resolve: h.mutation("Gagal menghapus team", func(p graphql.ResolveParams) (interface{}, error) {
+					id, err := parseID(p.Args["id"], "ID team tidak valid")
+					if err != nil {
+						return nil, err
+					}
+					return true, h.teamService.Delete(id)
+				}),
+			},
+			"createPlayer": {
+				Type: playerType,
+				Args: graphql.FieldConfigArgument{"input": {Type: graphql.NewNonNull(createPlayerInput)}},
+				Resolve: h.mutation("Gagal membuat player", func(p graphql.ResolveParams) (interface{}, error) {
+					var req CreatePlayerRequest
+					if err := decodeInput(p.Args["input"], &req, "Data player tidak valid"); err != nil {
+						return nil, err
+					}
+					player := req.Player()
+					if err := h.playerService.Create(&player); err != nil {
+						return nil, err
+					}
+					return &player, nil
+				}),
+			},
+			"updatePlayer": {
+				Type: playerType,
+				Args: idArg(graphql.FieldConfigArgument{"input": {Type: graphql.NewNonNull(updatePlayerInput)}}),
+				Resolve: h.mutation("Gagal memperbarui player", func(p graphql.ResolveParams) (interface{}, error) {
+					id, err := parseID(p.Args["id"], "ID player tidak valid")
+					if err != nil {
+						return nil, err
+					}
+					var req UpdatePlayerRequest
+					if err := decodeInput(p.Args["input"], &req, "Data player tidak valid"); err != nil {
+						return nil, err
+					}
+					return h.playerService.Update(id, req.Update())
+				}),
+			},
+			"deletePlayer": {
+				Type: graphql.Boolean,
+				Args: idArg(graphql.FieldConfigArgument{}),
+				Resolve: h.mutation("Gagal menghapus player", func(p graphql.ResolveParams) (interface{}, error) {
+					id, err := parseID(p.Args["id"], "ID player tidak valid")
+					if err != nil {
+						return nil, err
+					}
+					return true, h.playerService.Delete(id)
+				}),
+			},
+			"createMatch": {
+				Type: matchType,
+				Args: graphql.FieldConfigArgument{"input": {Type: graphql.NewNonNull(createMatchInput)}},
+				Resolve: h.mutation("Gagal membuat match", func(p graphql.ResolveParams) (interface{}, error) {
+					var req CreateMatchRequest
+					if err := decodeInput(p.Args["input"], &req, "Data match tidak valid"); err != nil {
+						return nil, err
+					}
+					input, err := req.Input()
+					if err != nil {
+						return nil, err
+					}
+					return h.matchService.Create(input)
+				}),
+			},
+			"rescheduleMatch": {
+				Type: matchType,
+				Args: idArg(graphql.FieldConfigArgument{"input": {Type: graphql.NewNonNull(rescheduleMatchInput)}}),
+				Resolve: h.mutation("Gagal mengubah jadwal match", func(p graphql.ResolveParams) (interface{}, error) {
+					id, err := parseID(p.Args["id"], "ID match tidak valid")
+					if err != nil {
+						return nil, err
+					}
+					var req RescheduleMatchRequest
+					if err := decodeInput(p.Args["input"], &req, "Data match tidak valid"); err != nil {
+						return nil, err
+					}
+					input, err := req.Input()
+					if err != nil {
+						return nil, err
+					}
+					return h.matchService.Reschedule(id, input)
+				}),
+			},
+			"reportMatchResult": {
+				Type: matchType,
+				Args: idArg(graphql.FieldConfigArgument{"input": {Type: graphql.NewNonNull(matchResultInput)}}),
+				Resolve: h.mutation("Gagal menyimpan hasil match", func(p graphql.ResolveParams) (interface{}, error) {
+					id, err := parseID(p.Args["id"], "ID match tidak valid")
+					if err != nil {
+						return nil, err
+					}
+					var req ReportMatchResultRequest
+					if err := decodeInput(p.Args["input"], &req, "Data hasil match tidak valid"); err != nil {
+						return nil, err
+					}
+					if err := h.matchService.ReportResult(id, req.Input()); err != nil {
+						return nil, err
+					}
+					return h.matchService.Get(id)
+				}),
+			},
+		},
+	})
+}
+
+// resolveTeams menangani query teams
+func (h *GraphQLHandler) resolveTeams(p graphql.ResolveParams) (interface{}, error) {
+	page, err := pageOf(p)
+	if err != nil {
+		return nil, fail(p.Context, err, "")
+	}
+	filter := repository.TeamFilter{}
+	filter.Name, _ = p.Args["name"].(string)
+	filter.City, _ = p.Args["city"].(string)
+
+	teams, total, err := h.repos.Teams.FindPage(filter, page)
+	if err != nil {
+		return nil, fail(p.Context, err, "Gagal mengambil data teams")
+	}
+	return newPage(teams, total, page), nil
+}
+
+// resolvePlayers menangani query players
+func (h *GraphQLHandler) resolvePlayers(p graphql.ResolveParams) (interface{}, error) {
+	page, err := pageOf(p)
+	if err != nil {
+		return nil, fail(p.Context, err, "")
+	}
+	filter := repository.PlayerFilter{}
+	filter.Position, _ = p.Args["position"].(string)
+	if filter.TeamID, err = optionalIDArg(p.Args["teamId"], "ID team tidak valid"); err != nil {
+		return nil, fail(p.Context, err, "")
+	}
+
+	players, total, err := h.repos.Players.FindPage(filter, page)
+	if err != nil {
+		return nil, fail(p.Context, err, "Gagal mengambil data players")
+	}
+	return newPage(players, total, page), nil
+}
+
+// resolveMatches menangani query matches
+func (h *GraphQLHandler) resolveMatches(p graphql.ResolveParams) (interface{}, error) {
+	page, err := pageOf(p)
+	if err != nil {
+		return nil, fail(p.Context, err, "")
+	}
+	page.Descending = p.Args["order"] == sortDescending
+
+	filter := repository.MatchFilter{}
+	filter.Status, _ = p.Args["status"].(model.MatchStatus)
+	if filter.TeamID, err = optionalIDArg(p.Args["teamId"], "ID team tidak valid"); err != nil {
+		return nil, fail(p.Context, err, "")
+	}
+	if filter.CompetitionID, err = optionalIDArg(p.Args["competitionId"], "ID kompetisi tidak valid"); err != nil {
+		return nil, fail(p.Context, err, "")
+	}
+
+	matches, total, err := h.repos.Matches.FindPage(filter, page)
+	if err != nil {
+		return nil, fail(p.Context, err, "Gagal mengambil data matches")
+	}
+	return newPage(matches, total, page), nil
+}
+
+// resolveTeamMatches menangani relasi Team.matches. Semua match milik team yang diminta
+// diambil dalam satu batch; filter status, urutan, dan first diterapkan per team.
+func (h *GraphQLHandler) resolveTeamMatches(p graphql.ResolveParams) (interface{}, error) {
+	team := sourceOf[model.Team](p)
+	first, _ := p.Args["first"].(int)
+	if first < 1 || first > graphQLMaxPageSize {
+		return nil, fail(p.Context, errInvalidFirst, "")
+	}
+	status, _ := p.Args["status"].(model.MatchStatus)
+	descending := p.Args["order"] == sortDescending
+
+	thunk := requestContext(p.Context).loaders.teamMatches.load(team.ID)
+	return func() (interface{}, error) {
+		all, err := thunk()
+		if err != nil {
+			return nil, fail(p.Context, err, "Gagal mengambil data matches")
+		}
+		matches := make([]model.Match, 0, len(all))
+		for _, match := range all {
+			if status == "" || match.Status == status {
+				matches = append(matches, match)
+			}
+		}
+		if descending {
+			slices.Reverse(matches)
+		}
+		return matches[:min(first, len(matches))], nil
+	}, nil
+}
+
+// Error argument pagination
+var (
+	errInvalidFirst  = apperror.Validationf("bad_request", "Parameter first harus antara 1 dan %d", graphQLMaxPageSize)
+	errInvalidOffset = apperror.Validation("bad_request", "Parameter offset tidak boleh negatif")
+)
+
+// pageArgs menambahkan argumen pagination first dan offset
+func pageArgs(args graphql.FieldConfigArgument) graphql.FieldConfigArgument {
+	args["first"] = &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: graphQLDefaultPageSize}
+	args["offset"] = &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 0}
+	return args
+}
+
+// pageOf membaca argumen pagination
+func pageOf(p graphql.ResolveParams) (repository.Page, error) {
+	first, _ := p.Args["first"].(int)
+	offset, _ := p.Args["offset"].(int)
+	if first < 1 || first > graphQLMaxPageSize {
+		return repository.Page{}, errInvalidFirst
+	}
+	if offset < 0 {
+		return repository.Page{}, errInvalidOffset
+	}
+	return repository.Page{Limit: first, Offset: offset}, nil
+}
+
+// newPage membuat source tipe halaman
+func newPage[T any](items []T, total int64, page repository.Page) graphQLPage {
+	return graphQLPage{
+		Items:       items,
+		TotalCount:  total,
+		HasNextPage: int64(page.Offset+len(items)) < total,
+	}
+}
+
+// pageType membuat tipe halaman untuk item bertipe itemType
+func pageType(name string, itemType *graphql.Object) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: name,
+		Fields: graphql.Fields{
+			"items":       prop(graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(itemType))), func(p graphQLPage) interface{} { return p.Items }),
+			"totalCount":  prop(graphql.NewNonNull(graphql.Int), func(p graphQLPage) interface{} { return p.TotalCount }),
+			"hasNextPage": prop(graphql.NewNonNull(graphql.Boolean), func(p graphQLPage) interface{} { return p.HasNextPage }),
+		},
+	})
+}
+
+// prop membuat field yang nilainya dibaca dari source bertipe T
+func prop[T any](typ graphql.Output, get func(T) interface{}) *graphql.Field {
+	return &graphql.Field{
+		Type: typ,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return get(sourceOf[T](p)), nil
+		},
+	}
+}
+
+// sourceOf mengambil source resolver yang bisa berupa nilai atau pointer
+func sourceOf[T any](p graphql.ResolveParams) T {
+	if ptr, ok := p.Source.(*T); ok {
+		return *ptr
+	}
+	return p.Source.(T)
+}
+
+// load mendaftarkan key ke loader dan mengembalikan thunk yang di-resolve executor setelah
+// semua key pada tingkat yang sama terkumpul
+func load[V any](p graphql.ResolveParams, loader *batchLoader[uint, V], key uint, failureMsg string) func() (interface{}, error) {
+	thunk := loader.load(key)
+	return func() (interface{}, error) {
+		value, err := thunk()
+		if err != nil {
+			return nil, fail(p.Context, err, failureMsg)
+		}
+		return value, nil
+	}
+}
+
+// byID membuat resolver query satu record berdasarkan argumen id. Record yang tidak ada menghasilkan null.
+func byID[V any](loader func(*graphQLLoaders) *batchLoader[uint, V], invalidMsg, failureMsg string) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		id, err := parseID(p.Args["id"], invalidMsg)
+		if err != nil {
+			return nil, fail(p.Context, err, failureMsg)
+		}
+		return load(p, loader(requestContext(p.Context).loaders), id, failureMsg), nil
+	}
+}
+
+// mutation membungkus resolver mutation: error diterjemahkan seperti abortWithError, lalu
+// cache loader dikosongkan agar field berikutnya membaca data setelah perubahan
+func (h *GraphQLHandler) mutation(failureMsg string, fn graphql.FieldResolveFn) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		result, err := fn(p)
+		requestContext(p.Context).loaders = newGraphQLLoaders(h.repos)
+		if err != nil {
+			return nil, fail(p.Context, err, failureMsg)
+		}
+		return result, nil
+	}
+}
+
+// idValue mengubah primary key menjadi nilai ID GraphQL
+func idValue(id uint) string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// optionalID mengubah foreign key nullable menjadi nilai ID GraphQL atau null
+func optionalID(id *uint) interface{} {
+	if id == nil {
+		return nil
+	}
+	return idValue(*id)
+}
+
+// parseID membaca argumen ID; ID harus bilangan bulat positif
+func parseID(value interface{}, invalidMsg string) (uint, error) {
+	text, _ := value.(string)
+	id, err := strconv.ParseUint(text, 10, 32)
+	if err != nil || id == 0 {
+		return 0, apperror.Validation("bad_request", invalidMsg)
+	}
+	return uint(id), nil
+}
+
+// optionalIDArg membaca argumen ID opsional
+func optionalIDArg(value interface{}, invalidMsg string) (*uint, error) {
+	if value == nil {
+		return nil, nil
+	}
+	id, err := parseID(value, invalidMsg)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// decodeInput mengisi request REST dst dari argumen input GraphQL lalu memvalidasinya dengan
+// validator yang sama seperti binding request body. Nama field camelCase diubah ke snake_case
+// (tag json request REST) dan ID, yang dikirim GraphQL sebagai string, diubah menjadi angka.
+func decodeInput(input interface{}, dst interface{}, message string) error {
+	body, err := json.Marshal(restInput(input))
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(body, dst); err != nil {
+		return bindError(err, message)
+	}
+	return ValidateRequest(dst, message)
+}
+
+// restInput mengubah nilai input GraphQL menjadi bentuk body request REST
+func restInput(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		converted := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			if id, ok := item.(string); ok && strings.HasSuffix(key, "Id") {
+				if n, err := strconv.ParseUint(id, 10, 32); err == nil {
+					item = n
+				}
+			}
+			converted[snakeCase(key)] = restInput(item)
+		}
+		return converted
+	case []interface{}:
+		converted := make([]interface{}, len(v))
+		for i, item := range v {
+			converted[i] = restInput(item)
+		}
+		return converted
+	default:
+		return value
+	}
+}
+
+// snakeCase mengubah nama field camelCase menjadi snake_case, misalnya jerseyNumber menjadi jersey_number
+func snakeCase(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		if unicode.IsUpper(r) {
+			b.WriteByte('_')
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// HealthResponse merepresentasikan struktur response health check
+type HealthResponse struct {
+	Status  string `json:"status" example:"ok"`
+	Message string `json:"message" example:"XYZ Football API is running"`
+}
+
+// Health menangani endpoint GET /health
+// @Summary Health check
+// @Description Memastikan server berjalan. Tidak memerlukan autentikasi.
+// @Tags System
+// @Produce json
+// @Success 200 {object} HealthResponse
+// @Router /health [get]
+func Health(c *gin.Context) {
+	c.JSON(http.StatusOK, HealthResponse{
+		Status:  "ok",
+		Message: "XYZ Football API is running",
+	})
+}
@@ -0,0 +1,155 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"xyz-football-api/internal/apperror"
+	"xyz-football-api/internal/service"
+	"xyz-football-api/pkg/spreadsheet"
+	"xyz-football-api/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxImportFileSize adalah ukuran maksimal file import (5 MB)
+const maxImportFileSize = 5 << 20
+
+// ImportHandler menangani endpoint import massal team dan player
+type ImportHandler struct {
+	importService *service.ImportService
+}
+
+// NewImportHandler membuat instance ImportHandler baru
+func NewImportHandler(importService *service.ImportService) *ImportHandler {
+	return &ImportHandler{importService: importService}
+}
+
+// ImportRowError adalah kesalahan validasi pada satu baris file (row = nomor baris di file, header = 1)
+type ImportRowError struct {
+	Row    int                `json:"row"`
+	Fields []utils.FieldError `json:"fields"`
+}
+
+// ImportResponse adalah hasil import. Jika import ditolak, Error dan Code diisi
+// seperti utils.ErrorResponse dan Errors berisi detail per baris.
+type ImportResponse struct {
+	Error     string           `json:"error,omitempty"`
+	Code      string           `json:"code,omitempty"`
+	DryRun    bool             `json:"dry_run"`
+	TotalRows int              `json:"total_rows"`
+	Imported  int              `json:"imported"`
+	Errors    []ImportRowError `json:"errors,omitempty"`
+}
+
+// ImportTeams menangani endpoint POST /import/teams
+// @Summary Import team dari file CSV/XLSX
+// @Description Kolom: name (wajib), logo_url, founded_year, headquarters_address, headquarters_city, home_venue_id.
+// @Description Dengan dry_run=true hanya validasi yang dijalankan. Tanpa dry_run, semua baris disimpan dalam satu transaksi,
+// @Description atau tidak ada sama sekali jika ada baris yang tidak valid.
+// @Tags Import
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "File CSV atau XLSX (baris pertama adalah header)"
+// @Param dry_run query bool false "Hanya validasi tanpa menyimpan"
+// @Success 200 {object} ImportResponse "Hasil dry run"
+// @Success 201 {object} ImportResponse
+// @Failure 400 {object} ImportResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /import/teams [post]
+func (h *ImportHandler) ImportTeams(c *gin.Context) {
+	h.handleImport(c, h.importService.ImportTeams)
+}
+
+// ImportPlayers menangani endpoint POST /import/players
+// @Summary Import player dari file CSV/XLSX
+// @Description Kolom: team_id, name, position, jersey_number (wajib), height_cm, weight_kg.
+// @Description Baris ditolak jika team tidak ada, posisi tidak valid, atau nomor punggung sudah dipakai (di database maupun di baris lain pada file).
+// @Description Dengan dry_run=true hanya validasi yang dijalankan. Tanpa dry_run, semua baris disimpan dalam satu transaksi,
+// @Description atau tidak ada sama sekali jika ada baris yang tidak valid.
+// @Tags Import
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "File CSV atau XLSX (baris pertama adalah header)"
+// @Param dry_run query bool false "Hanya validasi tanpa menyimpan"
+// @Success 200 {object} ImportResponse "Hasil dry run"
+// @Success 201 {object} ImportResponse
+// @Failure 400 {object} ImportResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /import/players [post]
+func (h *ImportHandler) ImportPlayers(c *gin.Context) {
+	h.handleImport(c, h.importService.ImportPlayers)
+}
+
+// handleImport membaca file upload, menjalankan import, lalu merender ImportResponse
+func (h *ImportHandler) handleImport(c *gin.Context, importFn func(*spreadsheet.Table, bool) (*service.ImportResult, error)) {
+	dryRun, err := strconv.ParseBool(c.DefaultQuery("dry_run", "false"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "Parameter dry_run tidak valid")
+		return
+	}
+
+	clearReadDeadline(c)
+	clearWriteDeadline(c)
+	table, err := readImportFile(c)
+	if err != nil {
+		abortWithError(c, err, "Gagal membaca file import")
+		return
+	}
+
+	result, err := importFn(table, dryRun)
+	if result == nil {
+		abortWithError(c, err, "Gagal mengimpor data")
+		return
+	}
+
+	response := ImportResponse{DryRun: dryRun, TotalRows: result.Rows, Imported: result.Imported}
+	for _, rowErr := range result.Errors {
+		response.Errors = append(response.Errors, ImportRowError{Row: rowErr.Line, Fields: translateFields(c, rowErr.Fields)})
+	}
+
+	status := http.StatusCreated
+	if dryRun {
+		status = http.StatusOK
+	}
+
+	var appErr *apperror.Error
+	if errors.As(err, &appErr) {
+		response.Error = utils.Translate(c, appErr.Message, appErr.Args...)
+		response.Code = appErr.Code
+		status = http.StatusBadRequest
+	}
+
+	c.Header("Content-Language", utils.Language(c))
+	utils.RespondSuccess(c, status, response)
+}
+
+// readImportFile membaca file dari form field "file" sebagai tabel
+func readImportFile(c *gin.Context) (*spreadsheet.Table, error) {
+	header, err := c.FormFile("file")
+	if err != nil {
+		return nil, apperror.Validation("validation_failed", "File import wajib diunggah", apperror.FieldError{Field: "file", Message: "wajib diisi"})
+	}
+	if header.Size > maxImportFileSize {
+		return nil, apperror.Validationf("file_too_large", "Ukuran file maksimal %d MB", maxImportFileSize>>20)
+	}
+
+	format, err := spreadsheet.FormatFromFilename(header.Filename)
+	if err != nil {
+		return nil, apperror.Validation("unsupported_format", "Format file harus CSV atau XLSX", apperror.FieldError{Field: "file", Message: "harus berekstensi .csv atau .xlsx"})
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	table, err := spreadsheet.Read(format, file)
+	if err != nil {
+		return nil, apperror.Validation("invalid_file", "File tidak dapat dibaca sebagai CSV/XLSX")
+	}
+	return table, nil
+}
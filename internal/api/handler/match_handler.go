@@ -5,8 +5,9 @@ import (
 	"net/http"
 	"strconv"
 	"time"
+	"xyz-football-api/internal/apperror"
 	"xyz-football-api/internal/model"
-	"xyz-football-api/internal/repository"
+	"xyz-football-api/internal/service"
 	"xyz-football-api/pkg/utils"
 
 	"github.com/gin-gonic/gin"
@@ -14,25 +15,12 @@ import (
 
 // MatchHandler menangani endpoint matches
 type MatchHandler struct {
-	matchRepo  *repository.MatchRepository
-	teamRepo   *repository.TeamRepository
-	playerRepo *repository.PlayerRepository
-	goalRepo   *repository.GoalRepository
+	matchService *service.MatchService
 }
 
 // NewMatchHandler membuat instance MatchHandler baru
-func NewMatchHandler(
-	matchRepo *repository.MatchRepository,
-	teamRepo *repository.TeamRepository,
-	playerRepo *repository.PlayerRepository,
-	goalRepo *repository.GoalRepository,
-) *MatchHandler {
-	return &MatchHandler{
-		matchRepo:  matchRepo,
-		teamRepo:   teamRepo,
-		playerRepo: playerRepo,
-		goalRepo:   goalRepo,
-	}
+func NewMatchHandler(matchService *service.MatchService) *MatchHandler {
+	return &MatchHandler{matchService: matchService}
 }
 
 // CreateMatchRequest adalah struct untuk request body create match
@@ -40,11 +28,30 @@ type CreateMatchRequest struct {
 	HomeTeamID    uint   `json:"home_team_id" binding:"required"`
 	AwayTeamID    uint   `json:"away_team_id" binding:"required"`
 	MatchDatetime string `json:"match_datetime" binding:"required"`
+	VenueID       *uint  `json:"venue_id"`
+}
+
+// errInvalidMatchDatetime dikembalikan jika match_datetime bukan RFC3339
+var errInvalidMatchDatetime = apperror.Validation("bad_request", "Format match_datetime tidak valid (gunakan ISO 8601/RFC3339)")
+
+// Input memetakan request ke input service
+func (req CreateMatchRequest) Input() (service.CreateMatchInput, error) {
+	matchTime, err := time.Parse(time.RFC3339, req.MatchDatetime)
+	if err != nil {
+		return service.CreateMatchInput{}, errInvalidMatchDatetime
+	}
+	return service.CreateMatchInput{
+		HomeTeamID:    req.HomeTeamID,
+		AwayTeamID:    req.AwayTeamID,
+		MatchDatetime: matchTime,
+		VenueID:       req.VenueID,
+	}, nil
 }
 
 // CreateMatch menangani endpoint POST /matches
 // @Summary Membuat jadwal pertandingan baru
-// @Description Endpoint untuk membuat jadwal pertandingan baru
+// @Description Endpoint untuk membuat jadwal pertandingan baru.
+// @Description Jika venue_id tidak diisi, pertandingan dimainkan di home venue milik home team.
 // @Tags Matches
 // @Accept json
 // @Produce json
@@ -58,70 +65,135 @@ func (h *MatchHandler) CreateMatch(c *gin.Context) {
 	var req CreateMatchRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		utils.RespondError(c, http.StatusBadRequest, "Data match tidak valid: "+err.Error())
+		abortWithBindError(c, err, "Data match tidak valid")
 		return
 	}
 
-	// Parse match datetime
-	matchTime, err := time.Parse(time.RFC3339, req.MatchDatetime)
+	input, err := req.Input()
 	if err != nil {
-		utils.RespondError(c, http.StatusBadRequest, "Format match_datetime tidak valid (gunakan ISO 8601/RFC3339)")
+		abortWithError(c, err, "Gagal membuat match")
 		return
 	}
 
-	// Mapping request ke model
-	match := model.Match{
-		HomeTeamID:    req.HomeTeamID,
-		AwayTeamID:    req.AwayTeamID,
-		MatchDatetime: matchTime,
-	}
-
-	// Validasi home team exists
-	_, err = h.teamRepo.FindByID(match.HomeTeamID)
+	match, err := h.matchService.Create(input)
 	if err != nil {
-		utils.RespondError(c, http.StatusBadRequest, "Home team tidak ditemukan")
+		abortWithError(c, err, "Gagal membuat match")
 		return
 	}
 
-	// Validasi away team exists
-	_, err = h.teamRepo.FindByID(match.AwayTeamID)
+	utils.RespondSuccess(c, http.StatusCreated, match)
+}
+
+// RescheduleMatchRequest adalah struct untuk request body PATCH /matches/:id; field yang tidak diisi tidak diubah
+type RescheduleMatchRequest struct {
+	MatchDatetime *string `json:"match_datetime"`
+	VenueID       *uint   `json:"venue_id"`
+	// Status hanya boleh scheduled atau cancelled
+	Status *model.MatchStatus `json:"status" enums:"scheduled,cancelled"`
+}
+
+// Input memetakan request ke input service
+func (req RescheduleMatchRequest) Input() (service.RescheduleMatchInput, error) {
+	input := service.RescheduleMatchInput{VenueID: req.VenueID, Status: req.Status}
+	if req.MatchDatetime != nil {
+		matchTime, err := time.Parse(time.RFC3339, *req.MatchDatetime)
+		if err != nil {
+			return input, errInvalidMatchDatetime
+		}
+		input.MatchDatetime = &matchTime
+	}
+	return input, nil
+}
+
+// RescheduleMatch menangani endpoint PATCH /matches/:id
+// @Summary Mengubah jadwal atau membatalkan pertandingan
+// @Description Mengubah waktu kick-off, venue, atau status (scheduled/cancelled) match yang belum dimainkan.
+// @Description Perubahan langsung terlihat di feed kalender (fixtures.ics).
+// @Tags Matches
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Match ID"
+// @Param body body RescheduleMatchRequest true "Perubahan jadwal"
+// @Success 200 {object} model.Match
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /matches/{id} [patch]
+func (h *MatchHandler) RescheduleMatch(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
-		utils.RespondError(c, http.StatusBadRequest, "Away team tidak ditemukan")
+		utils.RespondError(c, http.StatusBadRequest, "ID match tidak valid")
 		return
 	}
 
-	// Validasi home team != away team
-	if match.HomeTeamID == match.AwayTeamID {
-		utils.RespondError(c, http.StatusBadRequest, "Home team dan away team tidak boleh sama")
+	var req RescheduleMatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		abortWithBindError(c, err, "Data match tidak valid")
 		return
 	}
 
-	// Set default status
-	match.Status = model.MatchStatusScheduled
-	match.HomeScore = 0
-	match.AwayScore = 0
+	input, err := req.Input()
+	if err != nil {
+		abortWithError(c, err, "Gagal mengubah jadwal match")
+		return
+	}
 
-	if err := h.matchRepo.Create(&match); err != nil {
-		utils.RespondError(c, http.StatusInternalServerError, "Gagal membuat match: "+err.Error())
+	match, err := h.matchService.Reschedule(uint(id), input)
+	if err != nil {
+		abortWithError(c, err, "Gagal mengubah jadwal match")
 		return
 	}
 
-	utils.RespondSuccess(c, http.StatusCreated, match)
+	utils.RespondSuccess(c, http.StatusOK, match)
+}
+
+// PenaltyShootout merepresentasikan hasil adu penalti
+type PenaltyShootout struct {
+	Home int `json:"home" binding:"min=0"`
+	Away int `json:"away" binding:"min=0"`
 }
 
 // ReportMatchResultRequest merepresentasikan request untuk melaporkan hasil pertandingan
 type ReportMatchResultRequest struct {
-	HomeScore int `json:"home_score" binding:"required,min=0"`
-	AwayScore int `json:"away_score" binding:"required,min=0"`
-	Goals     []struct {
-		PlayerID uint `json:"player_id" binding:"required"`
-		GoalTime int  `json:"goal_time" binding:"required,min=1,max=120"`
-	} `json:"goals" binding:"required"`
+	HomeScore  int  `json:"home_score" binding:"min=0"`
+	AwayScore  int  `json:"away_score" binding:"min=0"`
+	Attendance *int `json:"attendance" binding:"omitempty,min=0"`
+	// ExtraTime dan Penalties hanya berlaku untuk leg penentu pada kompetisi cup
+	ExtraTime bool             `json:"extra_time"`
+	Penalties *PenaltyShootout `json:"penalties"`
+	Goals     []GoalRequest    `json:"goals" binding:"required,dive"`
+}
+
+// GoalRequest merepresentasikan satu gol dalam laporan hasil pertandingan
+type GoalRequest struct {
+	PlayerID uint `json:"player_id" binding:"required"`
+	GoalTime int  `json:"goal_time" binding:"required,min=1,max=120"`
+}
+
+// Input memetakan request ke input service
+func (req ReportMatchResultRequest) Input() service.MatchResultInput {
+	input := service.MatchResultInput{
+		HomeScore:  req.HomeScore,
+		AwayScore:  req.AwayScore,
+		Attendance: req.Attendance,
+		ExtraTime:  req.ExtraTime,
+	}
+	if req.Penalties != nil {
+		input.HomePenalties = &req.Penalties.Home
+		input.AwayPenalties = &req.Penalties.Away
+	}
+	for _, g := range req.Goals {
+		input.Goals = append(input.Goals, service.GoalInput{PlayerID: g.PlayerID, GoalTime: g.GoalTime})
+	}
+	return input
 }
 
 // ReportMatchResult menangani endpoint POST /matches/:id/result
 // @Summary Melaporkan hasil pertandingan
-// @Description Endpoint untuk melaporkan hasil pertandingan dan mencatat gol
+// @Description Endpoint untuk melaporkan hasil pertandingan dan mencatat gol.
+// @Description Untuk match cup, pemenang tie otomatis maju ke babak berikutnya.
 // @Tags Matches
 // @Accept json
 // @Produce json
@@ -130,6 +202,8 @@ type ReportMatchResultRequest struct {
 // @Param body body ReportMatchResultRequest true "Match Result Data"
 // @Success 200 {object} utils.SuccessResponse
 // @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
 // @Failure 500 {object} utils.ErrorResponse
 // @Router /matches/{id}/result [post]
 func (h *MatchHandler) ReportMatchResult(c *gin.Context) {
@@ -140,93 +214,67 @@ func (h *MatchHandler) ReportMatchResult(c *gin.Context) {
 		return
 	}
 
-	// Validasi match exists
-	match, err := h.matchRepo.FindByID(uint(id))
-	if err != nil {
-		utils.RespondError(c, http.StatusNotFound, "Match tidak ditemukan")
-		return
-	}
-
-	// Validasi status match
-	if match.Status == model.MatchStatusCompleted {
-		utils.RespondError(c, http.StatusBadRequest, "Match sudah dilaporkan sebelumnya")
-		return
-	}
-
 	var req ReportMatchResultRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		utils.RespondError(c, http.StatusBadRequest, "Data hasil match tidak valid: "+err.Error())
+		abortWithBindError(c, err, "Data hasil match tidak valid")
 		return
 	}
 
-	// Validasi total gol
-	totalGoals := req.HomeScore + req.AwayScore
-	if len(req.Goals) != totalGoals {
-		utils.RespondError(c, http.StatusBadRequest, fmt.Sprintf("Jumlah gol tidak sesuai. Total skor: %d, jumlah detail gol: %d", totalGoals, len(req.Goals)))
-		return
-	}
-
-	// Validasi setiap player dalam goals
-	for _, g := range req.Goals {
-		player, err := h.playerRepo.FindByID(g.PlayerID)
-		if err != nil {
-			utils.RespondError(c, http.StatusBadRequest, fmt.Sprintf("Player dengan ID %d tidak ditemukan", g.PlayerID))
-			return
-		}
-
-		// Validasi player adalah bagian dari salah satu tim yang bertanding
-		if player.TeamID != match.HomeTeamID && player.TeamID != match.AwayTeamID {
-			utils.RespondError(c, http.StatusBadRequest, fmt.Sprintf("Player %s tidak termasuk dalam tim yang bertanding", player.Name))
-			return
-		}
-	}
-
-	// Update match result
-	if err := h.matchRepo.UpdateResult(uint(id), req.HomeScore, req.AwayScore); err != nil {
-		utils.RespondError(c, http.StatusInternalServerError, "Gagal memperbarui hasil match: "+err.Error())
+	if err := h.matchService.ReportResult(uint(id), req.Input()); err != nil {
+		abortWithError(c, err, "Gagal menyimpan hasil match")
 		return
 	}
 
-	// Hapus goals lama jika ada (untuk update)
-	h.goalRepo.DeleteByMatchID(uint(id))
-
-	// Insert goals
-	var goals []model.Goal
-	for _, g := range req.Goals {
-		goals = append(goals, model.Goal{
-			MatchID:  uint(id),
-			PlayerID: g.PlayerID,
-			GoalTime: g.GoalTime,
-		})
-	}
-
-	if err := h.goalRepo.CreateBatch(goals); err != nil {
-		utils.RespondError(c, http.StatusInternalServerError, "Gagal mencatat gol: "+err.Error())
-		return
-	}
+	utils.RespondMessage(c, http.StatusOK, "Hasil match berhasil dilaporkan")
+}
 
-	utils.RespondMessage(c, http.StatusOK, "Match result reported successfully")
+// MatchReportOfficial merepresentasikan official dalam laporan pertandingan
+type MatchReportOfficial struct {
+	Role         model.OfficialRole `json:"role"`
+	Name         string             `json:"name"`
+	LicenseLevel *string            `json:"license_level,omitempty"`
 }
 
-// MatchReportResponse merepresentasikan response laporan pertandingan
+// MatchReportResponse merepresentasikan response laporan pertandingan.
+// MatchResult adalah enum yang stabil; MatchResultLabel adalah teksnya dalam bahasa dari Accept-Language.
 type MatchReportResponse struct {
-	Schedule          string `json:"schedule"`
-	HomeTeam          string `json:"home_team"`
-	AwayTeam          string `json:"away_team"`
-	FinalScore        string `json:"final_score"`
-	MatchResult       string `json:"match_result"`
-	TopScorerInMatch  string `json:"top_scorer_in_match"`
-	HomeTeamTotalWins int64  `json:"home_team_total_wins"`
-	AwayTeamTotalWins int64  `json:"away_team_total_wins"`
+	Schedule          string            `json:"schedule"`
+	HomeTeam          string            `json:"home_team"`
+	AwayTeam          string            `json:"away_team"`
+	Round             string            `json:"round,omitempty"`
+	Venue             string            `json:"venue,omitempty"`
+	Attendance        *int              `json:"attendance,omitempty"`
+	FinalScore        string            `json:"final_score"`
+	ExtraTime         bool              `json:"extra_time,omitempty"`
+	Penalties         string            `json:"penalties,omitempty"`
+	MatchResult       model.MatchResult `json:"match_result" enums:"not_finished,home_win,away_win,home_win_penalties,away_win_penalties,draw"`
+	MatchResultLabel  string            `json:"match_result_label"`
+	TopScorerInMatch  string            `json:"top_scorer_in_match"`
+	HomeTeamTotalWins int64             `json:"home_team_total_wins"`
+	AwayTeamTotalWins int64             `json:"away_team_total_wins"`
+
+	Officials []MatchReportOfficial `json:"officials,omitempty"`
+}
+
+// matchResultLabels adalah message ID label untuk setiap hasil pertandingan
+var matchResultLabels = map[model.MatchResult]string{
+	model.MatchResultNotFinished:      "Belum Selesai",
+	model.MatchResultHomeWin:          "Tim Home Menang",
+	model.MatchResultAwayWin:          "Tim Away Menang",
+	model.MatchResultHomeWinPenalties: "Tim Home Menang (Adu Penalti)",
+	model.MatchResultAwayWinPenalties: "Tim Away Menang (Adu Penalti)",
+	model.MatchResultDraw:             "Seri",
 }
 
 // GetMatchReport menangani endpoint GET /matches/:id/report
 // @Summary Mengambil laporan pertandingan
-// @Description Endpoint untuk mengambil laporan detail pertandingan
+// @Description Endpoint untuk mengambil laporan detail pertandingan.
+// @Description Teks (label hasil, top scorer, nama grup) mengikuti header Accept-Language (id atau en).
 // @Tags Matches
 // @Produce json
 // @Security BearerAuth
 // @Param id path int true "Match ID"
+// @Param Accept-Language header string false "Bahasa response (id, en)"
 // @Success 200 {object} MatchReportResponse
 // @Failure 400 {object} utils.ErrorResponse
 // @Failure 404 {object} utils.ErrorResponse
@@ -239,52 +287,58 @@ func (h *MatchHandler) GetMatchReport(c *gin.Context) {
 		return
 	}
 
-	// Ambil match dengan relasi
-	match, err := h.matchRepo.FindByIDWithGoals(uint(id))
+	report, err := h.matchService.Report(uint(id))
 	if err != nil {
-		utils.RespondError(c, http.StatusNotFound, "Match tidak ditemukan")
+		abortWithError(c, err, "Gagal mengambil laporan match")
 		return
 	}
 
-	// Build response
+	c.Header("Content-Language", utils.Language(c))
+	utils.RespondSuccess(c, http.StatusOK, buildMatchReport(c, report))
+}
+
+// buildMatchReport menyusun MatchReportResponse dalam bahasa request; dipakai laporan JSON dan PDF
+func buildMatchReport(c *gin.Context, report *service.MatchReport) MatchReportResponse {
+	match := report.Match
 	response := MatchReportResponse{
-		Schedule:   match.MatchDatetime.Format("2006-01-02T15:04:05Z07:00"),
-		HomeTeam:   match.HomeTeam.Name,
-		AwayTeam:   match.AwayTeam.Name,
-		FinalScore: fmt.Sprintf("%d-%d", match.HomeScore, match.AwayScore),
+		Schedule:          match.MatchDatetime.Format("2006-01-02T15:04:05Z07:00"),
+		HomeTeam:          match.HomeTeam.Name,
+		AwayTeam:          match.AwayTeam.Name,
+		Round:             report.Round,
+		MatchResult:       match.Result(),
+		FinalScore:        fmt.Sprintf("%d-%d", match.HomeScore, match.AwayScore),
+		Attendance:        match.Attendance,
+		ExtraTime:         match.ExtraTime,
+		HomeTeamTotalWins: report.HomeTeamTotalWins,
+		AwayTeamTotalWins: report.AwayTeamTotalWins,
 	}
 
-	// Determine match result
-	if match.Status != model.MatchStatusCompleted {
-		response.MatchResult = "Belum Selesai"
-	} else {
-		if match.HomeScore > match.AwayScore {
-			response.MatchResult = "Tim Home Menang"
-		} else if match.AwayScore > match.HomeScore {
-			response.MatchResult = "Tim Away Menang"
-		} else {
-			response.MatchResult = "Seri"
-		}
+	if match.HomePenalties != nil && match.AwayPenalties != nil {
+		response.Penalties = fmt.Sprintf("%d-%d", *match.HomePenalties, *match.AwayPenalties)
 	}
 
-	// Get top scorer in match
-	topScorer, goalCount, err := h.goalRepo.GetTopScorerInMatch(uint(id))
-	if err == nil && topScorer != nil {
-		response.TopScorerInMatch = fmt.Sprintf("%s (%d gol)", topScorer.Name, goalCount)
-	} else {
-		response.TopScorerInMatch = "Belum ada gol"
+	if match.Venue != nil {
+		response.Venue = fmt.Sprintf("%s, %s", match.Venue.Name, match.Venue.City)
 	}
 
-	// Get total wins for each team
-	homeWins, err := h.teamRepo.CountWinsByTeamID(match.HomeTeamID)
-	if err == nil {
-		response.HomeTeamTotalWins = homeWins
+	for _, mo := range match.Officials {
+		response.Officials = append(response.Officials, MatchReportOfficial{
+			Role:         mo.Role,
+			Name:         mo.Official.Name,
+			LicenseLevel: mo.Official.LicenseLevel,
+		})
 	}
 
-	awayWins, err := h.teamRepo.CountWinsByTeamID(match.AwayTeamID)
-	if err == nil {
-		response.AwayTeamTotalWins = awayWins
+	if report.GroupName != "" {
+		response.Round = utils.Translate(c, "Grup %s", report.GroupName)
+	}
+	response.MatchResultLabel = utils.Translate(c, matchResultLabels[response.MatchResult])
+
+	if report.TopScorer != nil {
+		response.TopScorerInMatch = utils.Translate(c, "%s (%d gol)", report.TopScorer.Name, report.TopScorerGoals)
+	} else {
+		response.TopScorerInMatch = utils.Translate(c, "Belum ada gol")
 	}
 
-	utils.RespondSuccess(c, http.StatusOK, response)
+	return response
 }
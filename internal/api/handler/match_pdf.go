@@ -0,0 +1,215 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/service"
+	"xyz-football-api/pkg/pdf"
+	"xyz-football-api/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// officialRoleLabels adalah message ID label untuk setiap peran official
+var officialRoleLabels = map[model.OfficialRole]string{
+	model.OfficialRoleReferee:          "Wasit",
+	model.OfficialRoleAssistantReferee: "Asisten Wasit",
+	model.OfficialRoleFourthOfficial:   "Ofisial Keempat",
+	model.OfficialRoleVAR:              "VAR",
+}
+
+// positionLabels adalah message ID label untuk setiap posisi player
+var positionLabels = map[string]string{
+	"penyerang":      "Penyerang",
+	"gelandang":      "Gelandang",
+	"bertahan":       "Bertahan",
+	"penjaga gawang": "Penjaga Gawang",
+}
+
+// GetMatchReportPDF menangani endpoint GET /matches/:id/report.pdf
+// @Summary Laporan pertandingan dalam format PDF
+// @Description Versi cetak dari GET /matches/{id}/report: jadwal, team, skor, urutan gol, top scorer,
+// @Description total kemenangan, official, dan kolom tanda tangan. Teks mengikuti header Accept-Language (id atau en).
+// @Tags Matches
+// @Produce application/pdf
+// @Security BearerAuth
+// @Param id path int true "Match ID"
+// @Param Accept-Language header string false "Bahasa dokumen (id, en)"
+// @Success 200 {file} file
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /matches/{id}/report.pdf [get]
+func (h *MatchHandler) GetMatchReportPDF(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "ID match tidak valid")
+		return
+	}
+
+	report, err := h.matchService.Report(uint(id))
+	if err != nil {
+		abortWithError(c, err, "Gagal mengambil laporan match")
+		return
+	}
+	response := buildMatchReport(c, report)
+
+	doc := pdf.New(fmt.Sprintf("%s vs %s", response.HomeTeam, response.AwayTeam))
+	doc.Title(utils.Translate(c, "Laporan Pertandingan"))
+	doc.Text(fmt.Sprintf("%s vs %s", response.HomeTeam, response.AwayTeam))
+	doc.Rule()
+
+	doc.Field(utils.Translate(c, "Jadwal"), report.Match.MatchDatetime.Format("2006-01-02 15:04 -07:00"))
+	if response.Round != "" {
+		doc.Field(utils.Translate(c, "Babak"), response.Round)
+	}
+	if response.Venue != "" {
+		doc.Field(utils.Translate(c, "Stadion"), response.Venue)
+	}
+	if response.Attendance != nil {
+		doc.Field(utils.Translate(c, "Penonton"), strconv.Itoa(*response.Attendance))
+	}
+	score := response.FinalScore
+	if response.ExtraTime {
+		score += " " + utils.Translate(c, "(perpanjangan waktu)")
+	}
+	doc.Field(utils.Translate(c, "Skor Akhir"), score)
+	if response.Penalties != "" {
+		doc.Field(utils.Translate(c, "Adu Penalti"), response.Penalties)
+	}
+	doc.Field(utils.Translate(c, "Hasil"), response.MatchResultLabel)
+	doc.Field(utils.Translate(c, "Top Scorer"), response.TopScorerInMatch)
+
+	doc.Heading(utils.Translate(c, "Urutan Gol"))
+	goals := slices.Clone(report.Match.Goals)
+	slices.SortStableFunc(goals, func(a, b model.Goal) int { return a.GoalTime - b.GoalTime })
+	if len(goals) == 0 {
+		doc.Text(utils.Translate(c, "Belum ada gol"))
+	} else {
+		rows := make([][]string, len(goals))
+		for i, goal := range goals {
+			team := response.HomeTeam
+			if goal.Player.TeamID == report.Match.AwayTeamID {
+				team = response.AwayTeam
+			}
+			rows[i] = []string{fmt.Sprintf("%d'", goal.GoalTime), goal.Player.Name, team}
+		}
+		doc.Table([]pdf.Column{
+			{Title: utils.Translate(c, "Menit"), Width: 60},
+			{Title: utils.Translate(c, "Pencetak Gol"), Width: 220},
+			{Title: utils.Translate(c, "Team"), Width: pdf.ContentWidth - 280},
+		}, rows)
+	}
+
+	doc.Heading(utils.Translate(c, "Total Kemenangan"))
+	doc.Field(response.HomeTeam, strconv.FormatInt(response.HomeTeamTotalWins, 10))
+	doc.Field(response.AwayTeam, strconv.FormatInt(response.AwayTeamTotalWins, 10))
+
+	if len(response.Officials) > 0 {
+		doc.Heading(utils.Translate(c, "Perangkat Pertandingan"))
+		for _, official := range response.Officials {
+			doc.Field(utils.Translate(c, officialRoleLabels[official.Role]), official.Name)
+		}
+	}
+
+	doc.Space(20)
+	doc.Signatures(
+		utils.Translate(c, "Wasit"),
+		utils.Translate(c, "Manajer %s", response.HomeTeam),
+		utils.Translate(c, "Manajer %s", response.AwayTeam),
+	)
+	respondPDF(c, fmt.Sprintf("match-%d-report.pdf", id), doc)
+}
+
+// GetTeamSheetPDF menangani endpoint GET /matches/:id/teamsheet.pdf
+// @Summary Team sheet pra-pertandingan dalam format PDF
+// @Description Daftar skuad kedua team terurut nomor punggung beserta posisinya. Player yang berhalangan
+// @Description (cedera, sakit, dll.) pada tanggal match tetap dicantumkan dengan status tidak tersedia.
+// @Tags Matches
+// @Produce application/pdf
+// @Security BearerAuth
+// @Param id path int true "Match ID"
+// @Param Accept-Language header string false "Bahasa dokumen (id, en)"
+// @Success 200 {file} file
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /matches/{id}/teamsheet.pdf [get]
+func (h *MatchHandler) GetTeamSheetPDF(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "ID match tidak valid")
+		return
+	}
+
+	sheet, err := h.matchService.TeamSheet(uint(id))
+	if err != nil {
+		abortWithError(c, err, "Gagal mengambil team sheet")
+		return
+	}
+	match := sheet.Match
+
+	doc := pdf.New(fmt.Sprintf("%s vs %s", match.HomeTeam.Name, match.AwayTeam.Name))
+	doc.Title(utils.Translate(c, "Team Sheet"))
+	doc.Text(fmt.Sprintf("%s vs %s", match.HomeTeam.Name, match.AwayTeam.Name))
+	doc.Rule()
+	doc.Field(utils.Translate(c, "Jadwal"), match.MatchDatetime.Format("2006-01-02 15:04 -07:00"))
+	if match.Venue != nil {
+		doc.Field(utils.Translate(c, "Stadion"), fmt.Sprintf("%s, %s", match.Venue.Name, match.Venue.City))
+	}
+
+	columns := []pdf.Column{
+		{Title: utils.Translate(c, "No"), Width: 40},
+		{Title: utils.Translate(c, "Nama"), Width: 215},
+		{Title: utils.Translate(c, "Posisi"), Width: 120},
+		{Title: utils.Translate(c, "Status"), Width: pdf.ContentWidth - 375},
+	}
+	for _, team := range []struct {
+		name  string
+		squad []service.SquadPlayer
+	}{
+		{match.HomeTeam.Name, sheet.Home},
+		{match.AwayTeam.Name, sheet.Away},
+	} {
+		doc.Heading(team.name)
+		if len(team.squad) == 0 {
+			doc.Text(utils.Translate(c, "Belum ada player terdaftar"))
+			continue
+		}
+		rows := make([][]string, len(team.squad))
+		for i, player := range team.squad {
+			status := utils.Translate(c, "Tersedia")
+			if !player.Available {
+				status = utils.Translate(c, "Tidak tersedia")
+			}
+			rows[i] = []string{strconv.Itoa(player.JerseyNumber), player.Name, utils.Translate(c, positionLabels[player.Position]), status}
+		}
+		doc.Table(columns, rows)
+	}
+
+	doc.Space(20)
+	doc.Signatures(
+		utils.Translate(c, "Manajer %s", match.HomeTeam.Name),
+		utils.Translate(c, "Manajer %s", match.AwayTeam.Name),
+	)
+	respondPDF(c, fmt.Sprintf("match-%d-teamsheet.pdf", id), doc)
+}
+
+// respondPDF mengirim dokumen sebagai application/pdf. Dokumen ditulis ke buffer dulu
+// sehingga error masih bisa dirender sebagai JSON.
+func respondPDF(c *gin.Context, filename string, doc *pdf.Document) {
+	clearWriteDeadline(c)
+	var buf bytes.Buffer
+	if _, err := doc.WriteTo(&buf); err != nil {
+		abortWithError(c, err, "Gagal membuat dokumen PDF")
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`inline; filename="%s"`, filename))
+	c.Header("Content-Language", utils.Language(c))
+	c.Data(http.StatusOK, pdf.ContentType, buf.Bytes())
+}
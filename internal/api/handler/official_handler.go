@@ -0,0 +1,435 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+	"xyz-football-api/internal/apperror"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+	"xyz-football-api/internal/service"
+	"xyz-football-api/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// officialMatchWindow adalah rentang waktu sebelum/sesudah kick-off di mana
+// official dianggap sedang bertugas, sehingga tidak boleh ditugaskan di match lain
+const officialMatchWindow = 3 * time.Hour
+
+// OfficialHandler menangani endpoint officials dan penugasannya ke match
+type OfficialHandler struct {
+	officialRepo repository.OfficialRepository
+	matchRepo    repository.MatchRepository
+	teamRepo     repository.TeamRepository
+}
+
+// NewOfficialHandler membuat instance OfficialHandler baru
+func NewOfficialHandler(
+	officialRepo repository.OfficialRepository,
+	matchRepo repository.MatchRepository,
+	teamRepo repository.TeamRepository,
+) *OfficialHandler {
+	return &OfficialHandler{
+		officialRepo: officialRepo,
+		matchRepo:    matchRepo,
+		teamRepo:     teamRepo,
+	}
+}
+
+// OfficialRequest adalah struct untuk request body create/update official
+type OfficialRequest struct {
+	Name              string  `json:"name" binding:"required"`
+	LicenseLevel      *string `json:"license_level"`
+	City              *string `json:"city"`
+	ConflictedTeamIDs []uint  `json:"conflicted_team_ids"`
+}
+
+// AssignOfficialRequest adalah struct untuk request body penugasan official ke match
+type AssignOfficialRequest struct {
+	OfficialID uint   `json:"official_id" binding:"required"`
+	Role       string `json:"role" binding:"required,oneof=referee assistant_referee fourth_official var"`
+}
+
+// CreateOfficial menangani endpoint POST /officials
+// @Summary Membuat official baru
+// @Description Endpoint untuk mendaftarkan wasit/perangkat pertandingan beserta tim yang konflik
+// @Tags Officials
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body OfficialRequest true "Official Data"
+// @Success 201 {object} model.Official
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /officials [post]
+func (h *OfficialHandler) CreateOfficial(c *gin.Context) {
+	var req OfficialRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		abortWithBindError(c, err, "Data official tidak valid")
+		return
+	}
+
+	teams, ok := h.findConflictedTeams(c, req.ConflictedTeamIDs)
+	if !ok {
+		return
+	}
+
+	official := model.Official{
+		Name:            req.Name,
+		LicenseLevel:    req.LicenseLevel,
+		City:            req.City,
+		ConflictedTeams: teams,
+	}
+
+	if err := h.officialRepo.Create(&official); err != nil {
+		abortWithError(c, err, "Gagal membuat official")
+		return
+	}
+
+	utils.RespondSuccess(c, http.StatusCreated, official)
+}
+
+// GetAllOfficials menangani endpoint GET /officials
+// @Summary Mengambil semua officials
+// @Description Endpoint untuk mengambil daftar semua official
+// @Tags Officials
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} model.Official
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /officials [get]
+func (h *OfficialHandler) GetAllOfficials(c *gin.Context) {
+	officials, err := h.officialRepo.FindAll()
+	if err != nil {
+		abortWithError(c, err, "Gagal mengambil data officials")
+		return
+	}
+
+	utils.RespondSuccess(c, http.StatusOK, officials)
+}
+
+// GetOfficialByID menangani endpoint GET /officials/:id
+// @Summary Mengambil official berdasarkan ID
+// @Description Endpoint untuk mengambil detail official berdasarkan ID
+// @Tags Officials
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Official ID"
+// @Success 200 {object} model.Official
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /officials/{id} [get]
+func (h *OfficialHandler) GetOfficialByID(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "ID official tidak valid")
+		return
+	}
+
+	official, err := h.officialRepo.FindByID(uint(id))
+	if err != nil {
+		abortWithError(c, lookupError(err, service.ErrOfficialNotFound), "Gagal mengambil data official")
+		return
+	}
+
+	utils.RespondSuccess(c, http.StatusOK, official)
+}
+
+// UpdateOfficial menangani endpoint PUT /officials/:id
+// @Summary Memperbarui data official
+// @Description Endpoint untuk memperbarui informasi official dan daftar tim yang konflik
+// @Tags Officials
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Official ID"
+// @Param body body OfficialRequest true "Updated Official Data"
+// @Success 200 {object} model.Official
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /officials/{id} [put]
+func (h *OfficialHandler) UpdateOfficial(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "ID official tidak valid")
+		return
+	}
+
+	// Cek apakah official ada
+	existingOfficial, err := h.officialRepo.FindByID(uint(id))
+	if err != nil {
+		abortWithError(c, lookupError(err, service.ErrOfficialNotFound), "Gagal mengambil data official")
+		return
+	}
+
+	var req OfficialRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		abortWithBindError(c, err, "Data official tidak valid")
+		return
+	}
+
+	teams, ok := h.findConflictedTeams(c, req.ConflictedTeamIDs)
+	if !ok {
+		return
+	}
+
+	existingOfficial.Name = req.Name
+	existingOfficial.LicenseLevel = req.LicenseLevel
+	existingOfficial.City = req.City
+	existingOfficial.ConflictedTeams = teams
+
+	if err := h.officialRepo.Update(existingOfficial); err != nil {
+		abortWithError(c, err, "Gagal memperbarui official")
+		return
+	}
+
+	utils.RespondSuccess(c, http.StatusOK, existingOfficial)
+}
+
+// DeleteOfficial menangani endpoint DELETE /officials/:id
+// @Summary Menghapus official
+// @Description Endpoint untuk menghapus official (soft delete)
+// @Tags Officials
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Official ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /officials/{id} [delete]
+func (h *OfficialHandler) DeleteOfficial(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "ID official tidak valid")
+		return
+	}
+
+	if _, err := h.officialRepo.FindByID(uint(id)); err != nil {
+		abortWithError(c, lookupError(err, service.ErrOfficialNotFound), "Gagal mengambil data official")
+		return
+	}
+
+	if err := h.officialRepo.Delete(uint(id)); err != nil {
+		abortWithError(c, err, "Gagal menghapus official")
+		return
+	}
+
+	utils.RespondMessage(c, http.StatusOK, "Official berhasil dihapus")
+}
+
+// AssignOfficial menangani endpoint POST /matches/:id/officials
+// @Summary Menugaskan official ke pertandingan
+// @Description Endpoint untuk menugaskan wasit, asisten wasit, fourth official atau VAR ke pertandingan.
+// @Description Official tidak boleh bertugas di pertandingan lain yang jadwalnya berdekatan
+// @Description atau di pertandingan yang melibatkan tim yang konflik dengannya.
+// @Tags Matches
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Match ID"
+// @Param body body AssignOfficialRequest true "Assignment Data"
+// @Success 201 {object} model.MatchOfficial
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /matches/{id}/officials [post]
+func (h *OfficialHandler) AssignOfficial(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "ID match tidak valid")
+		return
+	}
+
+	// Validasi match exists
+	match, err := h.matchRepo.FindByID(uint(id))
+	if err != nil {
+		abortWithError(c, lookupError(err, service.ErrMatchNotFound), "Gagal mengambil data match")
+		return
+	}
+
+	if match.Status != model.MatchStatusScheduled {
+		abortWithError(c, apperror.Validation("match_not_scheduled", "Official hanya dapat ditugaskan ke match yang masih scheduled"), "")
+		return
+	}
+
+	var req AssignOfficialRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		abortWithBindError(c, err, "Data penugasan tidak valid")
+		return
+	}
+	role := model.OfficialRole(req.Role)
+
+	// Validasi official exists
+	official, err := h.officialRepo.FindByID(req.OfficialID)
+	if err != nil {
+		abortWithError(c, lookupError(err, service.ErrUnknownOfficial), "Gagal mengambil data official")
+		return
+	}
+
+	// Validasi official belum bertugas di match ini
+	assignments, err := h.officialRepo.FindAssignmentsByMatchID(match.ID)
+	if err != nil {
+		abortWithError(c, err, "Gagal mengambil data penugasan")
+		return
+	}
+	for _, a := range assignments {
+		if a.OfficialID == official.ID {
+			abortWithError(c, apperror.Conflictf("official_already_assigned", "%s sudah ditugaskan sebagai %s di match ini", official.Name, a.Role), "")
+			return
+		}
+	}
+
+	// Validasi kuota peran
+	count, err := h.officialRepo.CountAssignmentsByRole(match.ID, role)
+	if err != nil {
+		abortWithError(c, err, "Gagal validasi peran official")
+		return
+	}
+	if int(count) >= role.MaxPerMatch() {
+		abortWithError(c, apperror.Conflictf("official_role_full", "Peran %s sudah terisi penuh untuk match ini", role), "")
+		return
+	}
+
+	// Validasi konflik kepentingan dengan tim yang bertanding
+	conflicted, err := h.officialRepo.IsConflictedWithTeams(official.ID, match.HomeTeamID, match.AwayTeamID)
+	if err != nil {
+		abortWithError(c, err, "Gagal validasi konflik official")
+		return
+	}
+	if conflicted {
+		abortWithError(c, apperror.Conflictf("official_conflict_of_interest", "%s memiliki konflik kepentingan dengan salah satu tim yang bertanding", official.Name), "")
+		return
+	}
+
+	// Validasi jadwal official tidak bentrok dengan match lain
+	overlap, err := h.officialRepo.HasOverlappingAssignment(
+		official.ID,
+		match.ID,
+		match.MatchDatetime.Add(-officialMatchWindow),
+		match.MatchDatetime.Add(officialMatchWindow),
+	)
+	if err != nil {
+		abortWithError(c, err, "Gagal validasi jadwal official")
+		return
+	}
+	if overlap {
+		abortWithError(c, apperror.Conflictf("official_schedule_clash", "%s sudah bertugas di match lain pada waktu yang berdekatan", official.Name), "")
+		return
+	}
+
+	assignment := model.MatchOfficial{
+		MatchID:    match.ID,
+		OfficialID: official.ID,
+		Role:       role,
+	}
+
+	if err := h.officialRepo.CreateAssignment(&assignment); err != nil {
+		abortWithError(c, err, "Gagal menugaskan official")
+		return
+	}
+
+	assignment.Official = *official
+	utils.RespondSuccess(c, http.StatusCreated, assignment)
+}
+
+// GetMatchOfficials menangani endpoint GET /matches/:id/officials
+// @Summary Mengambil daftar official pertandingan
+// @Description Endpoint untuk mengambil semua official yang ditugaskan ke pertandingan
+// @Tags Matches
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Match ID"
+// @Success 200 {array} model.MatchOfficial
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /matches/{id}/officials [get]
+func (h *OfficialHandler) GetMatchOfficials(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "ID match tidak valid")
+		return
+	}
+
+	if _, err := h.matchRepo.FindByID(uint(id)); err != nil {
+		abortWithError(c, lookupError(err, service.ErrMatchNotFound), "Gagal mengambil data match")
+		return
+	}
+
+	assignments, err := h.officialRepo.FindAssignmentsByMatchID(uint(id))
+	if err != nil {
+		abortWithError(c, err, "Gagal mengambil data penugasan")
+		return
+	}
+
+	utils.RespondSuccess(c, http.StatusOK, assignments)
+}
+
+// UnassignOfficial menangani endpoint DELETE /matches/:id/officials/:assignment_id
+// @Summary Membatalkan penugasan official
+// @Description Endpoint untuk menghapus penugasan official dari pertandingan
+// @Tags Matches
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Match ID"
+// @Param assignment_id path int true "Assignment ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /matches/{id}/officials/{assignment_id} [delete]
+func (h *OfficialHandler) UnassignOfficial(c *gin.Context) {
+	matchID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "ID match tidak valid")
+		return
+	}
+
+	assignmentID, err := strconv.ParseUint(c.Param("assignment_id"), 10, 32)
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "ID penugasan tidak valid")
+		return
+	}
+
+	assignment, err := h.officialRepo.FindAssignmentByID(uint(assignmentID))
+	if err != nil {
+		abortWithError(c, lookupError(err, service.ErrAssignmentNotFound), "Gagal mengambil data penugasan")
+		return
+	}
+	if assignment.MatchID != uint(matchID) {
+		abortWithError(c, service.ErrAssignmentNotFound, "")
+		return
+	}
+
+	if err := h.officialRepo.DeleteAssignment(assignment.ID); err != nil {
+		abortWithError(c, err, "Gagal menghapus penugasan official")
+		return
+	}
+
+	utils.RespondMessage(c, http.StatusOK, "Penugasan official berhasil dibatalkan")
+}
+
+// findConflictedTeams memastikan semua team ID yang konflik ada di database
+func (h *OfficialHandler) findConflictedTeams(c *gin.Context, teamIDs []uint) ([]model.Team, bool) {
+	teams := make([]model.Team, 0, len(teamIDs))
+	for _, teamID := range teamIDs {
+		team, err := h.teamRepo.FindByID(teamID)
+		if err != nil {
+			unknown := apperror.Validationf("unknown_team", "Team dengan ID %d tidak ditemukan", teamID)
+			abortWithError(c, lookupError(err, unknown), "Gagal mengambil data team")
+			return nil, false
+		}
+		team.HomeVenue = nil
+		teams = append(teams, *team)
+	}
+	return teams, true
+}
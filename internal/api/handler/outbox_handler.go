@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/service"
+	"xyz-football-api/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Batas jumlah event diparkir per request
+const (
+	defaultParkedLimit = 50
+	maxParkedLimit     = 500
+)
+
+// OutboxHandler menangani endpoint admin untuk event outbox yang diparkir
+type OutboxHandler struct {
+	outboxService *service.OutboxService
+}
+
+// NewOutboxHandler membuat instance OutboxHandler baru
+func NewOutboxHandler(outboxService *service.OutboxService) *OutboxHandler {
+	return &OutboxHandler{outboxService: outboxService}
+}
+
+// OutboxEventResponse adalah satu event outbox beserta payload-nya
+type OutboxEventResponse struct {
+	model.OutboxEvent
+	Payload json.RawMessage `json:"payload" swaggertype:"object"`
+}
+
+// RequeueOutboxRequest merepresentasikan request body requeue event outbox
+type RequeueOutboxRequest struct {
+	// IDs event yang dikembalikan ke antrean; kosongkan untuk semua event yang diparkir
+	IDs []uint `json:"ids,omitempty" example:"12,15"`
+}
+
+// RequeueOutboxResponse berisi jumlah event yang dikembalikan ke antrean
+type RequeueOutboxResponse struct {
+	Requeued int64 `json:"requeued" example:"2"`
+}
+
+// GetParkedEvents menangani endpoint GET /outbox/parked
+// @Summary Event outbox yang diparkir
+// @Description Event yang terus gagal dipublikasikan selama OUTBOX_PARK_AFTER dan tidak dikirim lagi
+// @Description sampai di-requeue, urut ID, beserta jumlah percobaan dan error terakhir.
+// @Description Hanya bisa dipanggil oleh admin dari konfigurasi (ADMIN_USERNAME).
+// @Tags Outbox
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "Jumlah maksimal event (default 50, maks. 500)"
+// @Success 200 {array} OutboxEventResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /outbox/parked [get]
+func (h *OutboxHandler) GetParkedEvents(c *gin.Context) {
+	limit := defaultParkedLimit
+	if value := c.Query("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			utils.RespondError(c, http.StatusBadRequest, "Parameter %s tidak valid", "limit")
+			return
+		}
+		limit = min(parsed, maxParkedLimit)
+	}
+
+	events, err := h.outboxService.Parked(limit)
+	if err != nil {
+		abortWithError(c, err, "Gagal mengambil event outbox yang diparkir")
+		return
+	}
+
+	response := make([]OutboxEventResponse, len(events))
+	for i, e := range events {
+		response[i] = OutboxEventResponse{OutboxEvent: e, Payload: json.RawMessage(e.Payload)}
+	}
+	utils.RespondSuccess(c, http.StatusOK, response)
+}
+
+// RequeueEvents menangani endpoint POST /outbox/requeue
+// @Summary Mengembalikan event outbox yang diparkir ke antrean
+// @Description Event dipublikasikan lagi oleh dispatcher pada putaran berikutnya dengan jumlah percobaan dari nol.
+// @Description Tanpa body atau dengan ids kosong semua event yang diparkir dikembalikan ke antrean.
+// @Description Hanya bisa dipanggil oleh admin dari konfigurasi (ADMIN_USERNAME).
+// @Tags Outbox
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body RequeueOutboxRequest false "Event IDs"
+// @Success 200 {object} RequeueOutboxResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /outbox/requeue [post]
+func (h *OutboxHandler) RequeueEvents(c *gin.Context) {
+	var req RequeueOutboxRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			abortWithBindError(c, err, "Data requeue outbox tidak valid")
+			return
+		}
+	}
+
+	requeued, err := h.outboxService.Requeue(req.IDs)
+	if err != nil {
+		abortWithError(c, err, "Gagal mengembalikan event outbox ke antrean")
+		return
+	}
+
+	utils.RespondSuccess(c, http.StatusOK, RequeueOutboxResponse{Requeued: requeued})
+}
@@ -0,0 +1,244 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+	"xyz-football-api/internal/apperror"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+	"xyz-football-api/internal/service"
+	"xyz-football-api/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// dateLayout adalah format tanggal (YYYY-MM-DD) untuk field tanggal tanpa waktu
+const dateLayout = "2006-01-02"
+
+// PlayerAvailabilityHandler menangani endpoint ketersediaan player
+type PlayerAvailabilityHandler struct {
+	availabilityRepo repository.PlayerAvailabilityRepository
+	playerRepo       repository.PlayerRepository
+}
+
+// NewPlayerAvailabilityHandler membuat instance PlayerAvailabilityHandler baru
+func NewPlayerAvailabilityHandler(
+	availabilityRepo repository.PlayerAvailabilityRepository,
+	playerRepo repository.PlayerRepository,
+) *PlayerAvailabilityHandler {
+	return &PlayerAvailabilityHandler{
+		availabilityRepo: availabilityRepo,
+		playerRepo:       playerRepo,
+	}
+}
+
+// PlayerAvailabilityRequest adalah struct untuk request body create/update ketersediaan player
+type PlayerAvailabilityRequest struct {
+	Status             string  `json:"status" binding:"required,oneof=available injured ill international_duty suspended"`
+	Notes              *string `json:"notes"`
+	StartDate          string  `json:"start_date" binding:"required"`
+	ExpectedReturnDate *string `json:"expected_return_date"`
+}
+
+// toModel memvalidasi tanggal pada request dan memetakannya ke model. Mengembalikan nil jika valid.
+func (req *PlayerAvailabilityRequest) toModel(availability *model.PlayerAvailability) *apperror.Error {
+	startDate, err := time.Parse(dateLayout, req.StartDate)
+	if err != nil {
+		return apperror.Validation("invalid_date", "Format start_date tidak valid (gunakan YYYY-MM-DD)")
+	}
+
+	var returnDate *time.Time
+	if req.ExpectedReturnDate != nil && *req.ExpectedReturnDate != "" {
+		parsed, err := time.Parse(dateLayout, *req.ExpectedReturnDate)
+		if err != nil {
+			return apperror.Validation("invalid_date", "Format expected_return_date tidak valid (gunakan YYYY-MM-DD)")
+		}
+		if !parsed.After(startDate) {
+			return apperror.Validation("invalid_date", "expected_return_date harus setelah start_date")
+		}
+		returnDate = &parsed
+	}
+
+	availability.Status = model.AvailabilityStatus(req.Status)
+	availability.Notes = req.Notes
+	availability.StartDate = startDate
+	availability.ExpectedReturnDate = returnDate
+	return nil
+}
+
+// CreateAvailability menangani endpoint POST /players/:id/availability
+// @Summary Mencatat ketersediaan player
+// @Description Endpoint untuk mencatat status ketersediaan player (cedera, sakit, tugas internasional, skorsing, tersedia)
+// @Tags Players
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Player ID"
+// @Param body body PlayerAvailabilityRequest true "Availability Data"
+// @Success 201 {object} model.PlayerAvailability
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /players/{id}/availability [post]
+func (h *PlayerAvailabilityHandler) CreateAvailability(c *gin.Context) {
+	playerID, ok := h.findPlayerID(c)
+	if !ok {
+		return
+	}
+
+	var req PlayerAvailabilityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		abortWithBindError(c, err, "Data ketersediaan tidak valid")
+		return
+	}
+
+	availability := model.PlayerAvailability{PlayerID: playerID}
+	if err := req.toModel(&availability); err != nil {
+		abortWithError(c, err, "")
+		return
+	}
+
+	if err := h.availabilityRepo.Create(&availability); err != nil {
+		abortWithError(c, err, "Gagal mencatat ketersediaan player")
+		return
+	}
+
+	utils.RespondSuccess(c, http.StatusCreated, availability)
+}
+
+// GetAvailabilities menangani endpoint GET /players/:id/availability
+// @Summary Mengambil riwayat ketersediaan player
+// @Description Endpoint untuk mengambil semua catatan ketersediaan player
+// @Tags Players
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Player ID"
+// @Success 200 {array} model.PlayerAvailability
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /players/{id}/availability [get]
+func (h *PlayerAvailabilityHandler) GetAvailabilities(c *gin.Context) {
+	playerID, ok := h.findPlayerID(c)
+	if !ok {
+		return
+	}
+
+	availabilities, err := h.availabilityRepo.FindByPlayerID(playerID)
+	if err != nil {
+		abortWithError(c, err, "Gagal mengambil data ketersediaan")
+		return
+	}
+
+	utils.RespondSuccess(c, http.StatusOK, availabilities)
+}
+
+// UpdateAvailability menangani endpoint PUT /players/:id/availability/:availability_id
+// @Summary Memperbarui catatan ketersediaan player
+// @Description Endpoint untuk memperbarui status atau tanggal kembali player
+// @Tags Players
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Player ID"
+// @Param availability_id path int true "Availability ID"
+// @Param body body PlayerAvailabilityRequest true "Availability Data"
+// @Success 200 {object} model.PlayerAvailability
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /players/{id}/availability/{availability_id} [put]
+func (h *PlayerAvailabilityHandler) UpdateAvailability(c *gin.Context) {
+	availability, ok := h.findAvailability(c)
+	if !ok {
+		return
+	}
+
+	var req PlayerAvailabilityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		abortWithBindError(c, err, "Data ketersediaan tidak valid")
+		return
+	}
+
+	if err := req.toModel(availability); err != nil {
+		abortWithError(c, err, "")
+		return
+	}
+
+	if err := h.availabilityRepo.Update(availability); err != nil {
+		abortWithError(c, err, "Gagal memperbarui ketersediaan player")
+		return
+	}
+
+	utils.RespondSuccess(c, http.StatusOK, availability)
+}
+
+// DeleteAvailability menangani endpoint DELETE /players/:id/availability/:availability_id
+// @Summary Menghapus catatan ketersediaan player
+// @Description Endpoint untuk menghapus catatan ketersediaan player (soft delete)
+// @Tags Players
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Player ID"
+// @Param availability_id path int true "Availability ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /players/{id}/availability/{availability_id} [delete]
+func (h *PlayerAvailabilityHandler) DeleteAvailability(c *gin.Context) {
+	availability, ok := h.findAvailability(c)
+	if !ok {
+		return
+	}
+
+	if err := h.availabilityRepo.Delete(availability.ID); err != nil {
+		abortWithError(c, err, "Gagal menghapus ketersediaan player")
+		return
+	}
+
+	utils.RespondMessage(c, http.StatusOK, "Data ketersediaan player berhasil dihapus")
+}
+
+// findPlayerID mem-parsing :id dan memastikan player ada
+func (h *PlayerAvailabilityHandler) findPlayerID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "ID player tidak valid")
+		return 0, false
+	}
+
+	if _, err := h.playerRepo.FindByID(uint(id)); err != nil {
+		abortWithError(c, lookupError(err, service.ErrPlayerNotFound), "Gagal mengambil data player")
+		return 0, false
+	}
+
+	return uint(id), true
+}
+
+// findAvailability mem-parsing :availability_id dan memastikan record milik player :id
+func (h *PlayerAvailabilityHandler) findAvailability(c *gin.Context) (*model.PlayerAvailability, bool) {
+	playerID, ok := h.findPlayerID(c)
+	if !ok {
+		return nil, false
+	}
+
+	id, err := strconv.ParseUint(c.Param("availability_id"), 10, 32)
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "ID ketersediaan tidak valid")
+		return nil, false
+	}
+
+	availability, err := h.availabilityRepo.FindByID(uint(id))
+	if err != nil {
+		abortWithError(c, lookupError(err, service.ErrAvailabilityNotFound), "Gagal mengambil data ketersediaan")
+		return nil, false
+	}
+	if availability.PlayerID != playerID {
+		abortWithError(c, service.ErrAvailabilityNotFound, "")
+		return nil, false
+	}
+
+	return availability, true
+}
@@ -3,8 +3,9 @@ package handler
 import (
 	"net/http"
 	"strconv"
+	"time"
 	"xyz-football-api/internal/model"
-	"xyz-football-api/internal/repository"
+	"xyz-football-api/internal/service"
 	"xyz-football-api/pkg/utils"
 
 	"github.com/gin-gonic/gin"
@@ -12,16 +13,12 @@ import (
 
 // PlayerHandler menangani endpoint players
 type PlayerHandler struct {
-	playerRepo *repository.PlayerRepository
-	teamRepo   *repository.TeamRepository
+	playerService *service.PlayerService
 }
 
 // NewPlayerHandler membuat instance PlayerHandler baru
-func NewPlayerHandler(playerRepo *repository.PlayerRepository, teamRepo *repository.TeamRepository) *PlayerHandler {
-	return &PlayerHandler{
-		playerRepo: playerRepo,
-		teamRepo:   teamRepo,
-	}
+func NewPlayerHandler(playerService *service.PlayerService) *PlayerHandler {
+	return &PlayerHandler{playerService: playerService}
 }
 
 // CreatePlayerRequest adalah struct untuk request body create player
@@ -34,6 +31,18 @@ type CreatePlayerRequest struct {
 	WeightKg     *int   `json:"weight_kg"`
 }
 
+// Player memetakan request ke model
+func (req CreatePlayerRequest) Player() model.Player {
+	return model.Player{
+		Name:         req.Name,
+		TeamID:       req.TeamID,
+		Position:     req.Position,
+		JerseyNumber: req.JerseyNumber,
+		HeightCm:     req.HeightCm,
+		WeightKg:     req.WeightKg,
+	}
+}
+
 // CreatePlayer menangani endpoint POST /players
 // @Summary Membuat player baru
 // @Description Endpoint untuk membuat player baru dalam sebuah team
@@ -44,46 +53,20 @@ type CreatePlayerRequest struct {
 // @Param body body CreatePlayerRequest true "Player Data"
 // @Success 201 {object} model.Player
 // @Failure 400 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
 // @Failure 500 {object} utils.ErrorResponse
 // @Router /players [post]
 func (h *PlayerHandler) CreatePlayer(c *gin.Context) {
 	var req CreatePlayerRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		utils.RespondError(c, http.StatusBadRequest, "Data player tidak valid: "+err.Error())
-		return
-	}
-
-	// Mapping request ke model
-	player := model.Player{
-		Name:         req.Name,
-		TeamID:       req.TeamID,
-		Position:     req.Position,
-		JerseyNumber: req.JerseyNumber,
-		HeightCm:     req.HeightCm,
-		WeightKg:     req.WeightKg,
-	}
-
-	// Validasi team exists
-	_, err := h.teamRepo.FindByID(player.TeamID)
-	if err != nil {
-		utils.RespondError(c, http.StatusBadRequest, "Team tidak ditemukan")
-		return
-	}
-
-	// Cek duplikat jersey number
-	exists, err := h.playerRepo.CheckJerseyNumberExists(player.TeamID, player.JerseyNumber, 0)
-	if err != nil {
-		utils.RespondError(c, http.StatusInternalServerError, "Gagal validasi nomor punggung: "+err.Error())
-		return
-	}
-	if exists {
-		utils.RespondError(c, http.StatusBadRequest, "Nomor punggung sudah digunakan di tim ini")
+		abortWithBindError(c, err, "Data player tidak valid")
 		return
 	}
 
-	if err := h.playerRepo.Create(&player); err != nil {
-		utils.RespondError(c, http.StatusInternalServerError, "Gagal membuat player: "+err.Error())
+	player := req.Player()
+	if err := h.playerService.Create(&player); err != nil {
+		abortWithError(c, err, "Gagal membuat player")
 		return
 	}
 
@@ -92,13 +75,16 @@ func (h *PlayerHandler) CreatePlayer(c *gin.Context) {
 
 // GetPlayersByTeam menangani endpoint GET /teams/:id/players
 // @Summary Mengambil semua players dari team tertentu
-// @Description Endpoint untuk mengambil daftar semua player dalam sebuah team
+// @Description Endpoint untuk mengambil daftar semua player dalam sebuah team.
+// @Description Jika available_on diisi, player yang tidak tersedia pada tanggal tersebut tidak ditampilkan.
 // @Tags Players
 // @Produce json
 // @Security BearerAuth
 // @Param id path int true "Team ID"
+// @Param available_on query string false "Tanggal ketersediaan (YYYY-MM-DD)"
 // @Success 200 {array} model.Player
 // @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
 // @Failure 500 {object} utils.ErrorResponse
 // @Router /teams/{id}/players [get]
 func (h *PlayerHandler) GetPlayersByTeam(c *gin.Context) {
@@ -109,22 +95,46 @@ func (h *PlayerHandler) GetPlayersByTeam(c *gin.Context) {
 		return
 	}
 
-	// Validasi team exists
-	_, err = h.teamRepo.FindByID(uint(id))
-	if err != nil {
-		utils.RespondError(c, http.StatusNotFound, "Team tidak ditemukan")
-		return
+	// Filter player yang tidak tersedia pada tanggal tertentu
+	var availableOn *time.Time
+	if value := c.Query("available_on"); value != "" {
+		date, err := time.Parse(dateLayout, value)
+		if err != nil {
+			utils.RespondError(c, http.StatusBadRequest, "Format available_on tidak valid (gunakan YYYY-MM-DD)")
+			return
+		}
+		availableOn = &date
 	}
 
-	players, err := h.playerRepo.FindByTeamID(uint(id))
+	players, err := h.playerService.ListByTeam(uint(id), availableOn)
 	if err != nil {
-		utils.RespondError(c, http.StatusInternalServerError, "Gagal mengambil data players: "+err.Error())
+		abortWithError(c, err, "Gagal mengambil data players")
 		return
 	}
 
 	utils.RespondSuccess(c, http.StatusOK, players)
 }
 
+// UpdatePlayerRequest adalah struct untuk request body update player (partial)
+type UpdatePlayerRequest struct {
+	Name         *string `json:"name,omitempty"`
+	HeightCm     *int    `json:"height_cm,omitempty"`
+	WeightKg     *int    `json:"weight_kg,omitempty"`
+	Position     *string `json:"position,omitempty"`
+	JerseyNumber *int    `json:"jersey_number,omitempty"`
+}
+
+// Update memetakan request ke perubahan player
+func (req UpdatePlayerRequest) Update() service.PlayerUpdate {
+	return service.PlayerUpdate{
+		Name:         req.Name,
+		HeightCm:     req.HeightCm,
+		WeightKg:     req.WeightKg,
+		Position:     req.Position,
+		JerseyNumber: req.JerseyNumber,
+	}
+}
+
 // UpdatePlayer menangani endpoint PUT /players/:id
 // @Summary Memperbarui data player
 // @Description Endpoint untuk memperbarui informasi player
@@ -133,10 +143,11 @@ func (h *PlayerHandler) GetPlayersByTeam(c *gin.Context) {
 // @Produce json
 // @Security BearerAuth
 // @Param id path int true "Player ID"
-// @Param body body model.Player true "Updated Player Data"
+// @Param body body UpdatePlayerRequest true "Updated Player Data"
 // @Success 200 {object} model.Player
 // @Failure 400 {object} utils.ErrorResponse
 // @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
 // @Router /players/{id} [put]
 func (h *PlayerHandler) UpdatePlayer(c *gin.Context) {
 	idStr := c.Param("id")
@@ -146,61 +157,19 @@ func (h *PlayerHandler) UpdatePlayer(c *gin.Context) {
 		return
 	}
 
-	// Cek apakah player ada
-	existingPlayer, err := h.playerRepo.FindByID(uint(id))
-	if err != nil {
-		utils.RespondError(c, http.StatusNotFound, "Player tidak ditemukan")
-		return
-	}
-
-	// Bind data baru (tidak perlu binding required untuk update partial)
-	type UpdatePlayerRequest struct {
-		Name         *string `json:"name,omitempty"`
-		HeightCm     *int    `json:"height_cm,omitempty"`
-		WeightKg     *int    `json:"weight_kg,omitempty"`
-		Position     *string `json:"position,omitempty"`
-		JerseyNumber *int    `json:"jersey_number,omitempty"`
-	}
-
-	var updateData UpdatePlayerRequest
-	if err := c.ShouldBindJSON(&updateData); err != nil {
-		utils.RespondError(c, http.StatusBadRequest, "Data player tidak valid: "+err.Error())
+	var req UpdatePlayerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		abortWithBindError(c, err, "Data player tidak valid")
 		return
 	}
 
-	// Update fields jika ada
-	if updateData.Name != nil {
-		existingPlayer.Name = *updateData.Name
-	}
-	if updateData.HeightCm != nil {
-		existingPlayer.HeightCm = updateData.HeightCm
-	}
-	if updateData.WeightKg != nil {
-		existingPlayer.WeightKg = updateData.WeightKg
-	}
-	if updateData.Position != nil {
-		existingPlayer.Position = *updateData.Position
-	}
-	if updateData.JerseyNumber != nil {
-		// Cek duplikat jersey number
-		exists, err := h.playerRepo.CheckJerseyNumberExists(existingPlayer.TeamID, *updateData.JerseyNumber, existingPlayer.ID)
-		if err != nil {
-			utils.RespondError(c, http.StatusInternalServerError, "Gagal validasi nomor punggung: "+err.Error())
-			return
-		}
-		if exists {
-			utils.RespondError(c, http.StatusBadRequest, "Nomor punggung sudah digunakan di tim ini")
-			return
-		}
-		existingPlayer.JerseyNumber = *updateData.JerseyNumber
-	}
-
-	if err := h.playerRepo.Update(existingPlayer); err != nil {
-		utils.RespondError(c, http.StatusInternalServerError, "Gagal memperbarui player: "+err.Error())
+	player, err := h.playerService.Update(uint(id), req.Update())
+	if err != nil {
+		abortWithError(c, err, "Gagal memperbarui player")
 		return
 	}
 
-	utils.RespondSuccess(c, http.StatusOK, existingPlayer)
+	utils.RespondSuccess(c, http.StatusOK, player)
 }
 
 // DeletePlayer menangani endpoint DELETE /players/:id
@@ -212,6 +181,7 @@ func (h *PlayerHandler) UpdatePlayer(c *gin.Context) {
 // @Param id path int true "Player ID"
 // @Success 200 {object} utils.SuccessResponse
 // @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
 // @Failure 500 {object} utils.ErrorResponse
 // @Router /players/{id} [delete]
 func (h *PlayerHandler) DeletePlayer(c *gin.Context) {
@@ -222,10 +192,10 @@ func (h *PlayerHandler) DeletePlayer(c *gin.Context) {
 		return
 	}
 
-	if err := h.playerRepo.Delete(uint(id)); err != nil {
-		utils.RespondError(c, http.StatusInternalServerError, "Gagal menghapus player: "+err.Error())
+	if err := h.playerService.Delete(uint(id)); err != nil {
+		abortWithError(c, err, "Gagal menghapus player")
 		return
 	}
 
-	utils.RespondMessage(c, http.StatusOK, "Player deleted successfully")
+	utils.RespondMessage(c, http.StatusOK, "Player berhasil dihapus")
 }
@@ -4,7 +4,7 @@ import (
 	"net/http"
 	"strconv"
 	"xyz-football-api/internal/model"
-	"xyz-football-api/internal/repository"
+	"xyz-football-api/internal/service"
 	"xyz-football-api/pkg/utils"
 
 	"github.com/gin-gonic/gin"
@@ -12,12 +12,12 @@ import (
 
 // TeamHandler menangani endpoint teams
 type TeamHandler struct {
-	teamRepo *repository.TeamRepository
+	teamService *service.TeamService
 }
 
 // NewTeamHandler membuat instance TeamHandler baru
-func NewTeamHandler(teamRepo *repository.TeamRepository) *TeamHandler {
-	return &TeamHandler{teamRepo: teamRepo}
+func NewTeamHandler(teamService *service.TeamService) *TeamHandler {
+	return &TeamHandler{teamService: teamService}
 }
 
 // CreateTeam menangani endpoint POST /teams
@@ -36,12 +36,12 @@ func (h *TeamHandler) CreateTeam(c *gin.Context) {
 	var team model.Team
 
 	if err := c.ShouldBindJSON(&team); err != nil {
-		utils.RespondError(c, http.StatusBadRequest, "Data team tidak valid: "+err.Error())
+		abortWithBindError(c, err, "Data team tidak valid")
 		return
 	}
 
-	if err := h.teamRepo.Create(&team); err != nil {
-		utils.RespondError(c, http.StatusInternalServerError, "Gagal membuat team: "+err.Error())
+	if err := h.teamService.Create(&team); err != nil {
+		abortWithError(c, err, "Gagal membuat team")
 		return
 	}
 
@@ -58,9 +58,9 @@ func (h *TeamHandler) CreateTeam(c *gin.Context) {
 // @Failure 500 {object} utils.ErrorResponse
 // @Router /teams [get]
 func (h *TeamHandler) GetAllTeams(c *gin.Context) {
-	teams, err := h.teamRepo.FindAll()
+	teams, err := h.teamService.List()
 	if err != nil {
-		utils.RespondError(c, http.StatusInternalServerError, "Gagal mengambil data teams: "+err.Error())
+		abortWithError(c, err, "Gagal mengambil data teams")
 		return
 	}
 
@@ -86,9 +86,9 @@ func (h *TeamHandler) GetTeamByID(c *gin.Context) {
 		return
 	}
 
-	team, err := h.teamRepo.FindByID(uint(id))
+	team, err := h.teamService.Get(uint(id))
 	if err != nil {
-		utils.RespondError(c, http.StatusNotFound, "Team tidak ditemukan")
+		abortWithError(c, err, "Gagal mengambil data team")
 		return
 	}
 
@@ -116,41 +116,19 @@ func (h *TeamHandler) UpdateTeam(c *gin.Context) {
 		return
 	}
 
-	// Cek apakah team ada
-	existingTeam, err := h.teamRepo.FindByID(uint(id))
-	if err != nil {
-		utils.RespondError(c, http.StatusNotFound, "Team tidak ditemukan")
-		return
-	}
-
-	// Bind data baru
 	var updateData model.Team
 	if err := c.ShouldBindJSON(&updateData); err != nil {
-		utils.RespondError(c, http.StatusBadRequest, "Data team tidak valid: "+err.Error())
+		abortWithBindError(c, err, "Data team tidak valid")
 		return
 	}
 
-	// Update fields
-	existingTeam.Name = updateData.Name
-	if updateData.LogoURL != nil {
-		existingTeam.LogoURL = updateData.LogoURL
-	}
-	if updateData.FoundedYear != nil {
-		existingTeam.FoundedYear = updateData.FoundedYear
-	}
-	if updateData.HeadquartersAddress != nil {
-		existingTeam.HeadquartersAddress = updateData.HeadquartersAddress
-	}
-	if updateData.HeadquartersCity != nil {
-		existingTeam.HeadquartersCity = updateData.HeadquartersCity
-	}
-
-	if err := h.teamRepo.Update(existingTeam); err != nil {
-		utils.RespondError(c, http.StatusInternalServerError, "Gagal memperbarui team: "+err.Error())
+	team, err := h.teamService.Update(uint(id), updateData)
+	if err != nil {
+		abortWithError(c, err, "Gagal memperbarui team")
 		return
 	}
 
-	utils.RespondSuccess(c, http.StatusOK, existingTeam)
+	utils.RespondSuccess(c, http.StatusOK, team)
 }
 
 // DeleteTeam menangani endpoint DELETE /teams/:id
@@ -162,6 +140,7 @@ func (h *TeamHandler) UpdateTeam(c *gin.Context) {
 // @Param id path int true "Team ID"
 // @Success 200 {object} utils.SuccessResponse
 // @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
 // @Failure 500 {object} utils.ErrorResponse
 // @Router /teams/{id} [delete]
 func (h *TeamHandler) DeleteTeam(c *gin.Context) {
@@ -172,10 +151,10 @@ func (h *TeamHandler) DeleteTeam(c *gin.Context) {
 		return
 	}
 
-	if err := h.teamRepo.Delete(uint(id)); err != nil {
-		utils.RespondError(c, http.StatusInternalServerError, "Gagal menghapus team: "+err.Error())
+	if err := h.teamService.Delete(uint(id)); err != nil {
+		abortWithError(c, err, "Gagal menghapus team")
 		return
 	}
 
-	utils.RespondMessage(c, http.StatusOK, "Team deleted successfully")
+	utils.RespondMessage(c, http.StatusOK, "Team berhasil dihapus")
 }
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/service"
+	"xyz-football-api/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// UserHandler menangani endpoint pengelolaan user
+type UserHandler struct {
+	userService *service.UserService
+}
+
+// NewUserHandler membuat instance UserHandler baru
+func NewUserHandler(userService *service.UserService) *UserHandler {
+	return &UserHandler{userService: userService}
+}
+
+// UserRequest merepresentasikan request body pembuatan user
+type UserRequest struct {
+	Username string `json:"username" binding:"required" example:"operator"`
+	// Password minimal 8 karakter; disimpan sebagai hash bcrypt
+	Password string `json:"password" binding:"required" example:"rahasia123"`
+}
+
+// UserResponse merepresentasikan user tanpa hash password
+type UserResponse struct {
+	ID        uint      `json:"id"`
+	Username  string    `json:"username"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// newUserResponse membuat response dari model user
+func newUserResponse(user *model.User) UserResponse {
+	return UserResponse{ID: user.ID, Username: user.Username, CreatedAt: user.CreatedAt}
+}
+
+// CreateUser menangani endpoint POST /users
+// @Summary Membuat user baru
+// @Description Membuat user yang bisa login lewat POST /login. Username admin dari konfigurasi tidak bisa dipakai.
+// @Description Hanya bisa dipanggil oleh admin dari konfigurasi (ADMIN_USERNAME).
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body UserRequest true "User Data"
+// @Success 201 {object} UserResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /users [post]
+func (h *UserHandler) CreateUser(c *gin.Context) {
+	var req UserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		abortWithBindError(c, err, "Data user tidak valid")
+		return
+	}
+
+	user, err := h.userService.Create(req.Username, req.Password)
+	if err != nil {
+		abortWithError(c, err, "Gagal membuat user")
+		return
+	}
+
+	utils.RespondSuccess(c, http.StatusCreated, newUserResponse(user))
+}
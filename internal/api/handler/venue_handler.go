@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+	"xyz-football-api/internal/service"
+	"xyz-football-api/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// VenueHandler menangani endpoint venues
+type VenueHandler struct {
+	venueRepo repository.VenueRepository
+}
+
+// NewVenueHandler membuat instance VenueHandler baru
+func NewVenueHandler(venueRepo repository.VenueRepository) *VenueHandler {
+	return &VenueHandler{venueRepo: venueRepo}
+}
+
+// CreateVenue menangani endpoint POST /venues
+// @Summary Membuat venue baru
+// @Description Endpoint untuk mendaftarkan stadion/venue pertandingan
+// @Tags Venues
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body model.Venue true "Venue Data"
+// @Success 201 {object} model.Venue
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /venues [post]
+func (h *VenueHandler) CreateVenue(c *gin.Context) {
+	var venue model.Venue
+
+	if err := c.ShouldBindJSON(&venue); err != nil {
+		abortWithBindError(c, err, "Data venue tidak valid")
+		return
+	}
+
+	if err := h.venueRepo.Create(&venue); err != nil {
+		abortWithError(c, err, "Gagal membuat venue")
+		return
+	}
+
+	utils.RespondSuccess(c, http.StatusCreated, venue)
+}
+
+// GetAllVenues menangani endpoint GET /venues
+// @Summary Mengambil semua venues
+// @Description Endpoint untuk mengambil daftar semua venue
+// @Tags Venues
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} model.Venue
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /venues [get]
+func (h *VenueHandler) GetAllVenues(c *gin.Context) {
+	venues, err := h.venueRepo.FindAll()
+	if err != nil {
+		abortWithError(c, err, "Gagal mengambil data venues")
+		return
+	}
+
+	utils.RespondSuccess(c, http.StatusOK, venues)
+}
+
+// GetVenueByID menangani endpoint GET /venues/:id
+// @Summary Mengambil venue berdasarkan ID
+// @Description Endpoint untuk mengambil detail venue berdasarkan ID
+// @Tags Venues
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Venue ID"
+// @Success 200 {object} model.Venue
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /venues/{id} [get]
+func (h *VenueHandler) GetVenueByID(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "ID venue tidak valid")
+		return
+	}
+
+	venue, err := h.venueRepo.FindByID(uint(id))
+	if err != nil {
+		abortWithError(c, lookupError(err, service.ErrVenueNotFound), "Gagal mengambil data venue")
+		return
+	}
+
+	utils.RespondSuccess(c, http.StatusOK, venue)
+}
+
+// UpdateVenue menangani endpoint PUT /venues/:id
+// @Summary Memperbarui data venue
+// @Description Endpoint untuk memperbarui informasi venue
+// @Tags Venues
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Venue ID"
+// @Param body body model.Venue true "Updated Venue Data"
+// @Success 200 {object} model.Venue
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /venues/{id} [put]
+func (h *VenueHandler) UpdateVenue(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "ID venue tidak valid")
+		return
+	}
+
+	// Cek apakah venue ada
+	existingVenue, err := h.venueRepo.FindByID(uint(id))
+	if err != nil {
+		abortWithError(c, lookupError(err, service.ErrVenueNotFound), "Gagal mengambil data venue")
+		return
+	}
+
+	// Bind data baru
+	var updateData model.Venue
+	if err := c.ShouldBindJSON(&updateData); err != nil {
+		abortWithBindError(c, err, "Data venue tidak valid")
+		return
+	}
+
+	// Update fields
+	existingVenue.Name = updateData.Name
+	existingVenue.City = updateData.City
+	existingVenue.Capacity = updateData.Capacity
+	if updateData.Surface != "" {
+		existingVenue.Surface = updateData.Surface
+	}
+	if updateData.Latitude != nil {
+		existingVenue.Latitude = updateData.Latitude
+	}
+	if updateData.Longitude != nil {
+		existingVenue.Longitude = updateData.Longitude
+	}
+
+	if err := h.venueRepo.Update(existingVenue); err != nil {
+		abortWithError(c, err, "Gagal memperbarui venue")
+		return
+	}
+
+	utils.RespondSuccess(c, http.StatusOK, existingVenue)
+}
+
+// DeleteVenue menangani endpoint DELETE /venues/:id
+// @Summary Menghapus venue
+// @Description Endpoint untuk menghapus venue (soft delete)
+// @Tags Venues
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Venue ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /venues/{id} [delete]
+func (h *VenueHandler) DeleteVenue(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "ID venue tidak valid")
+		return
+	}
+
+	if _, err := h.venueRepo.FindByID(uint(id)); err != nil {
+		abortWithError(c, lookupError(err, service.ErrVenueNotFound), "Gagal mengambil data venue")
+		return
+	}
+
+	if err := h.venueRepo.Delete(uint(id)); err != nil {
+		abortWithError(c, err, "Gagal menghapus venue")
+		return
+	}
+
+	utils.RespondMessage(c, http.StatusOK, "Venue berhasil dihapus")
+}
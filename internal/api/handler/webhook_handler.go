@@ -0,0 +1,278 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/service"
+	"xyz-football-api/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Batas jumlah log pengiriman per request
+const (
+	defaultDeliveryLimit = 50
+	maxDeliveryLimit     = 200
+)
+
+// WebhookHandler menangani endpoint subscription dan log pengiriman webhook
+type WebhookHandler struct {
+	webhookService *service.WebhookService
+}
+
+// NewWebhookHandler membuat instance WebhookHandler baru
+func NewWebhookHandler(webhookService *service.WebhookService) *WebhookHandler {
+	return &WebhookHandler{webhookService: webhookService}
+}
+
+// WebhookRequest merepresentasikan request body subscription webhook
+type WebhookRequest struct {
+	URL string `json:"url" binding:"required" example:"https://example.com/hooks/football"`
+	// Secret untuk tanda tangan HMAC (minimal 16 karakter). Wajib saat membuat; kosongkan saat update untuk memakai secret lama.
+	Secret string   `json:"secret" example:"s3cr3t-yang-panjang"`
+	Events []string `json:"events" binding:"required,min=1" example:"match.completed,goal.recorded"`
+	// Active hanya dipakai saat update; subscription baru selalu aktif
+	Active *bool `json:"active,omitempty"`
+}
+
+// toInput memetakan request ke input service
+func (req *WebhookRequest) toInput() service.WebhookInput {
+	return service.WebhookInput{URL: req.URL, Secret: req.Secret, Events: req.Events, Active: req.Active}
+}
+
+// WebhookDeliveryResponse adalah satu entri log pengiriman beserta payload yang dikirim
+type WebhookDeliveryResponse struct {
+	model.WebhookDelivery
+	Payload json.RawMessage `json:"payload" swaggertype:"object"`
+}
+
+// newWebhookDeliveryResponse membuat response log pengiriman
+func newWebhookDeliveryResponse(delivery model.WebhookDelivery) WebhookDeliveryResponse {
+	return WebhookDeliveryResponse{WebhookDelivery: delivery, Payload: json.RawMessage(delivery.Payload)}
+}
+
+// CreateWebhook menangani endpoint POST /webhooks
+// @Summary Membuat subscription webhook
+// @Description Mendaftarkan URL yang menerima POST JSON setiap kali event terjadi.
+// @Description Event: match.completed, goal.recorded, player.created, player.updated, player.deleted, team.deleted, match.scheduled, competition.completed.
+// @Description Setiap pengiriman ditandatangani dengan header X-Webhook-Signature: sha256=HMAC-SHA256(secret, body) dalam hex.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body WebhookRequest true "Webhook Data"
+// @Success 201 {object} model.WebhookSubscription
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /webhooks [post]
+func (h *WebhookHandler) CreateWebhook(c *gin.Context) {
+	var req WebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		abortWithBindError(c, err, "Data webhook tidak valid")
+		return
+	}
+
+	subscription, err := h.webhookService.Create(req.toInput())
+	if err != nil {
+		abortWithError(c, err, "Gagal membuat webhook")
+		return
+	}
+
+	utils.RespondSuccess(c, http.StatusCreated, subscription)
+}
+
+// GetAllWebhooks menangani endpoint GET /webhooks
+// @Summary Mengambil semua subscription webhook
+// @Description Endpoint untuk mengambil daftar subscription webhook (tanpa secret)
+// @Tags Webhooks
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} model.WebhookSubscription
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /webhooks [get]
+func (h *WebhookHandler) GetAllWebhooks(c *gin.Context) {
+	subscriptions, err := h.webhookService.List()
+	if err != nil {
+		abortWithError(c, err, "Gagal mengambil data webhook")
+		return
+	}
+
+	utils.RespondSuccess(c, http.StatusOK, subscriptions)
+}
+
+// GetWebhookByID menangani endpoint GET /webhooks/:id
+// @Summary Mengambil subscription webhook berdasarkan ID
+// @Description Endpoint untuk mengambil detail subscription webhook (tanpa secret)
+// @Tags Webhooks
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Webhook ID"
+// @Success 200 {object} model.WebhookSubscription
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /webhooks/{id} [get]
+func (h *WebhookHandler) GetWebhookByID(c *gin.Context) {
+	id, ok := webhookID(c)
+	if !ok {
+		return
+	}
+
+	subscription, err := h.webhookService.Get(id)
+	if err != nil {
+		abortWithError(c, err, "Gagal mengambil data webhook")
+		return
+	}
+
+	utils.RespondSuccess(c, http.StatusOK, subscription)
+}
+
+// UpdateWebhook menangani endpoint PUT /webhooks/:id
+// @Summary Memperbarui subscription webhook
+// @Description URL dan events selalu diganti; secret dan active hanya jika diisi.
+// @Description Set active ke false untuk menghentikan sementara pengiriman event baru.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Webhook ID"
+// @Param body body WebhookRequest true "Updated Webhook Data"
+// @Success 200 {object} model.WebhookSubscription
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /webhooks/{id} [put]
+func (h *WebhookHandler) UpdateWebhook(c *gin.Context) {
+	id, ok := webhookID(c)
+	if !ok {
+		return
+	}
+
+	var req WebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		abortWithBindError(c, err, "Data webhook tidak valid")
+		return
+	}
+
+	subscription, err := h.webhookService.Update(id, req.toInput())
+	if err != nil {
+		abortWithError(c, err, "Gagal memperbarui webhook")
+		return
+	}
+
+	utils.RespondSuccess(c, http.StatusOK, subscription)
+}
+
+// DeleteWebhook menangani endpoint DELETE /webhooks/:id
+// @Summary Menghapus subscription webhook
+// @Description Subscription beserta log pengirimannya dihapus; pengiriman yang masih antre dibatalkan.
+// @Tags Webhooks
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Webhook ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /webhooks/{id} [delete]
+func (h *WebhookHandler) DeleteWebhook(c *gin.Context) {
+	id, ok := webhookID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.webhookService.Delete(id); err != nil {
+		abortWithError(c, err, "Gagal menghapus webhook")
+		return
+	}
+
+	utils.RespondMessage(c, http.StatusOK, "Webhook berhasil dihapus")
+}
+
+// GetDeliveries menangani endpoint GET /webhooks/:id/deliveries
+// @Summary Log pengiriman webhook
+// @Description Pengiriman terbaru lebih dulu, termasuk status, jumlah percobaan, jadwal retry berikutnya,
+// @Description status HTTP dan error terakhir, serta payload yang dikirim.
+// @Tags Webhooks
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Webhook ID"
+// @Param limit query int false "Jumlah maksimal entri (default 50, maks. 200)"
+// @Success 200 {array} WebhookDeliveryResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /webhooks/{id}/deliveries [get]
+func (h *WebhookHandler) GetDeliveries(c *gin.Context) {
+	id, ok := webhookID(c)
+	if !ok {
+		return
+	}
+
+	limit := defaultDeliveryLimit
+	if value := c.Query("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			utils.RespondError(c, http.StatusBadRequest, "Parameter %s tidak valid", "limit")
+			return
+		}
+		limit = min(parsed, maxDeliveryLimit)
+	}
+
+	deliveries, err := h.webhookService.Deliveries(id, limit)
+	if err != nil {
+		abortWithError(c, err, "Gagal mengambil log pengiriman webhook")
+		return
+	}
+
+	response := make([]WebhookDeliveryResponse, len(deliveries))
+	for i, delivery := range deliveries {
+		response[i] = newWebhookDeliveryResponse(delivery)
+	}
+	utils.RespondSuccess(c, http.StatusOK, response)
+}
+
+// Redeliver menangani endpoint POST /webhooks/:id/deliveries/:delivery_id/redeliver
+// @Summary Mengirim ulang pengiriman webhook
+// @Description Payload pengiriman diantrekan ulang sebagai pengiriman baru dengan event ID yang sama,
+// @Description misalnya setelah sistem penerima diperbaiki. Pengiriman dilakukan worker di background.
+// @Tags Webhooks
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Webhook ID"
+// @Param delivery_id path int true "Delivery ID"
+// @Success 202 {object} WebhookDeliveryResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /webhooks/{id}/deliveries/{delivery_id}/redeliver [post]
+func (h *WebhookHandler) Redeliver(c *gin.Context) {
+	id, ok := webhookID(c)
+	if !ok {
+		return
+	}
+	deliveryID, err := strconv.ParseUint(c.Param("delivery_id"), 10, 32)
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "ID pengiriman webhook tidak valid")
+		return
+	}
+
+	delivery, err := h.webhookService.Redeliver(id, uint(deliveryID))
+	if err != nil {
+		abortWithError(c, err, "Gagal mengirim ulang webhook")
+		return
+	}
+
+	utils.RespondSuccess(c, http.StatusAccepted, newWebhookDeliveryResponse(*delivery))
+}
+
+// webhookID membaca parameter path ID webhook
+func webhookID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "ID webhook tidak valid")
+		return 0, false
+	}
+	return uint(id), true
+}
@@ -0,0 +1,264 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"xyz-football-api/config"
+	"xyz-football-api/internal/event"
+	"xyz-football-api/pkg/i18n"
+	"xyz-football-api/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/websocket"
+)
+
+// Batas pesan dari client per koneksi WebSocket
+const (
+	maxWebSocketTopics       = 200
+	maxWebSocketMessageBytes = 16 << 10
+)
+
+// Aksi yang bisa dikirim client
+const (
+	wsActionSubscribe   = "subscribe"
+	wsActionUnsubscribe = "unsubscribe"
+	wsActionPong        = "pong"
+)
+
+// Tipe pesan yang dikirim server
+const (
+	wsTypeSubscribed = "subscribed"
+	wsTypeEvent      = "event"
+	wsTypePing       = "ping"
+	wsTypeError      = "error"
+)
+
+// WebSocketHandler menangani koneksi WebSocket yang menerima event match, team,
+// dan kompetisi yang di-subscribe secara real time
+type WebSocketHandler struct {
+	bus *event.Bus
+	cfg config.WebSocketConfig
+}
+
+// NewWebSocketHandler membuat instance WebSocketHandler baru
+func NewWebSocketHandler(bus *event.Bus, cfg config.WebSocketConfig) *WebSocketHandler {
+	return &WebSocketHandler{bus: bus, cfg: cfg}
+}
+
+// WebSocketRequest adalah pesan dari client
+type WebSocketRequest struct {
+	// Action: subscribe, unsubscribe, atau pong (balasan heartbeat)
+	Action string `json:"action" example:"subscribe"`
+	// Topics berformat match:<id>, team:<id>, atau competition:<id>
+	Topics []string `json:"topics,omitempty" example:"match:12,team:3"`
+}
+
+// WebSocketMessage adalah pesan dari server
+type WebSocketMessage struct {
+	// Type: subscribed, event, ping, atau error
+	Type string `json:"type" example:"event"`
+	// Topics berisi semua topic yang di-subscribe (subscribed) atau topic yang cocok dengan event (event)
+	Topics  []string     `json:"topics,omitempty"`
+	Event   *event.Event `json:"event,omitempty"`
+	Code    string       `json:"code,omitempty"`
+	Message string       `json:"message,omitempty"`
+}
+
+// Connect menangani endpoint GET /ws
+// @Summary Push event real time lewat WebSocket
+// @Description Satu koneksi bisa subscribe ke banyak match, team, dan kompetisi sekaligus.
+// @Description Kirim {"action":"subscribe","topics":["match:12","team:3","competition:1"]} atau "unsubscribe";
+// @Description server membalas {"type":"subscribed","topics":[...]} lalu mengirim {"type":"event","event":{...}}
+// @Description untuk skor (match.completed), gol (goal.recorded), jadwal kompetisi (match.scheduled),
+// @Description juara kompetisi (competition.completed), dan perubahan roster (player.*, team.deleted).
+// @Description Server mengirim {"type":"ping"} secara berkala; koneksi ditutup jika client diam lebih dari dua kali interval.
+// @Description Client yang terlalu lambat menerima {"type":"error","code":"slow_consumer"} lalu koneksinya ditutup.
+// @Description Saat server dimatikan client menerima {"type":"error","code":"server_shutdown"} dan sebaiknya menyambung ulang.
+// @Description Token JWT dikirim lewat header Authorization atau parameter query token.
+// @Description Handshake dari browser dengan header Origin selain origin API sendiri atau WS_ALLOWED_ORIGINS ditolak dengan 403.
+// @Tags Live
+// @Produce json
+// @Security BearerAuth
+// @Param token query string false "JWT token (alternatif header Authorization untuk browser)"
+// @Success 101 {object} WebSocketMessage
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {string} string "Origin tidak diizinkan"
+// @Router /ws [get]
+func (h *WebSocketHandler) Connect(c *gin.Context) {
+	if !strings.EqualFold(c.GetHeader("Upgrade"), "websocket") {
+		utils.RespondError(c, http.StatusBadRequest, "Endpoint ini memerlukan koneksi WebSocket")
+		return
+	}
+
+	lang := utils.Language(c)
+	server := websocket.Server{
+		Handshake: h.checkOrigin,
+		Handler: func(ws *websocket.Conn) {
+			ws.MaxPayloadBytes = maxWebSocketMessageBytes
+			h.serve(ws, lang)
+		},
+	}
+	server.ServeHTTP(c.Writer, c.Request)
+}
+
+// errOriginNotAllowed dikembalikan Handshake sehingga websocket.Server menjawab 403
+var errOriginNotAllowed = errors.New("origin tidak diizinkan")
+
+// checkOrigin menolak handshake lintas origin (cross-site WebSocket hijacking). Client non-browser
+// tanpa header Origin dan origin yang sama dengan host API selalu diizinkan; origin lain harus
+// tercantum di WS_ALLOWED_ORIGINS.
+func (h *WebSocketHandler) checkOrigin(config *websocket.Config, req *http.Request) error {
+	origin, err := websocket.Origin(config, req)
+	if err != nil {
+		return err
+	}
+	config.Origin = origin
+	if origin == nil || strings.EqualFold(origin.Host, req.Host) {
+		return nil
+	}
+
+	for _, allowed := range h.cfg.AllowedOrigins {
+		if allowed == "*" || strings.EqualFold(strings.TrimSuffix(allowed, "/"), origin.Scheme+"://"+origin.Host) {
+			return nil
+		}
+	}
+	return errOriginNotAllowed
+}
+
+// serve menjalankan satu koneksi: membaca pesan client di goroutine terpisah,
+// sementara goroutine ini satu-satunya yang menulis ke koneksi
+func (h *WebSocketHandler) serve(ws *websocket.Conn, lang string) {
+	defer ws.Close()
+
+	sub := h.bus.Subscribe(h.cfg.BufferSize)
+	defer sub.Close()
+
+	requests := make(chan WebSocketRequest)
+	done := make(chan struct{})
+	defer close(done)
+	go h.read(ws, requests, done)
+
+	heartbeat := time.NewTicker(h.cfg.PingInterval)
+	defer heartbeat.Stop()
+
+	for {
+		var reply *WebSocketMessage
+		select {
+		case req, ok := <-requests:
+			if !ok {
+				return
+			}
+			reply = h.handle(sub, req, lang)
+		case msg, ok := <-sub.Messages():
+			if !ok {
+				if sub.Overflowed() {
+					h.send(ws, wsError("slow_consumer", i18n.Translate(lang, "Koneksi terlalu lambat menerima event dan akan ditutup")))
+				} else {
+					// Bus ditutup karena server dimatikan
+					h.send(ws, wsError("server_shutdown", i18n.Translate(lang, "Server sedang dimatikan, silakan sambung ulang")))
+				}
+				return
+			}
+			reply = &WebSocketMessage{Type: wsTypeEvent, Topics: msg.Topics, Event: &msg.Event}
+		case <-heartbeat.C:
+			reply = &WebSocketMessage{Type: wsTypePing}
+		}
+
+		if reply != nil && h.send(ws, reply) != nil {
+			return
+		}
+	}
+}
+
+// read meneruskan pesan client ke requests sampai koneksi putus atau client diam
+// lebih dari dua kali interval heartbeat. Pesan yang bukan JSON dibalas dengan error.
+func (h *WebSocketHandler) read(ws *websocket.Conn, requests chan<- WebSocketRequest, done <-chan struct{}) {
+	defer close(requests)
+
+	for {
+		ws.SetReadDeadline(time.Now().Add(2 * h.cfg.PingInterval))
+
+		var req WebSocketRequest
+		err := websocket.JSON.Receive(ws, &req)
+		var syntaxErr *json.SyntaxError
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &syntaxErr) || errors.As(err, &typeErr) {
+			req = WebSocketRequest{}
+		} else if err != nil {
+			return
+		}
+
+		select {
+		case requests <- req:
+		case <-done:
+			return
+		}
+	}
+}
+
+// handle menerapkan satu pesan client dan mengembalikan balasannya
+func (h *WebSocketHandler) handle(sub *event.Subscription, req WebSocketRequest, lang string) *WebSocketMessage {
+	switch req.Action {
+	case wsActionPong:
+		return nil
+	case wsActionSubscribe, wsActionUnsubscribe:
+	case "":
+		return wsError("invalid_message", i18n.Translate(lang, "Pesan tidak valid"))
+	default:
+		return wsError("unknown_action", i18n.Translate(lang, "Aksi %s tidak dikenal", req.Action))
+	}
+
+	for _, topic := range req.Topics {
+		if !validTopic(topic) {
+			return wsError("invalid_topic", i18n.Translate(lang, "Topic %s tidak valid", topic))
+		}
+	}
+
+	if req.Action == wsActionUnsubscribe {
+		sub.Remove(req.Topics...)
+	} else {
+		topics := sub.Topics()
+		for _, topic := range req.Topics {
+			if !slices.Contains(topics, topic) {
+				topics = append(topics, topic)
+			}
+		}
+		if len(topics) > maxWebSocketTopics {
+			return wsError("too_many_topics", i18n.Translate(lang, "Maksimal %d topic per koneksi", maxWebSocketTopics))
+		}
+		sub.Add(req.Topics...)
+	}
+	return &WebSocketMessage{Type: wsTypeSubscribed, Topics: sub.Topics()}
+}
+
+// send menulis satu pesan dengan batas waktu agar client yang tidak membaca tidak menahan goroutine
+func (h *WebSocketHandler) send(ws *websocket.Conn, msg *WebSocketMessage) error {
+	ws.SetWriteDeadline(time.Now().Add(h.cfg.WriteTimeout))
+	return websocket.JSON.Send(ws, msg)
+}
+
+// wsError membuat pesan error untuk client
+func wsError(code, message string) *WebSocketMessage {
+	return &WebSocketMessage{Type: wsTypeError, Code: code, Message: message}
+}
+
+// validTopic memeriksa format topic <prefix>:<id> dengan ID positif
+func validTopic(topic string) bool {
+	prefix, id, ok := strings.Cut(topic, ":")
+	if !ok {
+		return false
+	}
+	switch prefix {
+	case event.MatchTopicPrefix, event.TeamTopicPrefix, event.CompetitionTopicPrefix:
+	default:
+		return false
+	}
+	parsed, err := strconv.ParseUint(id, 10, 32)
+	return err == nil && parsed > 0 && strconv.FormatUint(parsed, 10) == id
+}
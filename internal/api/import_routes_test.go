@@ -0,0 +1,172 @@
+package api_test
+
+import (
+	"bytes"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"xyz-football-api/internal/api/handler"
+	"xyz-football-api/internal/model"
+)
+
+// upload mengirim file sebagai multipart form field "file"
+func (s *testServer) upload(path, filename, content string) *httptest.ResponseRecorder {
+	s.t.Helper()
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	part, err := form.CreateFormFile("file", filename)
+	if err != nil {
+		s.t.Fatalf("gagal membuat form file: %v", err)
+	}
+	part.Write([]byte(content))
+	form.Close()
+
+	return s.requestWithHeaders(http.MethodPost, path, body.String(), map[string]string{
+		"Authorization": "Bearer " + s.token,
+		"Content-Type":  form.FormDataContentType(),
+	})
+}
+
+// rowFields memetakan hasil import menjadi row -> field -> message
+func rowFields(response handler.ImportResponse) map[int]map[string]string {
+	rows := make(map[int]map[string]string)
+	for _, rowErr := range response.Errors {
+		rows[rowErr.Row] = make(map[string]string)
+		for _, f := range rowErr.Fields {
+			rows[rowErr.Row][f.Field] = f.Message
+		}
+	}
+	return rows
+}
+
+func TestImportTeams(t *testing.T) {
+	s := newTestServer(t)
+	venue := s.createVenue("Stadion Utama", 50000)
+
+	valid := fmt.Sprintf("name,founded_year,headquarters_city,home_venue_id\n"+
+		"Garuda FC,1990,Jakarta,%d\n"+
+		"Elang FC,,Bandung,\n", venue.ID)
+
+	t.Run("dry run does not save", func(t *testing.T) {
+		w := s.upload("/import/teams?dry_run=true", "teams.csv", valid)
+		expectStatus(t, w, http.StatusOK)
+		if got := decode[handler.ImportResponse](t, w); !got.DryRun || got.TotalRows != 2 || got.Imported != 0 || len(got.Errors) != 0 {
+			t.Errorf("response = %+v", got)
+		}
+		if teams := decode[[]model.Team](t, s.request(http.MethodGet, "/teams", nil)); len(teams) != 0 {
+			t.Errorf("len(teams) = %d after dry run, want 0", len(teams))
+		}
+	})
+
+	t.Run("invalid rows", func(t *testing.T) {
+		w := s.upload("/import/teams?dry_run=true", "teams.csv", "name,founded_year,home_venue_id\n,abc,\nRajawali FC,,999\n")
+		expectStatus(t, w, http.StatusOK)
+		rows := rowFields(decode[handler.ImportResponse](t, w))
+		if rows[2]["name"] != "wajib diisi" || rows[2]["founded_year"] != "harus berupa angka" {
+			t.Errorf("row 2 = %v", rows[2])
+		}
+		if rows[3]["home_venue_id"] != "venue tidak ditemukan" {
+			t.Errorf("row 3 = %v", rows[3])
+		}
+	})
+
+	t.Run("commit", func(t *testing.T) {
+		w := s.upload("/import/teams", "teams.csv", valid)
+		expectStatus(t, w, http.StatusCreated)
+		if got := decode[handler.ImportResponse](t, w); got.Imported != 2 {
+			t.Errorf("imported = %d, want 2", got.Imported)
+		}
+
+		teams := decode[[]model.Team](t, s.request(http.MethodGet, "/teams", nil))
+		if len(teams) != 2 || teams[0].Name != "Garuda FC" || teams[0].HomeVenueID == nil || *teams[0].HomeVenueID != venue.ID {
+			t.Errorf("teams = %+v", teams)
+		}
+	})
+
+	t.Run("file errors", func(t *testing.T) {
+		expectStatus(t, s.upload("/import/teams", "teams.txt", valid), http.StatusBadRequest)
+		expectStatus(t, s.upload("/import/teams", "teams.xlsx", valid), http.StatusBadRequest)
+		expectStatus(t, s.upload("/import/teams?dry_run=maybe", "teams.csv", valid), http.StatusBadRequest)
+		expectStatus(t, s.request(http.MethodPost, "/import/teams", nil), http.StatusBadRequest)
+
+		w := s.upload("/import/teams", "teams.csv", "nama,kota\nGaruda FC,Jakarta\n")
+		expectStatus(t, w, http.StatusBadRequest)
+		if got := decode[handler.ImportResponse](t, w); got.Code != "missing_columns" || got.Error != "Kolom wajib tidak ditemukan: name" {
+			t.Errorf("response = %+v", got)
+		}
+
+		w = s.upload("/import/teams", "teams.csv", "name\n")
+		expectStatus(t, w, http.StatusBadRequest)
+		if got := decode[handler.ImportResponse](t, w); got.Code != "empty_import" {
+			t.Errorf("code = %q, want empty_import", got.Code)
+		}
+	})
+}
+
+func TestImportPlayers(t *testing.T) {
+	s := newTestServer(t)
+	team := s.createTeam("Garuda FC")
+	s.createPlayer(team.ID, "Budi Santoso", 9)
+
+	invalid := fmt.Sprintf("team_id;name;position;jersey_number;height_cm\n"+
+		"%[1]d;Ahmad Dahlan;gelandang;11;175\n"+
+		"%[1]d;Rudi Hartono;kiper;9;\n"+
+		"999;Joko Susilo;bertahan;4;\n"+
+		"%[1]d;Dedi Kurnia;penyerang;11;\n", team.ID)
+
+	t.Run("dry run reports every row", func(t *testing.T) {
+		w := s.upload("/import/players?dry_run=true", "players.csv", invalid)
+		expectStatus(t, w, http.StatusOK)
+		got := decode[handler.ImportResponse](t, w)
+		if got.TotalRows != 4 || len(got.Errors) != 3 {
+			t.Fatalf("response = %+v", got)
+		}
+
+		rows := rowFields(got)
+		want := map[int]map[string]string{
+			3: {"position": "harus salah satu dari: penyerang, gelandang, bertahan, penjaga gawang", "jersey_number": "sudah digunakan di tim ini"},
+			4: {"team_id": "team tidak ditemukan"},
+			5: {"jersey_number": "sudah dipakai di baris 2"},
+		}
+		for row, fields := range want {
+			for field, msg := range fields {
+				if rows[row][field] != msg {
+					t.Errorf("row %d %s = %q, want %q", row, field, rows[row][field], msg)
+				}
+			}
+		}
+	})
+
+	t.Run("commit is all or nothing", func(t *testing.T) {
+		w := s.upload("/import/players", "players.csv", invalid)
+		expectStatus(t, w, http.StatusBadRequest)
+		got := decode[handler.ImportResponse](t, w)
+		if got.Code != "import_rejected" || got.Imported != 0 || len(got.Errors) != 3 {
+			t.Errorf("response = %+v", got)
+		}
+
+		players := decode[[]model.Player](t, s.request(http.MethodGet, fmt.Sprintf("/teams/%d/players", team.ID), nil))
+		if len(players) != 1 {
+			t.Errorf("len(players) = %d after rejected import, want 1", len(players))
+		}
+	})
+
+	t.Run("commit", func(t *testing.T) {
+		valid := fmt.Sprintf("team_id,name,position,jersey_number\n"+
+			"%[1]d,Ahmad Dahlan,gelandang,11\n"+
+			"%[1]d,Rudi Hartono,penjaga gawang,1\n", team.ID)
+		w := s.upload("/import/players", "players.csv", valid)
+		expectStatus(t, w, http.StatusCreated)
+		if got := decode[handler.ImportResponse](t, w); got.Imported != 2 {
+			t.Errorf("imported = %d, want 2", got.Imported)
+		}
+
+		players := decode[[]model.Player](t, s.request(http.MethodGet, fmt.Sprintf("/teams/%d/players", team.ID), nil))
+		if len(players) != 3 {
+			t.Errorf("len(players) = %d, want 3", len(players))
+		}
+	})
+}
@@ -0,0 +1,193 @@
+package api_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"xyz-football-api/internal/api/handler"
+	"xyz-football-api/internal/model"
+)
+
+func TestMatchRoutes(t *testing.T) {
+	s := newTestServer(t)
+	venue := s.createVenue("Stadion Utama", 100)
+	home := s.createTeam("Garuda FC")
+	away := s.createTeam("Elang FC")
+	striker := s.createPlayer(home.ID, "Budi Santoso", 9)
+	winger := s.createPlayer(home.ID, "Ahmad Dahlan", 11)
+	visitor := s.createPlayer(away.ID, "Rudi Hartono", 10)
+	outsider := s.createPlayer(s.createTeam("Rajawali FC").ID, "Joko Susilo", 7)
+
+	w := s.request(http.MethodPost, "/matches", map[string]any{
+		"home_team_id":   home.ID,
+		"away_team_id":   away.ID,
+		"match_datetime": "2025-03-01T19:00:00+07:00",
+		"venue_id":       venue.ID,
+	})
+	expectStatus(t, w, http.StatusCreated)
+	match := decode[model.Match](t, w)
+	if match.Status != model.MatchStatusScheduled {
+		t.Fatalf("status = %q, want scheduled", match.Status)
+	}
+
+	resultPath := fmt.Sprintf("/matches/%d/result", match.ID)
+	reportPath := fmt.Sprintf("/matches/%d/report", match.ID)
+
+	t.Run("report before result", func(t *testing.T) {
+		w := s.request(http.MethodGet, reportPath, nil)
+		expectStatus(t, w, http.StatusOK)
+		got := decode[handler.MatchReportResponse](t, w)
+		if got.MatchResult != model.MatchResultNotFinished || got.MatchResultLabel != "Belum Selesai" {
+			t.Errorf("match_result = %q (%q)", got.MatchResult, got.MatchResultLabel)
+		}
+	})
+
+	t.Run("result validation", func(t *testing.T) {
+		tests := []struct {
+			name string
+			body map[string]any
+			want string
+		}{
+			{
+				name: "goal count mismatch",
+				body: map[string]any{"home_score": 2, "away_score": 0, "goals": []any{}},
+				want: "Jumlah gol tidak sesuai. Total skor: 2, jumlah detail gol: 0",
+			},
+			{
+				name: "player from other team",
+				body: map[string]any{"home_score": 1, "away_score": 0, "goals": []any{
+					map[string]any{"player_id": outsider.ID, "goal_time": 10},
+				}},
+				want: "Player Joko Susilo tidak termasuk dalam tim yang bertanding",
+			},
+			{
+				name: "attendance over capacity",
+				body: map[string]any{"home_score": 0, "away_score": 0, "attendance": 101, "goals": []any{}},
+				want: "Jumlah penonton (101) melebihi kapasitas venue Stadion Utama (100)",
+			},
+			{
+				name: "extra time outside cup",
+				body: map[string]any{"home_score": 0, "away_score": 0, "extra_time": true, "goals": []any{}},
+				want: "Extra time dan adu penalti hanya berlaku untuk match kompetisi cup",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				w := s.request(http.MethodPost, resultPath, tt.body)
+				expectStatus(t, w, http.StatusBadRequest)
+				if got := errorMessage(t, w); got != tt.want {
+					t.Errorf("error = %q, want %q", got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("report result", func(t *testing.T) {
+		w := s.request(http.MethodPost, resultPath, map[string]any{
+			"home_score": 2,
+			"away_score": 1,
+			"attendance": 80,
+			"goals": []any{
+				map[string]any{"player_id": striker.ID, "goal_time": 12},
+				map[string]any{"player_id": visitor.ID, "goal_time": 40},
+				map[string]any{"player_id": winger.ID, "goal_time": 88},
+			},
+		})
+		expectStatus(t, w, http.StatusOK)
+
+		w = s.request(http.MethodPost, resultPath, map[string]any{"home_score": 0, "away_score": 0, "goals": []any{}})
+		expectStatus(t, w, http.StatusConflict)
+		if got := errorMessage(t, w); got != "Match sudah dilaporkan sebelumnya" {
+			t.Errorf("error = %q", got)
+		}
+	})
+
+	t.Run("report after result", func(t *testing.T) {
+		w := s.request(http.MethodGet, reportPath, nil)
+		expectStatus(t, w, http.StatusOK)
+		got := decode[handler.MatchReportResponse](t, w)
+
+		if got.FinalScore != "2-1" {
+			t.Errorf("final_score = %q", got.FinalScore)
+		}
+		if got.MatchResult != model.MatchResultHomeWin || got.MatchResultLabel != "Tim Home Menang" {
+			t.Errorf("match_result = %q (%q)", got.MatchResult, got.MatchResultLabel)
+		}
+		if got.TopScorerInMatch != "Budi Santoso (1 gol)" {
+			t.Errorf("top_scorer_in_match = %q", got.TopScorerInMatch)
+		}
+		if got.HomeTeamTotalWins != 1 || got.AwayTeamTotalWins != 0 {
+			t.Errorf("total wins = %d/%d, want 1/0", got.HomeTeamTotalWins, got.AwayTeamTotalWins)
+		}
+		if got.Venue != "Stadion Utama, Jakarta" || got.Attendance == nil || *got.Attendance != 80 {
+			t.Errorf("venue = %q, attendance = %v", got.Venue, got.Attendance)
+		}
+	})
+
+	t.Run("report in english", func(t *testing.T) {
+		w := s.requestLang(http.MethodGet, reportPath, nil, "en-US,en;q=0.9,id;q=0.5")
+		expectStatus(t, w, http.StatusOK)
+		if got := w.Header().Get("Content-Language"); got != "en" {
+			t.Errorf("Content-Language = %q, want en", got)
+		}
+
+		got := decode[handler.MatchReportResponse](t, w)
+		if got.MatchResult != model.MatchResultHomeWin || got.MatchResultLabel != "Home Team Won" {
+			t.Errorf("match_result = %q (%q)", got.MatchResult, got.MatchResultLabel)
+		}
+		if got.TopScorerInMatch != "Budi Santoso (goals: 1)" {
+			t.Errorf("top_scorer_in_match = %q", got.TopScorerInMatch)
+		}
+	})
+
+	t.Run("result for cancelled match", func(t *testing.T) {
+		cancelled := s.createMatch(home.ID, away.ID, "2025-03-08T19:00:00+07:00")
+		w := s.request(http.MethodPatch, fmt.Sprintf("/matches/%d", cancelled.ID), map[string]any{"status": "cancelled"})
+		expectStatus(t, w, http.StatusOK)
+
+		w = s.request(http.MethodPost, fmt.Sprintf("/matches/%d/result", cancelled.ID), map[string]any{
+			"home_score": 1,
+			"away_score": 0,
+			"goals":      []any{map[string]any{"player_id": striker.ID, "goal_time": 30}},
+		})
+		expectStatus(t, w, http.StatusConflict)
+		if got := errorMessage(t, w); got != "Match sudah dibatalkan sehingga hasilnya tidak bisa dilaporkan" {
+			t.Errorf("error = %q", got)
+		}
+
+		w = s.request(http.MethodGet, fmt.Sprintf("/matches/%d/report", cancelled.ID), nil)
+		expectStatus(t, w, http.StatusOK)
+		if got := decode[handler.MatchReportResponse](t, w); got.MatchResult != model.MatchResultNotFinished || got.FinalScore != "0-0" {
+			t.Errorf("match_result = %q, final_score = %q", got.MatchResult, got.FinalScore)
+		}
+	})
+}
+
+func TestMatchRoutesValidation(t *testing.T) {
+	s := newTestServer(t)
+	team := s.createTeam("Garuda FC")
+	other := s.createTeam("Elang FC")
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   any
+		want   int
+	}{
+		{"same teams", http.MethodPost, "/matches", map[string]any{"home_team_id": team.ID, "away_team_id": team.ID, "match_datetime": "2025-03-01T19:00:00Z"}, http.StatusBadRequest},
+		{"unknown team", http.MethodPost, "/matches", map[string]any{"home_team_id": team.ID, "away_team_id": 999, "match_datetime": "2025-03-01T19:00:00Z"}, http.StatusBadRequest},
+		{"invalid datetime", http.MethodPost, "/matches", map[string]any{"home_team_id": team.ID, "away_team_id": other.ID, "match_datetime": "besok"}, http.StatusBadRequest},
+		{"unknown venue", http.MethodPost, "/matches", map[string]any{"home_team_id": team.ID, "away_team_id": other.ID, "match_datetime": "2025-03-01T19:00:00Z", "venue_id": 999}, http.StatusBadRequest},
+		{"result unknown match", http.MethodPost, "/matches/999/result", map[string]any{"home_score": 0, "away_score": 0, "goals": []any{}}, http.StatusNotFound},
+		{"report invalid id", http.MethodGet, "/matches/abc/report", nil, http.StatusBadRequest},
+		{"report unknown match", http.MethodGet, "/matches/999/report", nil, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expectStatus(t, s.request(tt.method, tt.path, tt.body), tt.want)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package api_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"xyz-football-api/pkg/i18n"
+)
+
+// messageArgs adalah fungsi yang menerima message ID beserta posisi argumennya
+var messageArgs = map[string]int{
+	"utils.RespondError":      2,
+	"utils.RespondMessage":    2,
+	"utils.Translate":         1,
+	"i18n.Translate":          1,
+	"apperror.NotFound":       1,
+	"apperror.Conflict":       1,
+	"apperror.Conflictf":      1,
+	"apperror.Validation":     1,
+	"apperror.Validationf":    1,
+	"apperror.Forbidden":      1,
+	"apperror.Internal":       0,
+	"abortWithError":          2,
+	"abortWithBindError":      2,
+	"bindError":               1,
+	"ValidateRequest":         1,
+	"handler.ValidateRequest": 1,
+	"field":                   1,
+	"parseID":                 1,
+	"optionalIDArg":           1,
+	"decodeInput":             2,
+	"fail":                    2,
+	"load":                    3,
+	"byID":                    2,
+	"h.mutation":              0,
+}
+
+// collectMessageIDs mengumpulkan message ID literal dari source code di roots: argumen fungsi
+// di messageArgs, field Message pada FieldError, dan nilai map package-level *Labels/*Messages
+func collectMessageIDs(t *testing.T, roots ...string) map[string]string {
+	t.Helper()
+
+	ids := make(map[string]string)
+	fset := token.NewFileSet()
+	add := func(expr ast.Expr) {
+		lit, ok := expr.(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			return
+		}
+		value, err := strconv.Unquote(lit.Value)
+		if err == nil && value != "" {
+			ids[value] = fset.Position(lit.Pos()).String()
+		}
+	}
+
+	for _, root := range roots {
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+				return err
+			}
+			file, err := parser.ParseFile(fset, path, nil, 0)
+			if err != nil {
+				return err
+			}
+
+			ast.Inspect(file, func(n ast.Node) bool {
+				switch n := n.(type) {
+				case *ast.CallExpr:
+					if index, ok := messageArgs[callName(n.Fun)]; ok && index < len(n.Args) {
+						add(n.Args[index])
+					}
+				case *ast.CompositeLit:
+					if name := callName(n.Type); name == "apperror.FieldError" || name == "FieldError" {
+						for _, elt := range n.Elts {
+							if kv, ok := elt.(*ast.KeyValueExpr); ok && callName(kv.Key) == "Message" {
+								add(kv.Value)
+							}
+						}
+					}
+				case *ast.ValueSpec:
+					for i, name := range n.Names {
+						if i >= len(n.Values) || !(strings.HasSuffix(name.Name, "Labels") || strings.HasSuffix(name.Name, "Messages")) {
+							continue
+						}
+						if lit, ok := n.Values[i].(*ast.CompositeLit); ok {
+							for _, elt := range lit.Elts {
+								if kv, ok := elt.(*ast.KeyValueExpr); ok {
+									add(kv.Value)
+								}
+							}
+						}
+					}
+				}
+				return true
+			})
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("gagal membaca source: %v", err)
+		}
+	}
+	return ids
+}
+
+// callName mengembalikan nama fungsi/tipe seperti "utils.RespondError" atau "field"
+func callName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.SelectorExpr:
+		if x, ok := e.X.(*ast.Ident); ok {
+			return x.Name + "." + e.Sel.Name
+		}
+	}
+	return ""
+}
+
+// TestMessagesTranslated memastikan setiap pesan yang dikirim ke client memiliki terjemahan
+// di semua bahasa yang didukung
+func TestMessagesTranslated(t *testing.T) {
+	ids := collectMessageIDs(t, "..", "../../pkg")
+	if len(ids) == 0 {
+		t.Fatal("tidak ada message ID yang ditemukan")
+	}
+
+	for _, lang := range i18n.Languages() {
+		var missing []string
+		for id, pos := range ids {
+			if !i18n.Has(lang, id) {
+				missing = append(missing, pos+": "+strconv.Quote(id))
+			}
+		}
+		if len(missing) > 0 {
+			sort.Strings(missing)
+			t.Errorf("pesan tanpa terjemahan %s:\n  %s", lang, strings.Join(missing, "\n  "))
+		}
+	}
+}
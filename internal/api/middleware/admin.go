@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"net/http"
+	"xyz-football-api/config"
+	"xyz-football-api/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminOnly membatasi route untuk admin dari konfigurasi (ADMIN_USERNAME).
+// Harus dipasang setelah AuthMiddleware yang menyimpan username dari JWT ke context.
+// User dari tabel users tidak pernah memakai username admin (lihat UserService.Create).
+func AdminOnly(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString("username") != cfg.Admin.Username {
+			utils.RespondError(c, http.StatusForbidden, "Hanya admin yang dapat mengakses endpoint ini")
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
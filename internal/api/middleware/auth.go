@@ -20,27 +20,57 @@ func AuthMiddleware(cfg *config.Config) gin.HandlerFunc {
 			return
 		}
 
-		// Format: "Bearer <token>"
-		parts := strings.SplitN(authHeader, " ", 2)
-		if len(parts) != 2 || parts[0] != "Bearer" {
-			utils.RespondError(c, http.StatusUnauthorized, "Format token tidak valid")
-			c.Abort()
+		tokenString, ok := bearerToken(c, authHeader)
+		if !ok {
 			return
 		}
+		authenticate(c, cfg, tokenString)
+	}
+}
 
-		tokenString := parts[1]
+// WebSocketAuth memvalidasi JWT token dari header Authorization atau parameter query token.
+// Browser tidak bisa mengirim header Authorization saat membuka WebSocket.
+func WebSocketAuth(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		tokenString := c.Query("token")
+		if authHeader := c.GetHeader("Authorization"); authHeader != "" {
+			var ok bool
+			if tokenString, ok = bearerToken(c, authHeader); !ok {
+				return
+			}
+		}
 
-		// Validasi token
-		claims, err := utils.ValidateJWT(tokenString, cfg.JWT.Secret)
-		if err != nil {
-			utils.RespondError(c, http.StatusUnauthorized, "Token tidak valid atau sudah expired")
+		if tokenString == "" {
+			utils.RespondError(c, http.StatusUnauthorized, "Token tidak ditemukan")
 			c.Abort()
 			return
 		}
+		authenticate(c, cfg, tokenString)
+	}
+}
 
-		// Simpan user info ke context untuk digunakan handler
-		c.Set("username", claims.Username)
+// bearerToken mengambil token dari header berformat "Bearer <token>"
+func bearerToken(c *gin.Context, authHeader string) (string, bool) {
+	parts := strings.SplitN(authHeader, " ", 2)
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		utils.RespondError(c, http.StatusUnauthorized, "Format token tidak valid")
+		c.Abort()
+		return "", false
+	}
+	return parts[1], true
+}
 
-		c.Next()
+// authenticate memvalidasi token lalu melanjutkan request
+func authenticate(c *gin.Context, cfg *config.Config, tokenString string) {
+	claims, err := utils.ValidateJWT(tokenString, cfg.JWT.Secret)
+	if err != nil {
+		utils.RespondError(c, http.StatusUnauthorized, "Token tidak valid atau sudah expired")
+		c.Abort()
+		return
 	}
+
+	// Simpan user info ke context untuk digunakan handler
+	c.Set("username", claims.Username)
+
+	c.Next()
 }
@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"xyz-football-api/internal/service"
+	"xyz-football-api/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CalendarAuth memvalidasi token kalender dari parameter query token.
+// Dipakai untuk feed .ics karena aplikasi kalender tidak bisa mengirim header Authorization.
+func CalendarAuth(calendarService *service.CalendarService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		username, err := calendarService.Authenticate(c.Query("token"))
+		if errors.Is(err, service.ErrInvalidCalendarToken) {
+			utils.RespondError(c, http.StatusUnauthorized, "Token kalender tidak valid atau sudah dicabut")
+			c.Abort()
+			return
+		}
+		if err != nil {
+			c.Error(err)
+			c.Abort()
+			return
+		}
+
+		// Simpan user info ke context untuk digunakan handler
+		c.Set("username", username)
+
+		c.Next()
+	}
+}
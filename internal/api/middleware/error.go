@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"xyz-football-api/internal/apperror"
+	"xyz-football-api/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// errorStatus memetakan kind error domain ke status HTTP
+var errorStatus = map[apperror.Kind]int{
+	apperror.KindValidation: http.StatusBadRequest,
+	apperror.KindNotFound:   http.StatusNotFound,
+	apperror.KindConflict:   http.StatusConflict,
+	apperror.KindForbidden:  http.StatusForbidden,
+	apperror.KindInternal:   http.StatusInternalServerError,
+}
+
+// ErrorHandler middleware untuk merender error yang dicatat handler lewat c.Error.
+// Error domain dirender dengan status, code, dan field-nya dalam bahasa dari Accept-Language;
+// error lain dianggap error internal
+// sehingga pesan aslinya (misalnya dari database) hanya muncul di log, tidak di response.
+func ErrorHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+
+		var appErr *apperror.Error
+		if !errors.As(c.Errors.Last().Err, &appErr) {
+			appErr = apperror.Internal("Terjadi kesalahan pada server", c.Errors.Last().Err)
+		}
+
+		body := utils.ErrorResponse{Error: utils.Translate(c, appErr.Message, appErr.Args...), Code: appErr.Code}
+		for _, field := range appErr.Fields {
+			body.Fields = append(body.Fields, utils.FieldError{
+				Field:   field.Field,
+				Message: utils.Translate(c, field.Message, field.Args...),
+			})
+		}
+
+		status, ok := errorStatus[appErr.Kind]
+		if !ok {
+			status = http.StatusInternalServerError
+		}
+		utils.RespondErrorDetail(c, status, body)
+	}
+}
@@ -2,6 +2,8 @@ package middleware
 
 import (
 	"log"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -26,7 +28,7 @@ func Logger() gin.HandlerFunc {
 		errorMessage := c.Errors.ByType(gin.ErrorTypePrivate).String()
 
 		if raw != "" {
-			path = path + "?" + raw
+			path = path + "?" + redactQuery(raw)
 		}
 
 		log.Printf("[GIN] %s | %3d | %13v | %15s | %-7s %s %s",
@@ -40,3 +42,16 @@ func Logger() gin.HandlerFunc {
 		)
 	}
 }
+
+// redactQuery menyamarkan nilai parameter token (JWT WebSocket dan token kalender)
+// agar tidak tercatat di log. Urutan dan parameter lain dibiarkan apa adanya.
+func redactQuery(raw string) string {
+	parts := strings.Split(raw, "&")
+	for i, part := range parts {
+		key, _, _ := strings.Cut(part, "=")
+		if name, err := url.QueryUnescape(key); err == nil && name == "token" {
+			parts[i] = key + "=REDACTED"
+		}
+	}
+	return strings.Join(parts, "&")
+}
@@ -0,0 +1,146 @@
+package api_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"xyz-football-api/internal/model"
+)
+
+func TestOfficialRoutes(t *testing.T) {
+	s := newTestServer(t)
+	team := s.createTeam("Garuda FC")
+
+	w := s.request(http.MethodPost, "/officials", map[string]any{
+		"name":                "Thoriq Alkatiri",
+		"license_level":       "FIFA",
+		"conflicted_team_ids": []uint{team.ID},
+	})
+	expectStatus(t, w, http.StatusCreated)
+	official := decode[model.Official](t, w)
+
+	t.Run("list", func(t *testing.T) {
+		w := s.request(http.MethodGet, "/officials", nil)
+		expectStatus(t, w, http.StatusOK)
+		if got := decode[[]model.Official](t, w); len(got) != 1 {
+			t.Errorf("len(officials) = %d, want 1", len(got))
+		}
+	})
+
+	t.Run("get", func(t *testing.T) {
+		w := s.request(http.MethodGet, fmt.Sprintf("/officials/%d", official.ID), nil)
+		expectStatus(t, w, http.StatusOK)
+		got := decode[model.Official](t, w)
+		if len(got.ConflictedTeams) != 1 || got.ConflictedTeams[0].ID != team.ID {
+			t.Errorf("conflicted_teams = %+v", got.ConflictedTeams)
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		w := s.request(http.MethodPut, fmt.Sprintf("/officials/%d", official.ID), map[string]any{
+			"name":                "Thoriq Alkatiri",
+			"city":                "Jakarta",
+			"conflicted_team_ids": []uint{},
+		})
+		expectStatus(t, w, http.StatusOK)
+		got := decode[model.Official](t, w)
+		if got.City == nil || *got.City != "Jakarta" || len(got.ConflictedTeams) != 0 {
+			t.Errorf("official = %+v", got)
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		expectStatus(t, s.request(http.MethodPost, "/officials", map[string]any{"name": "X", "conflicted_team_ids": []uint{999}}), http.StatusBadRequest)
+		expectStatus(t, s.request(http.MethodGet, "/officials/999", nil), http.StatusNotFound)
+		expectStatus(t, s.request(http.MethodPut, "/officials/999", map[string]any{"name": "X"}), http.StatusNotFound)
+		expectStatus(t, s.request(http.MethodDelete, "/officials/abc", nil), http.StatusBadRequest)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		expectStatus(t, s.request(http.MethodDelete, fmt.Sprintf("/officials/%d", official.ID), nil), http.StatusOK)
+		expectStatus(t, s.request(http.MethodGet, fmt.Sprintf("/officials/%d", official.ID), nil), http.StatusNotFound)
+	})
+}
+
+func TestMatchOfficialRoutes(t *testing.T) {
+	s := newTestServer(t)
+	home := s.createTeam("Garuda FC")
+	away := s.createTeam("Elang FC")
+	third := s.createTeam("Rajawali FC")
+	fourth := s.createTeam("Merpati FC")
+
+	match := s.createMatch(home.ID, away.ID, "2025-03-01T19:00:00Z")
+	sameEvening := s.createMatch(third.ID, fourth.ID, "2025-03-01T20:00:00Z")
+	nextDay := s.createMatch(third.ID, fourth.ID, "2025-03-02T19:00:00Z")
+
+	createOfficial := func(name string, conflicted ...uint) model.Official {
+		t.Helper()
+		w := s.request(http.MethodPost, "/officials", map[string]any{"name": name, "conflicted_team_ids": conflicted})
+		expectStatus(t, w, http.StatusCreated)
+		return decode[model.Official](t, w)
+	}
+	referee := createOfficial("Thoriq Alkatiri")
+	other := createOfficial("Yudi Nurcahya")
+	fan := createOfficial("Oki Dwi Putra", home.ID)
+
+	assign := func(matchID, officialID uint, role string) *httptest.ResponseRecorder {
+		return s.request(http.MethodPost, fmt.Sprintf("/matches/%d/officials", matchID), map[string]any{
+			"official_id": officialID,
+			"role":        role,
+		})
+	}
+
+	w := assign(match.ID, referee.ID, "referee")
+	expectStatus(t, w, http.StatusCreated)
+	assignment := decode[model.MatchOfficial](t, w)
+
+	t.Run("conflicts", func(t *testing.T) {
+		tests := []struct {
+			name       string
+			matchID    uint
+			officialID uint
+			role       string
+			want       string
+		}{
+			{"same official twice", match.ID, referee.ID, "var", "Thoriq Alkatiri sudah ditugaskan sebagai referee di match ini"},
+			{"role already filled", match.ID, other.ID, "referee", "Peran referee sudah terisi penuh untuk match ini"},
+			{"conflict of interest", match.ID, fan.ID, "fourth_official", "Oki Dwi Putra memiliki konflik kepentingan dengan salah satu tim yang bertanding"},
+			{"overlapping schedule", sameEvening.ID, referee.ID, "referee", "Thoriq Alkatiri sudah bertugas di match lain pada waktu yang berdekatan"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				w := assign(tt.matchID, tt.officialID, tt.role)
+				expectStatus(t, w, http.StatusConflict)
+				if got := errorMessage(t, w); got != tt.want {
+					t.Errorf("error = %q, want %q", got, tt.want)
+				}
+			})
+		}
+
+		expectStatus(t, assign(nextDay.ID, referee.ID, "referee"), http.StatusCreated)
+		expectStatus(t, assign(match.ID, 999, "referee"), http.StatusBadRequest)
+		expectStatus(t, assign(match.ID, other.ID, "linesman"), http.StatusBadRequest)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		w := s.request(http.MethodGet, fmt.Sprintf("/matches/%d/officials", match.ID), nil)
+		expectStatus(t, w, http.StatusOK)
+		got := decode[[]model.MatchOfficial](t, w)
+		if len(got) != 1 || got[0].Official.Name != "Thoriq Alkatiri" {
+			t.Errorf("assignments = %+v", got)
+		}
+
+		expectStatus(t, s.request(http.MethodGet, "/matches/999/officials", nil), http.StatusNotFound)
+	})
+
+	t.Run("unassign", func(t *testing.T) {
+		path := fmt.Sprintf("/matches/%d/officials/%d", match.ID, assignment.ID)
+		expectStatus(t, s.request(http.MethodDelete, path, nil), http.StatusOK)
+		expectStatus(t, s.request(http.MethodDelete, path, nil), http.StatusNotFound)
+
+		// Setelah dilepas, peran referee bisa diisi official lain
+		expectStatus(t, assign(match.ID, other.ID, "referee"), http.StatusCreated)
+	})
+}
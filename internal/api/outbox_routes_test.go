@@ -0,0 +1,71 @@
+package api_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+	"xyz-football-api/internal/api/handler"
+	"xyz-football-api/internal/model"
+)
+
+func TestOutboxRequeue(t *testing.T) {
+	s := newTestServer(t)
+
+	var rows []model.OutboxEvent
+	for _, id := range []string{"event-parkir-1", "event-parkir-2"} {
+		rows = append(rows, model.OutboxEvent{EventID: id, EventType: "team.deleted", Payload: `{"id":7}`, OccurredAt: time.Now().UTC()})
+	}
+	if err := s.repos.Outbox.Append(rows); err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range rows {
+		if err := s.repos.Outbox.Park(row.ID, "payload tidak bisa diproses", time.Now().UTC()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	w := s.request(http.MethodGet, "/outbox/parked", nil)
+	expectStatus(t, w, http.StatusOK)
+	parked := decode[[]map[string]any](t, w)
+	if len(parked) != 2 || parked[0]["event_id"] != "event-parkir-1" || parked[0]["last_error"] != "payload tidak bisa diproses" || parked[0]["parked_at"] == nil {
+		t.Fatalf("parked = %v", parked)
+	}
+	if payload, ok := parked[0]["payload"].(map[string]any); !ok || payload["id"] != float64(7) {
+		t.Errorf("payload = %v", parked[0]["payload"])
+	}
+	expectStatus(t, s.request(http.MethodGet, "/outbox/parked?limit=0", nil), http.StatusBadRequest)
+
+	// Requeue satu event lewat ids, lalu sisanya tanpa body
+	w = s.request(http.MethodPost, "/outbox/requeue", handler.RequeueOutboxRequest{IDs: []uint{rows[0].ID}})
+	expectStatus(t, w, http.StatusOK)
+	if got := decode[handler.RequeueOutboxResponse](t, w).Requeued; got != 1 {
+		t.Errorf("requeued = %d, want 1", got)
+	}
+	w = s.request(http.MethodPost, "/outbox/requeue", nil)
+	expectStatus(t, w, http.StatusOK)
+	if got := decode[handler.RequeueOutboxResponse](t, w).Requeued; got != 1 {
+		t.Errorf("requeued = %d, want 1", got)
+	}
+
+	s.dispatch()
+	pending, err := s.repos.Outbox.FindPending(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 0 {
+		t.Errorf("event belum terkirim setelah requeue: %+v", pending)
+	}
+	w = s.request(http.MethodGet, "/outbox/parked", nil)
+	expectStatus(t, w, http.StatusOK)
+	if parked := decode[[]map[string]any](t, w); len(parked) != 0 {
+		t.Errorf("parked = %v", parked)
+	}
+
+	// Hanya admin dari konfigurasi yang boleh melihat dan me-requeue event
+	expectStatus(t, s.request(http.MethodPost, "/users", map[string]string{"username": "operator", "password": "rahasia123"}), http.StatusCreated)
+	w = s.requestWithToken(http.MethodPost, "/login", map[string]string{"username": "operator", "password": "rahasia123"}, "")
+	expectStatus(t, w, http.StatusOK)
+	token := decode[handler.LoginResponse](t, w).Token
+	expectStatus(t, s.requestWithToken(http.MethodGet, "/outbox/parked", nil, token), http.StatusForbidden)
+	expectStatus(t, s.requestWithToken(http.MethodPost, "/outbox/requeue", nil, token), http.StatusForbidden)
+}
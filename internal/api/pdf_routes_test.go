@@ -0,0 +1,121 @@
+package api_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// expectPDF memastikan response adalah dokumen PDF lengkap
+func expectPDF(t *testing.T, w *httptest.ResponseRecorder) {
+	t.Helper()
+	body := w.Body.String()
+	if got := w.Header().Get("Content-Type"); got != "application/pdf" {
+		t.Errorf("Content-Type = %q", got)
+	}
+	if !strings.HasPrefix(body, "%PDF-") || !strings.HasSuffix(body, "%%EOF\n") {
+		t.Errorf("body bukan dokumen PDF lengkap:\n%.200s", body)
+	}
+}
+
+// pdfTexts mengembalikan semua string yang digambar di dokumen dengan operator Tj, sesuai urutan
+func pdfTexts(body string) []string {
+	var texts []string
+	for _, line := range strings.Split(body, "\n") {
+		start, end := strings.Index(line, "("), strings.LastIndex(line, ") Tj")
+		if strings.HasPrefix(line, "BT ") && start >= 0 && end > start {
+			texts = append(texts, line[start+1:end])
+		}
+	}
+	return texts
+}
+
+func TestMatchPDFRoutes(t *testing.T) {
+	s := newTestServer(t)
+	venue := s.createVenue("Stadion Utama", 1000)
+	home := s.createTeam("Garuda FC")
+	away := s.createTeam("Elang FC")
+	striker := s.createPlayer(home.ID, "Budi Santoso", 9)
+	injured := s.createPlayer(home.ID, "Ahmad Dahlan", 11)
+	s.createPlayer(home.ID, "Cahyo Nugroho", 1)
+	visitor := s.createPlayer(away.ID, "Rudi Hartono", 10)
+
+	match := s.createMatch(home.ID, away.ID, "2025-03-01T12:00:00Z")
+	expectStatus(t, s.request(http.MethodPatch, fmt.Sprintf("/matches/%d", match.ID), map[string]any{"venue_id": venue.ID}), http.StatusOK)
+	expectStatus(t, s.request(http.MethodPost, fmt.Sprintf("/players/%d/availability", injured.ID), map[string]any{
+		"status":     "injured",
+		"start_date": "2025-02-20",
+	}), http.StatusCreated)
+
+	t.Run("team sheet", func(t *testing.T) {
+		w := s.request(http.MethodGet, fmt.Sprintf("/matches/%d/teamsheet.pdf", match.ID), nil)
+		expectStatus(t, w, http.StatusOK)
+		body := w.Body.String()
+		expectPDF(t, w)
+		if got := w.Header().Get("Content-Disposition"); got != fmt.Sprintf(`inline; filename="match-%d-teamsheet.pdf"`, match.ID) {
+			t.Errorf("Content-Disposition = %q", got)
+		}
+
+		texts := strings.Join(pdfTexts(body), "|")
+		// Skuad terurut nomor punggung; player cedera tetap dicantumkan sebagai tidak tersedia
+		for _, want := range []string{
+			"Team Sheet|Garuda FC vs Elang FC",
+			"Stadion Utama, Jakarta",
+			"1|Cahyo Nugroho|Penyerang|Tersedia|9|Budi Santoso|Penyerang|Tersedia|11|Ahmad Dahlan|Penyerang|Tidak tersedia",
+			"10|Rudi Hartono|Penyerang|Tersedia",
+			"Manajer Garuda FC|Manajer Elang FC",
+		} {
+			if !strings.Contains(texts, want) {
+				t.Errorf("team sheet tidak berisi %q:\n%s", want, texts)
+			}
+		}
+	})
+
+	t.Run("match report", func(t *testing.T) {
+		expectStatus(t, s.request(http.MethodPost, fmt.Sprintf("/matches/%d/result", match.ID), map[string]any{
+			"home_score": 2,
+			"away_score": 1,
+			"attendance": 800,
+			"goals": []any{
+				map[string]any{"player_id": striker.ID, "goal_time": 77},
+				map[string]any{"player_id": visitor.ID, "goal_time": 30},
+				map[string]any{"player_id": striker.ID, "goal_time": 12},
+			},
+		}), http.StatusOK)
+
+		w := s.requestLang(http.MethodGet, fmt.Sprintf("/matches/%d/report.pdf", match.ID), nil, "en")
+		expectStatus(t, w, http.StatusOK)
+		body := w.Body.String()
+		expectPDF(t, w)
+		if got := w.Header().Get("Content-Language"); got != "en" {
+			t.Errorf("Content-Language = %q", got)
+		}
+
+		texts := strings.Join(pdfTexts(body), "|")
+		for _, want := range []string{
+			"Match Report|Garuda FC vs Elang FC",
+			"Schedule|2025-03-01 12:00 +00:00",
+			"Attendance|800",
+			"Final Score|2-1",
+			"Result|Home Team Won",
+			`Top Scorer|Budi Santoso \(goals: 2\)`,
+			// Urutan gol berdasarkan menit, bukan urutan input
+			"12'|Budi Santoso|Garuda FC|30'|Rudi Hartono|Elang FC|77'|Budi Santoso|Garuda FC",
+			"Total Wins|Garuda FC|1|Elang FC|0",
+			"Referee|Garuda FC Manager|Elang FC Manager",
+		} {
+			if !strings.Contains(texts, want) {
+				t.Errorf("laporan tidak berisi %q:\n%s", want, texts)
+			}
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		expectStatus(t, s.request(http.MethodGet, "/matches/abc/report.pdf", nil), http.StatusBadRequest)
+		expectStatus(t, s.request(http.MethodGet, "/matches/999/report.pdf", nil), http.StatusNotFound)
+		expectStatus(t, s.request(http.MethodGet, "/matches/abc/teamsheet.pdf", nil), http.StatusBadRequest)
+		expectStatus(t, s.request(http.MethodGet, "/matches/999/teamsheet.pdf", nil), http.StatusNotFound)
+	})
+}
@@ -0,0 +1,157 @@
+package api_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"xyz-football-api/internal/model"
+)
+
+func TestPlayerRoutes(t *testing.T) {
+	s := newTestServer(t)
+	team := s.createTeam("Garuda FC")
+
+	player := s.createPlayer(team.ID, "Budi Santoso", 10)
+	s.createPlayer(team.ID, "Ahmad Dahlan", 8)
+
+	t.Run("duplicate jersey number", func(t *testing.T) {
+		w := s.request(http.MethodPost, "/players", map[string]any{
+			"name":          "Rudi Hartono",
+			"team_id":       team.ID,
+			"position":      "bertahan",
+			"jersey_number": 10,
+		})
+		expectStatus(t, w, http.StatusConflict)
+		if got := errorMessage(t, w); got != "Nomor punggung sudah digunakan di tim ini" {
+			t.Errorf("error = %q", got)
+		}
+	})
+
+	t.Run("list by team", func(t *testing.T) {
+		w := s.request(http.MethodGet, fmt.Sprintf("/teams/%d/players", team.ID), nil)
+		expectStatus(t, w, http.StatusOK)
+		if players := decode[[]model.Player](t, w); len(players) != 2 {
+			t.Errorf("len(players) = %d, want 2", len(players))
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		w := s.request(http.MethodPut, fmt.Sprintf("/players/%d", player.ID), map[string]any{
+			"position":      "gelandang",
+			"jersey_number": 7,
+		})
+		expectStatus(t, w, http.StatusOK)
+		got := decode[model.Player](t, w)
+		if got.Position != "gelandang" || got.JerseyNumber != 7 || got.Name != "Budi Santoso" {
+			t.Errorf("player = %+v", got)
+		}
+
+		w = s.request(http.MethodPut, fmt.Sprintf("/players/%d", player.ID), map[string]any{"jersey_number": 8})
+		expectStatus(t, w, http.StatusConflict)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		expectStatus(t, s.request(http.MethodPost, "/players", map[string]any{"name": "X", "team_id": 999, "position": "penyerang", "jersey_number": 1}), http.StatusBadRequest)
+		expectStatus(t, s.request(http.MethodPost, "/players", map[string]any{"name": "X", "team_id": team.ID, "position": "kiper", "jersey_number": 1}), http.StatusBadRequest)
+		expectStatus(t, s.request(http.MethodGet, "/teams/999/players", nil), http.StatusNotFound)
+		expectStatus(t, s.request(http.MethodPut, "/players/999", map[string]any{}), http.StatusNotFound)
+		expectStatus(t, s.request(http.MethodDelete, "/players/abc", nil), http.StatusBadRequest)
+		expectStatus(t, s.request(http.MethodDelete, "/players/999", nil), http.StatusNotFound)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		expectStatus(t, s.request(http.MethodDelete, fmt.Sprintf("/players/%d", player.ID), nil), http.StatusOK)
+
+		w := s.request(http.MethodGet, fmt.Sprintf("/teams/%d/players", team.ID), nil)
+		expectStatus(t, w, http.StatusOK)
+		if players := decode[[]model.Player](t, w); len(players) != 1 {
+			t.Errorf("len(players) = %d, want 1", len(players))
+		}
+	})
+}
+
+func TestPlayerAvailabilityRoutes(t *testing.T) {
+	s := newTestServer(t)
+	team := s.createTeam("Garuda FC")
+	injured := s.createPlayer(team.ID, "Budi Santoso", 10)
+	fit := s.createPlayer(team.ID, "Ahmad Dahlan", 8)
+
+	basePath := fmt.Sprintf("/players/%d/availability", injured.ID)
+
+	w := s.request(http.MethodPost, basePath, map[string]any{
+		"status":               "injured",
+		"notes":                "Cedera hamstring",
+		"start_date":           "2025-03-01",
+		"expected_return_date": "2025-03-15",
+	})
+	expectStatus(t, w, http.StatusCreated)
+	availability := decode[model.PlayerAvailability](t, w)
+
+	t.Run("list", func(t *testing.T) {
+		w := s.request(http.MethodGet, basePath, nil)
+		expectStatus(t, w, http.StatusOK)
+		if got := decode[[]model.PlayerAvailability](t, w); len(got) != 1 || got[0].Status != model.AvailabilityStatusInjured {
+			t.Errorf("availabilities = %+v", got)
+		}
+	})
+
+	t.Run("available_on filter", func(t *testing.T) {
+		tests := []struct {
+			date string
+			want int
+		}{
+			{"2025-02-28", 2},
+			{"2025-03-01", 1},
+			{"2025-03-14", 1},
+			{"2025-03-15", 2},
+		}
+		for _, tt := range tests {
+			w := s.request(http.MethodGet, fmt.Sprintf("/teams/%d/players?available_on=%s", team.ID, tt.date), nil)
+			expectStatus(t, w, http.StatusOK)
+			players := decode[[]model.Player](t, w)
+			if len(players) != tt.want {
+				t.Errorf("available_on=%s: len(players) = %d, want %d", tt.date, len(players), tt.want)
+			}
+			if len(players) == 1 && players[0].ID != fit.ID {
+				t.Errorf("available_on=%s: got player %d, want %d", tt.date, players[0].ID, fit.ID)
+			}
+		}
+
+		w := s.request(http.MethodGet, fmt.Sprintf("/teams/%d/players?available_on=kemarin", team.ID), nil)
+		expectStatus(t, w, http.StatusBadRequest)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		w := s.request(http.MethodPut, fmt.Sprintf("%s/%d", basePath, availability.ID), map[string]any{
+			"status":               "injured",
+			"start_date":           "2025-03-01",
+			"expected_return_date": "2025-03-20",
+		})
+		expectStatus(t, w, http.StatusOK)
+		got := decode[model.PlayerAvailability](t, w)
+		if got.ExpectedReturnDate == nil || got.ExpectedReturnDate.Day() != 20 {
+			t.Errorf("expected_return_date = %v", got.ExpectedReturnDate)
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		expectStatus(t, s.request(http.MethodPost, basePath, map[string]any{"status": "injured", "start_date": "01-03-2025"}), http.StatusBadRequest)
+		expectStatus(t, s.request(http.MethodPost, basePath, map[string]any{"status": "injured", "start_date": "2025-03-10", "expected_return_date": "2025-03-01"}), http.StatusBadRequest)
+		expectStatus(t, s.request(http.MethodPost, basePath, map[string]any{"status": "bored", "start_date": "2025-03-10"}), http.StatusBadRequest)
+		expectStatus(t, s.request(http.MethodGet, "/players/999/availability", nil), http.StatusNotFound)
+
+		// Record milik player lain tidak boleh diakses lewat player ini
+		otherPath := fmt.Sprintf("/players/%d/availability/%d", fit.ID, availability.ID)
+		expectStatus(t, s.request(http.MethodDelete, otherPath, nil), http.StatusNotFound)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		expectStatus(t, s.request(http.MethodDelete, fmt.Sprintf("%s/%d", basePath, availability.ID), nil), http.StatusOK)
+
+		w := s.request(http.MethodGet, basePath, nil)
+		expectStatus(t, w, http.StatusOK)
+		if got := decode[[]model.PlayerAvailability](t, w); len(got) != 0 {
+			t.Errorf("availabilities = %+v, want empty", got)
+		}
+	})
+}
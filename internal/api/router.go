@@ -2,16 +2,20 @@ package api
 
 import (
 	"xyz-football-api/config"
+	"xyz-football-api/internal/api/docs"
 	"xyz-football-api/internal/api/handler"
 	"xyz-football-api/internal/api/middleware"
+	"xyz-football-api/internal/event"
 	"xyz-football-api/internal/repository"
+	"xyz-football-api/internal/service"
 
 	"github.com/gin-gonic/gin"
-	"gorm.io/gorm"
 )
 
-// SetupRouter mengkonfigurasi semua routes dan middleware
-func SetupRouter(cfg *config.Config, db *gorm.DB) *gin.Engine {
+// SetupRouter mengkonfigurasi semua routes dan middleware.
+// Repository di-inject dari luar sehingga router bisa dipakai dengan database maupun implementasi in-memory.
+// bus adalah sumber event untuk koneksi WebSocket dan diisi oleh OutboxDispatcher lewat service.LiveFeed.
+func SetupRouter(cfg *config.Config, repos *repository.Repositories, bus *event.Bus) *gin.Engine {
 	// Set Gin mode
 	gin.SetMode(gin.ReleaseMode)
 
@@ -21,30 +25,66 @@ func SetupRouter(cfg *config.Config, db *gorm.DB) *gin.Engine {
 	router.Use(gin.Recovery())
 	router.Use(middleware.Logger())
 	router.Use(middleware.CORS())
+	router.Use(middleware.ErrorHandler())
 
-	// Initialize repositories
-	teamRepo := repository.NewTeamRepository(db)
-	playerRepo := repository.NewPlayerRepository(db)
-	matchRepo := repository.NewMatchRepository(db)
-	goalRepo := repository.NewGoalRepository(db)
+	// Initialize services
+	// Event domain ditulis ke outbox bersama perubahan datanya; OutboxDispatcher
+	// di background yang meneruskannya ke webhook dan publisher lain
+	webhookService := service.NewWebhookService(repos.Webhooks)
+	bracketService := service.NewBracketService(repos.Competitions, repos.Teams, repos.Outbox, repos.Transactor)
+	teamService := service.NewTeamService(repos.Teams, repos.Venues, repos.Transactor)
+	playerService := service.NewPlayerService(repos.Players, repos.Teams, repos.Availabilities, repos.Transactor)
+	matchService := service.NewMatchService(repos.Matches, repos.Teams, repos.Players, repos.Goals, repos.Venues, repos.Competitions, repos.Availabilities, bracketService, repos.Transactor)
+	importService := service.NewImportService(repos.Teams, repos.Venues, repos.Players, repos.Transactor)
+	exportService := service.NewExportService(repos.Teams, repos.Players, repos.Matches, repos.Goals, repos.Availabilities)
+	calendarService := service.NewCalendarService(repos.CalendarTokens, repos.Teams, repos.Competitions, repos.Matches)
+	userService := service.NewUserService(repos.Users, cfg.Admin.Username)
+	outboxService := service.NewOutboxService(repos.Outbox)
 
 	// Initialize handlers
-	authHandler := handler.NewAuthHandler(cfg)
-	teamHandler := handler.NewTeamHandler(teamRepo)
-	playerHandler := handler.NewPlayerHandler(playerRepo, teamRepo)
-	matchHandler := handler.NewMatchHandler(matchRepo, teamRepo, playerRepo, goalRepo)
+	authHandler := handler.NewAuthHandler(cfg, userService)
+	userHandler := handler.NewUserHandler(userService)
+	outboxHandler := handler.NewOutboxHandler(outboxService)
+	teamHandler := handler.NewTeamHandler(teamService)
+	playerHandler := handler.NewPlayerHandler(playerService)
+	availabilityHandler := handler.NewPlayerAvailabilityHandler(repos.Availabilities, repos.Players)
+	venueHandler := handler.NewVenueHandler(repos.Venues)
+	officialHandler := handler.NewOfficialHandler(repos.Officials, repos.Matches, repos.Teams)
+	competitionHandler := handler.NewCompetitionHandler(repos.Competitions, repos.Teams, bracketService)
+	matchHandler := handler.NewMatchHandler(matchService)
+	importHandler := handler.NewImportHandler(importService)
+	exportHandler := handler.NewExportHandler(exportService)
+	calendarHandler := handler.NewCalendarHandler(calendarService)
+	webhookHandler := handler.NewWebhookHandler(webhookService)
+	webSocketHandler := handler.NewWebSocketHandler(bus, cfg.WebSocket)
+	graphQLHandler := handler.NewGraphQLHandler(repos, teamService, playerService, matchService, cfg.GraphQL)
+	docsHandler := handler.NewDocsHandler(docs.Spec, docs.Page)
 
 	// Health check endpoint
-	router.GET("/health", func(c *gin.Context) {
-		c.JSON(200, gin.H{
-			"status":  "ok",
-			"message": "XYZ Football API is running",
-		})
-	})
+	router.GET("/health", handler.Health)
+
+	// Dokumentasi API (spesifikasi OpenAPI hasil generate dan halaman interaktif)
+	router.GET("/openapi.json", docsHandler.Spec)
+	router.GET("/docs", docsHandler.Page)
 
 	// Public routes (tidak memerlukan autentikasi)
 	router.POST("/login", authHandler.Login)
 
+	// Feed kalender (diautentikasi dengan token kalender di query string)
+	calendar := router.Group("/")
+	calendar.Use(middleware.CalendarAuth(calendarService))
+	{
+		calendar.GET("/teams/:id/fixtures.ics", calendarHandler.TeamFixtures)
+		calendar.GET("/competitions/:id/fixtures.ics", calendarHandler.CompetitionFixtures)
+	}
+
+	// WebSocket push event (JWT boleh dikirim lewat parameter query token)
+	live := router.Group("/")
+	live.Use(middleware.WebSocketAuth(cfg))
+	{
+		live.GET("/ws", webSocketHandler.Connect)
+	}
+
 	// Protected routes (memerlukan JWT token)
 	protected := router.Group("/")
 	protected.Use(middleware.AuthMiddleware(cfg))
@@ -62,10 +102,83 @@ func SetupRouter(cfg *config.Config, db *gorm.DB) *gin.Engine {
 		protected.PUT("/players/:id", playerHandler.UpdatePlayer)
 		protected.DELETE("/players/:id", playerHandler.DeletePlayer)
 
+		// Player availability endpoints
+		protected.POST("/players/:id/availability", availabilityHandler.CreateAvailability)
+		protected.GET("/players/:id/availability", availabilityHandler.GetAvailabilities)
+		protected.PUT("/players/:id/availability/:availability_id", availabilityHandler.UpdateAvailability)
+		protected.DELETE("/players/:id/availability/:availability_id", availabilityHandler.DeleteAvailability)
+
+		// Venues endpoints
+		protected.POST("/venues", venueHandler.CreateVenue)
+		protected.GET("/venues", venueHandler.GetAllVenues)
+		protected.GET("/venues/:id", venueHandler.GetVenueByID)
+		protected.PUT("/venues/:id", venueHandler.UpdateVenue)
+		protected.DELETE("/venues/:id", venueHandler.DeleteVenue)
+
 		// Matches endpoints
 		protected.POST("/matches", matchHandler.CreateMatch)
+		protected.PATCH("/matches/:id", matchHandler.RescheduleMatch)
 		protected.POST("/matches/:id/result", matchHandler.ReportMatchResult)
 		protected.GET("/matches/:id/report", matchHandler.GetMatchReport)
+		protected.GET("/matches/:id/report.pdf", matchHandler.GetMatchReportPDF)
+		protected.GET("/matches/:id/teamsheet.pdf", matchHandler.GetTeamSheetPDF)
+		protected.POST("/matches/:id/officials", officialHandler.AssignOfficial)
+		protected.GET("/matches/:id/officials", officialHandler.GetMatchOfficials)
+		protected.DELETE("/matches/:id/officials/:assignment_id", officialHandler.UnassignOfficial)
+
+		// Competitions endpoints
+		protected.POST("/competitions", competitionHandler.CreateCompetition)
+		protected.GET("/competitions", competitionHandler.GetAllCompetitions)
+		protected.GET("/competitions/:id", competitionHandler.GetCompetitionByID)
+		protected.POST("/competitions/:id/draw", competitionHandler.DrawCompetition)
+		protected.GET("/competitions/:id/bracket", competitionHandler.GetBracket)
+		protected.GET("/competitions/:id/groups", competitionHandler.GetGroups)
+		protected.GET("/competitions/:id/qualification", competitionHandler.GetQualificationScenarios)
+
+		// Officials endpoints
+		protected.POST("/officials", officialHandler.CreateOfficial)
+		protected.GET("/officials", officialHandler.GetAllOfficials)
+		protected.GET("/officials/:id", officialHandler.GetOfficialByID)
+		protected.PUT("/officials/:id", officialHandler.UpdateOfficial)
+		protected.DELETE("/officials/:id", officialHandler.DeleteOfficial)
+
+		// Import endpoints
+		protected.POST("/import/teams", importHandler.ImportTeams)
+		protected.POST("/import/players", importHandler.ImportPlayers)
+
+		// Calendar token endpoints
+		protected.POST("/calendar/token", calendarHandler.CreateToken)
+		protected.DELETE("/calendar/token", calendarHandler.RevokeToken)
+
+		// Export endpoints
+		protected.GET("/export/teams", exportHandler.ExportTeams)
+		protected.GET("/export/players", exportHandler.ExportPlayers)
+		protected.GET("/export/matches", exportHandler.ExportMatches)
+		protected.GET("/export/goals", exportHandler.ExportGoals)
+
+		// Webhook endpoints
+		protected.POST("/webhooks", webhookHandler.CreateWebhook)
+		protected.GET("/webhooks", webhookHandler.GetAllWebhooks)
+		protected.GET("/webhooks/:id", webhookHandler.GetWebhookByID)
+		protected.PUT("/webhooks/:id", webhookHandler.UpdateWebhook)
+		protected.DELETE("/webhooks/:id", webhookHandler.DeleteWebhook)
+		protected.GET("/webhooks/:id/deliveries", webhookHandler.GetDeliveries)
+		protected.POST("/webhooks/:id/deliveries/:delivery_id/redeliver", webhookHandler.Redeliver)
+
+		// GraphQL endpoint
+		protected.POST("/graphql", graphQLHandler.Query)
+	}
+
+	// Admin routes (hanya admin dari konfigurasi)
+	admin := protected.Group("/")
+	admin.Use(middleware.AdminOnly(cfg))
+	{
+		// Users endpoints
+		admin.POST("/users", userHandler.CreateUser)
+
+		// Outbox endpoints
+		admin.GET("/outbox/parked", outboxHandler.GetParkedEvents)
+		admin.POST("/outbox/requeue", outboxHandler.RequeueEvents)
 	}
 
 	return router
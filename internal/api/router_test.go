@@ -0,0 +1,345 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"xyz-football-api/config"
+	"xyz-football-api/internal/api"
+	"xyz-football-api/internal/event"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+	"xyz-football-api/internal/repository/memory"
+	"xyz-football-api/internal/service"
+	"xyz-football-api/pkg/database"
+	"xyz-football-api/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// testConfig adalah konfigurasi yang dipakai semua test router
+var testConfig = &config.Config{
+	JWT:    config.JWTConfig{Secret: "test-secret", ExpirationHours: 1},
+	Admin:  config.AdminConfig{Username: "admin", Password: "admin123"},
+	Outbox: config.OutboxConfig{ParkAfter: time.Hour},
+	WebSocket: config.WebSocketConfig{
+		PingInterval:   200 * time.Millisecond,
+		WriteTimeout:   time.Second,
+		BufferSize:     16,
+		AllowedOrigins: []string{"https://app.example.com"},
+	},
+	GraphQL: config.GraphQLConfig{MaxDepth: 6, MaxComplexity: 5000},
+}
+
+// routeHits mencatat route (method + pattern) yang sudah dipanggil oleh test
+var (
+	routeHitsMu sync.Mutex
+	routeHits   = make(map[string]bool)
+)
+
+// TestMain menjalankan semua test lalu memastikan setiap route di SetupRouter
+// dipanggil minimal sekali. Pemeriksaan dilewati jika test difilter dengan -run.
+func TestMain(m *testing.M) {
+	flag.Parse()
+	log.SetOutput(io.Discard)
+	code := m.Run()
+
+	if code == 0 && flag.Lookup("test.run").Value.String() == "" {
+		var missing []string
+		for _, route := range api.SetupRouter(testConfig, memory.NewRepositories(), event.NewBus()).Routes() {
+			key := route.Method + " " + route.Path
+			if !routeHits[key] {
+				missing = append(missing, key)
+			}
+		}
+		if len(missing) > 0 {
+			sort.Strings(missing)
+			fmt.Fprintf(os.Stderr, "route tanpa test:\n  %s\n", strings.Join(missing, "\n  "))
+			code = 1
+		}
+	}
+
+	os.Exit(code)
+}
+
+// testServer membungkus router dengan repository in-memory dan token login
+type testServer struct {
+	t      *testing.T
+	router *gin.Engine
+	repos  *repository.Repositories
+	bus    *event.Bus
+	token  string
+}
+
+// newTestRepositories membuat repository kosong untuk satu test. Secara default dipakai
+// repository in-memory; TEST_DB_DRIVER=sqlite menjalankan test yang sama di atas
+// repository GORM dengan database SQLite in-memory yang sudah dimigrasi.
+func newTestRepositories(t *testing.T) *repository.Repositories {
+	t.Helper()
+
+	if os.Getenv("TEST_DB_DRIVER") != config.DriverSQLite {
+		return memory.NewRepositories()
+	}
+
+	db, err := database.OpenSQLite(":memory:")
+	if err != nil {
+		t.Fatalf("gagal membuka SQLite: %v", err)
+	}
+	migrator, err := database.NewMigrator(db)
+	if err != nil {
+		t.Fatalf("gagal memuat migrasi: %v", err)
+	}
+	if _, err := migrator.Up(); err != nil {
+		t.Fatalf("gagal menjalankan migrasi: %v", err)
+	}
+	return repository.NewRepositories(db)
+}
+
+// newTestServer membuat router baru di atas repository kosong lalu login
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+	return newTestServerWith(t, newTestRepositories(t))
+}
+
+// newTestServerWith membuat router baru di atas repository tertentu lalu login
+func newTestServerWith(t *testing.T, repos *repository.Repositories) *testServer {
+	t.Helper()
+
+	bus := event.NewBus()
+	s := &testServer{t: t, router: api.SetupRouter(testConfig, repos, bus), repos: repos, bus: bus}
+
+	w := s.requestWithToken(http.MethodPost, "/login", map[string]string{
+		"username": testConfig.Admin.Username,
+		"password": testConfig.Admin.Password,
+	}, "")
+	expectStatus(t, w, http.StatusOK)
+	s.token = decode[map[string]string](t, w)["token"]
+
+	return s
+}
+
+// dispatch memublikasikan event di outbox ke webhook dan WebSocket, seperti OutboxDispatcher di background
+func (s *testServer) dispatch() {
+	s.t.Helper()
+	publishers := event.Publishers{service.NewWebhookService(s.repos.Webhooks), service.NewLiveFeed(s.repos.Matches, s.bus)}
+	if _, err := service.NewOutboxDispatcher(s.repos.Outbox, publishers, testConfig.Outbox.ParkAfter).DispatchPending(time.Now().UTC()); err != nil {
+		s.t.Fatalf("DispatchPending error: %v", err)
+	}
+}
+
+// request mengirim request terautentikasi ke router
+func (s *testServer) request(method, path string, body any) *httptest.ResponseRecorder {
+	s.t.Helper()
+	return s.requestWithToken(method, path, body, s.token)
+}
+
+// requestWithToken mengirim request dengan token tertentu (kosong = tanpa header Authorization)
+func (s *testServer) requestWithToken(method, path string, body any, token string) *httptest.ResponseRecorder {
+	s.t.Helper()
+
+	headers := map[string]string{}
+	if token != "" {
+		headers["Authorization"] = "Bearer " + token
+	}
+	return s.requestWithHeaders(method, path, body, headers)
+}
+
+// requestLang mengirim request terautentikasi dengan header Accept-Language
+func (s *testServer) requestLang(method, path string, body any, lang string) *httptest.ResponseRecorder {
+	s.t.Helper()
+	return s.requestWithHeaders(method, path, body, map[string]string{
+		"Authorization":   "Bearer " + s.token,
+		"Accept-Language": lang,
+	})
+}
+
+// requestWithHeaders mengirim request dengan header tambahan
+func (s *testServer) requestWithHeaders(method, path string, body any, headers map[string]string) *httptest.ResponseRecorder {
+	s.t.Helper()
+
+	var reader *bytes.Reader
+	switch b := body.(type) {
+	case nil:
+		reader = bytes.NewReader(nil)
+	case string:
+		reader = bytes.NewReader([]byte(b))
+	default:
+		payload, err := json.Marshal(b)
+		if err != nil {
+			s.t.Fatalf("gagal encode body: %v", err)
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req := httptest.NewRequest(method, path, reader)
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, req)
+	s.recordHit(method, req.URL.Path)
+	return w
+}
+
+// recordHit mencocokkan path dengan pattern route dan mencatatnya sebagai sudah dites
+func (s *testServer) recordHit(method, path string) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for _, route := range s.router.Routes() {
+		if route.Method != method {
+			continue
+		}
+		pattern := strings.Split(strings.Trim(route.Path, "/"), "/")
+		if len(pattern) != len(segments) {
+			continue
+		}
+		matched := true
+		for i := range pattern {
+			if !strings.HasPrefix(pattern[i], ":") && pattern[i] != segments[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			routeHitsMu.Lock()
+			routeHits[route.Method+" "+route.Path] = true
+			routeHitsMu.Unlock()
+			return
+		}
+	}
+}
+
+// expectStatus menggagalkan test jika status code tidak sesuai
+func expectStatus(t *testing.T, w *httptest.ResponseRecorder, want int) {
+	t.Helper()
+	if w.Code != want {
+		t.Fatalf("status = %d, want %d; body = %s", w.Code, want, w.Body.String())
+	}
+}
+
+// decode mem-parsing body JSON response ke tipe T
+func decode[T any](t *testing.T, w *httptest.ResponseRecorder) T {
+	t.Helper()
+	var v T
+	if err := json.Unmarshal(w.Body.Bytes(), &v); err != nil {
+		t.Fatalf("gagal decode response %q: %v", w.Body.String(), err)
+	}
+	return v
+}
+
+// errorMessage mengambil field error dari response error
+func errorMessage(t *testing.T, w *httptest.ResponseRecorder) string {
+	t.Helper()
+	return decode[utils.ErrorResponse](t, w).Error
+}
+
+// createTeam membuat team dan mengembalikan hasilnya
+func (s *testServer) createTeam(name string) model.Team {
+	s.t.Helper()
+	w := s.request(http.MethodPost, "/teams", map[string]any{"name": name})
+	expectStatus(s.t, w, http.StatusCreated)
+	return decode[model.Team](s.t, w)
+}
+
+// createPlayer membuat player dalam team dan mengembalikan hasilnya
+func (s *testServer) createPlayer(teamID uint, name string, jerseyNumber int) model.Player {
+	s.t.Helper()
+	w := s.request(http.MethodPost, "/players", map[string]any{
+		"name":          name,
+		"team_id":       teamID,
+		"position":      "penyerang",
+		"jersey_number": jerseyNumber,
+	})
+	expectStatus(s.t, w, http.StatusCreated)
+	return decode[model.Player](s.t, w)
+}
+
+// createVenue membuat venue dan mengembalikan hasilnya
+func (s *testServer) createVenue(name string, capacity int) model.Venue {
+	s.t.Helper()
+	w := s.request(http.MethodPost, "/venues", map[string]any{
+		"name":     name,
+		"city":     "Jakarta",
+		"capacity": capacity,
+	})
+	expectStatus(s.t, w, http.StatusCreated)
+	return decode[model.Venue](s.t, w)
+}
+
+// createMatch menjadwalkan match dan mengembalikan hasilnya
+func (s *testServer) createMatch(homeTeamID, awayTeamID uint, kickoff string) model.Match {
+	s.t.Helper()
+	w := s.request(http.MethodPost, "/matches", map[string]any{
+		"home_team_id":   homeTeamID,
+		"away_team_id":   awayTeamID,
+		"match_datetime": kickoff,
+	})
+	expectStatus(s.t, w, http.StatusCreated)
+	return decode[model.Match](s.t, w)
+}
+
+func TestHealth(t *testing.T) {
+	s := newTestServer(t)
+
+	w := s.requestWithToken(http.MethodGet, "/health", nil, "")
+	expectStatus(t, w, http.StatusOK)
+	if got := decode[map[string]string](t, w)["status"]; got != "ok" {
+		t.Errorf("status = %q, want ok", got)
+	}
+}
+
+func TestLogin(t *testing.T) {
+	s := newTestServer(t)
+
+	tests := []struct {
+		name string
+		body any
+		want int
+	}{
+		{"valid credentials", map[string]string{"username": "admin", "password": "admin123"}, http.StatusOK},
+		{"wrong password", map[string]string{"username": "admin", "password": "salah"}, http.StatusUnauthorized},
+		{"missing fields", map[string]string{"username": "admin"}, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := s.requestWithToken(http.MethodPost, "/login", tt.body, "")
+			expectStatus(t, w, tt.want)
+		})
+	}
+}
+
+func TestProtectedRoutesRequireToken(t *testing.T) {
+	s := newTestServer(t)
+
+	tests := []struct {
+		name  string
+		token string
+		want  string
+	}{
+		{"missing token", "", "Token tidak ditemukan"},
+		{"invalid token", "bukan-jwt", "Token tidak valid atau sudah expired"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := s.requestWithToken(http.MethodGet, "/teams", nil, tt.token)
+			expectStatus(t, w, http.StatusUnauthorized)
+			if got := errorMessage(t, w); got != tt.want {
+				t.Errorf("error = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
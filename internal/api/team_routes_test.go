@@ -0,0 +1,94 @@
+package api_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"xyz-football-api/internal/model"
+)
+
+func TestTeamRoutes(t *testing.T) {
+	s := newTestServer(t)
+	venue := s.createVenue("Stadion Utama", 50000)
+
+	w := s.request(http.MethodPost, "/teams", map[string]any{
+		"name":              "Garuda FC",
+		"headquarters_city": "Jakarta",
+		"home_venue_id":     venue.ID,
+	})
+	expectStatus(t, w, http.StatusCreated)
+	team := decode[model.Team](t, w)
+	if team.ID == 0 || team.Name != "Garuda FC" {
+		t.Fatalf("team = %+v", team)
+	}
+
+	s.createTeam("Elang FC")
+
+	t.Run("list", func(t *testing.T) {
+		w := s.request(http.MethodGet, "/teams", nil)
+		expectStatus(t, w, http.StatusOK)
+		if teams := decode[[]model.Team](t, w); len(teams) != 2 {
+			t.Errorf("len(teams) = %d, want 2", len(teams))
+		}
+	})
+
+	t.Run("get includes home venue", func(t *testing.T) {
+		w := s.request(http.MethodGet, fmt.Sprintf("/teams/%d", team.ID), nil)
+		expectStatus(t, w, http.StatusOK)
+		got := decode[model.Team](t, w)
+		if got.HomeVenue == nil || got.HomeVenue.Name != "Stadion Utama" {
+			t.Errorf("home_venue = %+v", got.HomeVenue)
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		w := s.request(http.MethodPut, fmt.Sprintf("/teams/%d", team.ID), map[string]any{
+			"name":         "Garuda United",
+			"founded_year": 2001,
+		})
+		expectStatus(t, w, http.StatusOK)
+		got := decode[model.Team](t, w)
+		if got.Name != "Garuda United" || got.FoundedYear == nil || *got.FoundedYear != 2001 {
+			t.Errorf("team = %+v", got)
+		}
+		if got.HeadquartersCity == nil || *got.HeadquartersCity != "Jakarta" {
+			t.Errorf("headquarters_city should be kept, got %v", got.HeadquartersCity)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		w := s.request(http.MethodDelete, fmt.Sprintf("/teams/%d", team.ID), nil)
+		expectStatus(t, w, http.StatusOK)
+
+		w = s.request(http.MethodGet, fmt.Sprintf("/teams/%d", team.ID), nil)
+		expectStatus(t, w, http.StatusNotFound)
+	})
+}
+
+func TestTeamRoutesValidation(t *testing.T) {
+	s := newTestServer(t)
+	team := s.createTeam("Garuda FC")
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   any
+		want   int
+	}{
+		{"create without name", http.MethodPost, "/teams", map[string]any{}, http.StatusBadRequest},
+		{"create with unknown venue", http.MethodPost, "/teams", map[string]any{"name": "X", "home_venue_id": 999}, http.StatusBadRequest},
+		{"get invalid id", http.MethodGet, "/teams/abc", nil, http.StatusBadRequest},
+		{"get unknown id", http.MethodGet, "/teams/999", nil, http.StatusNotFound},
+		{"update unknown id", http.MethodPut, "/teams/999", map[string]any{"name": "X"}, http.StatusNotFound},
+		{"update unknown venue", http.MethodPut, fmt.Sprintf("/teams/%d", team.ID), map[string]any{"name": "X", "home_venue_id": 999}, http.StatusBadRequest},
+		{"delete invalid id", http.MethodDelete, "/teams/abc", nil, http.StatusBadRequest},
+		{"delete unknown id", http.MethodDelete, "/teams/999", nil, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expectStatus(t, s.request(tt.method, tt.path, tt.body), tt.want)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package api_test
+
+import (
+	"net/http"
+	"testing"
+	"xyz-football-api/internal/api/handler"
+)
+
+func TestCreateUser(t *testing.T) {
+	s := newTestServer(t)
+
+	w := s.request(http.MethodPost, "/users", map[string]string{"username": "operator", "password": "rahasia123"})
+	expectStatus(t, w, http.StatusCreated)
+	user := decode[map[string]any](t, w)
+	if user["username"] != "operator" || user["id"] == nil {
+		t.Fatalf("user = %v", user)
+	}
+	if _, ok := user["password_hash"]; ok {
+		t.Error("response tidak boleh berisi hash password")
+	}
+
+	// User baru bisa login dan memakai token-nya
+	w = s.requestWithToken(http.MethodPost, "/login", map[string]string{"username": "operator", "password": "rahasia123"}, "")
+	expectStatus(t, w, http.StatusOK)
+	token := decode[handler.LoginResponse](t, w).Token
+	expectStatus(t, s.requestWithToken(http.MethodGet, "/teams", nil, token), http.StatusOK)
+
+	// Hanya admin dari konfigurasi yang boleh membuat akun baru
+	w = s.requestWithToken(http.MethodPost, "/users", map[string]string{"username": "penyusup", "password": "rahasia123"}, token)
+	expectStatus(t, w, http.StatusForbidden)
+	if got := decode[map[string]any](t, w)["code"]; got != "forbidden" {
+		t.Errorf("code = %v, want forbidden", got)
+	}
+
+	w = s.requestWithToken(http.MethodPost, "/login", map[string]string{"username": "operator", "password": "salah-sekali"}, "")
+	expectStatus(t, w, http.StatusUnauthorized)
+
+	tests := []struct {
+		name string
+		body map[string]string
+		want int
+		code string
+	}{
+		{"duplicate username", map[string]string{"username": "operator", "password": "rahasia123"}, http.StatusConflict, "username_taken"},
+		{"config admin username", map[string]string{"username": "admin", "password": "rahasia123"}, http.StatusConflict, "username_taken"},
+		{"short password", map[string]string{"username": "wasit", "password": "pendek"}, http.StatusBadRequest, "validation_failed"},
+		{"blank username", map[string]string{"username": "  ", "password": "rahasia123"}, http.StatusBadRequest, "validation_failed"},
+		{"missing password", map[string]string{"username": "wasit"}, http.StatusBadRequest, "validation_failed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := s.request(http.MethodPost, "/users", tt.body)
+			expectStatus(t, w, tt.want)
+			if got := decode[map[string]any](t, w)["code"]; got != tt.code {
+				t.Errorf("code = %v, want %s", got, tt.code)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package api_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"xyz-football-api/internal/model"
+)
+
+func TestVenueRoutes(t *testing.T) {
+	s := newTestServer(t)
+
+	venue := s.createVenue("Stadion Utama", 50000)
+	if venue.Surface != "grass" {
+		t.Errorf("default surface = %q, want grass", venue.Surface)
+	}
+
+	t.Run("list", func(t *testing.T) {
+		w := s.request(http.MethodGet, "/venues", nil)
+		expectStatus(t, w, http.StatusOK)
+		if venues := decode[[]model.Venue](t, w); len(venues) != 1 {
+			t.Errorf("len(venues) = %d, want 1", len(venues))
+		}
+	})
+
+	t.Run("get", func(t *testing.T) {
+		w := s.request(http.MethodGet, fmt.Sprintf("/venues/%d", venue.ID), nil)
+		expectStatus(t, w, http.StatusOK)
+		if got := decode[model.Venue](t, w); got.Capacity != 50000 {
+			t.Errorf("capacity = %d, want 50000", got.Capacity)
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		w := s.request(http.MethodPut, fmt.Sprintf("/venues/%d", venue.ID), map[string]any{
+			"name":     "Stadion Baru",
+			"city":     "Bandung",
+			"capacity": 30000,
+			"surface":  "hybrid",
+		})
+		expectStatus(t, w, http.StatusOK)
+		got := decode[model.Venue](t, w)
+		if got.Name != "Stadion Baru" || got.Surface != "hybrid" {
+			t.Errorf("venue = %+v", got)
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		expectStatus(t, s.request(http.MethodPost, "/venues", map[string]any{"name": "X", "city": "Y", "capacity": 0}), http.StatusBadRequest)
+		expectStatus(t, s.request(http.MethodPost, "/venues", map[string]any{"name": "X", "city": "Y", "capacity": 10, "surface": "sand"}), http.StatusBadRequest)
+		expectStatus(t, s.request(http.MethodGet, "/venues/999", nil), http.StatusNotFound)
+		expectStatus(t, s.request(http.MethodPut, "/venues/999", map[string]any{"name": "X", "city": "Y", "capacity": 10}), http.StatusNotFound)
+		expectStatus(t, s.request(http.MethodDelete, "/venues/abc", nil), http.StatusBadRequest)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		expectStatus(t, s.request(http.MethodDelete, fmt.Sprintf("/venues/%d", venue.ID), nil), http.StatusOK)
+		expectStatus(t, s.request(http.MethodGet, fmt.Sprintf("/venues/%d", venue.ID), nil), http.StatusNotFound)
+	})
+}
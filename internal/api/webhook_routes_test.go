@@ -0,0 +1,340 @@
+package api_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+	"xyz-football-api/internal/api/handler"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/service"
+)
+
+// receivedWebhook adalah satu request yang diterima stand-in penerima webhook
+type receivedWebhook struct {
+	header http.Header
+	body   []byte
+}
+
+// webhookReceiver adalah stand-in HTTP sistem penerima webhook yang mencatat setiap request
+type webhookReceiver struct {
+	*httptest.Server
+	mu       sync.Mutex
+	status   int
+	received []receivedWebhook
+}
+
+// newWebhookReceiver menjalankan stand-in penerima yang membalas 200 OK
+func newWebhookReceiver(t *testing.T) *webhookReceiver {
+	r := &webhookReceiver{status: http.StatusOK}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.received = append(r.received, receivedWebhook{header: req.Header.Clone(), body: body})
+		w.WriteHeader(r.status)
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+// respondWith mengubah status respons untuk request berikutnya
+func (r *webhookReceiver) respondWith(status int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.status = status
+}
+
+// take mengembalikan request yang diterima sejak pemanggilan sebelumnya
+func (r *webhookReceiver) take() []receivedWebhook {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	received := r.received
+	r.received = nil
+	return received
+}
+
+// webhookEnvelope adalah body setiap pengiriman webhook
+type webhookEnvelope struct {
+	ID   string          `json:"id"`
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// deliver menjalankan satu putaran worker webhook pada waktu now
+func (s *testServer) deliver(worker *service.WebhookWorker, now time.Time) int {
+	s.t.Helper()
+	processed, err := worker.DeliverDue(context.Background(), now)
+	if err != nil {
+		s.t.Fatalf("DeliverDue error: %v", err)
+	}
+	return processed
+}
+
+// deliveries mengambil log pengiriman webhook, terbaru lebih dulu
+func (s *testServer) deliveries(webhookID uint) []handler.WebhookDeliveryResponse {
+	s.t.Helper()
+	w := s.request(http.MethodGet, fmt.Sprintf("/webhooks/%d/deliveries", webhookID), nil)
+	expectStatus(s.t, w, http.StatusOK)
+	return decode[[]handler.WebhookDeliveryResponse](s.t, w)
+}
+
+func TestWebhookRoutes(t *testing.T) {
+	s := newTestServer(t)
+	receiver := newWebhookReceiver(t)
+	const secret = "rahasia-webhook-16"
+
+	w := s.request(http.MethodPost, "/webhooks", map[string]any{
+		"url":    receiver.URL + "/hooks",
+		"secret": secret,
+		"events": []string{"match.completed", "goal.recorded", "player.created", "team.deleted", "player.created"},
+	})
+	expectStatus(t, w, http.StatusCreated)
+	all := decode[model.WebhookSubscription](t, w)
+	if !all.Active || len(all.Events) != 4 {
+		t.Fatalf("subscription = %+v", all)
+	}
+	if _, ok := decode[map[string]any](t, w)["secret"]; ok {
+		t.Error("secret tidak boleh dikembalikan API")
+	}
+
+	w = s.request(http.MethodPost, "/webhooks", map[string]any{
+		"url":    receiver.URL + "/rosters",
+		"secret": secret,
+		"events": []string{"team.deleted"},
+	})
+	expectStatus(t, w, http.StatusCreated)
+	rosters := decode[model.WebhookSubscription](t, w)
+
+	worker := service.NewWebhookWorker(s.repos.Webhooks, receiver.Client(), 3)
+
+	t.Run("events are signed and delivered", func(t *testing.T) {
+		home := s.createTeam("Garuda FC")
+		away := s.createTeam("Elang FC")
+		striker := s.createPlayer(home.ID, "Budi Santoso", 9)
+		match := s.createMatch(home.ID, away.ID, "2025-03-01T19:00:00+07:00")
+		expectStatus(t, s.request(http.MethodPost, fmt.Sprintf("/matches/%d/result", match.ID), map[string]any{
+			"home_score": 2,
+			"away_score": 0,
+			"goals": []any{
+				map[string]any{"player_id": striker.ID, "goal_time": 12},
+				map[string]any{"player_id": striker.ID, "goal_time": 77},
+			},
+		}), http.StatusOK)
+		relegated := s.createTeam("Rajawali FC")
+		expectStatus(t, s.request(http.MethodDelete, fmt.Sprintf("/teams/%d", relegated.ID), nil), http.StatusOK)
+
+		if got := len(s.deliveries(all.ID)); got != 0 {
+			t.Fatalf("event harus menunggu dispatcher outbox, log = %d", got)
+		}
+		s.dispatch()
+		if got := s.deliver(worker, time.Now()); got != 6 {
+			t.Fatalf("processed = %d, want 6", got)
+		}
+
+		var types []string
+		for _, req := range receiver.take() {
+			mac := hmac.New(sha256.New, []byte(secret))
+			mac.Write(req.body)
+			if got, want := req.header.Get("X-Webhook-Signature"), "sha256="+hex.EncodeToString(mac.Sum(nil)); got != want {
+				t.Errorf("signature = %q, want %q", got, want)
+			}
+
+			var envelope webhookEnvelope
+			if err := json.Unmarshal(req.body, &envelope); err != nil {
+				t.Fatalf("body bukan JSON: %v\n%s", err, req.body)
+			}
+			if req.header.Get("X-Webhook-Event") != envelope.Type || envelope.ID == "" {
+				t.Errorf("header/body tidak konsisten: %v %s", req.header, req.body)
+			}
+			types = append(types, envelope.Type)
+
+			if envelope.Type == "match.completed" {
+				var data model.Match
+				json.Unmarshal(envelope.Data, &data)
+				if data.ID != match.ID || data.HomeScore != 2 || data.Status != model.MatchStatusCompleted || len(data.Goals) != 2 {
+					t.Errorf("data match.completed = %s", envelope.Data)
+				}
+			}
+		}
+		want := []string{"player.created", "match.completed", "goal.recorded", "goal.recorded", "team.deleted", "team.deleted"}
+		if fmt.Sprint(types) != fmt.Sprint(want) {
+			t.Errorf("event = %v, want %v", types, want)
+		}
+
+		log := s.deliveries(all.ID)
+		if len(log) != 5 {
+			t.Fatalf("len(log) = %d, want 5", len(log))
+		}
+		for _, delivery := range log {
+			if delivery.Status != model.WebhookDeliverySucceeded || delivery.Attempts != 1 || delivery.ResponseStatus == nil || *delivery.ResponseStatus != http.StatusOK || delivery.NextAttemptAt != nil {
+				t.Errorf("delivery = %+v", delivery)
+			}
+		}
+		if log[0].EventType != "team.deleted" || len(log[0].Payload) == 0 {
+			t.Errorf("log tidak terurut terbaru lebih dulu: %+v", log[0])
+		}
+		if got := s.deliveries(rosters.ID); len(got) != 1 || got[0].EventType != "team.deleted" {
+			t.Errorf("log rosters = %+v", got)
+		}
+		if s.deliver(worker, time.Now().Add(time.Hour)) != 0 {
+			t.Error("pengiriman yang berhasil tidak boleh dikirim lagi")
+		}
+	})
+
+	t.Run("failed deliveries retry with backoff then redeliver", func(t *testing.T) {
+		receiver.respondWith(http.StatusInternalServerError)
+		team := s.createTeam("Merpati FC")
+		s.createPlayer(team.ID, "Rudi Hartono", 10)
+		s.dispatch()
+
+		now := time.Now()
+		if got := s.deliver(worker, now); got != 1 {
+			t.Fatalf("processed = %d, want 1", got)
+		}
+		failing := s.deliveries(all.ID)[0]
+		if failing.Status != model.WebhookDeliveryPending || failing.Attempts != 1 || failing.NextAttemptAt == nil {
+			t.Fatalf("delivery = %+v", failing)
+		}
+		if wait := failing.NextAttemptAt.Sub(now); wait < 29*time.Second || wait > 31*time.Second {
+			t.Errorf("retry pertama setelah %v, want 30s", wait)
+		}
+
+		// Belum jatuh tempo, lalu retry kedua 30 detik kemudian dan retry ketiga 1 menit setelahnya
+		if got := s.deliver(worker, now.Add(10*time.Second)); got != 0 {
+			t.Errorf("processed sebelum jatuh tempo = %d", got)
+		}
+		s.deliver(worker, now.Add(30*time.Second))
+		if got := s.deliveries(all.ID)[0]; got.Attempts != 2 || got.NextAttemptAt.Sub(now) < 89*time.Second {
+			t.Errorf("setelah retry kedua: %+v", got)
+		}
+		s.deliver(worker, now.Add(90*time.Second))
+		failed := s.deliveries(all.ID)[0]
+		if failed.Status != model.WebhookDeliveryFailed || failed.Attempts != 3 || failed.NextAttemptAt != nil ||
+			*failed.ResponseStatus != http.StatusInternalServerError || *failed.LastError != "HTTP 500 Internal Server Error" {
+			t.Errorf("delivery setelah 3 percobaan = %+v", failed)
+		}
+		if got := len(receiver.take()); got != 3 {
+			t.Errorf("receiver menerima %d request, want 3", got)
+		}
+
+		receiver.respondWith(http.StatusOK)
+		w := s.request(http.MethodPost, fmt.Sprintf("/webhooks/%d/deliveries/%d/redeliver", all.ID, failed.ID), nil)
+		expectStatus(t, w, http.StatusAccepted)
+		redelivery := decode[handler.WebhookDeliveryResponse](t, w)
+		if redelivery.ID == failed.ID || redelivery.EventID != failed.EventID || redelivery.Status != model.WebhookDeliveryPending {
+			t.Errorf("redelivery = %+v", redelivery)
+		}
+
+		s.deliver(worker, time.Now())
+		received := receiver.take()
+		if len(received) != 1 || received[0].header.Get("X-Webhook-Delivery") != fmt.Sprint(redelivery.ID) {
+			t.Fatalf("received = %+v", received)
+		}
+		var envelope webhookEnvelope
+		json.Unmarshal(received[0].body, &envelope)
+		if envelope.ID != failed.EventID || envelope.Type != "player.created" {
+			t.Errorf("payload redelivery = %s", received[0].body)
+		}
+		if got := s.deliveries(all.ID); got[0].Status != model.WebhookDeliverySucceeded || got[1].Status != model.WebhookDeliveryFailed {
+			t.Errorf("log setelah redeliver = %+v", got[:2])
+		}
+	})
+
+	t.Run("stopped worker leaves the batch for the next run", func(t *testing.T) {
+		team := s.createTeam("Cendrawasih FC")
+		expectStatus(t, s.request(http.MethodDelete, fmt.Sprintf("/teams/%d", team.ID), nil), http.StatusOK)
+		s.dispatch()
+
+		// Worker yang dihentikan saat shutdown tidak menghabiskan jatah percobaan
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if processed, err := worker.DeliverDue(ctx, time.Now()); processed != 0 || err != nil {
+			t.Fatalf("processed = %d, err = %v; want 0, nil", processed, err)
+		}
+		if got := s.deliveries(rosters.ID)[0]; got.Status != model.WebhookDeliveryPending || got.Attempts != 0 {
+			t.Errorf("delivery setelah worker dihentikan = %+v", got)
+		}
+		if got := len(receiver.take()); got != 0 {
+			t.Errorf("receiver menerima %d request, want 0", got)
+		}
+
+		if got := s.deliver(worker, time.Now()); got != 2 {
+			t.Errorf("processed = %d, want 2", got)
+		}
+		receiver.take()
+	})
+
+	t.Run("update and deactivate", func(t *testing.T) {
+		w := s.request(http.MethodPut, fmt.Sprintf("/webhooks/%d", all.ID), map[string]any{
+			"url":    receiver.URL + "/hooks",
+			"events": []string{"player.created"},
+			"active": false,
+		})
+		expectStatus(t, w, http.StatusOK)
+		if got := decode[model.WebhookSubscription](t, w); got.Active || len(got.Events) != 1 {
+			t.Errorf("subscription = %+v", got)
+		}
+
+		before := len(s.deliveries(all.ID))
+		team := s.createTeam("Kenari FC")
+		s.createPlayer(team.ID, "Agus Salim", 7)
+		s.dispatch()
+		if got := len(s.deliveries(all.ID)); got != before {
+			t.Errorf("subscription nonaktif tetap menerima event (%d -> %d)", before, got)
+		}
+
+		w = s.request(http.MethodGet, fmt.Sprintf("/webhooks/%d", all.ID), nil)
+		expectStatus(t, w, http.StatusOK)
+		w = s.request(http.MethodGet, "/webhooks", nil)
+		expectStatus(t, w, http.StatusOK)
+		if got := decode[[]model.WebhookSubscription](t, w); len(got) != 2 {
+			t.Errorf("len(webhooks) = %d, want 2", len(got))
+		}
+	})
+
+	t.Run("validation and not found", func(t *testing.T) {
+		valid := map[string]any{"url": receiver.URL, "secret": secret, "events": []string{"team.deleted"}}
+		with := func(key string, value any) map[string]any {
+			body := map[string]any{}
+			for k, v := range valid {
+				body[k] = v
+			}
+			body[key] = value
+			return body
+		}
+
+		expectStatus(t, s.request(http.MethodPost, "/webhooks", with("url", "ftp://example.com")), http.StatusBadRequest)
+		expectStatus(t, s.request(http.MethodPost, "/webhooks", with("url", "/relatif")), http.StatusBadRequest)
+		expectStatus(t, s.request(http.MethodPost, "/webhooks", with("secret", "pendek")), http.StatusBadRequest)
+		expectStatus(t, s.request(http.MethodPost, "/webhooks", with("events", []string{"match.started"})), http.StatusBadRequest)
+		expectStatus(t, s.request(http.MethodPost, "/webhooks", with("events", []string{})), http.StatusBadRequest)
+		expectStatus(t, s.request(http.MethodPut, fmt.Sprintf("/webhooks/%d", rosters.ID), with("secret", "pendek")), http.StatusBadRequest)
+
+		expectStatus(t, s.request(http.MethodGet, "/webhooks/abc", nil), http.StatusBadRequest)
+		expectStatus(t, s.request(http.MethodGet, "/webhooks/999", nil), http.StatusNotFound)
+		expectStatus(t, s.request(http.MethodPut, "/webhooks/999", valid), http.StatusNotFound)
+		expectStatus(t, s.request(http.MethodGet, "/webhooks/999/deliveries", nil), http.StatusNotFound)
+		expectStatus(t, s.request(http.MethodGet, fmt.Sprintf("/webhooks/%d/deliveries?limit=0", all.ID), nil), http.StatusBadRequest)
+		expectStatus(t, s.request(http.MethodPost, fmt.Sprintf("/webhooks/%d/deliveries/abc/redeliver", all.ID), nil), http.StatusBadRequest)
+		expectStatus(t, s.request(http.MethodPost, fmt.Sprintf("/webhooks/%d/deliveries/999/redeliver", all.ID), nil), http.StatusNotFound)
+
+		// Delivery milik subscription lain
+		other := s.deliveries(rosters.ID)[0]
+		expectStatus(t, s.request(http.MethodPost, fmt.Sprintf("/webhooks/%d/deliveries/%d/redeliver", all.ID, other.ID), nil), http.StatusNotFound)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		expectStatus(t, s.request(http.MethodDelete, fmt.Sprintf("/webhooks/%d", rosters.ID), nil), http.StatusOK)
+		expectStatus(t, s.request(http.MethodGet, fmt.Sprintf("/webhooks/%d", rosters.ID), nil), http.StatusNotFound)
+		expectStatus(t, s.request(http.MethodDelete, fmt.Sprintf("/webhooks/%d", rosters.ID), nil), http.StatusNotFound)
+	})
+}
@@ -0,0 +1,288 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"xyz-football-api/internal/api/handler"
+	"xyz-football-api/internal/model"
+
+	"golang.org/x/net/websocket"
+)
+
+// wsClient adalah koneksi WebSocket ke router test
+type wsClient struct {
+	t    *testing.T
+	conn *websocket.Conn
+}
+
+// dialWebSocket membuka koneksi ke /ws pada server dengan query dan header tambahan
+func (s *testServer) dialWebSocket(server *httptest.Server, query string, header http.Header) (*wsClient, error) {
+	s.t.Helper()
+	return s.dialWebSocketFrom(server, server.URL, query, header)
+}
+
+// dialWebSocketFrom seperti dialWebSocket tetapi dengan header Origin tertentu
+func (s *testServer) dialWebSocketFrom(server *httptest.Server, origin, query string, header http.Header) (*wsClient, error) {
+	s.t.Helper()
+
+	config, err := websocket.NewConfig("ws"+strings.TrimPrefix(server.URL, "http")+"/ws?"+query, origin)
+	if err != nil {
+		return nil, err
+	}
+	config.Header = header
+	conn, err := websocket.DialConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	s.recordHit(http.MethodGet, "/ws")
+	s.t.Cleanup(func() { conn.Close() })
+	return &wsClient{t: s.t, conn: conn}, nil
+}
+
+// connect membuka koneksi dengan token login di parameter query
+func (s *testServer) connect(server *httptest.Server) *wsClient {
+	s.t.Helper()
+	client, err := s.dialWebSocket(server, "token="+s.token, nil)
+	if err != nil {
+		s.t.Fatalf("gagal membuka WebSocket: %v", err)
+	}
+	return client
+}
+
+// send mengirim satu pesan JSON
+func (c *wsClient) send(msg any) {
+	c.t.Helper()
+	if err := websocket.JSON.Send(c.conn, msg); err != nil {
+		c.t.Fatalf("gagal mengirim pesan: %v", err)
+	}
+}
+
+// receive membaca satu pesan apa adanya, termasuk ping
+func (c *wsClient) receive(timeout time.Duration) (handler.WebSocketMessage, error) {
+	var msg handler.WebSocketMessage
+	c.conn.SetReadDeadline(time.Now().Add(timeout))
+	err := websocket.JSON.Receive(c.conn, &msg)
+	return msg, err
+}
+
+// next membaca pesan berikutnya selain ping; setiap ping dibalas pong
+func (c *wsClient) next() handler.WebSocketMessage {
+	c.t.Helper()
+	for {
+		msg, err := c.receive(2 * time.Second)
+		if err != nil {
+			c.t.Fatalf("gagal membaca pesan: %v", err)
+		}
+		if msg.Type != "ping" {
+			return msg
+		}
+		c.send(map[string]string{"action": "pong"})
+	}
+}
+
+// expectEvent membaca pesan berikutnya dan memastikan isinya event bertipe eventType dengan topic tertentu
+func (c *wsClient) expectEvent(eventType string, topics ...string) handler.WebSocketMessage {
+	c.t.Helper()
+	msg := c.next()
+	if msg.Type != "event" || msg.Event == nil || string(msg.Event.Type) != eventType || fmt.Sprint(msg.Topics) != fmt.Sprint(topics) {
+		c.t.Fatalf("pesan = %+v, want event %s %v", msg, eventType, topics)
+	}
+	return msg
+}
+
+// expectSubscribed membaca konfirmasi subscribe/unsubscribe dengan daftar topic lengkap
+func (c *wsClient) expectSubscribed(topics ...string) {
+	c.t.Helper()
+	if msg := c.next(); msg.Type != "subscribed" || fmt.Sprint(msg.Topics) != fmt.Sprint(topics) {
+		c.t.Fatalf("pesan = %+v, want subscribed %v", msg, topics)
+	}
+}
+
+func TestWebSocketRoutes(t *testing.T) {
+	s := newTestServer(t)
+	server := httptest.NewServer(s.router)
+	defer server.Close()
+
+	t.Run("authentication", func(t *testing.T) {
+		expectStatus(t, s.requestWithToken(http.MethodGet, "/ws", nil, ""), http.StatusUnauthorized)
+		expectStatus(t, s.request(http.MethodGet, "/ws", nil), http.StatusBadRequest)
+
+		if _, err := s.dialWebSocket(server, "", nil); err == nil {
+			t.Error("koneksi tanpa token harus ditolak")
+		}
+		if _, err := s.dialWebSocket(server, "token=salah", nil); err == nil {
+			t.Error("koneksi dengan token tidak valid harus ditolak")
+		}
+		client, err := s.dialWebSocket(server, "", http.Header{"Authorization": {"Bearer " + s.token}})
+		if err != nil {
+			t.Fatalf("koneksi dengan header Authorization gagal: %v", err)
+		}
+		client.send(map[string]any{"action": "subscribe", "topics": []string{"team:1"}})
+		client.expectSubscribed("team:1")
+	})
+
+	t.Run("origin", func(t *testing.T) {
+		if _, err := s.dialWebSocketFrom(server, "https://evil.example.com", "token="+s.token, nil); err == nil {
+			t.Error("koneksi dari origin lain harus ditolak")
+		}
+		if _, err := s.dialWebSocketFrom(server, "https://APP.example.com", "token="+s.token, nil); err != nil {
+			t.Errorf("koneksi dari origin di WS_ALLOWED_ORIGINS gagal: %v", err)
+		}
+	})
+
+	t.Run("token is not logged", func(t *testing.T) {
+		var logs bytes.Buffer
+		log.SetOutput(&logs)
+		defer log.SetOutput(io.Discard)
+
+		s.requestWithToken(http.MethodGet, "/ws?topic=a&token="+s.token, nil, "")
+		if strings.Contains(logs.String(), s.token) || !strings.Contains(logs.String(), "/ws?topic=a&token=REDACTED") {
+			t.Errorf("log = %q, want token disamarkan", logs.String())
+		}
+	})
+
+	t.Run("subscribed events are pushed", func(t *testing.T) {
+		home := s.createTeam("Garuda FC")
+		away := s.createTeam("Elang FC")
+		other := s.createTeam("Rajawali FC")
+		striker := s.createPlayer(home.ID, "Budi Santoso", 9)
+		match := s.createMatch(home.ID, away.ID, "2025-03-01T19:00:00+07:00")
+		s.dispatch()
+
+		matchTopic := fmt.Sprintf("match:%d", match.ID)
+		homeTopic := fmt.Sprintf("team:%d", home.ID)
+		otherTopic := fmt.Sprintf("team:%d", other.ID)
+
+		client := s.connect(server)
+		client.send(map[string]any{"action": "subscribe", "topics": []string{homeTopic, matchTopic}})
+		client.expectSubscribed(matchTopic, homeTopic)
+
+		expectStatus(t, s.request(http.MethodPost, fmt.Sprintf("/matches/%d/result", match.ID), map[string]any{
+			"home_score": 2,
+			"away_score": 0,
+			"goals": []any{
+				map[string]any{"player_id": striker.ID, "goal_time": 12},
+				map[string]any{"player_id": striker.ID, "goal_time": 77},
+			},
+		}), http.StatusOK)
+		s.dispatch()
+
+		completed := client.expectEvent("match.completed", matchTopic, homeTopic)
+		var data model.Match
+		json.Unmarshal(completed.Event.Data, &data)
+		if data.ID != match.ID || data.HomeScore != 2 || len(data.Goals) != 2 {
+			t.Errorf("data match.completed = %s", completed.Event.Data)
+		}
+		client.expectEvent("goal.recorded", matchTopic, homeTopic)
+		client.expectEvent("goal.recorded", matchTopic, homeTopic)
+
+		// Roster team lain tidak dikirim; roster team yang di-subscribe dikirim
+		s.createPlayer(other.ID, "Rudi Hartono", 10)
+		winger := s.createPlayer(home.ID, "Agus Salim", 7)
+		s.dispatch()
+		if msg := client.expectEvent("player.created", homeTopic); !strings.Contains(string(msg.Event.Data), "Agus Salim") {
+			t.Errorf("data player.created = %s", msg.Event.Data)
+		}
+
+		client.send(map[string]any{"action": "unsubscribe", "topics": []string{homeTopic}})
+		client.expectSubscribed(matchTopic)
+		client.send(map[string]any{"action": "subscribe", "topics": []string{otherTopic}})
+		client.expectSubscribed(matchTopic, otherTopic)
+
+		expectStatus(t, s.request(http.MethodDelete, fmt.Sprintf("/players/%d", winger.ID), nil), http.StatusOK)
+		expectStatus(t, s.request(http.MethodDelete, fmt.Sprintf("/teams/%d", other.ID), nil), http.StatusOK)
+		s.dispatch()
+		client.expectEvent("team.deleted", otherTopic)
+	})
+
+	t.Run("invalid messages", func(t *testing.T) {
+		client, err := s.dialWebSocket(server, "token="+s.token, http.Header{"Accept-Language": {"en"}})
+		if err != nil {
+			t.Fatalf("gagal membuka WebSocket: %v", err)
+		}
+
+		tests := []struct {
+			message any
+			code    string
+			text    string
+		}{
+			{"bukan json", "invalid_message", "Invalid message"},
+			{map[string]any{"action": "dance"}, "unknown_action", "Unknown action dance"},
+			{map[string]any{"action": "subscribe", "topics": []string{"season:1"}}, "invalid_topic", "Invalid topic season:1"},
+			{map[string]any{"action": "subscribe", "topics": []string{"match:0"}}, "invalid_topic", "Invalid topic match:0"},
+			{map[string]any{"action": "subscribe", "topics": []string{"team:01"}}, "invalid_topic", "Invalid topic team:01"},
+		}
+		for _, tt := range tests {
+			if text, ok := tt.message.(string); ok {
+				if err := websocket.Message.Send(client.conn, text); err != nil {
+					t.Fatalf("gagal mengirim pesan: %v", err)
+				}
+			} else {
+				client.send(tt.message)
+			}
+			if msg := client.next(); msg.Type != "error" || msg.Code != tt.code || msg.Message != tt.text {
+				t.Errorf("balasan %v = %+v, want %s %q", tt.message, msg, tt.code, tt.text)
+			}
+		}
+
+		// Koneksi tetap bisa dipakai setelah pesan yang salah
+		client.send(map[string]any{"action": "subscribe", "topics": []string{"competition:3"}})
+		client.expectSubscribed("competition:3")
+	})
+
+	t.Run("heartbeat", func(t *testing.T) {
+		client := s.connect(server)
+
+		// Balasan pong menjaga koneksi tetap hidup melewati batas diam (2x interval)
+		for range 4 {
+			msg, err := client.receive(time.Second)
+			if err != nil || msg.Type != "ping" {
+				t.Fatalf("pesan = %+v, %v; want ping", msg, err)
+			}
+			client.send(map[string]string{"action": "pong"})
+		}
+
+		// Client yang berhenti membalas diputus oleh server
+		for {
+			_, err := client.receive(2 * time.Second)
+			var netErr net.Error
+			if errors.As(err, &netErr) && netErr.Timeout() {
+				t.Fatal("server tidak menutup koneksi client yang diam")
+			}
+			if err != nil {
+				break
+			}
+		}
+	})
+
+	// Harus paling akhir: bus yang ditutup tidak bisa dipakai lagi
+	t.Run("server shutdown", func(t *testing.T) {
+		client := s.connect(server)
+		client.send(map[string]any{"action": "subscribe", "topics": []string{"match:1"}})
+		client.expectSubscribed("match:1")
+
+		s.bus.Close()
+		if msg := client.next(); msg.Type != "error" || msg.Code != "server_shutdown" {
+			t.Fatalf("pesan = %+v, want error server_shutdown", msg)
+		}
+		if _, err := client.receive(time.Second); err == nil {
+			t.Error("koneksi harus ditutup setelah server_shutdown")
+		}
+
+		// Koneksi baru selama shutdown langsung ditutup
+		late := s.connect(server)
+		if msg := late.next(); msg.Code != "server_shutdown" {
+			t.Errorf("pesan = %+v, want error server_shutdown", msg)
+		}
+	})
+}
@@ -0,0 +1,97 @@
+// Package apperror berisi error domain yang dikembalikan service dan handler.
+// Middleware ErrorHandler merender error ini menjadi response HTTP terstruktur
+// (status, code, dan detail field) tanpa membocorkan pesan database ke client.
+//
+// Message adalah message ID katalog i18n (teks bahasa Indonesia, boleh berisi verb fmt);
+// Args diformat setelah pesan diterjemahkan ke bahasa client.
+package apperror
+
+import "fmt"
+
+// Kind mengelompokkan error domain; setiap kind dipetakan ke satu status HTTP
+type Kind int
+
+const (
+	// KindValidation berarti input melanggar aturan validasi atau aturan bisnis
+	KindValidation Kind = iota + 1
+	// KindNotFound berarti resource yang diminta tidak ada
+	KindNotFound
+	// KindConflict berarti operasi bentrok dengan state resource saat ini
+	KindConflict
+	// KindForbidden berarti operasi tidak diizinkan
+	KindForbidden
+	// KindInternal berarti kegagalan tak terduga (database, dll.)
+	KindInternal
+)
+
+// CodeInternal adalah code untuk semua error internal
+const CodeInternal = "internal_error"
+
+// FieldError menjelaskan kesalahan pada satu field input
+type FieldError struct {
+	Field   string
+	Message string
+	Args    []interface{}
+}
+
+// Error adalah error domain. Code dan Message aman ditampilkan ke client,
+// sedangkan Err (penyebab) hanya untuk log.
+type Error struct {
+	Kind    Kind
+	Code    string
+	Message string
+	Args    []interface{}
+	Fields  []FieldError
+	Err     error
+}
+
+func (e *Error) Error() string {
+	message := e.Message
+	if len(e.Args) > 0 {
+		message = fmt.Sprintf(message, e.Args...)
+	}
+	if e.Err != nil {
+		return message + ": " + e.Err.Error()
+	}
+	return message
+}
+
+// Unwrap mengembalikan penyebab error
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// NotFound membuat error resource tidak ditemukan
+func NotFound(code, message string) *Error {
+	return &Error{Kind: KindNotFound, Code: code, Message: message}
+}
+
+// Conflict membuat error bentrok dengan state resource
+func Conflict(code, message string) *Error {
+	return &Error{Kind: KindConflict, Code: code, Message: message}
+}
+
+// Validation membuat error validasi, opsional dengan detail per field
+func Validation(code, message string, fields ...FieldError) *Error {
+	return &Error{Kind: KindValidation, Code: code, Message: message, Fields: fields}
+}
+
+// Validationf membuat error validasi dengan pesan terformat
+func Validationf(code, format string, args ...interface{}) *Error {
+	return &Error{Kind: KindValidation, Code: code, Message: format, Args: args}
+}
+
+// Conflictf membuat error conflict dengan pesan terformat
+func Conflictf(code, format string, args ...interface{}) *Error {
+	return &Error{Kind: KindConflict, Code: code, Message: format, Args: args}
+}
+
+// Forbidden membuat error operasi tidak diizinkan
+func Forbidden(code, message string) *Error {
+	return &Error{Kind: KindForbidden, Code: code, Message: message}
+}
+
+// Internal membungkus kegagalan tak terduga. Hanya message yang dikirim ke client.
+func Internal(message string, err error) *Error {
+	return &Error{Kind: KindInternal, Code: CodeInternal, Message: message, Err: err}
+}
@@ -0,0 +1,168 @@
+package event
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// Prefix topic yang bisa di-subscribe. Topic berbentuk "<prefix>:<id>", mis. "match:12".
+const (
+	MatchTopicPrefix       = "match"
+	TeamTopicPrefix        = "team"
+	CompetitionTopicPrefix = "competition"
+)
+
+// Topic membuat nama topic dari prefix dan ID
+func Topic(prefix string, id uint) string {
+	return fmt.Sprintf("%s:%d", prefix, id)
+}
+
+// Message adalah event yang diterima subscriber beserta topic miliknya
+type Message struct {
+	Event  Event
+	Topics []string
+}
+
+// Bus menyebarkan event ke subscriber di dalam proses yang sama (mis. koneksi WebSocket).
+// Broadcast tidak pernah menunggu subscriber: subscriber yang buffer-nya penuh
+// dilepas dari bus sehingga satu client lambat tidak menghambat client lain.
+type Bus struct {
+	mu          sync.Mutex
+	subscribers map[*Subscription]struct{}
+	closed      bool
+}
+
+// NewBus membuat Bus tanpa subscriber
+func NewBus() *Bus {
+	return &Bus{subscribers: make(map[*Subscription]struct{})}
+}
+
+// Subscription adalah satu subscriber Bus dengan daftar topic yang bisa diubah kapan saja
+type Subscription struct {
+	bus        *Bus
+	messages   chan Message
+	topics     map[string]struct{}
+	overflowed bool
+	closed     bool
+}
+
+// Subscribe mendaftarkan subscriber baru dengan buffer sebanyak buffer pesan.
+// Subscriber belum menerima apa pun sampai topic ditambahkan.
+func (b *Bus) Subscribe(buffer int) *Subscription {
+	sub := &Subscription{
+		bus:      b,
+		messages: make(chan Message, buffer),
+		topics:   make(map[string]struct{}),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		sub.closed = true
+		close(sub.messages)
+		return sub
+	}
+	b.subscribers[sub] = struct{}{}
+	return sub
+}
+
+// Close melepas semua subscriber saat server dimatikan sehingga koneksi live
+// (WebSocket, stream gRPC) berakhir. Subscriber yang dibuat setelahnya langsung tertutup.
+func (b *Bus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for sub := range b.subscribers {
+		b.remove(sub)
+	}
+}
+
+// Broadcast mengirim event ke setiap subscriber yang berlangganan salah satu topic
+func (b *Bus) Broadcast(e Event, topics []string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for sub := range b.subscribers {
+		var matched []string
+		for _, topic := range topics {
+			if _, ok := sub.topics[topic]; ok {
+				matched = append(matched, topic)
+			}
+		}
+		if len(matched) == 0 {
+			continue
+		}
+
+		select {
+		case sub.messages <- Message{Event: e, Topics: matched}:
+		default:
+			sub.overflowed = true
+			b.remove(sub)
+		}
+	}
+}
+
+// remove melepas subscriber dan menutup channel-nya. Harus dipanggil dengan b.mu terkunci.
+func (b *Bus) remove(sub *Subscription) {
+	if sub.closed {
+		return
+	}
+	sub.closed = true
+	delete(b.subscribers, sub)
+	close(sub.messages)
+}
+
+// Messages mengembalikan channel pesan. Channel ditutup saat Close dipanggil, saat bus
+// ditutup, atau saat subscriber dilepas karena buffer penuh.
+func (s *Subscription) Messages() <-chan Message {
+	return s.messages
+}
+
+// Add menambahkan topic ke subscription
+func (s *Subscription) Add(topics ...string) {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+
+	for _, topic := range topics {
+		s.topics[topic] = struct{}{}
+	}
+}
+
+// Remove menghapus topic dari subscription
+func (s *Subscription) Remove(topics ...string) {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+
+	for _, topic := range topics {
+		delete(s.topics, topic)
+	}
+}
+
+// Topics mengembalikan topic yang sedang di-subscribe, terurut
+func (s *Subscription) Topics() []string {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+
+	topics := make([]string, 0, len(s.topics))
+	for topic := range s.topics {
+		topics = append(topics, topic)
+	}
+	slices.Sort(topics)
+	return topics
+}
+
+// Overflowed melaporkan apakah subscriber dilepas karena tidak membaca pesan secepat event masuk
+func (s *Subscription) Overflowed() bool {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+	return s.overflowed
+}
+
+// Close melepas subscriber dari bus
+func (s *Subscription) Close() {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+	s.bus.remove(s)
+}
@@ -0,0 +1,74 @@
+// Package event mendefinisikan domain event yang dikirim ke sistem lain (mis. webhook
+// atau message broker) setiap kali data penting berubah.
+package event
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+// Type adalah nama tipe event dengan format <entitas>.<aksi>
+type Type string
+
+// Tipe event yang didukung
+const (
+	MatchCompleted       Type = "match.completed"
+	GoalRecorded         Type = "goal.recorded"
+	PlayerCreated        Type = "player.created"
+	PlayerUpdated        Type = "player.updated"
+	PlayerDeleted        Type = "player.deleted"
+	TeamDeleted          Type = "team.deleted"
+	MatchScheduled       Type = "match.scheduled"
+	CompetitionCompleted Type = "competition.completed"
+)
+
+// Types adalah semua tipe event yang bisa di-subscribe
+var Types = []Type{MatchCompleted, GoalRecorded, PlayerCreated, PlayerUpdated, PlayerDeleted, TeamDeleted, MatchScheduled, CompetitionCompleted}
+
+// Valid memeriksa apakah t adalah tipe event yang dikenal
+func (t Type) Valid() bool {
+	for _, known := range Types {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Event adalah satu kejadian domain. Data berisi entitas yang berubah dalam bentuk JSON.
+type Event struct {
+	ID         string          `json:"id"`
+	Type       Type            `json:"type"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// New membuat event baru dengan ID acak. Data di-encode ke JSON saat itu juga
+// sehingga perubahan entitas setelahnya tidak ikut terkirim.
+func New(eventType Type, data any) (Event, error) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, err
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return Event{}, err
+	}
+	return Event{
+		ID:         hex.EncodeToString(id),
+		Type:       eventType,
+		OccurredAt: time.Now().UTC(),
+		Data:       encoded,
+	}, nil
+}
+
+// Publisher menerima event dari outbox dispatcher setelah perubahan datanya tersimpan.
+// Event yang gagal dipublikasikan akan dikirim ulang, dan event yang sudah berhasil bisa
+// terkirim lagi jika proses mati sebelum statusnya tercatat (at-least-once), sehingga
+// implementasi sebaiknya idempoten terhadap Event.ID.
+type Publisher interface {
+	Publish(event Event) error
+}
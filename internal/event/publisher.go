@@ -0,0 +1,54 @@
+package event
+
+import (
+	"encoding/json"
+	"log"
+)
+
+// LogPublisher mencatat setiap event ke log aplikasi
+type LogPublisher struct{}
+
+// Publish menulis tipe, ID, dan data event ke log
+func (LogPublisher) Publish(e Event) error {
+	log.Printf("EVENT %s %s %s", e.Type, e.ID, e.Data)
+	return nil
+}
+
+// Publishers meneruskan event ke beberapa publisher secara berurutan dan berhenti
+// pada error pertama. Event yang gagal diulang dari awal, jadi publisher sebelumnya
+// bisa menerima event yang sama lebih dari sekali.
+type Publishers []Publisher
+
+// Publish meneruskan event ke setiap publisher
+func (p Publishers) Publish(e Event) error {
+	for _, publisher := range p {
+		if err := publisher.Publish(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BrokerClient adalah kontrak minimal client message broker. *nats.Conn memenuhi
+// interface ini secara langsung; untuk Kafka cukup bungkus writer dengan method
+// Publish yang mengirim data ke topic bernama subject.
+type BrokerClient interface {
+	Publish(subject string, data []byte) error
+}
+
+// BrokerPublisher mengirim event ke message broker seperti NATS atau Kafka.
+// Subject berupa Prefix diikuti tipe event (mis. "football.match.completed")
+// dan datanya adalah JSON Event lengkap.
+type BrokerPublisher struct {
+	Client BrokerClient
+	Prefix string
+}
+
+// Publish meng-encode event lalu mengirimnya ke broker
+func (p BrokerPublisher) Publish(e Event) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	return p.Client.Publish(p.Prefix+string(e.Type), data)
+}
@@ -0,0 +1,169 @@
+package grpcapi
+
+import (
+	"time"
+	"xyz-football-api/internal/apperror"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+	"xyz-football-api/pkg/footballpb"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Ukuran halaman RPC list
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// Error argument pagination
+var (
+	errInvalidPageSize = apperror.Validationf("bad_request", "Parameter page_size harus antara 0 dan %d", maxPageSize)
+	errInvalidOffset   = apperror.Validation("bad_request", "Parameter offset tidak boleh negatif")
+)
+
+// pageOf membaca page_size dan offset; page_size 0 berarti ukuran default
+func pageOf(pageSize, offset int32) (repository.Page, error) {
+	if pageSize < 0 || pageSize > maxPageSize {
+		return repository.Page{}, errInvalidPageSize
+	}
+	if offset < 0 {
+		return repository.Page{}, errInvalidOffset
+	}
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	return repository.Page{Limit: int(pageSize), Offset: int(offset)}, nil
+}
+
+// matchStatuses memetakan status match antara model dan protobuf
+var matchStatuses = map[model.MatchStatus]footballpb.MatchStatus{
+	model.MatchStatusScheduled: footballpb.MatchStatus_MATCH_STATUS_SCHEDULED,
+	model.MatchStatusCompleted: footballpb.MatchStatus_MATCH_STATUS_COMPLETED,
+	model.MatchStatusCancelled: footballpb.MatchStatus_MATCH_STATUS_CANCELLED,
+}
+
+// matchResults memetakan hasil match dari model ke protobuf
+var matchResults = map[model.MatchResult]footballpb.MatchResult{
+	model.MatchResultNotFinished:      footballpb.MatchResult_MATCH_RESULT_NOT_FINISHED,
+	model.MatchResultHomeWin:          footballpb.MatchResult_MATCH_RESULT_HOME_WIN,
+	model.MatchResultAwayWin:          footballpb.MatchResult_MATCH_RESULT_AWAY_WIN,
+	model.MatchResultHomeWinPenalties: footballpb.MatchResult_MATCH_RESULT_HOME_WIN_PENALTIES,
+	model.MatchResultAwayWinPenalties: footballpb.MatchResult_MATCH_RESULT_AWAY_WIN_PENALTIES,
+	model.MatchResultDraw:             footballpb.MatchResult_MATCH_RESULT_DRAW,
+}
+
+// modelStatus mengubah status protobuf menjadi status model; UNSPECIFIED menghasilkan nil
+func modelStatus(status footballpb.MatchStatus) *model.MatchStatus {
+	for value, pb := range matchStatuses {
+		if pb == status {
+			return &value
+		}
+	}
+	return nil
+}
+
+func teamProto(t *model.Team) *footballpb.Team {
+	return &footballpb.Team{
+		Id:                  uint64(t.ID),
+		Name:                t.Name,
+		LogoUrl:             t.LogoURL,
+		FoundedYear:         int32Ptr(t.FoundedYear),
+		HeadquartersAddress: t.HeadquartersAddress,
+		HeadquartersCity:    t.HeadquartersCity,
+		HomeVenueId:         uint64Ptr(t.HomeVenueID),
+		CreatedAt:           timestamppb.New(t.CreatedAt),
+		UpdatedAt:           timestamppb.New(t.UpdatedAt),
+	}
+}
+
+func playerProto(p *model.Player) *footballpb.Player {
+	return &footballpb.Player{
+		Id:           uint64(p.ID),
+		TeamId:       uint64(p.TeamID),
+		Name:         p.Name,
+		Position:     p.Position,
+		JerseyNumber: int32(p.JerseyNumber),
+		HeightCm:     int32Ptr(p.HeightCm),
+		WeightKg:     int32Ptr(p.WeightKg),
+		CreatedAt:    timestamppb.New(p.CreatedAt),
+		UpdatedAt:    timestamppb.New(p.UpdatedAt),
+	}
+}
+
+// matchProto mengubah match beserta goals (jika diberikan) menjadi pesan protobuf
+func matchProto(m *model.Match, goals []model.Goal) *footballpb.Match {
+	pb := &footballpb.Match{
+		Id:            uint64(m.ID),
+		HomeTeamId:    uint64(m.HomeTeamID),
+		AwayTeamId:    uint64(m.AwayTeamID),
+		MatchDatetime: timestamppb.New(m.MatchDatetime),
+		Status:        matchStatuses[m.Status],
+		Result:        matchResults[m.Result()],
+		HomeScore:     int32(m.HomeScore),
+		AwayScore:     int32(m.AwayScore),
+		ExtraTime:     m.ExtraTime,
+		HomePenalties: int32Ptr(m.HomePenalties),
+		AwayPenalties: int32Ptr(m.AwayPenalties),
+		Attendance:    int32Ptr(m.Attendance),
+		VenueId:       uint64Ptr(m.VenueID),
+		CompetitionId: uint64Ptr(m.CompetitionID),
+		CreatedAt:     timestamppb.New(m.CreatedAt),
+		UpdatedAt:     timestamppb.New(m.UpdatedAt),
+	}
+	for i := range goals {
+		pb.Goals = append(pb.Goals, goalProto(&goals[i]))
+	}
+	return pb
+}
+
+func goalProto(g *model.Goal) *footballpb.Goal {
+	return &footballpb.Goal{
+		Id:        uint64(g.ID),
+		MatchId:   uint64(g.MatchID),
+		PlayerId:  uint64(g.PlayerID),
+		GoalTime:  int32(g.GoalTime),
+		CreatedAt: timestamppb.New(g.CreatedAt),
+	}
+}
+
+func int32Ptr(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	n := int32(*v)
+	return &n
+}
+
+func uint64Ptr(v *uint) *uint64 {
+	if v == nil {
+		return nil
+	}
+	n := uint64(*v)
+	return &n
+}
+
+func intPtr(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	n := int(*v)
+	return &n
+}
+
+func uintPtr(v *uint64) *uint {
+	if v == nil {
+		return nil
+	}
+	n := uint(*v)
+	return &n
+}
+
+// rfc3339 memformat timestamp protobuf seperti field match_datetime pada request REST;
+// timestamp kosong menghasilkan string kosong sehingga validasi required REST berlaku
+func rfc3339(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.AsTime().Format(time.RFC3339)
+}
@@ -0,0 +1,89 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"log"
+	"xyz-football-api/internal/apperror"
+	"xyz-football-api/pkg/i18n"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// errorDomain adalah domain ErrorInfo pada detail status error
+const errorDomain = "xyz-football-api"
+
+// statusCodes memetakan kind error domain ke code status gRPC, padanan status HTTP pada REST
+var statusCodes = map[apperror.Kind]codes.Code{
+	apperror.KindValidation: codes.InvalidArgument,
+	apperror.KindNotFound:   codes.NotFound,
+	apperror.KindConflict:   codes.FailedPrecondition,
+	apperror.KindForbidden:  codes.PermissionDenied,
+	apperror.KindInternal:   codes.Internal,
+}
+
+// language mengembalikan bahasa response dari metadata accept-language
+func language(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get("accept-language"); len(values) > 0 {
+		return i18n.Negotiate(values[0])
+	}
+	return i18n.Negotiate("")
+}
+
+// statusError mengubah error menjadi status gRPC dalam bahasa request. Error selain
+// *apperror.Error dianggap kegagalan internal: failureMsg dikirim ke client dan err hanya
+// dicatat di log, seperti abortWithError pada REST. Code REST dikirim sebagai ErrorInfo.Reason
+// dan detail field sebagai BadRequest.FieldViolations.
+func statusError(ctx context.Context, err error, failureMsg string) error {
+	var appErr *apperror.Error
+	if !errors.As(err, &appErr) {
+		appErr = apperror.Internal(failureMsg, err)
+	}
+	if appErr.Kind == apperror.KindInternal {
+		method, _ := grpc.Method(ctx)
+		log.Printf("gRPC %s: %v", method, appErr)
+	}
+
+	code, ok := statusCodes[appErr.Kind]
+	if !ok {
+		code = codes.Internal
+	}
+	lang := language(ctx)
+	st := status.New(code, i18n.Translate(lang, appErr.Message, appErr.Args...))
+
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: appErr.Code, Domain: errorDomain}}
+	if len(appErr.Fields) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, f := range appErr.Fields {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       f.Field,
+				Description: i18n.Translate(lang, f.Message, f.Args...),
+			})
+		}
+		details = append(details, badRequest)
+	}
+	if detailed, err := st.WithDetails(details...); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// unauthenticated membuat status UNAUTHENTICATED dengan code unauthorized seperti REST
+func unauthenticated(ctx context.Context, message string) error {
+	return newStatus(ctx, codes.Unauthenticated, "unauthorized", message)
+}
+
+// newStatus membuat status error di luar error domain, dengan reason sebagai ErrorInfo.Reason
+func newStatus(ctx context.Context, code codes.Code, reason, message string) error {
+	st := status.New(code, i18n.Translate(language(ctx), message))
+	if detailed, err := st.WithDetails(&errdetails.ErrorInfo{Reason: reason, Domain: errorDomain}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
@@ -0,0 +1,211 @@
+package grpcapi
+
+import (
+	"context"
+	"encoding/json"
+	"xyz-football-api/internal/api/handler"
+	"xyz-football-api/internal/event"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+	"xyz-football-api/internal/service"
+	"xyz-football-api/pkg/footballpb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// matchServer mengimplementasikan footballpb.MatchServiceServer
+type matchServer struct {
+	footballpb.UnimplementedMatchServiceServer
+	repos        *repository.Repositories
+	matchService *service.MatchService
+	bus          *event.Bus
+	// bufferSize adalah jumlah update tertunda per stream WatchMatch
+	bufferSize int
+}
+
+// matchWithGoals mengambil match beserta goals-nya dalam bentuk protobuf
+func (s *matchServer) matchWithGoals(id uint) (*footballpb.Match, error) {
+	match, err := s.matchService.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	goals, err := s.repos.Goals.FindByMatchIDs([]uint{id})
+	if err != nil {
+		return nil, err
+	}
+	return matchProto(match, goals), nil
+}
+
+func (s *matchServer) CreateMatch(ctx context.Context, req *footballpb.CreateMatchRequest) (*footballpb.Match, error) {
+	request := handler.CreateMatchRequest{
+		HomeTeamID:    uint(req.GetHomeTeamId()),
+		AwayTeamID:    uint(req.GetAwayTeamId()),
+		MatchDatetime: rfc3339(req.GetMatchDatetime()),
+		VenueID:       uintPtr(req.VenueId),
+	}
+	if err := handler.ValidateRequest(&request, "Data match tidak valid"); err != nil {
+		return nil, statusError(ctx, err, "")
+	}
+	input, err := request.Input()
+	if err != nil {
+		return nil, statusError(ctx, err, "")
+	}
+
+	match, err := s.matchService.Create(input)
+	if err != nil {
+		return nil, statusError(ctx, err, "Gagal membuat match")
+	}
+	return matchProto(match, nil), nil
+}
+
+func (s *matchServer) GetMatch(ctx context.Context, req *footballpb.GetMatchRequest) (*footballpb.Match, error) {
+	match, err := s.matchWithGoals(uint(req.GetId()))
+	if err != nil {
+		return nil, statusError(ctx, err, "Gagal mengambil data match")
+	}
+	return match, nil
+}
+
+func (s *matchServer) ListMatches(ctx context.Context, req *footballpb.ListMatchesRequest) (*footballpb.ListMatchesResponse, error) {
+	page, err := pageOf(req.GetPageSize(), req.GetOffset())
+	if err != nil {
+		return nil, statusError(ctx, err, "")
+	}
+	page.Descending = req.GetDescending()
+
+	filter := repository.MatchFilter{TeamID: uintPtr(req.TeamId), CompetitionID: uintPtr(req.CompetitionId)}
+	if status := modelStatus(req.GetStatus()); status != nil {
+		filter.Status = *status
+	}
+	matches, total, err := s.repos.Matches.FindPage(filter, page)
+	if err != nil {
+		return nil, statusError(ctx, err, "Gagal mengambil data matches")
+	}
+
+	resp := &footballpb.ListMatchesResponse{TotalCount: total}
+	for i := range matches {
+		resp.Matches = append(resp.Matches, matchProto(&matches[i], nil))
+	}
+	return resp, nil
+}
+
+func (s *matchServer) RescheduleMatch(ctx context.Context, req *footballpb.RescheduleMatchRequest) (*footballpb.Match, error) {
+	request := handler.RescheduleMatchRequest{VenueID: uintPtr(req.VenueId), Status: modelStatus(req.GetStatus())}
+	if req.MatchDatetime != nil {
+		matchDatetime := rfc3339(req.MatchDatetime)
+		request.MatchDatetime = &matchDatetime
+	}
+	input, err := request.Input()
+	if err != nil {
+		return nil, statusError(ctx, err, "")
+	}
+
+	match, err := s.matchService.Reschedule(uint(req.GetId()), input)
+	if err != nil {
+		return nil, statusError(ctx, err, "Gagal mengubah jadwal match")
+	}
+	return matchProto(match, nil), nil
+}
+
+func (s *matchServer) ReportMatchResult(ctx context.Context, req *footballpb.ReportMatchResultRequest) (*footballpb.Match, error) {
+	request := handler.ReportMatchResultRequest{
+		HomeScore:  int(req.GetHomeScore()),
+		AwayScore:  int(req.GetAwayScore()),
+		Attendance: intPtr(req.Attendance),
+		ExtraTime:  req.GetExtraTime(),
+		Goals:      make([]handler.GoalRequest, 0, len(req.GetGoals())),
+	}
+	if penalties := req.GetPenalties(); penalties != nil {
+		request.Penalties = &handler.PenaltyShootout{Home: int(penalties.GetHome()), Away: int(penalties.GetAway())}
+	}
+	for _, goal := range req.GetGoals() {
+		request.Goals = append(request.Goals, handler.GoalRequest{PlayerID: uint(goal.GetPlayerId()), GoalTime: int(goal.GetGoalTime())})
+	}
+	if err := handler.ValidateRequest(&request, "Data hasil match tidak valid"); err != nil {
+		return nil, statusError(ctx, err, "")
+	}
+
+	if err := s.matchService.ReportResult(uint(req.GetId()), request.Input()); err != nil {
+		return nil, statusError(ctx, err, "Gagal menyimpan hasil match")
+	}
+	match, err := s.matchWithGoals(uint(req.GetId()))
+	if err != nil {
+		return nil, statusError(ctx, err, "Gagal mengambil data match")
+	}
+	return match, nil
+}
+
+// WatchMatch mengirim snapshot match lalu update dari event match.completed dan goal.recorded.
+// Event berasal dari bus yang sama dengan WebSocket /ws (diisi OutboxDispatcher).
+func (s *matchServer) WatchMatch(req *footballpb.WatchMatchRequest, stream footballpb.MatchService_WatchMatchServer) error {
+	ctx := stream.Context()
+	id := uint(req.GetMatchId())
+
+	// Subscribe sebelum snapshot dibaca agar update di antaranya tidak terlewat
+	sub := s.bus.Subscribe(s.bufferSize)
+	defer sub.Close()
+	sub.Add(event.Topic(event.MatchTopicPrefix, id))
+
+	snapshot, err := s.matchWithGoals(id)
+	if err != nil {
+		return statusError(ctx, err, "Gagal mengambil data match")
+	}
+	if err := stream.Send(&footballpb.MatchUpdate{
+		Type:       footballpb.MatchUpdate_TYPE_SNAPSHOT,
+		OccurredAt: timestamppb.Now(),
+		Match:      snapshot,
+	}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-sub.Messages():
+			if !ok {
+				// Bus melepas subscriber yang buffer-nya penuh, atau ditutup karena server dimatikan
+				if sub.Overflowed() {
+					return newStatus(ctx, codes.ResourceExhausted, "slow_consumer", "Koneksi terlalu lambat menerima event dan akan ditutup")
+				}
+				return newStatus(ctx, codes.Unavailable, "server_shutdown", "Server sedang dimatikan, silakan sambung ulang")
+			}
+			update, err := s.matchUpdate(id, msg.Event)
+			if err != nil {
+				return statusError(ctx, err, "Gagal mengambil data match")
+			}
+			if update == nil {
+				continue
+			}
+			if err := stream.Send(update); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// matchUpdate membuat pesan stream dari event; event lain pada topic match diabaikan (nil)
+func (s *matchServer) matchUpdate(id uint, e event.Event) (*footballpb.MatchUpdate, error) {
+	update := &footballpb.MatchUpdate{EventId: e.ID, OccurredAt: timestamppb.New(e.OccurredAt)}
+	switch e.Type {
+	case event.MatchCompleted:
+		update.Type = footballpb.MatchUpdate_TYPE_MATCH_COMPLETED
+	case event.GoalRecorded:
+		var goal model.Goal
+		if err := json.Unmarshal(e.Data, &goal); err != nil {
+			return nil, err
+		}
+		update.Type = footballpb.MatchUpdate_TYPE_GOAL_RECORDED
+		update.Goal = goalProto(&goal)
+	default:
+		return nil, nil
+	}
+
+	match, err := s.matchWithGoals(id)
+	if err != nil {
+		return nil, err
+	}
+	update.Match = match
+	return update, nil
+}
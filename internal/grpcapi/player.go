@@ -0,0 +1,87 @@
+package grpcapi
+
+import (
+	"context"
+	"xyz-football-api/internal/api/handler"
+	"xyz-football-api/internal/repository"
+	"xyz-football-api/internal/service"
+	"xyz-football-api/pkg/footballpb"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// playerServer mengimplementasikan footballpb.PlayerServiceServer
+type playerServer struct {
+	footballpb.UnimplementedPlayerServiceServer
+	repos         *repository.Repositories
+	playerService *service.PlayerService
+}
+
+func (s *playerServer) CreatePlayer(ctx context.Context, req *footballpb.CreatePlayerRequest) (*footballpb.Player, error) {
+	input := handler.CreatePlayerRequest{
+		Name:         req.GetName(),
+		TeamID:       uint(req.GetTeamId()),
+		Position:     req.GetPosition(),
+		JerseyNumber: int(req.GetJerseyNumber()),
+		HeightCm:     intPtr(req.HeightCm),
+		WeightKg:     intPtr(req.WeightKg),
+	}
+	if err := handler.ValidateRequest(&input, "Data player tidak valid"); err != nil {
+		return nil, statusError(ctx, err, "")
+	}
+
+	player := input.Player()
+	if err := s.playerService.Create(&player); err != nil {
+		return nil, statusError(ctx, err, "Gagal membuat player")
+	}
+	return playerProto(&player), nil
+}
+
+func (s *playerServer) GetPlayer(ctx context.Context, req *footballpb.GetPlayerRequest) (*footballpb.Player, error) {
+	player, err := s.playerService.Get(uint(req.GetId()))
+	if err != nil {
+		return nil, statusError(ctx, err, "Gagal mengambil data player")
+	}
+	return playerProto(player), nil
+}
+
+func (s *playerServer) ListPlayers(ctx context.Context, req *footballpb.ListPlayersRequest) (*footballpb.ListPlayersResponse, error) {
+	page, err := pageOf(req.GetPageSize(), req.GetOffset())
+	if err != nil {
+		return nil, statusError(ctx, err, "")
+	}
+
+	filter := repository.PlayerFilter{TeamID: uintPtr(req.TeamId), Position: req.GetPosition()}
+	players, total, err := s.repos.Players.FindPage(filter, page)
+	if err != nil {
+		return nil, statusError(ctx, err, "Gagal mengambil data players")
+	}
+
+	resp := &footballpb.ListPlayersResponse{TotalCount: total}
+	for i := range players {
+		resp.Players = append(resp.Players, playerProto(&players[i]))
+	}
+	return resp, nil
+}
+
+func (s *playerServer) UpdatePlayer(ctx context.Context, req *footballpb.UpdatePlayerRequest) (*footballpb.Player, error) {
+	input := handler.UpdatePlayerRequest{
+		Name:         req.Name,
+		HeightCm:     intPtr(req.HeightCm),
+		WeightKg:     intPtr(req.WeightKg),
+		Position:     req.Position,
+		JerseyNumber: intPtr(req.JerseyNumber),
+	}
+	player, err := s.playerService.Update(uint(req.GetId()), input.Update())
+	if err != nil {
+		return nil, statusError(ctx, err, "Gagal memperbarui player")
+	}
+	return playerProto(player), nil
+}
+
+func (s *playerServer) DeletePlayer(ctx context.Context, req *footballpb.DeletePlayerRequest) (*emptypb.Empty, error) {
+	if err := s.playerService.Delete(uint(req.GetId())); err != nil {
+		return nil, statusError(ctx, err, "Gagal menghapus player")
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,95 @@
+// Package grpcapi menyediakan server gRPC untuk team, player, dan match di samping REST API.
+// RPC memakai service, validasi request, dan JWT yang sama dengan route gin sehingga kedua
+// transport menghasilkan aturan dan error yang sama. Definisi protobuf ada di proto/football/v1.
+package grpcapi
+
+import (
+	"context"
+	"strings"
+	"xyz-football-api/config"
+	"xyz-football-api/internal/event"
+	"xyz-football-api/internal/repository"
+	"xyz-football-api/internal/service"
+	"xyz-football-api/pkg/footballpb"
+	"xyz-football-api/pkg/utils"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+)
+
+// publicServices adalah service gRPC yang bisa dipanggil tanpa JWT. Reflection sengaja
+// tidak termasuk agar skema service tidak bisa dibaca tanpa token.
+var publicServices = []string{"/grpc.health.v1.Health/"}
+
+// NewServer membuat server gRPC dengan semua service terdaftar.
+// Repository dan bus sama dengan yang dipakai SetupRouter.
+func NewServer(cfg *config.Config, repos *repository.Repositories, bus *event.Bus) *grpc.Server {
+	bracketService := service.NewBracketService(repos.Competitions, repos.Teams, repos.Outbox, repos.Transactor)
+	teamService := service.NewTeamService(repos.Teams, repos.Venues, repos.Transactor)
+	playerService := service.NewPlayerService(repos.Players, repos.Teams, repos.Availabilities, repos.Transactor)
+	matchService := service.NewMatchService(repos.Matches, repos.Teams, repos.Players, repos.Goals, repos.Venues, repos.Competitions, repos.Availabilities, bracketService, repos.Transactor)
+
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(unaryAuth(cfg.JWT.Secret)),
+		grpc.StreamInterceptor(streamAuth(cfg.JWT.Secret)),
+	)
+	footballpb.RegisterTeamServiceServer(server, &teamServer{repos: repos, teamService: teamService})
+	footballpb.RegisterPlayerServiceServer(server, &playerServer{repos: repos, playerService: playerService})
+	footballpb.RegisterMatchServiceServer(server, &matchServer{
+		repos:        repos,
+		matchService: matchService,
+		bus:          bus,
+		bufferSize:   cfg.GRPC.StreamBufferSize,
+	})
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	reflection.Register(server)
+	return server
+}
+
+// unaryAuth memvalidasi JWT setiap unary RPC
+func unaryAuth(secret string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := authenticate(ctx, info.FullMethod, secret); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// streamAuth memvalidasi JWT setiap streaming RPC
+func streamAuth(secret string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authenticate(ss.Context(), info.FullMethod, secret); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// authenticate memvalidasi token dari metadata "authorization: Bearer <token>" dengan
+// aturan dan pesan yang sama seperti AuthMiddleware
+func authenticate(ctx context.Context, method, secret string) error {
+	for _, prefix := range publicServices {
+		if strings.HasPrefix(method, prefix) {
+			return nil
+		}
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 || values[0] == "" {
+		return unauthenticated(ctx, "Token tidak ditemukan")
+	}
+
+	scheme, token, ok := strings.Cut(values[0], " ")
+	if !ok || scheme != "Bearer" {
+		return unauthenticated(ctx, "Format token tidak valid")
+	}
+	if _, err := utils.ValidateJWT(token, secret); err != nil {
+		return unauthenticated(ctx, "Token tidak valid atau sudah expired")
+	}
+	return nil
+}
@@ -0,0 +1,421 @@
+package grpcapi_test
+
+import (
+	"context"
+	"io"
+	"log"
+	"net"
+	"os"
+	"testing"
+	"time"
+	"xyz-football-api/config"
+	"xyz-football-api/internal/event"
+	"xyz-football-api/internal/grpcapi"
+	"xyz-football-api/internal/repository"
+	"xyz-football-api/internal/repository/memory"
+	"xyz-football-api/internal/service"
+	"xyz-football-api/pkg/footballpb"
+	"xyz-football-api/pkg/utils"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var testConfig = &config.Config{
+	JWT:    config.JWTConfig{Secret: "test-secret", ExpirationHours: 1},
+	GRPC:   config.GRPCConfig{StreamBufferSize: 16},
+	Outbox: config.OutboxConfig{ParkAfter: time.Hour},
+}
+
+func TestMain(m *testing.M) {
+	log.SetOutput(io.Discard)
+	os.Exit(m.Run())
+}
+
+// testClient adalah client gRPC ke server di atas repository in-memory
+type testClient struct {
+	t       *testing.T
+	conn    *grpc.ClientConn
+	repos   *repository.Repositories
+	bus     *event.Bus
+	token   string
+	teams   footballpb.TeamServiceClient
+	players footballpb.PlayerServiceClient
+	matches footballpb.MatchServiceClient
+}
+
+// newTestClient menjalankan server gRPC lewat bufconn dan membuat client dengan token valid
+func newTestClient(t *testing.T) *testClient {
+	t.Helper()
+
+	repos := memory.NewRepositories()
+	bus := event.NewBus()
+	listener := bufconn.Listen(1 << 20)
+	server := grpcapi.NewServer(testConfig, repos, bus)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("gagal membuat client: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	token, err := utils.GenerateJWT("admin", testConfig.JWT.Secret, testConfig.JWT.ExpirationHours)
+	if err != nil {
+		t.Fatalf("gagal membuat token: %v", err)
+	}
+	return &testClient{
+		t:       t,
+		conn:    conn,
+		repos:   repos,
+		bus:     bus,
+		token:   token,
+		teams:   footballpb.NewTeamServiceClient(conn),
+		players: footballpb.NewPlayerServiceClient(conn),
+		matches: footballpb.NewMatchServiceClient(conn),
+	}
+}
+
+// ctx mengembalikan context dengan token dan bahasa (kosong = default)
+func (c *testClient) ctx(lang string) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	c.t.Cleanup(cancel)
+	md := metadata.Pairs("authorization", "Bearer "+c.token)
+	if lang != "" {
+		md.Append("accept-language", lang)
+	}
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// dispatch memublikasikan event di outbox ke bus, seperti OutboxDispatcher di background
+func (c *testClient) dispatch() {
+	c.t.Helper()
+	publishers := event.Publishers{service.NewLiveFeed(c.repos.Matches, c.bus)}
+	if _, err := service.NewOutboxDispatcher(c.repos.Outbox, publishers, testConfig.Outbox.ParkAfter).DispatchPending(time.Now().UTC()); err != nil {
+		c.t.Fatalf("DispatchPending error: %v", err)
+	}
+}
+
+func (c *testClient) createTeam(name string) *footballpb.Team {
+	c.t.Helper()
+	team, err := c.teams.CreateTeam(c.ctx(""), &footballpb.CreateTeamRequest{Team: &footballpb.TeamInput{Name: name}})
+	if err != nil {
+		c.t.Fatalf("CreateTeam error: %v", err)
+	}
+	return team
+}
+
+func (c *testClient) createPlayer(teamID uint64, name string, jerseyNumber int32) *footballpb.Player {
+	c.t.Helper()
+	player, err := c.players.CreatePlayer(c.ctx(""), &footballpb.CreatePlayerRequest{
+		TeamId:       teamID,
+		Name:         name,
+		Position:     "penyerang",
+		JerseyNumber: jerseyNumber,
+	})
+	if err != nil {
+		c.t.Fatalf("CreatePlayer error: %v", err)
+	}
+	return player
+}
+
+func (c *testClient) createMatch(homeTeamID, awayTeamID uint64) *footballpb.Match {
+	c.t.Helper()
+	match, err := c.matches.CreateMatch(c.ctx(""), &footballpb.CreateMatchRequest{
+		HomeTeamId:    homeTeamID,
+		AwayTeamId:    awayTeamID,
+		MatchDatetime: timestamppb.New(time.Date(2024, 8, 1, 19, 0, 0, 0, time.UTC)),
+	})
+	if err != nil {
+		c.t.Fatalf("CreateMatch error: %v", err)
+	}
+	return match
+}
+
+// expectStatus memeriksa code status gRPC dan reason ErrorInfo, lalu mengembalikan status-nya
+func expectStatus(t *testing.T, err error, code codes.Code, reason string) *status.Status {
+	t.Helper()
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != code {
+		t.Fatalf("error = %v, want code %s", err, code)
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			if info.Reason != reason {
+				t.Errorf("reason = %q, want %q", info.Reason, reason)
+			}
+			return st
+		}
+	}
+	t.Errorf("status tanpa ErrorInfo: %v", st)
+	return st
+}
+
+// fieldViolations mengembalikan detail field error dari status
+func fieldViolations(st *status.Status) map[string]string {
+	fields := make(map[string]string)
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, v := range badRequest.FieldViolations {
+				fields[v.Field] = v.Description
+			}
+		}
+	}
+	return fields
+}
+
+func TestAuthentication(t *testing.T) {
+	c := newTestClient(t)
+
+	tests := []struct {
+		name    string
+		md      metadata.MD
+		message string
+	}{
+		{"missing token", metadata.MD{}, "Token tidak ditemukan"},
+		{"wrong scheme", metadata.Pairs("authorization", "Token "+c.token), "Format token tidak valid"},
+		{"invalid token", metadata.Pairs("authorization", "Bearer bukan-jwt"), "Token tidak valid atau sudah expired"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewOutgoingContext(context.Background(), tt.md)
+			_, err := c.teams.ListTeams(ctx, &footballpb.ListTeamsRequest{})
+			if st := expectStatus(t, err, codes.Unauthenticated, "unauthorized"); st.Message() != tt.message {
+				t.Errorf("message = %q, want %q", st.Message(), tt.message)
+			}
+		})
+	}
+
+	t.Run("reflection requires token", func(t *testing.T) {
+		listServices := func(ctx context.Context) (*reflectionpb.ServerReflectionResponse, error) {
+			stream, err := reflectionpb.NewServerReflectionClient(c.conn).ServerReflectionInfo(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if err := stream.Send(&reflectionpb.ServerReflectionRequest{MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{}}); err != nil {
+				return nil, err
+			}
+			return stream.Recv()
+		}
+
+		_, err := listServices(context.Background())
+		expectStatus(t, err, codes.Unauthenticated, "unauthorized")
+
+		resp, err := listServices(c.ctx(""))
+		if err != nil || len(resp.GetListServicesResponse().GetService()) == 0 {
+			t.Errorf("ListServices dengan token = %v, %v", resp, err)
+		}
+	})
+
+	t.Run("health check is public", func(t *testing.T) {
+		resp, err := healthpb.NewHealthClient(c.conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+		if err != nil || resp.Status != healthpb.HealthCheckResponse_SERVING {
+			t.Errorf("Check = %v, %v", resp, err)
+		}
+	})
+}
+
+func TestTeamAndPlayerRPCs(t *testing.T) {
+	c := newTestClient(t)
+	garuda := c.createTeam("Garuda FC")
+	c.createTeam("Elang United")
+
+	t.Run("get, list and update team", func(t *testing.T) {
+		got, err := c.teams.GetTeam(c.ctx(""), &footballpb.GetTeamRequest{Id: garuda.Id})
+		if err != nil || got.Name != "Garuda FC" {
+			t.Fatalf("GetTeam = %v, %v", got, err)
+		}
+
+		list, err := c.teams.ListTeams(c.ctx(""), &footballpb.ListTeamsRequest{Name: "fc"})
+		if err != nil || list.TotalCount != 1 || list.Teams[0].Id != garuda.Id {
+			t.Fatalf("ListTeams = %v, %v", list, err)
+		}
+
+		updated, err := c.teams.UpdateTeam(c.ctx(""), &footballpb.UpdateTeamRequest{
+			Id:   garuda.Id,
+			Team: &footballpb.TeamInput{Name: "Garuda Muda", HeadquartersCity: proto.String("Bandung")},
+		})
+		if err != nil || updated.Name != "Garuda Muda" || updated.GetHeadquartersCity() != "Bandung" {
+			t.Errorf("UpdateTeam = %v, %v", updated, err)
+		}
+	})
+
+	t.Run("team errors", func(t *testing.T) {
+		_, err := c.teams.CreateTeam(c.ctx("en"), &footballpb.CreateTeamRequest{})
+		st := expectStatus(t, err, codes.InvalidArgument, "validation_failed")
+		if st.Message() != "Invalid team data" || fieldViolations(st)["name"] != "is required" {
+			t.Errorf("status = %v, fields = %v", st.Message(), fieldViolations(st))
+		}
+
+		_, err = c.teams.GetTeam(c.ctx(""), &footballpb.GetTeamRequest{Id: 999})
+		expectStatus(t, err, codes.NotFound, "team_not_found")
+
+		_, err = c.teams.ListTeams(c.ctx(""), &footballpb.ListTeamsRequest{PageSize: 500})
+		expectStatus(t, err, codes.InvalidArgument, "bad_request")
+	})
+
+	t.Run("player lifecycle", func(t *testing.T) {
+		player := c.createPlayer(garuda.Id, "Budi Santoso", 10)
+		c.createPlayer(garuda.Id, "Ahmad Dahlan", 8)
+
+		_, err := c.players.CreatePlayer(c.ctx("en"), &footballpb.CreatePlayerRequest{TeamId: garuda.Id, Name: "X", Position: "kiper", JerseyNumber: 100})
+		st := expectStatus(t, err, codes.InvalidArgument, "validation_failed")
+		fields := fieldViolations(st)
+		if len(fields) != 2 || fields["jersey_number"] != "must be at most 99" || fields["position"] == "" {
+			t.Errorf("fields = %v", fields)
+		}
+
+		_, err = c.players.CreatePlayer(c.ctx(""), &footballpb.CreatePlayerRequest{TeamId: garuda.Id, Name: "X", Position: "bertahan", JerseyNumber: 10})
+		expectStatus(t, err, codes.FailedPrecondition, "jersey_number_taken")
+
+		updated, err := c.players.UpdatePlayer(c.ctx(""), &footballpb.UpdatePlayerRequest{Id: player.Id, Position: proto.String("gelandang"), JerseyNumber: proto.Int32(7)})
+		if err != nil || updated.Position != "gelandang" || updated.JerseyNumber != 7 || updated.Name != "Budi Santoso" {
+			t.Fatalf("UpdatePlayer = %v, %v", updated, err)
+		}
+
+		list, err := c.players.ListPlayers(c.ctx(""), &footballpb.ListPlayersRequest{TeamId: proto.Uint64(garuda.Id), PageSize: 1})
+		if err != nil || list.TotalCount != 2 || len(list.Players) != 1 {
+			t.Fatalf("ListPlayers = %v, %v", list, err)
+		}
+
+		if _, err := c.players.DeletePlayer(c.ctx(""), &footballpb.DeletePlayerRequest{Id: player.Id}); err != nil {
+			t.Fatalf("DeletePlayer error: %v", err)
+		}
+		_, err = c.players.GetPlayer(c.ctx(""), &footballpb.GetPlayerRequest{Id: player.Id})
+		expectStatus(t, err, codes.NotFound, "player_not_found")
+	})
+}
+
+func TestMatchRPCs(t *testing.T) {
+	c := newTestClient(t)
+	home := c.createTeam("Garuda FC")
+	away := c.createTeam("Elang United")
+	scorer := c.createPlayer(home.Id, "Budi Santoso", 10)
+	match := c.createMatch(home.Id, away.Id)
+
+	if match.Status != footballpb.MatchStatus_MATCH_STATUS_SCHEDULED || match.Result != footballpb.MatchResult_MATCH_RESULT_NOT_FINISHED {
+		t.Fatalf("CreateMatch = %v", match)
+	}
+
+	t.Run("create validation", func(t *testing.T) {
+		_, err := c.matches.CreateMatch(c.ctx("en"), &footballpb.CreateMatchRequest{HomeTeamId: home.Id, AwayTeamId: away.Id})
+		st := expectStatus(t, err, codes.InvalidArgument, "validation_failed")
+		if fieldViolations(st)["match_datetime"] != "is required" {
+			t.Errorf("fields = %v", fieldViolations(st))
+		}
+	})
+
+	t.Run("report result", func(t *testing.T) {
+		_, err := c.matches.ReportMatchResult(c.ctx(""), &footballpb.ReportMatchResultRequest{
+			Id:        match.Id,
+			HomeScore: 1,
+			Goals:     []*footballpb.GoalInput{{PlayerId: scorer.Id, GoalTime: 0}},
+		})
+		st := expectStatus(t, err, codes.InvalidArgument, "validation_failed")
+		if _, ok := fieldViolations(st)["goals[0].goal_time"]; !ok {
+			t.Errorf("fields = %v", fieldViolations(st))
+		}
+
+		reported, err := c.matches.ReportMatchResult(c.ctx(""), &footballpb.ReportMatchResultRequest{
+			Id:         match.Id,
+			HomeScore:  1,
+			Attendance: proto.Int32(25000),
+			Goals:      []*footballpb.GoalInput{{PlayerId: scorer.Id, GoalTime: 30}},
+		})
+		if err != nil {
+			t.Fatalf("ReportMatchResult error: %v", err)
+		}
+		if reported.Result != footballpb.MatchResult_MATCH_RESULT_HOME_WIN || reported.GetAttendance() != 25000 || len(reported.Goals) != 1 {
+			t.Errorf("ReportMatchResult = %v", reported)
+		}
+
+		_, err = c.matches.RescheduleMatch(c.ctx(""), &footballpb.RescheduleMatchRequest{Id: match.Id, MatchDatetime: timestamppb.Now()})
+		expectStatus(t, err, codes.FailedPrecondition, "match_already_reported")
+	})
+
+	t.Run("get and list", func(t *testing.T) {
+		got, err := c.matches.GetMatch(c.ctx(""), &footballpb.GetMatchRequest{Id: match.Id})
+		if err != nil || len(got.Goals) != 1 || got.Goals[0].PlayerId != scorer.Id {
+			t.Fatalf("GetMatch = %v, %v", got, err)
+		}
+
+		c.createMatch(away.Id, home.Id)
+		list, err := c.matches.ListMatches(c.ctx(""), &footballpb.ListMatchesRequest{
+			TeamId: proto.Uint64(home.Id),
+			Status: footballpb.MatchStatus_MATCH_STATUS_SCHEDULED,
+		})
+		if err != nil || list.TotalCount != 1 || list.Matches[0].HomeTeamId != away.Id {
+			t.Errorf("ListMatches = %v, %v", list, err)
+		}
+
+		_, err = c.matches.GetMatch(c.ctx(""), &footballpb.GetMatchRequest{Id: 999})
+		expectStatus(t, err, codes.NotFound, "match_not_found")
+	})
+}
+
+func TestWatchMatch(t *testing.T) {
+	c := newTestClient(t)
+	home := c.createTeam("Garuda FC")
+	away := c.createTeam("Elang United")
+	scorer := c.createPlayer(home.Id, "Budi Santoso", 10)
+	match := c.createMatch(home.Id, away.Id)
+
+	stream, err := c.matches.WatchMatch(c.ctx(""), &footballpb.WatchMatchRequest{MatchId: match.Id})
+	if err != nil {
+		t.Fatalf("WatchMatch error: %v", err)
+	}
+	snapshot, err := stream.Recv()
+	if err != nil || snapshot.Type != footballpb.MatchUpdate_TYPE_SNAPSHOT || snapshot.Match.Id != match.Id {
+		t.Fatalf("snapshot = %v, %v", snapshot, err)
+	}
+
+	_, err = c.matches.ReportMatchResult(c.ctx(""), &footballpb.ReportMatchResultRequest{
+		Id:        match.Id,
+		HomeScore: 1,
+		Goals:     []*footballpb.GoalInput{{PlayerId: scorer.Id, GoalTime: 55}},
+	})
+	if err != nil {
+		t.Fatalf("ReportMatchResult error: %v", err)
+	}
+	c.dispatch()
+
+	completed, err := stream.Recv()
+	if err != nil || completed.Type != footballpb.MatchUpdate_TYPE_MATCH_COMPLETED || completed.EventId == "" {
+		t.Fatalf("update = %v, %v", completed, err)
+	}
+	if completed.Match.Status != footballpb.MatchStatus_MATCH_STATUS_COMPLETED || completed.Match.HomeScore != 1 {
+		t.Errorf("match = %v", completed.Match)
+	}
+
+	goal, err := stream.Recv()
+	if err != nil || goal.Type != footballpb.MatchUpdate_TYPE_GOAL_RECORDED || goal.Goal.GetGoalTime() != 55 || goal.Goal.GetPlayerId() != scorer.Id {
+		t.Fatalf("update = %v, %v", goal, err)
+	}
+
+	t.Run("unknown match", func(t *testing.T) {
+		stream, err := c.matches.WatchMatch(c.ctx(""), &footballpb.WatchMatchRequest{MatchId: 999})
+		if err == nil {
+			_, err = stream.Recv()
+		}
+		expectStatus(t, err, codes.NotFound, "match_not_found")
+	})
+
+	t.Run("server shutdown", func(t *testing.T) {
+		c.bus.Close()
+		_, err := stream.Recv()
+		expectStatus(t, err, codes.Unavailable, "server_shutdown")
+	})
+}
@@ -0,0 +1,92 @@
+package grpcapi
+
+import (
+	"context"
+	"xyz-football-api/internal/api/handler"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+	"xyz-football-api/internal/service"
+	"xyz-football-api/pkg/footballpb"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// teamServer mengimplementasikan footballpb.TeamServiceServer
+type teamServer struct {
+	footballpb.UnimplementedTeamServiceServer
+	repos       *repository.Repositories
+	teamService *service.TeamService
+}
+
+// teamInput memetakan input protobuf ke model lalu memvalidasinya seperti body POST /teams
+func teamInput(input *footballpb.TeamInput) (model.Team, error) {
+	if input == nil {
+		input = &footballpb.TeamInput{}
+	}
+	team := model.Team{
+		Name:                input.GetName(),
+		LogoURL:             input.LogoUrl,
+		FoundedYear:         intPtr(input.FoundedYear),
+		HeadquartersAddress: input.HeadquartersAddress,
+		HeadquartersCity:    input.HeadquartersCity,
+		HomeVenueID:         uintPtr(input.HomeVenueId),
+	}
+	return team, handler.ValidateRequest(&team, "Data team tidak valid")
+}
+
+func (s *teamServer) CreateTeam(ctx context.Context, req *footballpb.CreateTeamRequest) (*footballpb.Team, error) {
+	team, err := teamInput(req.GetTeam())
+	if err != nil {
+		return nil, statusError(ctx, err, "")
+	}
+	if err := s.teamService.Create(&team); err != nil {
+		return nil, statusError(ctx, err, "Gagal membuat team")
+	}
+	return teamProto(&team), nil
+}
+
+func (s *teamServer) GetTeam(ctx context.Context, req *footballpb.GetTeamRequest) (*footballpb.Team, error) {
+	team, err := s.teamService.Get(uint(req.GetId()))
+	if err != nil {
+		return nil, statusError(ctx, err, "Gagal mengambil data team")
+	}
+	return teamProto(team), nil
+}
+
+func (s *teamServer) ListTeams(ctx context.Context, req *footballpb.ListTeamsRequest) (*footballpb.ListTeamsResponse, error) {
+	page, err := pageOf(req.GetPageSize(), req.GetOffset())
+	if err != nil {
+		return nil, statusError(ctx, err, "")
+	}
+
+	filter := repository.TeamFilter{Name: req.GetName(), City: req.GetCity()}
+	teams, total, err := s.repos.Teams.FindPage(filter, page)
+	if err != nil {
+		return nil, statusError(ctx, err, "Gagal mengambil data teams")
+	}
+
+	resp := &footballpb.ListTeamsResponse{TotalCount: total}
+	for i := range teams {
+		resp.Teams = append(resp.Teams, teamProto(&teams[i]))
+	}
+	return resp, nil
+}
+
+func (s *teamServer) UpdateTeam(ctx context.Context, req *footballpb.UpdateTeamRequest) (*footballpb.Team, error) {
+	changes, err := teamInput(req.GetTeam())
+	if err != nil {
+		return nil, statusError(ctx, err, "")
+	}
+	team, err := s.teamService.Update(uint(req.GetId()), changes)
+	if err != nil {
+		return nil, statusError(ctx, err, "Gagal memperbarui team")
+	}
+	return teamProto(team), nil
+}
+
+func (s *teamServer) DeleteTeam(ctx context.Context, req *footballpb.DeleteTeamRequest) (*emptypb.Empty, error) {
+	if err := s.teamService.Delete(uint(req.GetId())); err != nil {
+		return nil, statusError(ctx, err, "Gagal menghapus team")
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// CalendarToken merepresentasikan tabel calendar_tokens di database.
+// Setiap user memiliki paling banyak satu token feed kalender; yang disimpan hanya hash SHA-256
+// dari token sehingga token asli tidak bisa dibaca dari database.
+type CalendarToken struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	Username  string    `gorm:"type:varchar(255);not null;uniqueIndex" json:"username"`
+	TokenHash string    `gorm:"type:varchar(64);not null;uniqueIndex" json:"-"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName menentukan nama tabel untuk model CalendarToken
+func (CalendarToken) TableName() string {
+	return "calendar_tokens"
+}
@@ -0,0 +1,150 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// CompetitionFormat merepresentasikan format kompetisi
+type CompetitionFormat string
+
+const (
+	CompetitionFormatCup           CompetitionFormat = "cup"
+	CompetitionFormatGroupKnockout CompetitionFormat = "group_knockout"
+)
+
+// CompetitionSeeding merepresentasikan cara penempatan tim di bracket
+type CompetitionSeeding string
+
+const (
+	CompetitionSeedingSeeded CompetitionSeeding = "seeded"
+	CompetitionSeedingDrawn  CompetitionSeeding = "drawn"
+)
+
+// CompetitionStatus merepresentasikan status kompetisi
+type CompetitionStatus string
+
+const (
+	CompetitionStatusDraft      CompetitionStatus = "draft"
+	CompetitionStatusInProgress CompetitionStatus = "in_progress"
+	CompetitionStatusCompleted  CompetitionStatus = "completed"
+)
+
+// Competition merepresentasikan tabel competitions di database
+type Competition struct {
+	ID                 uint               `gorm:"primaryKey" json:"id"`
+	Name               string             `gorm:"type:varchar(255);not null" json:"name"`
+	Format             CompetitionFormat  `gorm:"type:varchar(50);not null;check:format IN ('cup', 'group_knockout')" json:"format"`
+	Seeding            CompetitionSeeding `gorm:"type:varchar(50);not null;default:'seeded';check:seeding IN ('seeded', 'drawn')" json:"seeding"`
+	TwoLegged          bool               `gorm:"not null;default:false" json:"two_legged"`
+	AwayGoalsRule      bool               `gorm:"not null;default:false" json:"away_goals_rule"`
+	StartDatetime      time.Time          `gorm:"not null" json:"start_datetime"`
+	RoundIntervalDays  int                `gorm:"not null;default:7" json:"round_interval_days"`
+	LegIntervalDays    int                `gorm:"not null;default:3" json:"leg_interval_days"`
+	GroupCount         int                `gorm:"not null;default:0" json:"group_count,omitempty"`
+	QualifiersPerGroup int                `gorm:"not null;default:0" json:"qualifiers_per_group,omitempty"`
+	// KnockoutStartDatetime diisi saat babak knockout dibuat setelah fase grup selesai
+	KnockoutStartDatetime *time.Time        `json:"knockout_start_datetime,omitempty"`
+	Status                CompetitionStatus `gorm:"type:varchar(50);not null;default:'draft';check:status IN ('draft', 'in_progress', 'completed')" json:"status"`
+	ChampionTeamID        *uint             `json:"champion_team_id,omitempty"`
+	CreatedAt             time.Time         `json:"created_at"`
+	UpdatedAt             time.Time         `json:"updated_at"`
+	DeletedAt             gorm.DeletedAt    `gorm:"index" json:"-"`
+
+	// Relasi
+	Teams        []CompetitionTeam  `gorm:"foreignKey:CompetitionID" json:"teams,omitempty"`
+	Groups       []CompetitionGroup `gorm:"foreignKey:CompetitionID" json:"-"`
+	Ties         []CupTie           `gorm:"foreignKey:CompetitionID" json:"-"`
+	ChampionTeam *Team              `gorm:"foreignKey:ChampionTeamID" json:"champion_team,omitempty"`
+}
+
+// TableName menentukan nama tabel untuk model Competition
+func (Competition) TableName() string {
+	return "competitions"
+}
+
+// KnockoutStart mengembalikan waktu kick-off babak pertama knockout
+func (c *Competition) KnockoutStart() time.Time {
+	if c.KnockoutStartDatetime != nil {
+		return *c.KnockoutStartDatetime
+	}
+	return c.StartDatetime
+}
+
+// CompetitionTeam merepresentasikan tabel competition_teams (peserta kompetisi)
+type CompetitionTeam struct {
+	ID            uint  `gorm:"primaryKey" json:"id"`
+	CompetitionID uint  `gorm:"not null;uniqueIndex:idx_competition_teams_competition_team" json:"competition_id"`
+	TeamID        uint  `gorm:"not null;uniqueIndex:idx_competition_teams_competition_team" json:"team_id"`
+	Seed          int   `gorm:"not null" json:"seed"`
+	GroupID       *uint `gorm:"index" json:"group_id,omitempty"`
+
+	// Relasi
+	Team Team `gorm:"foreignKey:TeamID" json:"team,omitempty"`
+}
+
+// TableName menentukan nama tabel untuk model CompetitionTeam
+func (CompetitionTeam) TableName() string {
+	return "competition_teams"
+}
+
+// CompetitionGroup merepresentasikan tabel competition_groups (grup pada fase grup)
+type CompetitionGroup struct {
+	ID            uint   `gorm:"primaryKey" json:"id"`
+	CompetitionID uint   `gorm:"not null;uniqueIndex:idx_competition_groups_name" json:"competition_id"`
+	Name          string `gorm:"type:varchar(50);not null;uniqueIndex:idx_competition_groups_name" json:"name"`
+
+	// Relasi
+	Teams   []CompetitionTeam `gorm:"foreignKey:GroupID" json:"teams,omitempty"`
+	Matches []Match           `gorm:"foreignKey:GroupID" json:"matches,omitempty"`
+}
+
+// TableName menentukan nama tabel untuk model CompetitionGroup
+func (CompetitionGroup) TableName() string {
+	return "competition_groups"
+}
+
+// TeamIDs mengembalikan team ID anggota grup
+func (g CompetitionGroup) TeamIDs() []uint {
+	ids := make([]uint, 0, len(g.Teams))
+	for _, ct := range g.Teams {
+		ids = append(ids, ct.TeamID)
+	}
+	return ids
+}
+
+// TeamNames mengembalikan peta team ID ke nama tim anggota grup (Teams.Team harus di-preload)
+func (g CompetitionGroup) TeamNames() map[uint]string {
+	names := make(map[uint]string, len(g.Teams))
+	for _, ct := range g.Teams {
+		names[ct.TeamID] = ct.Team.Name
+	}
+	return names
+}
+
+// CupTie merepresentasikan tabel cup_ties (satu slot pertemuan dalam bracket knockout).
+// Round dimulai dari 1 (babak pertama) dan Position dimulai dari 0 di setiap babak;
+// pemenang tie di posisi p akan maju ke posisi p/2 pada babak berikutnya.
+type CupTie struct {
+	ID            uint      `gorm:"primaryKey" json:"id"`
+	CompetitionID uint      `gorm:"not null;uniqueIndex:idx_cup_ties_slot" json:"competition_id"`
+	Round         int       `gorm:"not null;uniqueIndex:idx_cup_ties_slot" json:"round"`
+	Position      int       `gorm:"not null;uniqueIndex:idx_cup_ties_slot" json:"position"`
+	HomeTeamID    *uint     `json:"home_team_id,omitempty"`
+	AwayTeamID    *uint     `json:"away_team_id,omitempty"`
+	WinnerTeamID  *uint     `json:"winner_team_id,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+
+	// Relasi
+	HomeTeam   *Team   `gorm:"foreignKey:HomeTeamID" json:"home_team,omitempty"`
+	AwayTeam   *Team   `gorm:"foreignKey:AwayTeamID" json:"away_team,omitempty"`
+	WinnerTeam *Team   `gorm:"foreignKey:WinnerTeamID" json:"winner_team,omitempty"`
+	Matches    []Match `gorm:"foreignKey:TieID" json:"matches,omitempty"`
+}
+
+// TableName menentukan nama tabel untuk model CupTie
+func (CupTie) TableName() string {
+	return "cup_ties"
+}
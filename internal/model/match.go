@@ -15,26 +15,70 @@ const (
 	MatchStatusCancelled MatchStatus = "cancelled"
 )
 
+// MatchResult adalah hasil akhir pertandingan dalam bentuk enum yang stabil untuk dibaca client
+type MatchResult string
+
+const (
+	MatchResultNotFinished      MatchResult = "not_finished"
+	MatchResultHomeWin          MatchResult = "home_win"
+	MatchResultAwayWin          MatchResult = "away_win"
+	MatchResultHomeWinPenalties MatchResult = "home_win_penalties"
+	MatchResultAwayWinPenalties MatchResult = "away_win_penalties"
+	MatchResultDraw             MatchResult = "draw"
+)
+
 // Match merepresentasikan tabel matches di database
 type Match struct {
-	ID            uint           `gorm:"primaryKey" json:"id"`
-	HomeTeamID    uint           `gorm:"not null" json:"home_team_id" binding:"required"`
-	AwayTeamID    uint           `gorm:"not null" json:"away_team_id" binding:"required"`
-	MatchDatetime time.Time      `gorm:"not null" json:"match_datetime" binding:"required"`
-	Status        MatchStatus    `gorm:"type:varchar(50);not null;default:'scheduled';check:status IN ('scheduled', 'completed', 'cancelled')" json:"status"`
-	HomeScore     int            `gorm:"default:0" json:"home_score"`
-	AwayScore     int            `gorm:"default:0" json:"away_score"`
-	CreatedAt     time.Time      `json:"created_at"`
-	UpdatedAt     time.Time      `json:"updated_at"`
-	DeletedAt     gorm.DeletedAt `gorm:"index" json:"-"`
+	ID            uint        `gorm:"primaryKey" json:"id"`
+	HomeTeamID    uint        `gorm:"not null" json:"home_team_id" binding:"required"`
+	AwayTeamID    uint        `gorm:"not null" json:"away_team_id" binding:"required"`
+	MatchDatetime time.Time   `gorm:"not null" json:"match_datetime" binding:"required"`
+	Status        MatchStatus `gorm:"type:varchar(50);not null;default:'scheduled';check:status IN ('scheduled', 'completed', 'cancelled')" json:"status"`
+	HomeScore     int         `gorm:"default:0" json:"home_score"`
+	AwayScore     int         `gorm:"default:0" json:"away_score"`
+	VenueID       *uint       `json:"venue_id,omitempty"`
+	Attendance    *int        `json:"attendance,omitempty"`
+	CompetitionID *uint       `gorm:"index" json:"competition_id,omitempty"`
+	GroupID       *uint       `gorm:"index" json:"group_id,omitempty"`
+	TieID         *uint       `gorm:"index" json:"tie_id,omitempty"`
+	Leg           *int        `json:"leg,omitempty"`
+	ExtraTime     bool        `gorm:"not null;default:false" json:"extra_time"`
+	HomePenalties *int        `json:"home_penalties,omitempty"`
+	AwayPenalties *int        `json:"away_penalties,omitempty"`
+	// ScheduleVersion naik setiap kali jadwal, venue, atau status match diubah (SEQUENCE di feed kalender)
+	ScheduleVersion int            `gorm:"not null;default:0" json:"schedule_version"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	DeletedAt       gorm.DeletedAt `gorm:"index" json:"-"`
 
 	// Relasi
-	HomeTeam Team   `gorm:"foreignKey:HomeTeamID" json:"home_team,omitempty"`
-	AwayTeam Team   `gorm:"foreignKey:AwayTeamID" json:"away_team,omitempty"`
-	Goals    []Goal `gorm:"foreignKey:MatchID" json:"goals,omitempty"`
+	HomeTeam  Team            `gorm:"foreignKey:HomeTeamID" json:"home_team,omitempty"`
+	AwayTeam  Team            `gorm:"foreignKey:AwayTeamID" json:"away_team,omitempty"`
+	Venue     *Venue          `gorm:"foreignKey:VenueID" json:"venue,omitempty"`
+	Goals     []Goal          `gorm:"foreignKey:MatchID" json:"goals,omitempty"`
+	Officials []MatchOfficial `gorm:"foreignKey:MatchID" json:"officials,omitempty"`
 }
 
 // TableName menentukan nama tabel untuk model Match
 func (Match) TableName() string {
 	return "matches"
 }
+
+// Result menentukan hasil akhir pertandingan dari skor dan adu penalti
+func (m Match) Result() MatchResult {
+	switch {
+	case m.Status != MatchStatusCompleted:
+		return MatchResultNotFinished
+	case m.HomeScore > m.AwayScore:
+		return MatchResultHomeWin
+	case m.AwayScore > m.HomeScore:
+		return MatchResultAwayWin
+	case m.HomePenalties != nil && m.AwayPenalties != nil:
+		if *m.HomePenalties > *m.AwayPenalties {
+			return MatchResultHomeWinPenalties
+		}
+		return MatchResultAwayWinPenalties
+	default:
+		return MatchResultDraw
+	}
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// OfficialRole merepresentasikan peran perangkat pertandingan
+type OfficialRole string
+
+const (
+	OfficialRoleReferee          OfficialRole = "referee"
+	OfficialRoleAssistantReferee OfficialRole = "assistant_referee"
+	OfficialRoleFourthOfficial   OfficialRole = "fourth_official"
+	OfficialRoleVAR              OfficialRole = "var"
+)
+
+// MaxPerMatch mengembalikan jumlah maksimal official dengan peran ini dalam satu pertandingan
+func (r OfficialRole) MaxPerMatch() int {
+	if r == OfficialRoleAssistantReferee {
+		return 2
+	}
+	return 1
+}
+
+// Official merepresentasikan tabel officials (wasit dan perangkat pertandingan) di database
+type Official struct {
+	ID           uint           `gorm:"primaryKey" json:"id"`
+	Name         string         `gorm:"type:varchar(255);not null" json:"name" binding:"required"`
+	LicenseLevel *string        `gorm:"type:varchar(50)" json:"license_level,omitempty"`
+	City         *string        `gorm:"type:varchar(100)" json:"city,omitempty"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+	DeletedAt    gorm.DeletedAt `gorm:"index" json:"-"`
+
+	// Relasi
+	// ConflictedTeams berisi tim yang tidak boleh dipimpin official ini (mis. mantan klub atau domisili)
+	ConflictedTeams []Team `gorm:"many2many:official_team_conflicts" json:"conflicted_teams,omitempty"`
+}
+
+// TableName menentukan nama tabel untuk model Official
+func (Official) TableName() string {
+	return "officials"
+}
+
+// MatchOfficial merepresentasikan tabel match_officials (penugasan official ke pertandingan)
+type MatchOfficial struct {
+	ID         uint         `gorm:"primaryKey" json:"id"`
+	MatchID    uint         `gorm:"not null;uniqueIndex:idx_match_officials_match_official" json:"match_id"`
+	OfficialID uint         `gorm:"not null;uniqueIndex:idx_match_officials_match_official" json:"official_id"`
+	Role       OfficialRole `gorm:"type:varchar(50);not null;check:role IN ('referee', 'assistant_referee', 'fourth_official', 'var')" json:"role"`
+	CreatedAt  time.Time    `json:"created_at"`
+
+	// Relasi
+	Match    Match    `gorm:"foreignKey:MatchID" json:"-"`
+	Official Official `gorm:"foreignKey:OfficialID" json:"official,omitempty"`
+}
+
+// TableName menentukan nama tabel untuk model MatchOfficial
+func (MatchOfficial) TableName() string {
+	return "match_officials"
+}
@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+// OutboxEvent merepresentasikan tabel outbox_events: event domain yang ditulis dalam
+// transaksi yang sama dengan perubahan datanya. PublishedAt kosong selama event belum
+// berhasil dipublikasikan dispatcher. Event yang gagal dicoba lagi setelah NextAttemptAt;
+// event yang terus gagal sejak FailingSince melewati batas waktu diparkir (ParkedAt terisi)
+// sampai dikembalikan ke antrean oleh admin.
+type OutboxEvent struct {
+	ID            uint       `gorm:"primaryKey" json:"id"`
+	EventID       string     `gorm:"type:varchar(64);not null;uniqueIndex" json:"event_id"`
+	EventType     string     `gorm:"type:varchar(50);not null" json:"event_type"`
+	Payload       string     `gorm:"type:text;not null" json:"-"`
+	OccurredAt    time.Time  `gorm:"not null" json:"occurred_at"`
+	Attempts      int        `gorm:"not null;default:0" json:"attempts"`
+	LastError     *string    `gorm:"type:text" json:"last_error,omitempty"`
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	FailingSince  *time.Time `json:"failing_since,omitempty"`
+	ParkedAt      *time.Time `json:"parked_at,omitempty"`
+	PublishedAt   *time.Time `json:"published_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// TableName menentukan nama tabel untuk model OutboxEvent
+func (OutboxEvent) TableName() string {
+	return "outbox_events"
+}
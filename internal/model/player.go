@@ -6,6 +6,9 @@ import (
 	"gorm.io/gorm"
 )
 
+// PlayerPositions adalah posisi pemain yang valid
+var PlayerPositions = []string{"penyerang", "gelandang", "bertahan", "penjaga gawang"}
+
 // Player merepresentasikan tabel players di database
 type Player struct {
 	ID           uint           `gorm:"primaryKey" json:"id"`
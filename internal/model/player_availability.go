@@ -0,0 +1,41 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// AvailabilityStatus merepresentasikan status ketersediaan pemain
+type AvailabilityStatus string
+
+const (
+	AvailabilityStatusAvailable         AvailabilityStatus = "available"
+	AvailabilityStatusInjured           AvailabilityStatus = "injured"
+	AvailabilityStatusIll               AvailabilityStatus = "ill"
+	AvailabilityStatusInternationalDuty AvailabilityStatus = "international_duty"
+	AvailabilityStatusSuspended         AvailabilityStatus = "suspended"
+)
+
+// PlayerAvailability merepresentasikan tabel player_availabilities di database.
+// Setiap record mencatat satu periode ketersediaan pemain (cedera, sakit, dll)
+// mulai dari StartDate sampai ExpectedReturnDate (eksklusif).
+type PlayerAvailability struct {
+	ID                 uint               `gorm:"primaryKey" json:"id"`
+	PlayerID           uint               `gorm:"not null;index" json:"player_id"`
+	Status             AvailabilityStatus `gorm:"type:varchar(50);not null;check:status IN ('available', 'injured', 'ill', 'international_duty', 'suspended')" json:"status"`
+	Notes              *string            `gorm:"type:text" json:"notes,omitempty"`
+	StartDate          time.Time          `gorm:"type:date;not null" json:"start_date"`
+	ExpectedReturnDate *time.Time         `gorm:"type:date" json:"expected_return_date,omitempty"`
+	CreatedAt          time.Time          `json:"created_at"`
+	UpdatedAt          time.Time          `json:"updated_at"`
+	DeletedAt          gorm.DeletedAt     `gorm:"index" json:"-"`
+
+	// Relasi
+	Player Player `gorm:"foreignKey:PlayerID" json:"-"`
+}
+
+// TableName menentukan nama tabel untuk model PlayerAvailability
+func (PlayerAvailability) TableName() string {
+	return "player_availabilities"
+}
@@ -14,11 +14,13 @@ type Team struct {
 	FoundedYear         *int           `json:"founded_year,omitempty"`
 	HeadquartersAddress *string        `gorm:"type:text" json:"headquarters_address,omitempty"`
 	HeadquartersCity    *string        `gorm:"type:varchar(100)" json:"headquarters_city,omitempty"`
+	HomeVenueID         *uint          `json:"home_venue_id,omitempty"`
 	CreatedAt           time.Time      `json:"created_at"`
 	UpdatedAt           time.Time      `json:"updated_at"`
 	DeletedAt           gorm.DeletedAt `gorm:"index" json:"-"`
 
 	// Relasi
+	HomeVenue   *Venue   `gorm:"foreignKey:HomeVenueID" json:"home_venue,omitempty"`
 	Players     []Player `gorm:"foreignKey:TeamID" json:"players,omitempty"`
 	HomeMatches []Match  `gorm:"foreignKey:HomeTeamID" json:"-"`
 	AwayMatches []Match  `gorm:"foreignKey:AwayTeamID" json:"-"`
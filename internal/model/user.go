@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// User merepresentasikan tabel users di database.
+// Selain admin dari konfigurasi, user di tabel ini juga bisa login; yang disimpan
+// hanya hash bcrypt dari password.
+type User struct {
+	ID           uint      `gorm:"primaryKey" json:"id"`
+	Username     string    `gorm:"type:varchar(255);not null;uniqueIndex" json:"username"`
+	PasswordHash string    `gorm:"type:varchar(255);not null" json:"-"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// TableName menentukan nama tabel untuk model User
+func (User) TableName() string {
+	return "users"
+}
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Venue merepresentasikan tabel venues (stadion) di database
+type Venue struct {
+	ID        uint           `gorm:"primaryKey" json:"id"`
+	Name      string         `gorm:"type:varchar(255);not null" json:"name" binding:"required"`
+	City      string         `gorm:"type:varchar(100);not null" json:"city" binding:"required"`
+	Capacity  int            `gorm:"not null" json:"capacity" binding:"required,min=1"`
+	Surface   string         `gorm:"type:varchar(50);not null;default:'grass';check:surface IN ('grass', 'artificial', 'hybrid')" json:"surface" binding:"omitempty,oneof=grass artificial hybrid"`
+	Latitude  *float64       `json:"latitude,omitempty" binding:"omitempty,min=-90,max=90"`
+	Longitude *float64       `json:"longitude,omitempty" binding:"omitempty,min=-180,max=180"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+}
+
+// TableName menentukan nama tabel untuk model Venue
+func (Venue) TableName() string {
+	return "venues"
+}
@@ -0,0 +1,95 @@
+package model
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// WebhookEvents adalah daftar tipe event yang di-subscribe. Di database disimpan
+// sebagai teks dipisah koma, di JSON sebagai array.
+type WebhookEvents []string
+
+// Has memeriksa apakah eventType termasuk dalam daftar
+func (e WebhookEvents) Has(eventType string) bool {
+	return slices.Contains(e, eventType)
+}
+
+// Value mengubah daftar event menjadi teks untuk disimpan di database
+func (e WebhookEvents) Value() (driver.Value, error) {
+	return strings.Join(e, ","), nil
+}
+
+// Scan membaca daftar event dari kolom teks
+func (e *WebhookEvents) Scan(src any) error {
+	var text string
+	switch v := src.(type) {
+	case string:
+		text = v
+	case []byte:
+		text = string(v)
+	case nil:
+	default:
+		return fmt.Errorf("tipe kolom events tidak didukung: %T", src)
+	}
+
+	*e = nil
+	if text != "" {
+		*e = strings.Split(text, ",")
+	}
+	return nil
+}
+
+// WebhookSubscription merepresentasikan tabel webhook_subscriptions di database.
+// Secret dipakai untuk menandatangani setiap pengiriman dan tidak pernah dikembalikan oleh API.
+type WebhookSubscription struct {
+	ID        uint          `gorm:"primaryKey" json:"id"`
+	URL       string        `gorm:"type:varchar(2048);not null" json:"url"`
+	Secret    string        `gorm:"type:varchar(255);not null" json:"-"`
+	Events    WebhookEvents `gorm:"type:text;not null" json:"events"`
+	Active    bool          `gorm:"not null;default:true" json:"active"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
+}
+
+// TableName menentukan nama tabel untuk model WebhookSubscription
+func (WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// WebhookDeliveryStatus merepresentasikan status pengiriman webhook
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending menunggu dikirim (pertama kali atau retry berikutnya)
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	// WebhookDeliveryFailed berarti semua percobaan gagal; bisa dikirim ulang manual
+	WebhookDeliveryFailed WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery merepresentasikan tabel webhook_deliveries: satu event untuk satu subscription.
+// Tabel ini sekaligus menjadi antrean worker; NextAttemptAt kosong jika tidak ada percobaan lagi.
+type WebhookDelivery struct {
+	ID             uint                  `gorm:"primaryKey" json:"id"`
+	SubscriptionID uint                  `gorm:"not null;index" json:"subscription_id"`
+	EventID        string                `gorm:"type:varchar(64);not null;index" json:"event_id"`
+	EventType      string                `gorm:"type:varchar(50);not null" json:"event_type"`
+	Payload        string                `gorm:"type:text;not null" json:"-"`
+	Status         WebhookDeliveryStatus `gorm:"type:varchar(20);not null;check:status IN ('pending', 'succeeded', 'failed')" json:"status"`
+	Attempts       int                   `gorm:"not null;default:0" json:"attempts"`
+	NextAttemptAt  *time.Time            `gorm:"index" json:"next_attempt_at,omitempty"`
+	LastAttemptAt  *time.Time            `json:"last_attempt_at,omitempty"`
+	ResponseStatus *int                  `json:"response_status,omitempty"`
+	LastError      *string               `gorm:"type:text" json:"last_error,omitempty"`
+	DeliveredAt    *time.Time            `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time             `json:"created_at"`
+	UpdatedAt      time.Time             `json:"updated_at"`
+}
+
+// TableName menentukan nama tabel untuk model WebhookDelivery
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"xyz-football-api/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// CalendarTokenRepository mendefinisikan operasi data untuk CalendarToken
+type CalendarTokenRepository interface {
+	// Replace menyimpan token baru untuk user dan menggantikan token lamanya
+	Replace(token *model.CalendarToken) error
+	FindByHash(hash string) (*model.CalendarToken, error)
+	DeleteByUsername(username string) error
+}
+
+// calendarTokenRepository adalah implementasi CalendarTokenRepository berbasis GORM
+type calendarTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewCalendarTokenRepository membuat instance CalendarTokenRepository berbasis GORM
+func NewCalendarTokenRepository(db *gorm.DB) CalendarTokenRepository {
+	return &calendarTokenRepository{db: db}
+}
+
+// Replace menghapus token lama milik user lalu menyimpan token baru dalam satu transaksi
+func (r *calendarTokenRepository) Replace(token *model.CalendarToken) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("username = ?", token.Username).Delete(&model.CalendarToken{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(token).Error
+	})
+}
+
+// FindByHash mengambil token berdasarkan hash-nya
+func (r *calendarTokenRepository) FindByHash(hash string) (*model.CalendarToken, error) {
+	var token model.CalendarToken
+	err := r.db.Where("token_hash = ?", hash).First(&token).Error
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// DeleteByUsername mencabut token milik user
+func (r *calendarTokenRepository) DeleteByUsername(username string) error {
+	return r.db.Where("username = ?", username).Delete(&model.CalendarToken{}).Error
+}
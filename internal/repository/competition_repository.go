@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"xyz-football-api/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// CompetitionRepository mendefinisikan operasi data untuk Competition dan bracket-nya
+type CompetitionRepository interface {
+	Create(competition *model.Competition) error
+	FindAll() ([]model.Competition, error)
+	FindByID(id uint) (*model.Competition, error)
+	Update(competition *model.Competition) error
+	// StartDraw mengubah status kompetisi dari draft menjadi in_progress dalam satu statement.
+	// Mengembalikan false jika kompetisi sudah tidak draft, sehingga dua undian bersamaan
+	// tidak bisa sama-sama lolos.
+	StartDraw(competitionID uint) (bool, error)
+	CreateGroupStage(competition *model.Competition, groups []model.CompetitionGroup, groupTeams [][]uint) error
+	FindGroups(competitionID uint) ([]model.CompetitionGroup, error)
+	FindGroupByID(id uint) (*model.CompetitionGroup, error)
+	CountPendingGroupMatches(competitionID uint) (int64, error)
+	CreateBracket(competition *model.Competition, ties []model.CupTie) error
+	FindTies(competitionID uint) ([]model.CupTie, error)
+	FindTieByID(id uint) (*model.CupTie, error)
+	FindTieBySlot(competitionID uint, round, position int) (*model.CupTie, error)
+	CountRounds(competitionID uint) (int, error)
+	UpdateTie(tie *model.CupTie) error
+}
+
+// competitionRepository adalah implementasi CompetitionRepository berbasis GORM
+type competitionRepository struct {
+	db *gorm.DB
+}
+
+// NewCompetitionRepository membuat instance CompetitionRepository berbasis GORM
+func NewCompetitionRepository(db *gorm.DB) CompetitionRepository {
+	return &competitionRepository{db: db}
+}
+
+// Create membuat kompetisi baru beserta daftar peserta
+func (r *competitionRepository) Create(competition *model.Competition) error {
+	return r.db.Create(competition).Error
+}
+
+// FindAll mengambil semua kompetisi
+func (r *competitionRepository) FindAll() ([]model.Competition, error) {
+	var competitions []model.Competition
+	err := r.db.Order("id").Find(&competitions).Error
+	return competitions, err
+}
+
+// FindByID mengambil kompetisi berdasarkan ID beserta peserta (urut seed) dan juara
+func (r *competitionRepository) FindByID(id uint) (*model.Competition, error) {
+	var competition model.Competition
+	err := r.db.Preload("Teams", func(db *gorm.DB) *gorm.DB { return db.Order("seed") }).
+		Preload("Teams.Team").
+		Preload("ChampionTeam").
+		First(&competition, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &competition, nil
+}
+
+// Update memperbarui data kompetisi (tanpa menyentuh relasi)
+func (r *competitionRepository) Update(competition *model.Competition) error {
+	return r.db.Omit("Teams", "Groups", "Ties", "ChampionTeam").Save(competition).Error
+}
+
+// StartDraw mengubah status kompetisi draft menjadi in_progress; false jika bukan draft
+func (r *competitionRepository) StartDraw(competitionID uint) (bool, error) {
+	result := r.db.Model(&model.Competition{}).
+		Where("id = ? AND status = ?", competitionID, model.CompetitionStatusDraft).
+		Update("status", model.CompetitionStatusInProgress)
+	return result.RowsAffected == 1, result.Error
+}
+
+// CreateGroupStage menyimpan grup beserta jadwal round-robin-nya, menempatkan peserta
+// ke grup masing-masing dan menandai kompetisi sedang berjalan dalam satu transaksi.
+// groupTeams[i] berisi team ID anggota groups[i].
+func (r *competitionRepository) CreateGroupStage(competition *model.Competition, groups []model.CompetitionGroup, groupTeams [][]uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for i := range groups {
+			if err := tx.Create(&groups[i]).Error; err != nil {
+				return err
+			}
+			err := tx.Model(&model.CompetitionTeam{}).
+				Where("competition_id = ? AND team_id IN ?", competition.ID, groupTeams[i]).
+				Update("group_id", groups[i].ID).Error
+			if err != nil {
+				return err
+			}
+		}
+		return tx.Model(competition).Update("status", model.CompetitionStatusInProgress).Error
+	})
+}
+
+// FindGroups mengambil semua grup kompetisi beserta peserta dan match-nya
+func (r *competitionRepository) FindGroups(competitionID uint) ([]model.CompetitionGroup, error) {
+	var groups []model.CompetitionGroup
+	err := r.db.Where("competition_id = ?", competitionID).
+		Preload("Teams", func(db *gorm.DB) *gorm.DB { return db.Order("seed") }).
+		Preload("Teams.Team").
+		Preload("Matches", func(db *gorm.DB) *gorm.DB { return db.Order("match_datetime, id") }).
+		Order("name").
+		Find(&groups).Error
+	return groups, err
+}
+
+// FindGroupByID mengambil grup berdasarkan ID
+func (r *competitionRepository) FindGroupByID(id uint) (*model.CompetitionGroup, error) {
+	var group model.CompetitionGroup
+	err := r.db.First(&group, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+// CountPendingGroupMatches menghitung match fase grup yang belum selesai
+func (r *competitionRepository) CountPendingGroupMatches(competitionID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&model.Match{}).
+		Where("competition_id = ? AND group_id IS NOT NULL AND status = ?", competitionID, model.MatchStatusScheduled).
+		Count(&count).Error
+	return count, err
+}
+
+// CreateBracket menyimpan seluruh tie (beserta match babak pertama) dan
+// menandai kompetisi sedang berjalan dalam satu transaksi
+func (r *competitionRepository) CreateBracket(competition *model.Competition, ties []model.CupTie) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&ties).Error; err != nil {
+			return err
+		}
+		return tx.Model(competition).Update("status", model.CompetitionStatusInProgress).Error
+	})
+}
+
+// FindTies mengambil semua tie kompetisi beserta tim dan match, urut babak dan posisi
+func (r *competitionRepository) FindTies(competitionID uint) ([]model.CupTie, error) {
+	var ties []model.CupTie
+	err := r.db.Where("competition_id = ?", competitionID).
+		Preload("HomeTeam").
+		Preload("AwayTeam").
+		Preload("WinnerTeam").
+		Preload("Matches", func(db *gorm.DB) *gorm.DB { return db.Order("leg") }).
+		Order("round, position").
+		Find(&ties).Error
+	return ties, err
+}
+
+// FindTieByID mengambil tie berdasarkan ID beserta match-nya
+func (r *competitionRepository) FindTieByID(id uint) (*model.CupTie, error) {
+	var tie model.CupTie
+	err := r.db.Preload("Matches", func(db *gorm.DB) *gorm.DB { return db.Order("leg") }).
+		First(&tie, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &tie, nil
+}
+
+// FindTieBySlot mengambil tie berdasarkan babak dan posisi di bracket
+func (r *competitionRepository) FindTieBySlot(competitionID uint, round, position int) (*model.CupTie, error) {
+	var tie model.CupTie
+	err := r.db.Where("competition_id = ? AND round = ? AND position = ?", competitionID, round, position).
+		First(&tie).Error
+	if err != nil {
+		return nil, err
+	}
+	return &tie, nil
+}
+
+// CountRounds mengembalikan jumlah babak knockout dalam kompetisi
+func (r *competitionRepository) CountRounds(competitionID uint) (int, error) {
+	var rounds int
+	err := r.db.Model(&model.CupTie{}).
+		Where("competition_id = ?", competitionID).
+		Select("COALESCE(MAX(round), 0)").
+		Scan(&rounds).Error
+	return rounds, err
+}
+
+// UpdateTie memperbarui tie beserta match baru yang belum tersimpan
+func (r *competitionRepository) UpdateTie(tie *model.CupTie) error {
+	return r.db.Omit("HomeTeam", "AwayTeam", "WinnerTeam").Save(tie).Error
+}
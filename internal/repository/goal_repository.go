@@ -6,18 +6,35 @@ import (
 	"gorm.io/gorm"
 )
 
-// GoalRepository menangani operasi database untuk Goal
-type GoalRepository struct {
+// GoalRepository mendefinisikan operasi data untuk Goal
+type GoalRepository interface {
+	CreateBatch(goals []model.Goal) error
+	FindByMatchID(matchID uint) ([]model.Goal, error)
+	FindByMatchIDs(matchIDs []uint) ([]model.Goal, error)
+	FindByPlayerIDs(playerIDs []uint) ([]model.Goal, error)
+	FindInBatches(filter GoalFilter, fn func(goals []model.Goal) error) error
+	GetTopScorerInMatch(matchID uint) (*model.Player, int, error)
+	DeleteByMatchID(matchID uint) error
+}
+
+// GoalFilter membatasi goal yang dibaca FindInBatches; field kosong tidak memfilter
+type GoalFilter struct {
+	MatchID  *uint
+	PlayerID *uint
+}
+
+// goalRepository adalah implementasi GoalRepository berbasis GORM
+type goalRepository struct {
 	db *gorm.DB
 }
 
-// NewGoalRepository membuat instance GoalRepository baru
-func NewGoalRepository(db *gorm.DB) *GoalRepository {
-	return &GoalRepository{db: db}
+// NewGoalRepository membuat instance GoalRepository berbasis GORM
+func NewGoalRepository(db *gorm.DB) GoalRepository {
+	return &goalRepository{db: db}
 }
 
 // CreateBatch membuat multiple goals sekaligus
-func (r *GoalRepository) CreateBatch(goals []model.Goal) error {
+func (r *goalRepository) CreateBatch(goals []model.Goal) error {
 	if len(goals) == 0 {
 		return nil
 	}
@@ -25,14 +42,45 @@ func (r *GoalRepository) CreateBatch(goals []model.Goal) error {
 }
 
 // FindByMatchID mengambil semua goal dari match tertentu
-func (r *GoalRepository) FindByMatchID(matchID uint) ([]model.Goal, error) {
+func (r *goalRepository) FindByMatchID(matchID uint) ([]model.Goal, error) {
 	var goals []model.Goal
 	err := r.db.Where("match_id = ?", matchID).Preload("Player").Find(&goals).Error
 	return goals, err
 }
 
-// GetTopScorerInMatch mengambil pencetak gol terbanyak dalam satu pertandingan
-func (r *GoalRepository) GetTopScorerInMatch(matchID uint) (*model.Player, int, error) {
+// FindByMatchIDs mengambil goal (tanpa relasi) dari beberapa match sekaligus, terurut berdasarkan menit gol
+func (r *goalRepository) FindByMatchIDs(matchIDs []uint) ([]model.Goal, error) {
+	var goals []model.Goal
+	err := r.db.Where("match_id IN ?", matchIDs).Order("goal_time, id").Find(&goals).Error
+	return goals, err
+}
+
+// FindByPlayerIDs mengambil goal (tanpa relasi) dari beberapa player sekaligus, terurut berdasarkan ID
+func (r *goalRepository) FindByPlayerIDs(playerIDs []uint) ([]model.Goal, error) {
+	var goals []model.Goal
+	err := r.db.Where("player_id IN ?", playerIDs).Order("id").Find(&goals).Error
+	return goals, err
+}
+
+// FindInBatches membaca goal beserta player per BatchSize record, terurut berdasarkan ID
+func (r *goalRepository) FindInBatches(filter GoalFilter, fn func(goals []model.Goal) error) error {
+	query := r.db.Preload("Player")
+	if filter.MatchID != nil {
+		query = query.Where("match_id = ?", *filter.MatchID)
+	}
+	if filter.PlayerID != nil {
+		query = query.Where("player_id = ?", *filter.PlayerID)
+	}
+
+	var goals []model.Goal
+	return query.FindInBatches(&goals, BatchSize, func(*gorm.DB, int) error {
+		return fn(goals)
+	}).Error
+}
+
+// GetTopScorerInMatch mengambil pencetak gol terbanyak dalam satu pertandingan.
+// Jika jumlah gol sama, pemain yang mencetak gol lebih dulu yang diambil.
+func (r *goalRepository) GetTopScorerInMatch(matchID uint) (*model.Player, int, error) {
 	type Result struct {
 		PlayerID  uint
 		GoalCount int
@@ -43,7 +91,7 @@ func (r *GoalRepository) GetTopScorerInMatch(matchID uint) (*model.Player, int,
 		Select("player_id, COUNT(*) as goal_count").
 		Where("match_id = ?", matchID).
 		Group("player_id").
-		Order("goal_count DESC").
+		Order("goal_count DESC, MIN(goal_time), player_id").
 		Limit(1).
 		Scan(&result).Error
 
@@ -67,6 +115,6 @@ func (r *GoalRepository) GetTopScorerInMatch(matchID uint) (*model.Player, int,
 }
 
 // DeleteByMatchID menghapus semua goal dari match tertentu (untuk update result)
-func (r *GoalRepository) DeleteByMatchID(matchID uint) error {
+func (r *goalRepository) DeleteByMatchID(matchID uint) error {
 	return r.db.Where("match_id = ?", matchID).Delete(&model.Goal{}).Error
 }
@@ -6,23 +6,58 @@ import (
 	"gorm.io/gorm"
 )
 
-// MatchRepository menangani operasi database untuk Match
-type MatchRepository struct {
+// MatchRepository mendefinisikan operasi data untuk Match
+type MatchRepository interface {
+	Create(match *model.Match) error
+	FindByID(id uint) (*model.Match, error)
+	FindInBatches(filter MatchFilter, fn func(matches []model.Match) error) error
+	FindByIDWithGoals(id uint) (*model.Match, error)
+	FindByIDs(ids []uint) ([]model.Match, error)
+	FindByTeamIDs(teamIDs []uint) ([]model.Match, error)
+	FindPage(filter MatchFilter, page Page) ([]model.Match, int64, error)
+	Update(match *model.Match) error
+	UpdateResult(match *model.Match) error
+	UpdateSchedule(match *model.Match) error
+}
+
+// MatchFilter membatasi match yang dibaca FindInBatches dan FindPage; field kosong tidak memfilter
+type MatchFilter struct {
+	TeamID        *uint // match di mana team bermain sebagai home atau away
+	CompetitionID *uint
+	Status        model.MatchStatus
+}
+
+// apply menambahkan kondisi filter ke query
+func (f MatchFilter) apply(query *gorm.DB) *gorm.DB {
+	if f.TeamID != nil {
+		query = query.Where("home_team_id = ? OR away_team_id = ?", *f.TeamID, *f.TeamID)
+	}
+	if f.CompetitionID != nil {
+		query = query.Where("competition_id = ?", *f.CompetitionID)
+	}
+	if f.Status != "" {
+		query = query.Where("status = ?", f.Status)
+	}
+	return query
+}
+
+// matchRepository adalah implementasi MatchRepository berbasis GORM
+type matchRepository struct {
 	db *gorm.DB
 }
 
-// NewMatchRepository membuat instance MatchRepository baru
-func NewMatchRepository(db *gorm.DB) *MatchRepository {
-	return &MatchRepository{db: db}
+// NewMatchRepository membuat instance MatchRepository berbasis GORM
+func NewMatchRepository(db *gorm.DB) MatchRepository {
+	return &matchRepository{db: db}
 }
 
 // Create membuat match baru
-func (r *MatchRepository) Create(match *model.Match) error {
+func (r *matchRepository) Create(match *model.Match) error {
 	return r.db.Create(match).Error
 }
 
 // FindByID mengambil match berdasarkan ID dengan relasi teams
-func (r *MatchRepository) FindByID(id uint) (*model.Match, error) {
+func (r *matchRepository) FindByID(id uint) (*model.Match, error) {
 	var match model.Match
 	err := r.db.Preload("HomeTeam").Preload("AwayTeam").First(&match, id).Error
 	if err != nil {
@@ -31,12 +66,25 @@ func (r *MatchRepository) FindByID(id uint) (*model.Match, error) {
 	return &match, nil
 }
 
-// FindByIDWithGoals mengambil match beserta goals dan player info
-func (r *MatchRepository) FindByIDWithGoals(id uint) (*model.Match, error) {
+// FindInBatches membaca match beserta team dan venue per BatchSize record, terurut berdasarkan ID
+func (r *matchRepository) FindInBatches(filter MatchFilter, fn func(matches []model.Match) error) error {
+	query := filter.apply(r.db.Preload("HomeTeam").Preload("AwayTeam").Preload("Venue"))
+
+	var matches []model.Match
+	return query.FindInBatches(&matches, BatchSize, func(*gorm.DB, int) error {
+		return fn(matches)
+	}).Error
+}
+
+// FindByIDWithGoals mengambil match beserta goals, player info dan official
+func (r *matchRepository) FindByIDWithGoals(id uint) (*model.Match, error) {
 	var match model.Match
 	err := r.db.Preload("HomeTeam").
 		Preload("AwayTeam").
+		Preload("Venue").
 		Preload("Goals.Player").
+		Preload("Officials", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+		Preload("Officials.Official").
 		First(&match, id).Error
 	if err != nil {
 		return nil, err
@@ -44,23 +92,71 @@ func (r *MatchRepository) FindByIDWithGoals(id uint) (*model.Match, error) {
 	return &match, nil
 }
 
+// FindByIDs mengambil match dengan ID yang diberikan (tanpa relasi), terurut berdasarkan ID
+func (r *matchRepository) FindByIDs(ids []uint) ([]model.Match, error) {
+	var matches []model.Match
+	err := r.db.Where("id IN ?", ids).Order("id").Find(&matches).Error
+	return matches, err
+}
+
+// FindByTeamIDs mengambil match (tanpa relasi) di mana salah satu team bermain sebagai home
+// atau away, terurut berdasarkan waktu kick-off
+func (r *matchRepository) FindByTeamIDs(teamIDs []uint) ([]model.Match, error) {
+	var matches []model.Match
+	err := r.db.Where("home_team_id IN ? OR away_team_id IN ?", teamIDs, teamIDs).
+		Order("match_datetime, id").
+		Find(&matches).Error
+	return matches, err
+}
+
+// FindPage mengambil satu halaman match (tanpa relasi) terurut berdasarkan waktu kick-off
+// beserta jumlah total match yang cocok
+func (r *matchRepository) FindPage(filter MatchFilter, page Page) ([]model.Match, int64, error) {
+	query := filter.apply(r.db.Model(&model.Match{})).Session(&gorm.Session{})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var matches []model.Match
+	err := query.Order(page.order("match_datetime", "id")).Limit(page.Limit).Offset(page.Offset).Find(&matches).Error
+	return matches, total, err
+}
+
 // Update memperbarui data match
-func (r *MatchRepository) Update(match *model.Match) error {
+func (r *matchRepository) Update(match *model.Match) error {
 	return r.db.Save(match).Error
 }
 
-// UpdateResult memperbarui hasil pertandingan dalam transaksi
-func (r *MatchRepository) UpdateResult(matchID uint, homeScore, awayScore int) error {
+// UpdateResult memperbarui hasil pertandingan (skor, penonton, extra time, adu penalti) dalam transaksi
+func (r *matchRepository) UpdateResult(match *model.Match) error {
 	return r.db.Transaction(func(tx *gorm.DB) error {
-		// Update match scores dan status
+		// Update match scores, attendance dan status
 		err := tx.Model(&model.Match{}).
-			Where("id = ?", matchID).
+			Where("id = ?", match.ID).
 			Updates(map[string]interface{}{
-				"home_score": homeScore,
-				"away_score": awayScore,
-				"status":     model.MatchStatusCompleted,
+				"home_score":     match.HomeScore,
+				"away_score":     match.AwayScore,
+				"attendance":     match.Attendance,
+				"extra_time":     match.ExtraTime,
+				"home_penalties": match.HomePenalties,
+				"away_penalties": match.AwayPenalties,
+				"status":         model.MatchStatusCompleted,
 			}).Error
 
 		return err
 	})
 }
+
+// UpdateSchedule memperbarui jadwal match (waktu kick-off, venue, status dan versi jadwal)
+func (r *matchRepository) UpdateSchedule(match *model.Match) error {
+	return r.db.Model(&model.Match{}).
+		Where("id = ?", match.ID).
+		Updates(map[string]interface{}{
+			"match_datetime":   match.MatchDatetime,
+			"venue_id":         match.VenueID,
+			"status":           match.Status,
+			"schedule_version": match.ScheduleVersion,
+		}).Error
+}
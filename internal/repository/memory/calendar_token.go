@@ -0,0 +1,45 @@
+package memory
+
+import (
+	"xyz-football-api/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// calendarTokenRepository adalah implementasi in-memory repository.CalendarTokenRepository
+type calendarTokenRepository struct {
+	s *Store
+}
+
+// Replace menyimpan token baru untuk user dan menggantikan token lamanya
+func (r *calendarTokenRepository) Replace(token *model.CalendarToken) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	token.ID = r.s.nextID()
+	token.CreatedAt = r.s.now()
+	r.s.calendarTokens[token.Username] = *token
+	return nil
+}
+
+// FindByHash mengambil token berdasarkan hash-nya
+func (r *calendarTokenRepository) FindByHash(hash string) (*model.CalendarToken, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	for _, token := range r.s.calendarTokens {
+		if token.TokenHash == hash {
+			return &token, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+// DeleteByUsername mencabut token milik user
+func (r *calendarTokenRepository) DeleteByUsername(username string) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	delete(r.s.calendarTokens, username)
+	return nil
+}
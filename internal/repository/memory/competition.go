@@ -0,0 +1,321 @@
+package memory
+
+import (
+	"cmp"
+	"slices"
+	"xyz-football-api/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// competitionRepository adalah implementasi in-memory repository.CompetitionRepository
+type competitionRepository struct {
+	s *Store
+}
+
+// Create membuat kompetisi baru beserta daftar peserta
+func (r *competitionRepository) Create(competition *model.Competition) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	now := r.s.now()
+	competition.ID = r.s.nextID()
+	competition.CreatedAt = now
+	competition.UpdatedAt = now
+	if competition.Status == "" {
+		competition.Status = model.CompetitionStatusDraft
+	}
+
+	for i := range competition.Teams {
+		competition.Teams[i].ID = r.s.nextID()
+		competition.Teams[i].CompetitionID = competition.ID
+
+		stored := competition.Teams[i]
+		stored.Team = model.Team{}
+		r.s.competitionTeams[stored.ID] = stored
+	}
+
+	r.s.competitions[competition.ID] = stripCompetition(*competition)
+	return nil
+}
+
+// FindAll mengambil semua kompetisi
+func (r *competitionRepository) FindAll() ([]model.Competition, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	return sortedValues(r.s.competitions), nil
+}
+
+// FindByID mengambil kompetisi berdasarkan ID beserta peserta (urut seed) dan juara
+func (r *competitionRepository) FindByID(id uint) (*model.Competition, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	competition, ok := r.s.competitions[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	competition.Teams = r.s.competitionTeamsWhere(func(ct model.CompetitionTeam) bool {
+		return ct.CompetitionID == id
+	})
+	competition.ChampionTeam = r.s.teamPtr(competition.ChampionTeamID)
+	return &competition, nil
+}
+
+// Update memperbarui data kompetisi (tanpa menyentuh relasi)
+func (r *competitionRepository) Update(competition *model.Competition) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	competition.UpdatedAt = r.s.now()
+	r.s.competitions[competition.ID] = stripCompetition(*competition)
+	return nil
+}
+
+// StartDraw mengubah status kompetisi draft menjadi in_progress; false jika bukan draft
+func (r *competitionRepository) StartDraw(competitionID uint) (bool, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	competition, ok := r.s.competitions[competitionID]
+	if !ok || competition.Status != model.CompetitionStatusDraft {
+		return false, nil
+	}
+	r.s.setCompetitionStatus(&competition, model.CompetitionStatusInProgress)
+	return true, nil
+}
+
+// CreateGroupStage menyimpan grup beserta jadwal round-robin-nya, menempatkan peserta
+// ke grup masing-masing dan menandai kompetisi sedang berjalan
+func (r *competitionRepository) CreateGroupStage(competition *model.Competition, groups []model.CompetitionGroup, groupTeams [][]uint) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	for i := range groups {
+		groups[i].ID = r.s.nextID()
+		for j := range groups[i].Matches {
+			groupID := groups[i].ID
+			groups[i].Matches[j].GroupID = &groupID
+			r.s.insertMatch(&groups[i].Matches[j])
+		}
+
+		stored := groups[i]
+		stored.Teams = nil
+		stored.Matches = nil
+		r.s.groups[stored.ID] = stored
+
+		for id, ct := range r.s.competitionTeams {
+			if ct.CompetitionID == competition.ID && slices.Contains(groupTeams[i], ct.TeamID) {
+				groupID := groups[i].ID
+				ct.GroupID = &groupID
+				r.s.competitionTeams[id] = ct
+			}
+		}
+	}
+
+	r.s.setCompetitionStatus(competition, model.CompetitionStatusInProgress)
+	return nil
+}
+
+// FindGroups mengambil semua grup kompetisi beserta peserta dan match-nya
+func (r *competitionRepository) FindGroups(competitionID uint) ([]model.CompetitionGroup, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var groups []model.CompetitionGroup
+	for _, group := range sortedValues(r.s.groups) {
+		if group.CompetitionID != competitionID {
+			continue
+		}
+		group.Teams = r.s.competitionTeamsWhere(func(ct model.CompetitionTeam) bool {
+			return uintEqual(ct.GroupID, group.ID)
+		})
+		group.Matches = r.s.matchesWhere(func(m model.Match) bool {
+			return uintEqual(m.GroupID, group.ID)
+		}, byKickoff)
+		groups = append(groups, group)
+	}
+	slices.SortStableFunc(groups, func(a, b model.CompetitionGroup) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return groups, nil
+}
+
+// FindGroupByID mengambil grup berdasarkan ID
+func (r *competitionRepository) FindGroupByID(id uint) (*model.CompetitionGroup, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	group, ok := r.s.groups[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &group, nil
+}
+
+// CountPendingGroupMatches menghitung match fase grup yang belum selesai
+func (r *competitionRepository) CountPendingGroupMatches(competitionID uint) (int64, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	pending := r.s.matchesWhere(func(m model.Match) bool {
+		return uintEqual(m.CompetitionID, competitionID) && m.GroupID != nil && m.Status == model.MatchStatusScheduled
+	}, nil)
+	return int64(len(pending)), nil
+}
+
+// CreateBracket menyimpan seluruh tie (beserta match babak pertama) dan
+// menandai kompetisi sedang berjalan
+func (r *competitionRepository) CreateBracket(competition *model.Competition, ties []model.CupTie) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	for i := range ties {
+		now := r.s.now()
+		ties[i].ID = r.s.nextID()
+		ties[i].CreatedAt = now
+		ties[i].UpdatedAt = now
+		r.s.saveTie(&ties[i])
+	}
+
+	r.s.setCompetitionStatus(competition, model.CompetitionStatusInProgress)
+	return nil
+}
+
+// FindTies mengambil semua tie kompetisi beserta tim dan match, urut babak dan posisi
+func (r *competitionRepository) FindTies(competitionID uint) ([]model.CupTie, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var ties []model.CupTie
+	for _, tie := range sortedValues(r.s.ties) {
+		if tie.CompetitionID != competitionID {
+			continue
+		}
+		tie.HomeTeam = r.s.teamPtr(tie.HomeTeamID)
+		tie.AwayTeam = r.s.teamPtr(tie.AwayTeamID)
+		tie.WinnerTeam = r.s.teamPtr(tie.WinnerTeamID)
+		tie.Matches = r.s.tieMatches(tie.ID)
+		ties = append(ties, tie)
+	}
+	slices.SortStableFunc(ties, func(a, b model.CupTie) int {
+		if c := cmp.Compare(a.Round, b.Round); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Position, b.Position)
+	})
+	return ties, nil
+}
+
+// FindTieByID mengambil tie berdasarkan ID beserta match-nya
+func (r *competitionRepository) FindTieByID(id uint) (*model.CupTie, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	tie, ok := r.s.ties[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	tie.Matches = r.s.tieMatches(id)
+	return &tie, nil
+}
+
+// FindTieBySlot mengambil tie berdasarkan babak dan posisi di bracket
+func (r *competitionRepository) FindTieBySlot(competitionID uint, round, position int) (*model.CupTie, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	for _, tie := range r.s.ties {
+		if tie.CompetitionID == competitionID && tie.Round == round && tie.Position == position {
+			return &tie, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+// CountRounds mengembalikan jumlah babak knockout dalam kompetisi
+func (r *competitionRepository) CountRounds(competitionID uint) (int, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	rounds := 0
+	for _, tie := range r.s.ties {
+		if tie.CompetitionID == competitionID && tie.Round > rounds {
+			rounds = tie.Round
+		}
+	}
+	return rounds, nil
+}
+
+// UpdateTie memperbarui tie beserta match baru yang belum tersimpan
+func (r *competitionRepository) UpdateTie(tie *model.CupTie) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	tie.UpdatedAt = r.s.now()
+	r.s.saveTie(tie)
+	return nil
+}
+
+// saveTie menyimpan tie dan match-nya; match tanpa ID dibuat baru dengan tie_id terisi
+func (s *Store) saveTie(tie *model.CupTie) {
+	for i := range tie.Matches {
+		tieID := tie.ID
+		tie.Matches[i].TieID = &tieID
+		if tie.Matches[i].ID == 0 {
+			s.insertMatch(&tie.Matches[i])
+		} else {
+			tie.Matches[i].UpdatedAt = s.now()
+			s.matches[tie.Matches[i].ID] = stripMatch(tie.Matches[i])
+		}
+	}
+
+	stored := *tie
+	stored.HomeTeam = nil
+	stored.AwayTeam = nil
+	stored.WinnerTeam = nil
+	stored.Matches = nil
+	s.ties[stored.ID] = stored
+}
+
+// tieMatches mengambil match milik tie, urut berdasarkan leg
+func (s *Store) tieMatches(tieID uint) []model.Match {
+	return s.matchesWhere(func(m model.Match) bool {
+		return uintEqual(m.TieID, tieID)
+	}, byLeg)
+}
+
+// competitionTeamsWhere mengambil peserta kompetisi yang memenuhi filter (urut seed) beserta team-nya
+func (s *Store) competitionTeamsWhere(filter func(model.CompetitionTeam) bool) []model.CompetitionTeam {
+	var teams []model.CompetitionTeam
+	for _, ct := range sortedValues(s.competitionTeams) {
+		if filter(ct) {
+			ct.Team, _ = s.team(ct.TeamID)
+			teams = append(teams, ct)
+		}
+	}
+	slices.SortStableFunc(teams, func(a, b model.CompetitionTeam) int {
+		return cmp.Compare(a.Seed, b.Seed)
+	})
+	return teams
+}
+
+// setCompetitionStatus memperbarui status kompetisi di store dan pada struct yang diberikan
+func (s *Store) setCompetitionStatus(competition *model.Competition, status model.CompetitionStatus) {
+	competition.Status = status
+	if stored, ok := s.competitions[competition.ID]; ok {
+		stored.Status = status
+		stored.UpdatedAt = s.now()
+		s.competitions[competition.ID] = stored
+	}
+}
+
+// stripCompetition membuang relasi agar yang tersimpan hanya kolom tabel competitions
+func stripCompetition(competition model.Competition) model.Competition {
+	competition.Teams = nil
+	competition.Groups = nil
+	competition.Ties = nil
+	competition.ChampionTeam = nil
+	return competition
+}
@@ -0,0 +1,141 @@
+package memory
+
+import (
+	"cmp"
+	"slices"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+
+	"gorm.io/gorm"
+)
+
+// goalRepository adalah implementasi in-memory repository.GoalRepository
+type goalRepository struct {
+	s *Store
+}
+
+// CreateBatch membuat multiple goals sekaligus
+func (r *goalRepository) CreateBatch(goals []model.Goal) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	for i := range goals {
+		goals[i].ID = r.s.nextID()
+		goals[i].CreatedAt = r.s.now()
+
+		stored := goals[i]
+		stored.Match = model.Match{}
+		stored.Player = model.Player{}
+		r.s.goals[stored.ID] = stored
+	}
+	return nil
+}
+
+// FindByMatchID mengambil semua goal dari match tertentu
+func (r *goalRepository) FindByMatchID(matchID uint) ([]model.Goal, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	return r.s.goalsByMatch(matchID), nil
+}
+
+// FindByMatchIDs mengambil goal (tanpa relasi) dari beberapa match sekaligus, terurut berdasarkan menit gol
+func (r *goalRepository) FindByMatchIDs(matchIDs []uint) ([]model.Goal, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var goals []model.Goal
+	for _, goal := range sortedValues(r.s.goals) {
+		if slices.Contains(matchIDs, goal.MatchID) {
+			goals = append(goals, goal)
+		}
+	}
+	slices.SortStableFunc(goals, func(a, b model.Goal) int {
+		return cmp.Compare(a.GoalTime, b.GoalTime)
+	})
+	return goals, nil
+}
+
+// FindByPlayerIDs mengambil goal (tanpa relasi) dari beberapa player sekaligus, terurut berdasarkan ID
+func (r *goalRepository) FindByPlayerIDs(playerIDs []uint) ([]model.Goal, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var goals []model.Goal
+	for _, goal := range sortedValues(r.s.goals) {
+		if slices.Contains(playerIDs, goal.PlayerID) {
+			goals = append(goals, goal)
+		}
+	}
+	return goals, nil
+}
+
+// FindInBatches membaca goal beserta player per batch, terurut berdasarkan ID
+func (r *goalRepository) FindInBatches(filter repository.GoalFilter, fn func(goals []model.Goal) error) error {
+	r.s.mu.RLock()
+	var goals []model.Goal
+	for _, goal := range sortedValues(r.s.goals) {
+		if filter.MatchID != nil && goal.MatchID != *filter.MatchID || filter.PlayerID != nil && goal.PlayerID != *filter.PlayerID {
+			continue
+		}
+		goal.Player = r.s.players[goal.PlayerID]
+		goals = append(goals, goal)
+	}
+	r.s.mu.RUnlock()
+
+	return inBatches(goals, fn)
+}
+
+// GetTopScorerInMatch mengambil pencetak gol terbanyak dalam satu pertandingan.
+// Jika jumlah gol sama, player yang mencetak gol lebih dulu yang diambil.
+func (r *goalRepository) GetTopScorerInMatch(matchID uint) (*model.Player, int, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	counts := make(map[uint]int)
+	firstGoal := make(map[uint]int)
+	for _, goal := range r.s.goals {
+		if goal.MatchID != matchID {
+			continue
+		}
+		counts[goal.PlayerID]++
+		if first, ok := firstGoal[goal.PlayerID]; !ok || goal.GoalTime < first {
+			firstGoal[goal.PlayerID] = goal.GoalTime
+		}
+	}
+
+	// Gol terbanyak, lalu gol pertama paling awal, lalu player ID terkecil
+	var topPlayerID uint
+	for playerID, count := range counts {
+		if topPlayerID == 0 ||
+			count > counts[topPlayerID] ||
+			count == counts[topPlayerID] && firstGoal[playerID] < firstGoal[topPlayerID] ||
+			count == counts[topPlayerID] && firstGoal[playerID] == firstGoal[topPlayerID] && playerID < topPlayerID {
+			topPlayerID = playerID
+		}
+	}
+
+	// Jika tidak ada gol
+	if topPlayerID == 0 {
+		return nil, 0, nil
+	}
+
+	player, ok := r.s.players[topPlayerID]
+	if !ok {
+		return nil, 0, gorm.ErrRecordNotFound
+	}
+	return &player, counts[topPlayerID], nil
+}
+
+// DeleteByMatchID menghapus semua goal dari match tertentu
+func (r *goalRepository) DeleteByMatchID(matchID uint) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	for id, goal := range r.s.goals {
+		if goal.MatchID == matchID {
+			delete(r.s.goals, id)
+		}
+	}
+	return nil
+}
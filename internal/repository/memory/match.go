@@ -0,0 +1,206 @@
+package memory
+
+import (
+	"cmp"
+	"slices"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+
+	"gorm.io/gorm"
+)
+
+// matchRepository adalah implementasi in-memory repository.MatchRepository
+type matchRepository struct {
+	s *Store
+}
+
+// Create membuat match baru
+func (r *matchRepository) Create(match *model.Match) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	r.s.insertMatch(match)
+	return nil
+}
+
+// FindByID mengambil match berdasarkan ID dengan relasi teams
+func (r *matchRepository) FindByID(id uint) (*model.Match, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	match, ok := r.s.matches[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	match.HomeTeam, _ = r.s.team(match.HomeTeamID)
+	match.AwayTeam, _ = r.s.team(match.AwayTeamID)
+	return &match, nil
+}
+
+// FindInBatches membaca match beserta team dan venue per batch, terurut berdasarkan ID
+func (r *matchRepository) FindInBatches(filter repository.MatchFilter, fn func(matches []model.Match) error) error {
+	r.s.mu.RLock()
+	var matches []model.Match
+	for _, match := range sortedValues(r.s.matches) {
+		if !matchMatches(match, filter) {
+			continue
+		}
+		match.HomeTeam, _ = r.s.team(match.HomeTeamID)
+		match.AwayTeam, _ = r.s.team(match.AwayTeamID)
+		match.Venue = r.s.venuePtr(match.VenueID)
+		matches = append(matches, match)
+	}
+	r.s.mu.RUnlock()
+
+	return inBatches(matches, fn)
+}
+
+// FindByIDWithGoals mengambil match beserta goals, player info dan official
+func (r *matchRepository) FindByIDWithGoals(id uint) (*model.Match, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	match, ok := r.s.matches[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	match.HomeTeam, _ = r.s.team(match.HomeTeamID)
+	match.AwayTeam, _ = r.s.team(match.AwayTeamID)
+	match.Venue = r.s.venuePtr(match.VenueID)
+	match.Goals = r.s.goalsByMatch(id)
+	match.Officials = r.s.assignmentsByMatch(id)
+	return &match, nil
+}
+
+// FindByIDs mengambil match dengan ID yang diberikan (tanpa relasi), terurut berdasarkan ID
+func (r *matchRepository) FindByIDs(ids []uint) ([]model.Match, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	return r.s.matchesWhere(func(match model.Match) bool {
+		return slices.Contains(ids, match.ID)
+	}, nil), nil
+}
+
+// FindByTeamIDs mengambil match (tanpa relasi) di mana salah satu team bermain sebagai home
+// atau away, terurut berdasarkan waktu kick-off
+func (r *matchRepository) FindByTeamIDs(teamIDs []uint) ([]model.Match, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	return r.s.matchesWhere(func(match model.Match) bool {
+		return slices.Contains(teamIDs, match.HomeTeamID) || slices.Contains(teamIDs, match.AwayTeamID)
+	}, byKickoff), nil
+}
+
+// FindPage mengambil satu halaman match (tanpa relasi) terurut berdasarkan waktu kick-off
+// beserta jumlah total match yang cocok
+func (r *matchRepository) FindPage(filter repository.MatchFilter, page repository.Page) ([]model.Match, int64, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	matches, total := paginate(r.s.matchesWhere(func(match model.Match) bool {
+		return matchMatches(match, filter)
+	}, byKickoff), page)
+	return matches, total, nil
+}
+
+// matchMatches memeriksa apakah match memenuhi filter
+func matchMatches(match model.Match, filter repository.MatchFilter) bool {
+	return (filter.TeamID == nil || match.HomeTeamID == *filter.TeamID || match.AwayTeamID == *filter.TeamID) &&
+		(filter.CompetitionID == nil || uintEqual(match.CompetitionID, *filter.CompetitionID)) &&
+		(filter.Status == "" || match.Status == filter.Status)
+}
+
+// Update memperbarui data match
+func (r *matchRepository) Update(match *model.Match) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	match.UpdatedAt = r.s.now()
+	r.s.matches[match.ID] = stripMatch(*match)
+	return nil
+}
+
+// UpdateResult memperbarui hasil pertandingan (skor, penonton, extra time, adu penalti)
+func (r *matchRepository) UpdateResult(match *model.Match) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	stored, ok := r.s.matches[match.ID]
+	if !ok {
+		return nil
+	}
+	stored.HomeScore = match.HomeScore
+	stored.AwayScore = match.AwayScore
+	stored.Attendance = match.Attendance
+	stored.ExtraTime = match.ExtraTime
+	stored.HomePenalties = match.HomePenalties
+	stored.AwayPenalties = match.AwayPenalties
+	stored.Status = model.MatchStatusCompleted
+	stored.UpdatedAt = r.s.now()
+	r.s.matches[match.ID] = stored
+	return nil
+}
+
+// goalsByMatch mengambil goal match tertentu beserta player-nya
+func (s *Store) goalsByMatch(matchID uint) []model.Goal {
+	var goals []model.Goal
+	for _, goal := range sortedValues(s.goals) {
+		if goal.MatchID == matchID {
+			goal.Player = s.players[goal.PlayerID]
+			goals = append(goals, goal)
+		}
+	}
+	return goals
+}
+
+// assignmentsByMatch mengambil penugasan official match tertentu (urut ID) beserta official-nya
+func (s *Store) assignmentsByMatch(matchID uint) []model.MatchOfficial {
+	var assignments []model.MatchOfficial
+	for _, assignment := range sortedValues(s.assignments) {
+		if assignment.MatchID == matchID {
+			assignment.Official = s.officials[assignment.OfficialID]
+			assignments = append(assignments, assignment)
+		}
+	}
+	return assignments
+}
+
+// byKickoff mengurutkan match berdasarkan waktu kick-off lalu ID
+func byKickoff(a, b model.Match) int {
+	if c := a.MatchDatetime.Compare(b.MatchDatetime); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.ID, b.ID)
+}
+
+// byLeg mengurutkan match berdasarkan nomor leg
+func byLeg(a, b model.Match) int {
+	return cmp.Compare(legOf(a), legOf(b))
+}
+
+func legOf(match model.Match) int {
+	if match.Leg == nil {
+		return 0
+	}
+	return *match.Leg
+}
+
+// UpdateSchedule memperbarui jadwal match (waktu kick-off, venue, status dan versi jadwal)
+func (r *matchRepository) UpdateSchedule(match *model.Match) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	stored, ok := r.s.matches[match.ID]
+	if !ok {
+		return nil
+	}
+	stored.MatchDatetime = match.MatchDatetime
+	stored.VenueID = match.VenueID
+	stored.Status = match.Status
+	stored.ScheduleVersion = match.ScheduleVersion
+	stored.UpdatedAt = r.s.now()
+	r.s.matches[match.ID] = stored
+	return nil
+}
@@ -0,0 +1,193 @@
+package memory
+
+import (
+	"slices"
+	"time"
+	"xyz-football-api/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// officialRepository adalah implementasi in-memory repository.OfficialRepository
+type officialRepository struct {
+	s *Store
+}
+
+// Create membuat official baru beserta daftar tim yang konflik
+func (r *officialRepository) Create(official *model.Official) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	now := r.s.now()
+	official.ID = r.s.nextID()
+	official.CreatedAt = now
+	official.UpdatedAt = now
+	r.s.saveOfficial(*official)
+	return nil
+}
+
+// FindAll mengambil semua official
+func (r *officialRepository) FindAll() ([]model.Official, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	officials := sortedValues(r.s.officials)
+	for i := range officials {
+		officials[i].ConflictedTeams = r.s.conflictedTeams(officials[i].ID)
+	}
+	return officials, nil
+}
+
+// FindByID mengambil official berdasarkan ID beserta daftar tim yang konflik
+func (r *officialRepository) FindByID(id uint) (*model.Official, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	official, ok := r.s.officials[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	official.ConflictedTeams = r.s.conflictedTeams(id)
+	return &official, nil
+}
+
+// Update memperbarui data official dan mengganti daftar tim yang konflik
+func (r *officialRepository) Update(official *model.Official) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	official.UpdatedAt = r.s.now()
+	r.s.saveOfficial(*official)
+	return nil
+}
+
+// Delete menghapus official
+func (r *officialRepository) Delete(id uint) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	delete(r.s.officials, id)
+	return nil
+}
+
+// IsConflictedWithTeams memeriksa apakah official ditandai konflik dengan salah satu tim
+func (r *officialRepository) IsConflictedWithTeams(officialID uint, teamIDs ...uint) (bool, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	for _, teamID := range r.s.officialConflicts[officialID] {
+		if slices.Contains(teamIDs, teamID) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// CreateAssignment menugaskan official ke sebuah match.
+// Official yang sama tidak bisa ditugaskan dua kali di match yang sama (unique index).
+func (r *officialRepository) CreateAssignment(assignment *model.MatchOfficial) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	for _, existing := range r.s.assignments {
+		if existing.MatchID == assignment.MatchID && existing.OfficialID == assignment.OfficialID {
+			return gorm.ErrDuplicatedKey
+		}
+	}
+
+	assignment.ID = r.s.nextID()
+	assignment.CreatedAt = r.s.now()
+
+	stored := *assignment
+	stored.Match = model.Match{}
+	stored.Official = model.Official{}
+	r.s.assignments[stored.ID] = stored
+	return nil
+}
+
+// FindAssignmentsByMatchID mengambil semua penugasan official dalam match tertentu
+func (r *officialRepository) FindAssignmentsByMatchID(matchID uint) ([]model.MatchOfficial, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	return r.s.assignmentsByMatch(matchID), nil
+}
+
+// FindAssignmentByID mengambil penugasan official berdasarkan ID
+func (r *officialRepository) FindAssignmentByID(id uint) (*model.MatchOfficial, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	assignment, ok := r.s.assignments[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &assignment, nil
+}
+
+// DeleteAssignment menghapus penugasan official
+func (r *officialRepository) DeleteAssignment(id uint) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	delete(r.s.assignments, id)
+	return nil
+}
+
+// CountAssignmentsByRole menghitung jumlah official dengan peran tertentu dalam match
+func (r *officialRepository) CountAssignmentsByRole(matchID uint, role model.OfficialRole) (int64, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var count int64
+	for _, assignment := range r.s.assignments {
+		if assignment.MatchID == matchID && assignment.Role == role {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// HasOverlappingAssignment memeriksa apakah official sudah ditugaskan di match lain
+// (yang tidak dibatalkan) dengan jadwal di antara from dan to
+func (r *officialRepository) HasOverlappingAssignment(officialID, excludeMatchID uint, from, to time.Time) (bool, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	for _, assignment := range r.s.assignments {
+		if assignment.OfficialID != officialID || assignment.MatchID == excludeMatchID {
+			continue
+		}
+		match, ok := r.s.matches[assignment.MatchID]
+		if !ok || match.Status == model.MatchStatusCancelled {
+			continue
+		}
+		if match.MatchDatetime.After(from) && match.MatchDatetime.Before(to) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// saveOfficial menyimpan official dan mengganti daftar tim yang konflik
+func (s *Store) saveOfficial(official model.Official) {
+	teamIDs := make([]uint, 0, len(official.ConflictedTeams))
+	for _, team := range official.ConflictedTeams {
+		teamIDs = append(teamIDs, team.ID)
+	}
+	s.officialConflicts[official.ID] = teamIDs
+
+	official.ConflictedTeams = nil
+	s.officials[official.ID] = official
+}
+
+// conflictedTeams mengambil tim yang konflik dengan official (tim yang sudah dihapus diabaikan)
+func (s *Store) conflictedTeams(officialID uint) []model.Team {
+	var teams []model.Team
+	for _, teamID := range s.officialConflicts[officialID] {
+		if team, ok := s.team(teamID); ok {
+			teams = append(teams, team)
+		}
+	}
+	return teams
+}
@@ -0,0 +1,173 @@
+package memory
+
+import (
+	"time"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+)
+
+// outboxRepository adalah implementasi in-memory repository.OutboxRepository
+type outboxRepository struct {
+	s *Store
+}
+
+// Append menyimpan event baru ke outbox
+func (r *outboxRepository) Append(events []model.OutboxEvent) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	now := r.s.now()
+	for i := range events {
+		events[i].ID = r.s.nextID()
+		events[i].CreatedAt = now
+		r.s.outboxEvents[events[i].ID] = events[i]
+	}
+	return nil
+}
+
+// FindPending mengambil event yang belum dipublikasikan dan belum diparkir, urut ID
+func (r *outboxRepository) FindPending(limit int) ([]model.OutboxEvent, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var events []model.OutboxEvent
+	for _, e := range sortedValues(r.s.outboxEvents) {
+		if len(events) == limit {
+			break
+		}
+		if e.PublishedAt == nil && e.ParkedAt == nil {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+// MarkPublished menandai event sudah dipublikasikan
+func (r *outboxRepository) MarkPublished(id uint, at time.Time) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	e, ok := r.s.outboxEvents[id]
+	if !ok {
+		return nil
+	}
+	e.Attempts++
+	e.LastError = nil
+	e.NextAttemptAt = nil
+	e.FailingSince = nil
+	e.PublishedAt = &at
+	r.s.outboxEvents[id] = e
+	return nil
+}
+
+// RecordFailure menambah jumlah percobaan, menyimpan error terakhir, dan menjadwalkan percobaan berikutnya
+func (r *outboxRepository) RecordFailure(id uint, message string, failingSince, nextAttemptAt time.Time) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	e, ok := r.s.outboxEvents[id]
+	if !ok {
+		return nil
+	}
+	e.Attempts++
+	e.LastError = &message
+	e.FailingSince = &failingSince
+	e.NextAttemptAt = &nextAttemptAt
+	r.s.outboxEvents[id] = e
+	return nil
+}
+
+// Park menandai event diparkir
+func (r *outboxRepository) Park(id uint, message string, at time.Time) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	e, ok := r.s.outboxEvents[id]
+	if !ok {
+		return nil
+	}
+	e.Attempts++
+	e.LastError = &message
+	e.NextAttemptAt = nil
+	e.ParkedAt = &at
+	r.s.outboxEvents[id] = e
+	return nil
+}
+
+// FindParked mengambil event yang diparkir, urut ID
+func (r *outboxRepository) FindParked(limit int) ([]model.OutboxEvent, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var events []model.OutboxEvent
+	for _, e := range sortedValues(r.s.outboxEvents) {
+		if len(events) == limit {
+			break
+		}
+		if e.PublishedAt == nil && e.ParkedAt != nil {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+// Requeue mengembalikan event yang diparkir ke antrean dengan jumlah percobaan dari nol
+func (r *outboxRepository) Requeue(ids []uint) (int64, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	selected := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		selected[id] = true
+	}
+
+	var requeued int64
+	for id, e := range r.s.outboxEvents {
+		if e.PublishedAt != nil || e.ParkedAt == nil || (len(ids) > 0 && !selected[id]) {
+			continue
+		}
+		e.Attempts = 0
+		e.NextAttemptAt = nil
+		e.FailingSince = nil
+		e.ParkedAt = nil
+		r.s.outboxEvents[id] = e
+		requeued++
+	}
+	return requeued, nil
+}
+
+// transactor adalah implementasi in-memory repository.Transactor. Isi Store disalin
+// sebelum fn dipanggil dan dikembalikan jika fn gagal. Perubahan dari luar transaksi
+// yang terjadi bersamaan ikut dibatalkan, sehingga implementasi ini hanya cocok untuk testing.
+type transactor struct {
+	s *Store
+	// nested bernilai true untuk transactor di dalam transaksi yang sudah memegang txMu
+	nested bool
+}
+
+// Transaction menjalankan fn lalu mengembalikan isi Store jika fn gagal atau panic
+func (t *transactor) Transaction(fn func(repos *repository.Repositories) error) error {
+	if !t.nested {
+		t.s.txMu.Lock()
+		defer t.s.txMu.Unlock()
+	}
+
+	t.s.mu.RLock()
+	snapshot := t.s.tables.clone()
+	t.s.mu.RUnlock()
+
+	committed := false
+	defer func() {
+		if !committed {
+			t.s.mu.Lock()
+			t.s.tables = snapshot
+			t.s.mu.Unlock()
+		}
+	}()
+
+	if err := fn(t.s.repositories(&transactor{s: t.s, nested: true})); err != nil {
+		return err
+	}
+	committed = true
+	return nil
+}
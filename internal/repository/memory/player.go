@@ -0,0 +1,177 @@
+package memory
+
+import (
+	"cmp"
+	"slices"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+
+	"gorm.io/gorm"
+)
+
+// playerRepository adalah implementasi in-memory repository.PlayerRepository
+type playerRepository struct {
+	s *Store
+}
+
+// Create membuat player baru
+func (r *playerRepository) Create(player *model.Player) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	now := r.s.now()
+	player.ID = r.s.nextID()
+	player.CreatedAt = now
+	player.UpdatedAt = now
+	r.s.players[player.ID] = stripPlayer(*player)
+	return nil
+}
+
+// CreateBatch membuat banyak player sekaligus
+func (r *playerRepository) CreateBatch(players []model.Player) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	now := r.s.now()
+	for i := range players {
+		players[i].ID = r.s.nextID()
+		players[i].CreatedAt = now
+		players[i].UpdatedAt = now
+		r.s.players[players[i].ID] = stripPlayer(players[i])
+	}
+	return nil
+}
+
+// FindByTeamID mengambil semua player dari team tertentu
+func (r *playerRepository) FindByTeamID(teamID uint) ([]model.Player, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var players []model.Player
+	for _, player := range sortedValues(r.s.players) {
+		if player.TeamID == teamID {
+			players = append(players, player)
+		}
+	}
+	return players, nil
+}
+
+// FindInBatches membaca player beserta team-nya per batch, terurut berdasarkan ID
+func (r *playerRepository) FindInBatches(filter repository.PlayerFilter, fn func(players []model.Player) error) error {
+	r.s.mu.RLock()
+	var players []model.Player
+	for _, player := range sortedValues(r.s.players) {
+		if !playerMatches(player, filter) {
+			continue
+		}
+		player.Team, _ = r.s.team(player.TeamID)
+		players = append(players, player)
+	}
+	r.s.mu.RUnlock()
+
+	return inBatches(players, fn)
+}
+
+// FindByID mengambil player berdasarkan ID
+func (r *playerRepository) FindByID(id uint) (*model.Player, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	player, ok := r.s.players[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &player, nil
+}
+
+// FindByIDs mengambil player dengan ID yang diberikan (tanpa relasi), terurut berdasarkan ID
+func (r *playerRepository) FindByIDs(ids []uint) ([]model.Player, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var players []model.Player
+	for _, player := range sortedValues(r.s.players) {
+		if slices.Contains(ids, player.ID) {
+			players = append(players, player)
+		}
+	}
+	return players, nil
+}
+
+// FindByTeamIDs mengambil player dari beberapa team sekaligus, terurut berdasarkan nomor punggung
+func (r *playerRepository) FindByTeamIDs(teamIDs []uint) ([]model.Player, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var players []model.Player
+	for _, player := range sortedValues(r.s.players) {
+		if slices.Contains(teamIDs, player.TeamID) {
+			players = append(players, player)
+		}
+	}
+	slices.SortStableFunc(players, func(a, b model.Player) int {
+		return cmp.Compare(a.JerseyNumber, b.JerseyNumber)
+	})
+	return players, nil
+}
+
+// FindPage mengambil satu halaman player terurut berdasarkan ID beserta jumlah total player yang cocok
+func (r *playerRepository) FindPage(filter repository.PlayerFilter, page repository.Page) ([]model.Player, int64, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var players []model.Player
+	for _, player := range sortedValues(r.s.players) {
+		if playerMatches(player, filter) {
+			players = append(players, player)
+		}
+	}
+	players, total := paginate(players, page)
+	return players, total, nil
+}
+
+// Update memperbarui data player
+func (r *playerRepository) Update(player *model.Player) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	player.UpdatedAt = r.s.now()
+	r.s.players[player.ID] = stripPlayer(*player)
+	return nil
+}
+
+// Delete menghapus player
+func (r *playerRepository) Delete(id uint) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	delete(r.s.players, id)
+	return nil
+}
+
+// CheckJerseyNumberExists memeriksa apakah nomor punggung sudah digunakan di tim
+func (r *playerRepository) CheckJerseyNumberExists(teamID uint, jerseyNumber int, excludePlayerID uint) (bool, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	for _, player := range r.s.players {
+		if player.TeamID == teamID && player.JerseyNumber == jerseyNumber && player.ID != excludePlayerID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// playerMatches memeriksa apakah player memenuhi filter
+func playerMatches(player model.Player, filter repository.PlayerFilter) bool {
+	return (filter.TeamID == nil || player.TeamID == *filter.TeamID) &&
+		(filter.Position == "" || player.Position == filter.Position) &&
+		!slices.Contains(filter.ExcludeIDs, player.ID)
+}
+
+// stripPlayer membuang relasi agar yang tersimpan hanya kolom tabel players
+func stripPlayer(player model.Player) model.Player {
+	player.Team = model.Team{}
+	player.Goals = nil
+	return player
+}
@@ -0,0 +1,105 @@
+package memory
+
+import (
+	"slices"
+	"time"
+	"xyz-football-api/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// playerAvailabilityRepository adalah implementasi in-memory repository.PlayerAvailabilityRepository
+type playerAvailabilityRepository struct {
+	s *Store
+}
+
+// Create membuat record ketersediaan baru
+func (r *playerAvailabilityRepository) Create(availability *model.PlayerAvailability) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	now := r.s.now()
+	availability.ID = r.s.nextID()
+	availability.CreatedAt = now
+	availability.UpdatedAt = now
+	r.s.availabilities[availability.ID] = stripAvailability(*availability)
+	return nil
+}
+
+// FindByPlayerID mengambil semua record ketersediaan dari player tertentu (terbaru dulu)
+func (r *playerAvailabilityRepository) FindByPlayerID(playerID uint) ([]model.PlayerAvailability, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var availabilities []model.PlayerAvailability
+	for _, availability := range sortedValues(r.s.availabilities) {
+		if availability.PlayerID == playerID {
+			availabilities = append(availabilities, availability)
+		}
+	}
+	slices.SortStableFunc(availabilities, func(a, b model.PlayerAvailability) int {
+		return b.StartDate.Compare(a.StartDate)
+	})
+	return availabilities, nil
+}
+
+// FindByID mengambil record ketersediaan berdasarkan ID
+func (r *playerAvailabilityRepository) FindByID(id uint) (*model.PlayerAvailability, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	availability, ok := r.s.availabilities[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &availability, nil
+}
+
+// Update memperbarui record ketersediaan
+func (r *playerAvailabilityRepository) Update(availability *model.PlayerAvailability) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	availability.UpdatedAt = r.s.now()
+	r.s.availabilities[availability.ID] = stripAvailability(*availability)
+	return nil
+}
+
+// Delete menghapus record ketersediaan
+func (r *playerAvailabilityRepository) Delete(id uint) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	delete(r.s.availabilities, id)
+	return nil
+}
+
+// FindUnavailablePlayerIDs mengambil ID player dari team tertentu yang tidak tersedia pada tanggal tertentu
+func (r *playerAvailabilityRepository) FindUnavailablePlayerIDs(teamID uint, date time.Time) ([]uint, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var playerIDs []uint
+	for _, availability := range sortedValues(r.s.availabilities) {
+		player, ok := r.s.players[availability.PlayerID]
+		if !ok || player.TeamID != teamID {
+			continue
+		}
+		if availability.Status == model.AvailabilityStatusAvailable || availability.StartDate.After(date) {
+			continue
+		}
+		if availability.ExpectedReturnDate != nil && !availability.ExpectedReturnDate.After(date) {
+			continue
+		}
+		if !slices.Contains(playerIDs, availability.PlayerID) {
+			playerIDs = append(playerIDs, availability.PlayerID)
+		}
+	}
+	return playerIDs, nil
+}
+
+// stripAvailability membuang relasi agar yang tersimpan hanya kolom tabel player_availabilities
+func stripAvailability(availability model.PlayerAvailability) model.PlayerAvailability {
+	availability.Player = model.Player{}
+	return availability
+}
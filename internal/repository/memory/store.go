@@ -0,0 +1,256 @@
+// Package memory menyediakan implementasi repository in-memory untuk testing
+// tanpa database. Semua repository berbagi satu Store sehingga relasi antar
+// tabel (preload, join, soft delete) berperilaku seperti implementasi GORM.
+package memory
+
+import (
+	"maps"
+	"slices"
+	"sync"
+	"time"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+)
+
+// Store menyimpan seluruh "tabel" in-memory. Record yang dihapus langsung
+// dibuang dari map sehingga tidak pernah terlihat lagi, sama seperti soft delete.
+type Store struct {
+	mu     sync.RWMutex
+	lastID uint
+	tables
+
+	// txMu memastikan hanya satu transaksi berjalan pada satu waktu
+	txMu sync.Mutex
+
+	// now adalah sumber waktu untuk CreatedAt/UpdatedAt
+	now func() time.Time
+}
+
+// tables berisi semua map data. Dipisahkan dari Store agar transaksi bisa
+// menyalin seluruh isinya dan mengembalikannya saat rollback.
+type tables struct {
+	teams                map[uint]model.Team
+	players              map[uint]model.Player
+	matches              map[uint]model.Match
+	goals                map[uint]model.Goal
+	availabilities       map[uint]model.PlayerAvailability
+	venues               map[uint]model.Venue
+	officials            map[uint]model.Official
+	officialConflicts    map[uint][]uint
+	assignments          map[uint]model.MatchOfficial
+	competitions         map[uint]model.Competition
+	competitionTeams     map[uint]model.CompetitionTeam
+	groups               map[uint]model.CompetitionGroup
+	ties                 map[uint]model.CupTie
+	calendarTokens       map[string]model.CalendarToken // key: username
+	webhookSubscriptions map[uint]model.WebhookSubscription
+	webhookDeliveries    map[uint]model.WebhookDelivery
+	outboxEvents         map[uint]model.OutboxEvent
+	users                map[string]model.User // key: username
+}
+
+// clone menyalin semua map. Nilai map tidak pernah diubah di tempat (selalu
+// diganti utuh) sehingga salinan dangkal sudah cukup.
+func (t *tables) clone() tables {
+	return tables{
+		teams:                maps.Clone(t.teams),
+		players:              maps.Clone(t.players),
+		matches:              maps.Clone(t.matches),
+		goals:                maps.Clone(t.goals),
+		availabilities:       maps.Clone(t.availabilities),
+		venues:               maps.Clone(t.venues),
+		officials:            maps.Clone(t.officials),
+		officialConflicts:    maps.Clone(t.officialConflicts),
+		assignments:          maps.Clone(t.assignments),
+		competitions:         maps.Clone(t.competitions),
+		competitionTeams:     maps.Clone(t.competitionTeams),
+		groups:               maps.Clone(t.groups),
+		ties:                 maps.Clone(t.ties),
+		calendarTokens:       maps.Clone(t.calendarTokens),
+		webhookSubscriptions: maps.Clone(t.webhookSubscriptions),
+		webhookDeliveries:    maps.Clone(t.webhookDeliveries),
+		outboxEvents:         maps.Clone(t.outboxEvents),
+		users:                maps.Clone(t.users),
+	}
+}
+
+// NewStore membuat Store kosong
+func NewStore() *Store {
+	return &Store{
+		tables: tables{
+			teams:                make(map[uint]model.Team),
+			players:              make(map[uint]model.Player),
+			matches:              make(map[uint]model.Match),
+			goals:                make(map[uint]model.Goal),
+			availabilities:       make(map[uint]model.PlayerAvailability),
+			venues:               make(map[uint]model.Venue),
+			officials:            make(map[uint]model.Official),
+			officialConflicts:    make(map[uint][]uint),
+			assignments:          make(map[uint]model.MatchOfficial),
+			competitions:         make(map[uint]model.Competition),
+			competitionTeams:     make(map[uint]model.CompetitionTeam),
+			groups:               make(map[uint]model.CompetitionGroup),
+			ties:                 make(map[uint]model.CupTie),
+			calendarTokens:       make(map[string]model.CalendarToken),
+			webhookSubscriptions: make(map[uint]model.WebhookSubscription),
+			webhookDeliveries:    make(map[uint]model.WebhookDelivery),
+			outboxEvents:         make(map[uint]model.OutboxEvent),
+			users:                make(map[string]model.User),
+		},
+		now: time.Now,
+	}
+}
+
+// NewRepositories membuat semua repository in-memory di atas satu Store baru
+func NewRepositories() *repository.Repositories {
+	return NewStore().Repositories()
+}
+
+// Repositories mengembalikan semua repository in-memory yang berbagi Store ini
+func (s *Store) Repositories() *repository.Repositories {
+	return s.repositories(&transactor{s: s})
+}
+
+// repositories membuat bundle repository dengan transactor tertentu
+func (s *Store) repositories(tx repository.Transactor) *repository.Repositories {
+	return &repository.Repositories{
+		Teams:          &teamRepository{s},
+		Players:        &playerRepository{s},
+		Matches:        &matchRepository{s},
+		Goals:          &goalRepository{s},
+		Availabilities: &playerAvailabilityRepository{s},
+		Venues:         &venueRepository{s},
+		Officials:      &officialRepository{s},
+		Competitions:   &competitionRepository{s},
+		CalendarTokens: &calendarTokenRepository{s},
+		Webhooks:       &webhookRepository{s},
+		Outbox:         &outboxRepository{s},
+		Users:          &userRepository{s},
+		Transactor:     tx,
+	}
+}
+
+// nextID menghasilkan primary key baru. Satu sequence dipakai untuk semua tabel
+// sehingga ID tidak pernah dipakai ulang.
+func (s *Store) nextID() uint {
+	s.lastID++
+	return s.lastID
+}
+
+// sortedValues mengembalikan isi map terurut berdasarkan ID
+func sortedValues[T any](records map[uint]T) []T {
+	values := make([]T, 0, len(records))
+	for _, id := range slices.Sorted(maps.Keys(records)) {
+		values = append(values, records[id])
+	}
+	return values
+}
+
+// inBatches memanggil fn untuk setiap potongan records sebanyak repository.BatchSize.
+// Dipanggil tanpa memegang lock agar fn boleh memakai repository lain.
+func inBatches[T any](records []T, fn func([]T) error) error {
+	for start := 0; start < len(records); start += repository.BatchSize {
+		end := min(start+repository.BatchSize, len(records))
+		if err := fn(records[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// paginate memotong records yang sudah terurut sesuai page dan mengembalikan jumlah totalnya
+func paginate[T any](records []T, page repository.Page) ([]T, int64) {
+	if page.Descending {
+		slices.Reverse(records)
+	}
+	start := min(page.Offset, len(records))
+	end := min(start+page.Limit, len(records))
+	return records[start:end], int64(len(records))
+}
+
+// team mengembalikan team (tanpa relasi) jika ada
+func (s *Store) team(id uint) (model.Team, bool) {
+	team, ok := s.teams[id]
+	return team, ok
+}
+
+// teamPtr mengembalikan pointer ke salinan team, atau nil jika id nil/tidak ada
+func (s *Store) teamPtr(id *uint) *model.Team {
+	if id == nil {
+		return nil
+	}
+	team, ok := s.teams[*id]
+	if !ok {
+		return nil
+	}
+	return &team
+}
+
+// venuePtr mengembalikan pointer ke salinan venue, atau nil jika id nil/tidak ada
+func (s *Store) venuePtr(id *uint) *model.Venue {
+	if id == nil {
+		return nil
+	}
+	venue, ok := s.venues[*id]
+	if !ok {
+		return nil
+	}
+	return &venue
+}
+
+// insertMatch menyimpan match baru (tanpa relasi) dan mengisi ID serta timestamp
+func (s *Store) insertMatch(match *model.Match) {
+	now := s.now()
+	match.ID = s.nextID()
+	match.CreatedAt = now
+	match.UpdatedAt = now
+	if match.Status == "" {
+		match.Status = model.MatchStatusScheduled
+	}
+	s.matches[match.ID] = stripMatch(*match)
+}
+
+// stripMatch membuang relasi agar yang tersimpan hanya kolom tabel matches
+func stripMatch(match model.Match) model.Match {
+	match.HomeTeam = model.Team{}
+	match.AwayTeam = model.Team{}
+	match.Venue = nil
+	match.Goals = nil
+	match.Officials = nil
+	return match
+}
+
+// matchesWhere mengembalikan match yang memenuhi filter, terurut berdasarkan less
+func (s *Store) matchesWhere(filter func(model.Match) bool, less func(a, b model.Match) int) []model.Match {
+	var matches []model.Match
+	for _, match := range sortedValues(s.matches) {
+		if filter(match) {
+			matches = append(matches, match)
+		}
+	}
+	if less != nil {
+		slices.SortStableFunc(matches, less)
+	}
+	return matches
+}
+
+// uintEqual memeriksa apakah foreign key nullable bernilai v
+func uintEqual(p *uint, v uint) bool {
+	return p != nil && *p == v
+}
+
+// Pastikan setiap implementasi memenuhi interface repository
+var (
+	_ repository.TeamRepository               = (*teamRepository)(nil)
+	_ repository.PlayerRepository             = (*playerRepository)(nil)
+	_ repository.MatchRepository              = (*matchRepository)(nil)
+	_ repository.GoalRepository               = (*goalRepository)(nil)
+	_ repository.PlayerAvailabilityRepository = (*playerAvailabilityRepository)(nil)
+	_ repository.VenueRepository              = (*venueRepository)(nil)
+	_ repository.OfficialRepository           = (*officialRepository)(nil)
+	_ repository.CompetitionRepository        = (*competitionRepository)(nil)
+	_ repository.CalendarTokenRepository      = (*calendarTokenRepository)(nil)
+	_ repository.WebhookRepository            = (*webhookRepository)(nil)
+	_ repository.OutboxRepository             = (*outboxRepository)(nil)
+	_ repository.Transactor                   = (*transactor)(nil)
+)
@@ -0,0 +1,152 @@
+package memory
+
+import (
+	"slices"
+	"strings"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+
+	"gorm.io/gorm"
+)
+
+// teamRepository adalah implementasi in-memory repository.TeamRepository
+type teamRepository struct {
+	s *Store
+}
+
+// Create membuat team baru
+func (r *teamRepository) Create(team *model.Team) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	now := r.s.now()
+	team.ID = r.s.nextID()
+	team.CreatedAt = now
+	team.UpdatedAt = now
+	r.s.teams[team.ID] = stripTeam(*team)
+	return nil
+}
+
+// CreateBatch membuat banyak team sekaligus
+func (r *teamRepository) CreateBatch(teams []model.Team) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	now := r.s.now()
+	for i := range teams {
+		teams[i].ID = r.s.nextID()
+		teams[i].CreatedAt = now
+		teams[i].UpdatedAt = now
+		r.s.teams[teams[i].ID] = stripTeam(teams[i])
+	}
+	return nil
+}
+
+// FindAll mengambil semua team
+func (r *teamRepository) FindAll() ([]model.Team, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	return sortedValues(r.s.teams), nil
+}
+
+// FindInBatches membaca semua team per batch, terurut berdasarkan ID
+func (r *teamRepository) FindInBatches(fn func(teams []model.Team) error) error {
+	r.s.mu.RLock()
+	teams := sortedValues(r.s.teams)
+	r.s.mu.RUnlock()
+
+	return inBatches(teams, fn)
+}
+
+// FindByID mengambil team berdasarkan ID beserta home venue
+func (r *teamRepository) FindByID(id uint) (*model.Team, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	team, ok := r.s.team(id)
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	team.HomeVenue = r.s.venuePtr(team.HomeVenueID)
+	return &team, nil
+}
+
+// FindByIDs mengambil team dengan ID yang diberikan (tanpa relasi), terurut berdasarkan ID
+func (r *teamRepository) FindByIDs(ids []uint) ([]model.Team, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var teams []model.Team
+	for _, team := range sortedValues(r.s.teams) {
+		if slices.Contains(ids, team.ID) {
+			teams = append(teams, team)
+		}
+	}
+	return teams, nil
+}
+
+// FindPage mengambil satu halaman team terurut berdasarkan ID beserta jumlah total team yang cocok
+func (r *teamRepository) FindPage(filter repository.TeamFilter, page repository.Page) ([]model.Team, int64, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var teams []model.Team
+	for _, team := range sortedValues(r.s.teams) {
+		if filter.Name != "" && !strings.Contains(strings.ToLower(team.Name), strings.ToLower(filter.Name)) {
+			continue
+		}
+		if filter.City != "" && (team.HeadquartersCity == nil || !strings.EqualFold(*team.HeadquartersCity, filter.City)) {
+			continue
+		}
+		teams = append(teams, team)
+	}
+	teams, total := paginate(teams, page)
+	return teams, total, nil
+}
+
+// Update memperbarui data team
+func (r *teamRepository) Update(team *model.Team) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	team.UpdatedAt = r.s.now()
+	r.s.teams[team.ID] = stripTeam(*team)
+	return nil
+}
+
+// Delete menghapus team
+func (r *teamRepository) Delete(id uint) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	delete(r.s.teams, id)
+	return nil
+}
+
+// CountWinsByTeamID menghitung total kemenangan tim sebagai home atau away
+func (r *teamRepository) CountWinsByTeamID(teamID uint) (int64, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var wins int64
+	for _, match := range r.s.matches {
+		if match.Status != model.MatchStatusCompleted {
+			continue
+		}
+		if (match.HomeTeamID == teamID && match.HomeScore > match.AwayScore) ||
+			(match.AwayTeamID == teamID && match.AwayScore > match.HomeScore) {
+			wins++
+		}
+	}
+	return wins, nil
+}
+
+// stripTeam membuang relasi agar yang tersimpan hanya kolom tabel teams
+func stripTeam(team model.Team) model.Team {
+	team.HomeVenue = nil
+	team.Players = nil
+	team.HomeMatches = nil
+	team.AwayMatches = nil
+	return team
+}
@@ -0,0 +1,39 @@
+package memory
+
+import (
+	"xyz-football-api/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// userRepository adalah implementasi in-memory repository.UserRepository
+type userRepository struct {
+	s *Store
+}
+
+// Create menyimpan user baru. Username harus unik (unique index).
+func (r *userRepository) Create(user *model.User) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if _, exists := r.s.users[user.Username]; exists {
+		return gorm.ErrDuplicatedKey
+	}
+
+	user.ID = r.s.nextID()
+	user.CreatedAt = r.s.now()
+	r.s.users[user.Username] = *user
+	return nil
+}
+
+// FindByUsername mengambil user berdasarkan username
+func (r *userRepository) FindByUsername(username string) (*model.User, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	user, ok := r.s.users[username]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &user, nil
+}
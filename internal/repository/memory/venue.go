@@ -0,0 +1,67 @@
+package memory
+
+import (
+	"xyz-football-api/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// venueRepository adalah implementasi in-memory repository.VenueRepository
+type venueRepository struct {
+	s *Store
+}
+
+// Create membuat venue baru (surface default grass seperti default kolom database)
+func (r *venueRepository) Create(venue *model.Venue) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	now := r.s.now()
+	venue.ID = r.s.nextID()
+	venue.CreatedAt = now
+	venue.UpdatedAt = now
+	if venue.Surface == "" {
+		venue.Surface = "grass"
+	}
+	r.s.venues[venue.ID] = *venue
+	return nil
+}
+
+// FindAll mengambil semua venue
+func (r *venueRepository) FindAll() ([]model.Venue, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	return sortedValues(r.s.venues), nil
+}
+
+// FindByID mengambil venue berdasarkan ID
+func (r *venueRepository) FindByID(id uint) (*model.Venue, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	venue, ok := r.s.venues[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &venue, nil
+}
+
+// Update memperbarui data venue
+func (r *venueRepository) Update(venue *model.Venue) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	venue.UpdatedAt = r.s.now()
+	r.s.venues[venue.ID] = *venue
+	return nil
+}
+
+// Delete menghapus venue
+func (r *venueRepository) Delete(id uint) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	delete(r.s.venues, id)
+	return nil
+}
@@ -0,0 +1,167 @@
+package memory
+
+import (
+	"slices"
+	"time"
+	"xyz-football-api/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// webhookRepository adalah implementasi in-memory repository.WebhookRepository
+type webhookRepository struct {
+	s *Store
+}
+
+// CreateSubscription menyimpan subscription baru
+func (r *webhookRepository) CreateSubscription(subscription *model.WebhookSubscription) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	now := r.s.now()
+	subscription.ID = r.s.nextID()
+	subscription.CreatedAt = now
+	subscription.UpdatedAt = now
+	r.s.webhookSubscriptions[subscription.ID] = *subscription
+	return nil
+}
+
+// FindAllSubscriptions mengambil semua subscription
+func (r *webhookRepository) FindAllSubscriptions() ([]model.WebhookSubscription, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	return sortedValues(r.s.webhookSubscriptions), nil
+}
+
+// FindSubscriptionByID mengambil subscription berdasarkan ID
+func (r *webhookRepository) FindSubscriptionByID(id uint) (*model.WebhookSubscription, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	subscription, ok := r.s.webhookSubscriptions[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &subscription, nil
+}
+
+// UpdateSubscription memperbarui subscription
+func (r *webhookRepository) UpdateSubscription(subscription *model.WebhookSubscription) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	subscription.UpdatedAt = r.s.now()
+	r.s.webhookSubscriptions[subscription.ID] = *subscription
+	return nil
+}
+
+// DeleteSubscription menghapus subscription beserta log pengirimannya
+func (r *webhookRepository) DeleteSubscription(id uint) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	delete(r.s.webhookSubscriptions, id)
+	for deliveryID, delivery := range r.s.webhookDeliveries {
+		if delivery.SubscriptionID == id {
+			delete(r.s.webhookDeliveries, deliveryID)
+		}
+	}
+	return nil
+}
+
+// FindActiveSubscriptions mengambil subscription yang aktif
+func (r *webhookRepository) FindActiveSubscriptions() ([]model.WebhookSubscription, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var subscriptions []model.WebhookSubscription
+	for _, subscription := range sortedValues(r.s.webhookSubscriptions) {
+		if subscription.Active {
+			subscriptions = append(subscriptions, subscription)
+		}
+	}
+	return subscriptions, nil
+}
+
+// CreateDeliveries menyimpan beberapa pengiriman sekaligus
+func (r *webhookRepository) CreateDeliveries(deliveries []model.WebhookDelivery) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	now := r.s.now()
+	for i := range deliveries {
+		deliveries[i].ID = r.s.nextID()
+		deliveries[i].CreatedAt = now
+		deliveries[i].UpdatedAt = now
+		r.s.webhookDeliveries[deliveries[i].ID] = deliveries[i]
+	}
+	return nil
+}
+
+// HasDeliveriesForEvent memeriksa apakah event sudah pernah diantrekan
+func (r *webhookRepository) HasDeliveriesForEvent(eventID string) (bool, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	for _, delivery := range r.s.webhookDeliveries {
+		if delivery.EventID == eventID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// FindDeliveryByID mengambil pengiriman berdasarkan ID
+func (r *webhookRepository) FindDeliveryByID(id uint) (*model.WebhookDelivery, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	delivery, ok := r.s.webhookDeliveries[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &delivery, nil
+}
+
+// FindDeliveriesBySubscription mengambil log pengiriman subscription, terbaru lebih dulu
+func (r *webhookRepository) FindDeliveriesBySubscription(subscriptionID uint, limit int) ([]model.WebhookDelivery, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var deliveries []model.WebhookDelivery
+	for _, delivery := range sortedValues(r.s.webhookDeliveries) {
+		if delivery.SubscriptionID == subscriptionID {
+			deliveries = append(deliveries, delivery)
+		}
+	}
+	slices.Reverse(deliveries)
+	return deliveries[:min(limit, len(deliveries))], nil
+}
+
+// FindDueDeliveries mengambil pengiriman pending yang jadwal percobaannya sudah lewat, urut ID
+func (r *webhookRepository) FindDueDeliveries(now time.Time, limit int) ([]model.WebhookDelivery, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var deliveries []model.WebhookDelivery
+	for _, delivery := range sortedValues(r.s.webhookDeliveries) {
+		if len(deliveries) == limit {
+			break
+		}
+		if delivery.Status == model.WebhookDeliveryPending && delivery.NextAttemptAt != nil && !delivery.NextAttemptAt.After(now) {
+			deliveries = append(deliveries, delivery)
+		}
+	}
+	return deliveries, nil
+}
+
+// UpdateDelivery menyimpan hasil percobaan pengiriman
+func (r *webhookRepository) UpdateDelivery(delivery *model.WebhookDelivery) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	delivery.UpdatedAt = r.s.now()
+	r.s.webhookDeliveries[delivery.ID] = *delivery
+	return nil
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"time"
+	"xyz-football-api/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// OfficialRepository mendefinisikan operasi data untuk Official dan penugasannya ke match
+type OfficialRepository interface {
+	Create(official *model.Official) error
+	FindAll() ([]model.Official, error)
+	FindByID(id uint) (*model.Official, error)
+	Update(official *model.Official) error
+	Delete(id uint) error
+	IsConflictedWithTeams(officialID uint, teamIDs ...uint) (bool, error)
+	CreateAssignment(assignment *model.MatchOfficial) error
+	FindAssignmentsByMatchID(matchID uint) ([]model.MatchOfficial, error)
+	FindAssignmentByID(id uint) (*model.MatchOfficial, error)
+	DeleteAssignment(id uint) error
+	CountAssignmentsByRole(matchID uint, role model.OfficialRole) (int64, error)
+	HasOverlappingAssignment(officialID, excludeMatchID uint, from, to time.Time) (bool, error)
+}
+
+// officialRepository adalah implementasi OfficialRepository berbasis GORM
+type officialRepository struct {
+	db *gorm.DB
+}
+
+// NewOfficialRepository membuat instance OfficialRepository berbasis GORM
+func NewOfficialRepository(db *gorm.DB) OfficialRepository {
+	return &officialRepository{db: db}
+}
+
+// Create membuat official baru beserta daftar tim yang konflik
+func (r *officialRepository) Create(official *model.Official) error {
+	return r.db.Create(official).Error
+}
+
+// FindAll mengambil semua official
+func (r *officialRepository) FindAll() ([]model.Official, error) {
+	var officials []model.Official
+	err := r.db.Preload("ConflictedTeams").Find(&officials).Error
+	return officials, err
+}
+
+// FindByID mengambil official berdasarkan ID beserta daftar tim yang konflik
+func (r *officialRepository) FindByID(id uint) (*model.Official, error) {
+	var official model.Official
+	err := r.db.Preload("ConflictedTeams").First(&official, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &official, nil
+}
+
+// Update memperbarui data official dan mengganti daftar tim yang konflik
+func (r *officialRepository) Update(official *model.Official) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("ConflictedTeams").Save(official).Error; err != nil {
+			return err
+		}
+		return tx.Model(official).Association("ConflictedTeams").Replace(official.ConflictedTeams)
+	})
+}
+
+// Delete menghapus official (soft delete)
+func (r *officialRepository) Delete(id uint) error {
+	return r.db.Delete(&model.Official{}, id).Error
+}
+
+// IsConflictedWithTeams memeriksa apakah official ditandai konflik dengan salah satu tim
+func (r *officialRepository) IsConflictedWithTeams(officialID uint, teamIDs ...uint) (bool, error) {
+	var count int64
+	err := r.db.Table("official_team_conflicts").
+		Where("official_id = ? AND team_id IN ?", officialID, teamIDs).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// CreateAssignment menugaskan official ke sebuah match
+func (r *officialRepository) CreateAssignment(assignment *model.MatchOfficial) error {
+	return r.db.Create(assignment).Error
+}
+
+// FindAssignmentsByMatchID mengambil semua penugasan official dalam match tertentu
+func (r *officialRepository) FindAssignmentsByMatchID(matchID uint) ([]model.MatchOfficial, error) {
+	var assignments []model.MatchOfficial
+	err := r.db.Where("match_id = ?", matchID).Preload("Official").Order("id").Find(&assignments).Error
+	return assignments, err
+}
+
+// FindAssignmentByID mengambil penugasan official berdasarkan ID
+func (r *officialRepository) FindAssignmentByID(id uint) (*model.MatchOfficial, error) {
+	var assignment model.MatchOfficial
+	err := r.db.First(&assignment, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &assignment, nil
+}
+
+// DeleteAssignment menghapus penugasan official
+func (r *officialRepository) DeleteAssignment(id uint) error {
+	return r.db.Delete(&model.MatchOfficial{}, id).Error
+}
+
+// CountAssignmentsByRole menghitung jumlah official dengan peran tertentu dalam match
+func (r *officialRepository) CountAssignmentsByRole(matchID uint, role model.OfficialRole) (int64, error) {
+	var count int64
+	err := r.db.Model(&model.MatchOfficial{}).
+		Where("match_id = ? AND role = ?", matchID, role).
+		Count(&count).Error
+	return count, err
+}
+
+// HasOverlappingAssignment memeriksa apakah official sudah ditugaskan di match lain
+// (yang tidak dibatalkan) dengan jadwal di antara from dan to
+func (r *officialRepository) HasOverlappingAssignment(officialID, excludeMatchID uint, from, to time.Time) (bool, error) {
+	var count int64
+	err := r.db.Model(&model.MatchOfficial{}).
+		Joins("JOIN matches ON matches.id = match_officials.match_id AND matches.deleted_at IS NULL").
+		Where("match_officials.official_id = ?", officialID).
+		Where("match_officials.match_id <> ?", excludeMatchID).
+		Where("matches.status <> ?", model.MatchStatusCancelled).
+		Where("matches.match_datetime > ? AND matches.match_datetime < ?", from, to).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"time"
+	"xyz-football-api/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// OutboxRepository mendefinisikan operasi data untuk tabel outbox event.
+// Append dipanggil di dalam Transaction bersama perubahan datanya; sisanya dipakai dispatcher.
+type OutboxRepository interface {
+	Append(events []model.OutboxEvent) error
+	// FindPending mengambil event yang belum dipublikasikan dan tidak diparkir, urut ID.
+	// Di PostgreSQL urutan ID bukan urutan commit: transaksi yang mendapat ID lebih kecil bisa
+	// commit belakangan, sehingga event-nya baru terlihat setelah event dengan ID lebih besar.
+	FindPending(limit int) ([]model.OutboxEvent, error)
+	MarkPublished(id uint, at time.Time) error
+	// RecordFailure menambah jumlah percobaan, menyimpan error terakhir, dan menjadwalkan
+	// percobaan berikutnya. failingSince adalah waktu kegagalan pertama yang belum terpulihkan.
+	RecordFailure(id uint, message string, failingSince, nextAttemptAt time.Time) error
+	// Park menandai event diparkir sehingga tidak diambil FindPending lagi
+	Park(id uint, message string, at time.Time) error
+	// FindParked mengambil event yang diparkir, urut ID
+	FindParked(limit int) ([]model.OutboxEvent, error)
+	// Requeue mengembalikan event yang diparkir ke antrean dan mengembalikan jumlahnya.
+	// ids kosong berarti semua event yang diparkir.
+	Requeue(ids []uint) (int64, error)
+}
+
+// outboxRepository adalah implementasi OutboxRepository berbasis GORM
+type outboxRepository struct {
+	db *gorm.DB
+}
+
+// NewOutboxRepository membuat instance OutboxRepository berbasis GORM
+func NewOutboxRepository(db *gorm.DB) OutboxRepository {
+	return &outboxRepository{db: db}
+}
+
+// Append menyimpan event baru ke outbox
+func (r *outboxRepository) Append(events []model.OutboxEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	return r.db.CreateInBatches(events, createBatchSize).Error
+}
+
+// FindPending mengambil event yang belum dipublikasikan dan belum diparkir, urut ID
+func (r *outboxRepository) FindPending(limit int) ([]model.OutboxEvent, error) {
+	var events []model.OutboxEvent
+	err := r.db.Where("published_at IS NULL AND parked_at IS NULL").Order("id").Limit(limit).Find(&events).Error
+	return events, err
+}
+
+// MarkPublished menandai event sudah dipublikasikan
+func (r *outboxRepository) MarkPublished(id uint, at time.Time) error {
+	return r.db.Model(&model.OutboxEvent{}).Where("id = ?", id).Updates(map[string]any{
+		"published_at":    at,
+		"attempts":        gorm.Expr("attempts + 1"),
+		"last_error":      nil,
+		"next_attempt_at": nil,
+		"failing_since":   nil,
+	}).Error
+}
+
+// RecordFailure menambah jumlah percobaan, menyimpan error terakhir, dan menjadwalkan percobaan berikutnya
+func (r *outboxRepository) RecordFailure(id uint, message string, failingSince, nextAttemptAt time.Time) error {
+	return r.db.Model(&model.OutboxEvent{}).Where("id = ?", id).Updates(map[string]any{
+		"attempts":        gorm.Expr("attempts + 1"),
+		"last_error":      message,
+		"failing_since":   failingSince,
+		"next_attempt_at": nextAttemptAt,
+	}).Error
+}
+
+// Park menandai event diparkir
+func (r *outboxRepository) Park(id uint, message string, at time.Time) error {
+	return r.db.Model(&model.OutboxEvent{}).Where("id = ?", id).Updates(map[string]any{
+		"attempts":        gorm.Expr("attempts + 1"),
+		"last_error":      message,
+		"next_attempt_at": nil,
+		"parked_at":       at,
+	}).Error
+}
+
+// FindParked mengambil event yang diparkir, urut ID
+func (r *outboxRepository) FindParked(limit int) ([]model.OutboxEvent, error) {
+	var events []model.OutboxEvent
+	err := r.db.Where("published_at IS NULL AND parked_at IS NOT NULL").Order("id").Limit(limit).Find(&events).Error
+	return events, err
+}
+
+// Requeue mengembalikan event yang diparkir ke antrean dengan jumlah percobaan dari nol
+func (r *outboxRepository) Requeue(ids []uint) (int64, error) {
+	query := r.db.Model(&model.OutboxEvent{}).Where("published_at IS NULL AND parked_at IS NOT NULL")
+	if len(ids) > 0 {
+		query = query.Where("id IN ?", ids)
+	}
+	result := query.Updates(map[string]any{
+		"attempts":        0,
+		"next_attempt_at": nil,
+		"failing_since":   nil,
+		"parked_at":       nil,
+	})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"time"
+	"xyz-football-api/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// PlayerAvailabilityRepository mendefinisikan operasi data untuk PlayerAvailability
+type PlayerAvailabilityRepository interface {
+	Create(availability *model.PlayerAvailability) error
+	FindByPlayerID(playerID uint) ([]model.PlayerAvailability, error)
+	FindByID(id uint) (*model.PlayerAvailability, error)
+	Update(availability *model.PlayerAvailability) error
+	Delete(id uint) error
+	FindUnavailablePlayerIDs(teamID uint, date time.Time) ([]uint, error)
+}
+
+// playerAvailabilityRepository adalah implementasi PlayerAvailabilityRepository berbasis GORM
+type playerAvailabilityRepository struct {
+	db *gorm.DB
+}
+
+// NewPlayerAvailabilityRepository membuat instance PlayerAvailabilityRepository berbasis GORM
+func NewPlayerAvailabilityRepository(db *gorm.DB) PlayerAvailabilityRepository {
+	return &playerAvailabilityRepository{db: db}
+}
+
+// Create membuat record ketersediaan baru
+func (r *playerAvailabilityRepository) Create(availability *model.PlayerAvailability) error {
+	return r.db.Create(availability).Error
+}
+
+// FindByPlayerID mengambil semua record ketersediaan dari player tertentu
+func (r *playerAvailabilityRepository) FindByPlayerID(playerID uint) ([]model.PlayerAvailability, error) {
+	var availabilities []model.PlayerAvailability
+	err := r.db.Where("player_id = ?", playerID).Order("start_date DESC").Find(&availabilities).Error
+	return availabilities, err
+}
+
+// FindByID mengambil record ketersediaan berdasarkan ID
+func (r *playerAvailabilityRepository) FindByID(id uint) (*model.PlayerAvailability, error) {
+	var availability model.PlayerAvailability
+	err := r.db.First(&availability, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &availability, nil
+}
+
+// Update memperbarui record ketersediaan
+func (r *playerAvailabilityRepository) Update(availability *model.PlayerAvailability) error {
+	return r.db.Save(availability).Error
+}
+
+// Delete menghapus record ketersediaan (soft delete)
+func (r *playerAvailabilityRepository) Delete(id uint) error {
+	return r.db.Delete(&model.PlayerAvailability{}, id).Error
+}
+
+// FindUnavailablePlayerIDs mengambil ID player dari team tertentu yang tidak tersedia pada tanggal tertentu.
+// Player dianggap tidak tersedia jika memiliki record dengan status selain available
+// yang dimulai pada/sebelum tanggal tersebut dan belum mencapai expected_return_date.
+func (r *playerAvailabilityRepository) FindUnavailablePlayerIDs(teamID uint, date time.Time) ([]uint, error) {
+	var playerIDs []uint
+	err := r.db.Model(&model.PlayerAvailability{}).
+		Joins("JOIN players ON players.id = player_availabilities.player_id AND players.deleted_at IS NULL").
+		Where("players.team_id = ?", teamID).
+		Where("player_availabilities.status <> ?", model.AvailabilityStatusAvailable).
+		Where("player_availabilities.start_date <= ?", date).
+		Where("player_availabilities.expected_return_date IS NULL OR player_availabilities.expected_return_date > ?", date).
+		Distinct().
+		Pluck("player_availabilities.player_id", &playerIDs).Error
+	return playerIDs, err
+}
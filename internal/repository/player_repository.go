@@ -6,30 +6,83 @@ import (
 	"gorm.io/gorm"
 )
 
-// PlayerRepository menangani operasi database untuk Player
-type PlayerRepository struct {
+// PlayerRepository mendefinisikan operasi data untuk Player
+type PlayerRepository interface {
+	Create(player *model.Player) error
+	CreateBatch(players []model.Player) error
+	FindByTeamID(teamID uint) ([]model.Player, error)
+	FindInBatches(filter PlayerFilter, fn func(players []model.Player) error) error
+	FindByID(id uint) (*model.Player, error)
+	FindByIDs(ids []uint) ([]model.Player, error)
+	FindByTeamIDs(teamIDs []uint) ([]model.Player, error)
+	FindPage(filter PlayerFilter, page Page) ([]model.Player, int64, error)
+	Update(player *model.Player) error
+	Delete(id uint) error
+	CheckJerseyNumberExists(teamID uint, jerseyNumber int, excludePlayerID uint) (bool, error)
+}
+
+// PlayerFilter membatasi player yang dibaca FindInBatches dan FindPage; field kosong tidak memfilter
+type PlayerFilter struct {
+	TeamID     *uint
+	Position   string
+	ExcludeIDs []uint
+}
+
+// apply menambahkan kondisi filter ke query
+func (f PlayerFilter) apply(query *gorm.DB) *gorm.DB {
+	if f.TeamID != nil {
+		query = query.Where("team_id = ?", *f.TeamID)
+	}
+	if f.Position != "" {
+		query = query.Where("position = ?", f.Position)
+	}
+	if len(f.ExcludeIDs) > 0 {
+		query = query.Where("id NOT IN ?", f.ExcludeIDs)
+	}
+	return query
+}
+
+// playerRepository adalah implementasi PlayerRepository berbasis GORM
+type playerRepository struct {
 	db *gorm.DB
 }
 
-// NewPlayerRepository membuat instance PlayerRepository baru
-func NewPlayerRepository(db *gorm.DB) *PlayerRepository {
-	return &PlayerRepository{db: db}
+// NewPlayerRepository membuat instance PlayerRepository berbasis GORM
+func NewPlayerRepository(db *gorm.DB) PlayerRepository {
+	return &playerRepository{db: db}
 }
 
 // Create membuat player baru
-func (r *PlayerRepository) Create(player *model.Player) error {
+func (r *playerRepository) Create(player *model.Player) error {
 	return r.db.Create(player).Error
 }
 
+// CreateBatch membuat banyak player dalam satu transaksi; jika satu gagal, tidak ada yang tersimpan
+func (r *playerRepository) CreateBatch(players []model.Player) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return tx.CreateInBatches(players, createBatchSize).Error
+	})
+}
+
 // FindByTeamID mengambil semua player dari team tertentu
-func (r *PlayerRepository) FindByTeamID(teamID uint) ([]model.Player, error) {
+func (r *playerRepository) FindByTeamID(teamID uint) ([]model.Player, error) {
 	var players []model.Player
 	err := r.db.Where("team_id = ?", teamID).Find(&players).Error
 	return players, err
 }
 
+// FindInBatches membaca player beserta team-nya per BatchSize record, terurut berdasarkan ID
+func (r *playerRepository) FindInBatches(filter PlayerFilter, fn func(players []model.Player) error) error {
+	query := filter.apply(r.db.Preload("Team"))
+
+	var players []model.Player
+	return query.FindInBatches(&players, BatchSize, func(*gorm.DB, int) error {
+		return fn(players)
+	}).Error
+}
+
 // FindByID mengambil player berdasarkan ID
-func (r *PlayerRepository) FindByID(id uint) (*model.Player, error) {
+func (r *playerRepository) FindByID(id uint) (*model.Player, error) {
 	var player model.Player
 	err := r.db.First(&player, id).Error
 	if err != nil {
@@ -38,18 +91,46 @@ func (r *PlayerRepository) FindByID(id uint) (*model.Player, error) {
 	return &player, nil
 }
 
+// FindByIDs mengambil player dengan ID yang diberikan (tanpa relasi), terurut berdasarkan ID
+func (r *playerRepository) FindByIDs(ids []uint) ([]model.Player, error) {
+	var players []model.Player
+	err := r.db.Where("id IN ?", ids).Order("id").Find(&players).Error
+	return players, err
+}
+
+// FindByTeamIDs mengambil player dari beberapa team sekaligus, terurut berdasarkan nomor punggung
+func (r *playerRepository) FindByTeamIDs(teamIDs []uint) ([]model.Player, error) {
+	var players []model.Player
+	err := r.db.Where("team_id IN ?", teamIDs).Order("jersey_number, id").Find(&players).Error
+	return players, err
+}
+
+// FindPage mengambil satu halaman player terurut berdasarkan ID beserta jumlah total player yang cocok
+func (r *playerRepository) FindPage(filter PlayerFilter, page Page) ([]model.Player, int64, error) {
+	query := filter.apply(r.db.Model(&model.Player{})).Session(&gorm.Session{})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var players []model.Player
+	err := query.Order(page.order("id")).Limit(page.Limit).Offset(page.Offset).Find(&players).Error
+	return players, total, err
+}
+
 // Update memperbarui data player
-func (r *PlayerRepository) Update(player *model.Player) error {
+func (r *playerRepository) Update(player *model.Player) error {
 	return r.db.Save(player).Error
 }
 
 // Delete menghapus player (soft delete)
-func (r *PlayerRepository) Delete(id uint) error {
+func (r *playerRepository) Delete(id uint) error {
 	return r.db.Delete(&model.Player{}, id).Error
 }
 
 // CheckJerseyNumberExists memeriksa apakah nomor punggung sudah digunakan di tim
-func (r *PlayerRepository) CheckJerseyNumberExists(teamID uint, jerseyNumber int, excludePlayerID uint) (bool, error) {
+func (r *playerRepository) CheckJerseyNumberExists(teamID uint, jerseyNumber int, excludePlayerID uint) (bool, error) {
 	var count int64
 	query := r.db.Model(&model.Player{}).
 		Where("team_id = ? AND jersey_number = ?", teamID, jerseyNumber)
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// createBatchSize adalah jumlah baris per statement INSERT pada operasi CreateBatch
+const createBatchSize = 100
+
+// BatchSize adalah jumlah record per batch pada operasi FindInBatches, sehingga
+// data dalam jumlah besar dibaca bertahap tanpa dimuat sekaligus ke memory
+const BatchSize = 500
+
+// Page membatasi hasil operasi FindPage: paling banyak Limit record, dimulai setelah
+// Offset record pertama. Descending membalik urutan bawaan FindPage.
+type Page struct {
+	Limit      int
+	Offset     int
+	Descending bool
+}
+
+// order mengembalikan klausa ORDER BY dari kolom urutan bawaan sesuai arah page
+func (p Page) order(columns ...string) string {
+	direction := ""
+	if p.Descending {
+		direction = " DESC"
+	}
+	for i, column := range columns {
+		columns[i] = column + direction
+	}
+	return strings.Join(columns, ", ")
+}
+
+// Repositories mengelompokkan semua repository yang dibutuhkan oleh handler
+type Repositories struct {
+	Teams          TeamRepository
+	Players        PlayerRepository
+	Matches        MatchRepository
+	Goals          GoalRepository
+	Availabilities PlayerAvailabilityRepository
+	Venues         VenueRepository
+	Officials      OfficialRepository
+	Competitions   CompetitionRepository
+	CalendarTokens CalendarTokenRepository
+	Webhooks       WebhookRepository
+	Outbox         OutboxRepository
+	Users          UserRepository
+	Transactor     Transactor
+}
+
+// Transactor menjalankan beberapa operasi repository dalam satu transaksi database
+type Transactor interface {
+	// Transaction memanggil fn dengan repository yang terikat ke transaksi baru.
+	// Jika fn mengembalikan error, semua perubahan dibatalkan.
+	Transaction(fn func(repos *Repositories) error) error
+}
+
+// gormTransactor adalah implementasi Transactor berbasis GORM
+type gormTransactor struct {
+	db *gorm.DB
+}
+
+// Transaction menjalankan fn dalam transaksi. Transaction bersarang memakai savepoint.
+func (t *gormTransactor) Transaction(fn func(repos *Repositories) error) error {
+	return t.db.Transaction(func(tx *gorm.DB) error {
+		return fn(NewRepositories(tx))
+	})
+}
+
+// NewRepositories membuat semua repository berbasis GORM dari satu koneksi database
+func NewRepositories(db *gorm.DB) *Repositories {
+	return &Repositories{
+		Teams:          NewTeamRepository(db),
+		Players:        NewPlayerRepository(db),
+		Matches:        NewMatchRepository(db),
+		Goals:          NewGoalRepository(db),
+		Availabilities: NewPlayerAvailabilityRepository(db),
+		Venues:         NewVenueRepository(db),
+		Officials:      NewOfficialRepository(db),
+		Competitions:   NewCompetitionRepository(db),
+		CalendarTokens: NewCalendarTokenRepository(db),
+		Webhooks:       NewWebhookRepository(db),
+		Outbox:         NewOutboxRepository(db),
+		Users:          NewUserRepository(db),
+		Transactor:     &gormTransactor{db: db},
+	}
+}
@@ -1,55 +1,119 @@
 package repository
 
 import (
+	"strings"
 	"xyz-football-api/internal/model"
 
 	"gorm.io/gorm"
 )
 
-// TeamRepository menangani operasi database untuk Team
-type TeamRepository struct {
+// TeamRepository mendefinisikan operasi data untuk Team
+type TeamRepository interface {
+	Create(team *model.Team) error
+	CreateBatch(teams []model.Team) error
+	FindAll() ([]model.Team, error)
+	FindInBatches(fn func(teams []model.Team) error) error
+	FindByID(id uint) (*model.Team, error)
+	FindByIDs(ids []uint) ([]model.Team, error)
+	FindPage(filter TeamFilter, page Page) ([]model.Team, int64, error)
+	Update(team *model.Team) error
+	Delete(id uint) error
+	CountWinsByTeamID(teamID uint) (int64, error)
+}
+
+// TeamFilter membatasi team yang dibaca FindPage; field kosong tidak memfilter
+type TeamFilter struct {
+	Name string // bagian dari nama team, tanpa membedakan huruf besar/kecil
+	City string // kota markas, tanpa membedakan huruf besar/kecil
+}
+
+// teamRepository adalah implementasi TeamRepository berbasis GORM
+type teamRepository struct {
 	db *gorm.DB
 }
 
-// NewTeamRepository membuat instance TeamRepository baru
-func NewTeamRepository(db *gorm.DB) *TeamRepository {
-	return &TeamRepository{db: db}
+// NewTeamRepository membuat instance TeamRepository berbasis GORM
+func NewTeamRepository(db *gorm.DB) TeamRepository {
+	return &teamRepository{db: db}
 }
 
 // Create membuat team baru
-func (r *TeamRepository) Create(team *model.Team) error {
+func (r *teamRepository) Create(team *model.Team) error {
 	return r.db.Create(team).Error
 }
 
+// CreateBatch membuat banyak team dalam satu transaksi; jika satu gagal, tidak ada yang tersimpan
+func (r *teamRepository) CreateBatch(teams []model.Team) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return tx.CreateInBatches(teams, createBatchSize).Error
+	})
+}
+
 // FindAll mengambil semua team
-func (r *TeamRepository) FindAll() ([]model.Team, error) {
+func (r *teamRepository) FindAll() ([]model.Team, error) {
 	var teams []model.Team
 	err := r.db.Find(&teams).Error
 	return teams, err
 }
 
-// FindByID mengambil team berdasarkan ID
-func (r *TeamRepository) FindByID(id uint) (*model.Team, error) {
+// FindInBatches membaca semua team per BatchSize record, terurut berdasarkan ID
+func (r *teamRepository) FindInBatches(fn func(teams []model.Team) error) error {
+	var teams []model.Team
+	return r.db.FindInBatches(&teams, BatchSize, func(*gorm.DB, int) error {
+		return fn(teams)
+	}).Error
+}
+
+// FindByID mengambil team berdasarkan ID beserta home venue
+func (r *teamRepository) FindByID(id uint) (*model.Team, error) {
 	var team model.Team
-	err := r.db.First(&team, id).Error
+	err := r.db.Preload("HomeVenue").First(&team, id).Error
 	if err != nil {
 		return nil, err
 	}
 	return &team, nil
 }
 
+// FindByIDs mengambil team dengan ID yang diberikan (tanpa relasi), terurut berdasarkan ID
+func (r *teamRepository) FindByIDs(ids []uint) ([]model.Team, error) {
+	var teams []model.Team
+	err := r.db.Where("id IN ?", ids).Order("id").Find(&teams).Error
+	return teams, err
+}
+
+// FindPage mengambil satu halaman team terurut berdasarkan ID beserta jumlah total team yang cocok
+func (r *teamRepository) FindPage(filter TeamFilter, page Page) ([]model.Team, int64, error) {
+	query := r.db.Model(&model.Team{})
+	if filter.Name != "" {
+		query = query.Where("LOWER(name) LIKE ?", "%"+strings.ToLower(filter.Name)+"%")
+	}
+	if filter.City != "" {
+		query = query.Where("LOWER(headquarters_city) = ?", strings.ToLower(filter.City))
+	}
+	query = query.Session(&gorm.Session{})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var teams []model.Team
+	err := query.Order(page.order("id")).Limit(page.Limit).Offset(page.Offset).Find(&teams).Error
+	return teams, total, err
+}
+
 // Update memperbarui data team
-func (r *TeamRepository) Update(team *model.Team) error {
+func (r *teamRepository) Update(team *model.Team) error {
 	return r.db.Save(team).Error
 }
 
 // Delete menghapus team (soft delete)
-func (r *TeamRepository) Delete(id uint) error {
+func (r *teamRepository) Delete(id uint) error {
 	return r.db.Delete(&model.Team{}, id).Error
 }
 
 // CountWinsByTeamID menghitung total kemenangan tim sebagai home atau away
-func (r *TeamRepository) CountWinsByTeamID(teamID uint) (int64, error) {
+func (r *teamRepository) CountWinsByTeamID(teamID uint) (int64, error) {
 	var count int64
 
 	// Hitung kemenangan sebagai home team (home_score > away_score)
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"xyz-football-api/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// UserRepository mendefinisikan operasi data untuk User
+type UserRepository interface {
+	Create(user *model.User) error
+	FindByUsername(username string) (*model.User, error)
+}
+
+// userRepository adalah implementasi UserRepository berbasis GORM
+type userRepository struct {
+	db *gorm.DB
+}
+
+// NewUserRepository membuat instance UserRepository berbasis GORM
+func NewUserRepository(db *gorm.DB) UserRepository {
+	return &userRepository{db: db}
+}
+
+// Create menyimpan user baru
+func (r *userRepository) Create(user *model.User) error {
+	return r.db.Create(user).Error
+}
+
+// FindByUsername mengambil user berdasarkan username
+func (r *userRepository) FindByUsername(username string) (*model.User, error) {
+	var user model.User
+	err := r.db.Where("username = ?", username).First(&user).Error
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
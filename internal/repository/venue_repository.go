@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"xyz-football-api/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// VenueRepository mendefinisikan operasi data untuk Venue
+type VenueRepository interface {
+	Create(venue *model.Venue) error
+	FindAll() ([]model.Venue, error)
+	FindByID(id uint) (*model.Venue, error)
+	Update(venue *model.Venue) error
+	Delete(id uint) error
+}
+
+// venueRepository adalah implementasi VenueRepository berbasis GORM
+type venueRepository struct {
+	db *gorm.DB
+}
+
+// NewVenueRepository membuat instance VenueRepository berbasis GORM
+func NewVenueRepository(db *gorm.DB) VenueRepository {
+	return &venueRepository{db: db}
+}
+
+// Create membuat venue baru
+func (r *venueRepository) Create(venue *model.Venue) error {
+	return r.db.Create(venue).Error
+}
+
+// FindAll mengambil semua venue
+func (r *venueRepository) FindAll() ([]model.Venue, error) {
+	var venues []model.Venue
+	err := r.db.Find(&venues).Error
+	return venues, err
+}
+
+// FindByID mengambil venue berdasarkan ID
+func (r *venueRepository) FindByID(id uint) (*model.Venue, error) {
+	var venue model.Venue
+	err := r.db.First(&venue, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &venue, nil
+}
+
+// Update memperbarui data venue
+func (r *venueRepository) Update(venue *model.Venue) error {
+	return r.db.Save(venue).Error
+}
+
+// Delete menghapus venue (soft delete)
+func (r *venueRepository) Delete(id uint) error {
+	return r.db.Delete(&model.Venue{}, id).Error
+}
@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"time"
+	"xyz-football-api/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// WebhookRepository mendefinisikan operasi data untuk subscription dan pengiriman webhook
+type WebhookRepository interface {
+	CreateSubscription(subscription *model.WebhookSubscription) error
+	FindAllSubscriptions() ([]model.WebhookSubscription, error)
+	FindSubscriptionByID(id uint) (*model.WebhookSubscription, error)
+	UpdateSubscription(subscription *model.WebhookSubscription) error
+	// DeleteSubscription menghapus subscription beserta seluruh log pengirimannya
+	DeleteSubscription(id uint) error
+	FindActiveSubscriptions() ([]model.WebhookSubscription, error)
+
+	CreateDeliveries(deliveries []model.WebhookDelivery) error
+	// HasDeliveriesForEvent memeriksa apakah event sudah pernah diantrekan
+	HasDeliveriesForEvent(eventID string) (bool, error)
+	FindDeliveryByID(id uint) (*model.WebhookDelivery, error)
+	// FindDeliveriesBySubscription mengambil log pengiriman subscription, terbaru lebih dulu
+	FindDeliveriesBySubscription(subscriptionID uint, limit int) ([]model.WebhookDelivery, error)
+	// FindDueDeliveries mengambil pengiriman pending yang jadwal percobaannya sudah lewat, urut ID
+	FindDueDeliveries(now time.Time, limit int) ([]model.WebhookDelivery, error)
+	UpdateDelivery(delivery *model.WebhookDelivery) error
+}
+
+// webhookRepository adalah implementasi WebhookRepository berbasis GORM
+type webhookRepository struct {
+	db *gorm.DB
+}
+
+// NewWebhookRepository membuat instance WebhookRepository berbasis GORM
+func NewWebhookRepository(db *gorm.DB) WebhookRepository {
+	return &webhookRepository{db: db}
+}
+
+// CreateSubscription menyimpan subscription baru
+func (r *webhookRepository) CreateSubscription(subscription *model.WebhookSubscription) error {
+	return r.db.Create(subscription).Error
+}
+
+// FindAllSubscriptions mengambil semua subscription
+func (r *webhookRepository) FindAllSubscriptions() ([]model.WebhookSubscription, error) {
+	var subscriptions []model.WebhookSubscription
+	err := r.db.Order("id").Find(&subscriptions).Error
+	return subscriptions, err
+}
+
+// FindSubscriptionByID mengambil subscription berdasarkan ID
+func (r *webhookRepository) FindSubscriptionByID(id uint) (*model.WebhookSubscription, error) {
+	var subscription model.WebhookSubscription
+	if err := r.db.First(&subscription, id).Error; err != nil {
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+// UpdateSubscription memperbarui subscription
+func (r *webhookRepository) UpdateSubscription(subscription *model.WebhookSubscription) error {
+	return r.db.Save(subscription).Error
+}
+
+// DeleteSubscription menghapus subscription dan log pengirimannya dalam satu transaksi
+func (r *webhookRepository) DeleteSubscription(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("subscription_id = ?", id).Delete(&model.WebhookDelivery{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&model.WebhookSubscription{}, id).Error
+	})
+}
+
+// FindActiveSubscriptions mengambil subscription yang aktif
+func (r *webhookRepository) FindActiveSubscriptions() ([]model.WebhookSubscription, error) {
+	var subscriptions []model.WebhookSubscription
+	err := r.db.Where("active = ?", true).Order("id").Find(&subscriptions).Error
+	return subscriptions, err
+}
+
+// CreateDeliveries menyimpan beberapa pengiriman sekaligus
+func (r *webhookRepository) CreateDeliveries(deliveries []model.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	return r.db.CreateInBatches(deliveries, createBatchSize).Error
+}
+
+// HasDeliveriesForEvent memeriksa apakah event sudah pernah diantrekan
+func (r *webhookRepository) HasDeliveriesForEvent(eventID string) (bool, error) {
+	var count int64
+	err := r.db.Model(&model.WebhookDelivery{}).Where("event_id = ?", eventID).Count(&count).Error
+	return count > 0, err
+}
+
+// FindDeliveryByID mengambil pengiriman berdasarkan ID
+func (r *webhookRepository) FindDeliveryByID(id uint) (*model.WebhookDelivery, error) {
+	var delivery model.WebhookDelivery
+	if err := r.db.First(&delivery, id).Error; err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// FindDeliveriesBySubscription mengambil log pengiriman subscription, terbaru lebih dulu
+func (r *webhookRepository) FindDeliveriesBySubscription(subscriptionID uint, limit int) ([]model.WebhookDelivery, error) {
+	var deliveries []model.WebhookDelivery
+	err := r.db.Where("subscription_id = ?", subscriptionID).Order("id DESC").Limit(limit).Find(&deliveries).Error
+	return deliveries, err
+}
+
+// FindDueDeliveries mengambil pengiriman pending yang jadwal percobaannya sudah lewat
+func (r *webhookRepository) FindDueDeliveries(now time.Time, limit int) ([]model.WebhookDelivery, error) {
+	var deliveries []model.WebhookDelivery
+	err := r.db.Where("status = ? AND next_attempt_at <= ?", model.WebhookDeliveryPending, now).
+		Order("id").
+		Limit(limit).
+		Find(&deliveries).Error
+	return deliveries, err
+}
+
+// UpdateDelivery menyimpan hasil percobaan pengiriman
+func (r *webhookRepository) UpdateDelivery(delivery *model.WebhookDelivery) error {
+	return r.db.Save(delivery).Error
+}
@@ -0,0 +1,396 @@
+package service
+
+import (
+	"time"
+	"xyz-football-api/internal/event"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+	"xyz-football-api/internal/tournament"
+)
+
+// BracketService mengatur pembuatan bracket dan perpindahan pemenang tie ke babak berikutnya.
+// Dipakai bersama oleh undian kompetisi (bye) dan MatchService (hasil pertandingan).
+// Match grup dan knockout yang dijadwalkan serta kompetisi yang selesai dicatat ke outbox
+// sebagai event match.scheduled dan competition.completed.
+type BracketService struct {
+	competitionRepo repository.CompetitionRepository
+	teamRepo        repository.TeamRepository
+	outboxRepo      repository.OutboxRepository
+	tx              repository.Transactor
+}
+
+// NewBracketService membuat instance BracketService baru
+func NewBracketService(
+	competitionRepo repository.CompetitionRepository,
+	teamRepo repository.TeamRepository,
+	outboxRepo repository.OutboxRepository,
+	tx repository.Transactor,
+) *BracketService {
+	return &BracketService{
+		competitionRepo: competitionRepo,
+		teamRepo:        teamRepo,
+		outboxRepo:      outboxRepo,
+		tx:              tx,
+	}
+}
+
+// newTxBracketService membuat BracketService yang memakai repository transaksi
+func newTxBracketService(repos *repository.Repositories) *BracketService {
+	return NewBracketService(repos.Competitions, repos.Teams, repos.Outbox, repos.Transactor)
+}
+
+// Draw melakukan undian kompetisi draft: membuat bracket (cup) atau fase grup (group_knockout)
+// dari peserta urut seed, diacak dengan drawSeed jika seeding=drawn. Perubahan status, jadwal,
+// bye, dan event match.scheduled disimpan dalam satu transaksi.
+func (s *BracketService) Draw(competitionID uint, drawSeed int64) error {
+	return s.tx.Transaction(func(repos *repository.Repositories) error {
+		started, err := repos.Competitions.StartDraw(competitionID)
+		if err != nil {
+			return err
+		}
+		if !started {
+			return ErrCompetitionAlreadyDrawn
+		}
+
+		competition, err := repos.Competitions.FindByID(competitionID)
+		if err != nil {
+			return err
+		}
+
+		teamIDs := make([]uint, 0, len(competition.Teams))
+		for _, ct := range competition.Teams {
+			teamIDs = append(teamIDs, ct.TeamID)
+		}
+		if competition.Seeding == model.CompetitionSeedingDrawn {
+			teamIDs = tournament.Draw(teamIDs, drawSeed)
+		}
+
+		bracket := newTxBracketService(repos)
+		if competition.Format == model.CompetitionFormatGroupKnockout {
+			return bracket.GenerateGroups(competition, teamIDs)
+		}
+		return bracket.Generate(competition, teamIDs)
+	})
+}
+
+// Rules mengembalikan aturan tie dari konfigurasi kompetisi
+func (s *BracketService) Rules(competition *model.Competition) tournament.TieRules {
+	return tournament.TieRules{
+		TwoLegged:     competition.TwoLegged,
+		AwayGoalsRule: competition.AwayGoalsRule,
+	}
+}
+
+// Generate membuat seluruh tie bracket dari daftar tim terurut, menjadwalkan
+// match babak pertama, lalu memajukan tim yang mendapat bye
+func (s *BracketService) Generate(competition *model.Competition, orderedTeamIDs []uint) error {
+	pairings, err := tournament.FirstRound(orderedTeamIDs)
+	if err != nil {
+		return err
+	}
+
+	totalRounds := tournament.TotalRounds(tournament.BracketSize(len(orderedTeamIDs)))
+
+	var ties []model.CupTie
+	for _, pairing := range pairings {
+		tie := model.CupTie{
+			CompetitionID: competition.ID,
+			Round:         1,
+			Position:      pairing.Position,
+		}
+		if pairing.HomeTeamID != 0 {
+			tie.HomeTeamID = uintPtr(pairing.HomeTeamID)
+		}
+		if pairing.AwayTeamID != 0 {
+			tie.AwayTeamID = uintPtr(pairing.AwayTeamID)
+		}
+		if tie.HomeTeamID != nil && tie.AwayTeamID != nil {
+			tie.Matches = s.scheduleTie(competition, &tie)
+		}
+		ties = append(ties, tie)
+	}
+
+	for round := 2; round <= totalRounds; round++ {
+		for position := 0; position < len(pairings)>>(round-1); position++ {
+			ties = append(ties, model.CupTie{
+				CompetitionID: competition.ID,
+				Round:         round,
+				Position:      position,
+			})
+		}
+	}
+
+	if err := s.competitionRepo.CreateBracket(competition, ties); err != nil {
+		return err
+	}
+	for _, tie := range ties {
+		if err := s.recordScheduled(tie.Matches); err != nil {
+			return err
+		}
+	}
+
+	// Majukan tim yang mendapat bye
+	for _, tie := range ties {
+		if tie.Round == 1 && (tie.HomeTeamID == nil || tie.AwayTeamID == nil) {
+			if err := s.Advance(tie.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// GenerateGroups membagi tim terurut ke dalam grup dan menjadwalkan round-robin setiap grup.
+// Semua grup memainkan matchday yang sama pada tanggal yang sama. Panggil di dalam transaksi
+// (lihat Draw) agar jadwal dan event match.scheduled-nya tersimpan bersama.
+func (s *BracketService) GenerateGroups(competition *model.Competition, orderedTeamIDs []uint) error {
+	groupTeams := tournament.DistributeGroups(orderedTeamIDs, competition.GroupCount)
+
+	groups := make([]model.CompetitionGroup, 0, len(groupTeams))
+	for i, teamIDs := range groupTeams {
+		group := model.CompetitionGroup{
+			CompetitionID: competition.ID,
+			Name:          tournament.GroupName(i),
+		}
+		for _, fixture := range tournament.RoundRobin(teamIDs) {
+			kickoff := competition.StartDatetime.AddDate(0, 0, (fixture.Matchday-1)*competition.RoundIntervalDays)
+			match := s.newMatch(competition, fixture.HomeTeamID, fixture.AwayTeamID, kickoff)
+			group.Matches = append(group.Matches, match)
+		}
+		groups = append(groups, group)
+	}
+
+	if err := s.competitionRepo.CreateGroupStage(competition, groups, groupTeams); err != nil {
+		return err
+	}
+	for _, group := range groups {
+		if err := s.recordScheduled(group.Matches); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CompleteGroupStage membuat bracket knockout dari tim yang lolos setelah semua match grup selesai.
+// Tidak melakukan apa-apa jika masih ada match grup tersisa atau bracket sudah dibuat.
+func (s *BracketService) CompleteGroupStage(competitionID uint) error {
+	competition, err := s.competitionRepo.FindByID(competitionID)
+	if err != nil {
+		return err
+	}
+	if competition.Format != model.CompetitionFormatGroupKnockout {
+		return nil
+	}
+
+	pending, err := s.competitionRepo.CountPendingGroupMatches(competitionID)
+	if err != nil || pending > 0 {
+		return err
+	}
+	rounds, err := s.competitionRepo.CountRounds(competitionID)
+	if err != nil || rounds > 0 {
+		return err
+	}
+
+	groups, err := s.competitionRepo.FindGroups(competitionID)
+	if err != nil {
+		return err
+	}
+
+	tables := make([][]tournament.StandingRow, 0, len(groups))
+	var lastKickoff time.Time
+	for _, group := range groups {
+		tables = append(tables, tournament.GroupTable(group.TeamIDs(), group.Matches))
+		for _, m := range group.Matches {
+			if m.MatchDatetime.After(lastKickoff) {
+				lastKickoff = m.MatchDatetime
+			}
+		}
+	}
+
+	knockoutStart := lastKickoff.AddDate(0, 0, competition.RoundIntervalDays)
+	competition.KnockoutStartDatetime = &knockoutStart
+	if err := s.competitionRepo.Update(competition); err != nil {
+		return err
+	}
+
+	return s.Generate(competition, tournament.KnockoutSeeds(tables, competition.QualifiersPerGroup))
+}
+
+// GroupStanding adalah klasemen dan jadwal satu grup
+type GroupStanding struct {
+	Group model.CompetitionGroup
+	Table []RankedStanding
+}
+
+// RankedStanding adalah satu baris klasemen grup beserta peringkat dan nama timnya
+type RankedStanding struct {
+	Position int
+	TeamName string
+	tournament.StandingRow
+}
+
+// Standings mengembalikan klasemen setiap grup kompetisi group_knockout
+func (s *BracketService) Standings(competitionID uint) ([]GroupStanding, error) {
+	competition, err := s.competitionRepo.FindByID(competitionID)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, ErrCompetitionNotFound
+		}
+		return nil, err
+	}
+	if competition.Format != model.CompetitionFormatGroupKnockout {
+		return nil, ErrNoGroupStage
+	}
+	return s.GroupStandings(competitionID)
+}
+
+// GroupStandings menghitung klasemen setiap grup kompetisi tanpa memeriksa formatnya.
+// Urutan baris mengikuti tournament.GroupTable.
+func (s *BracketService) GroupStandings(competitionID uint) ([]GroupStanding, error) {
+	groups, err := s.competitionRepo.FindGroups(competitionID)
+	if err != nil {
+		return nil, err
+	}
+
+	standings := make([]GroupStanding, 0, len(groups))
+	for _, group := range groups {
+		names := group.TeamNames()
+		standing := GroupStanding{Group: group, Table: []RankedStanding{}}
+		for i, row := range tournament.GroupTable(group.TeamIDs(), group.Matches) {
+			standing.Table = append(standing.Table, RankedStanding{
+				Position:    i + 1,
+				TeamName:    names[row.TeamID],
+				StandingRow: row,
+			})
+		}
+		standings = append(standings, standing)
+	}
+	return standings, nil
+}
+
+// scheduleTie membuat match (satu atau dua leg) untuk tie yang kedua timnya sudah diketahui
+func (s *BracketService) scheduleTie(competition *model.Competition, tie *model.CupTie) []model.Match {
+	kickoff := competition.KnockoutStart().AddDate(0, 0, (tie.Round-1)*competition.RoundIntervalDays)
+
+	matches := []model.Match{s.newLeg(competition, *tie.HomeTeamID, *tie.AwayTeamID, kickoff, 1)}
+	if competition.TwoLegged {
+		secondLeg := kickoff.AddDate(0, 0, competition.LegIntervalDays)
+		matches = append(matches, s.newLeg(competition, *tie.AwayTeamID, *tie.HomeTeamID, secondLeg, 2))
+	}
+	return matches
+}
+
+// newLeg membuat satu match leg knockout
+func (s *BracketService) newLeg(competition *model.Competition, homeTeamID, awayTeamID uint, kickoff time.Time, leg int) model.Match {
+	match := s.newMatch(competition, homeTeamID, awayTeamID, kickoff)
+	match.Leg = &leg
+	return match
+}
+
+// newMatch membuat match kompetisi di home venue milik tuan rumah
+func (s *BracketService) newMatch(competition *model.Competition, homeTeamID, awayTeamID uint, kickoff time.Time) model.Match {
+	match := model.Match{
+		HomeTeamID:    homeTeamID,
+		AwayTeamID:    awayTeamID,
+		MatchDatetime: kickoff,
+		Status:        model.MatchStatusScheduled,
+		CompetitionID: uintPtr(competition.ID),
+	}
+	if homeTeam, err := s.teamRepo.FindByID(homeTeamID); err == nil {
+		match.VenueID = homeTeam.HomeVenueID
+	}
+	return match
+}
+
+// Advance menentukan pemenang tie (jika sudah bisa ditentukan) lalu menempatkannya
+// di tie babak berikutnya, atau menobatkan juara jika tie adalah final
+func (s *BracketService) Advance(tieID uint) error {
+	tie, err := s.competitionRepo.FindTieByID(tieID)
+	if err != nil {
+		return err
+	}
+
+	competition, err := s.competitionRepo.FindByID(tie.CompetitionID)
+	if err != nil {
+		return err
+	}
+
+	winner, decided := tournament.TieWinner(*tie, tie.Matches, s.Rules(competition))
+	if !decided {
+		return nil
+	}
+
+	tie.WinnerTeamID = uintPtr(winner)
+	tie.Matches = nil
+	if err := s.competitionRepo.UpdateTie(tie); err != nil {
+		return err
+	}
+
+	next, err := s.competitionRepo.FindTieBySlot(competition.ID, tie.Round+1, tie.Position/2)
+	if isNotFound(err) {
+		// Tie ini adalah final
+		competition.ChampionTeamID = uintPtr(winner)
+		competition.Status = model.CompetitionStatusCompleted
+		if err := s.competitionRepo.Update(competition); err != nil {
+			return err
+		}
+		return s.recordCompleted(competition)
+	}
+	if err != nil {
+		return err
+	}
+
+	if tie.Position%2 == 0 {
+		next.HomeTeamID = uintPtr(winner)
+	} else {
+		next.AwayTeamID = uintPtr(winner)
+	}
+	scheduled := next.HomeTeamID != nil && next.AwayTeamID != nil
+	if scheduled {
+		next.Matches = s.scheduleTie(competition, next)
+	}
+
+	if err := s.competitionRepo.UpdateTie(next); err != nil {
+		return err
+	}
+	if !scheduled {
+		return nil
+	}
+	return s.recordScheduled(next.Matches)
+}
+
+// recordScheduled mencatat event match.scheduled untuk setiap match yang baru dijadwalkan
+func (s *BracketService) recordScheduled(matches []model.Match) error {
+	for _, match := range matches {
+		home, err := s.teamRepo.FindByID(match.HomeTeamID)
+		if err != nil {
+			return err
+		}
+		away, err := s.teamRepo.FindByID(match.AwayTeamID)
+		if err != nil {
+			return err
+		}
+		match.HomeTeam, match.AwayTeam = *home, *away
+		if err := recordEvent(s.outboxRepo, event.MatchScheduled, match); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordCompleted mencatat event competition.completed beserta tim juaranya
+func (s *BracketService) recordCompleted(competition *model.Competition) error {
+	completed := *competition
+	champion, err := s.teamRepo.FindByID(*competition.ChampionTeamID)
+	if err != nil {
+		return err
+	}
+	completed.ChampionTeam = champion
+	return recordEvent(s.outboxRepo, event.CompetitionCompleted, completed)
+}
+
+// uintPtr mengembalikan pointer ke v
+func uintPtr(v uint) *uint {
+	return &v
+}
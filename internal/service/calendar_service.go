@@ -0,0 +1,159 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"time"
+	"xyz-football-api/internal/apperror"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+	"xyz-football-api/pkg/ical"
+)
+
+// calendarProductID adalah PRODID feed kalender
+const calendarProductID = "-//XYZ Football//Fixtures//ID"
+
+// matchDuration adalah perkiraan durasi match untuk DTEND (90 menit + jeda + injury time)
+const matchDuration = 2 * time.Hour
+
+// ErrInvalidCalendarToken dikembalikan Authenticate jika token tidak dikenal atau sudah dicabut
+var ErrInvalidCalendarToken = apperror.Forbidden("invalid_calendar_token", "Token kalender tidak valid atau sudah dicabut")
+
+// CalendarService mengelola token feed kalender per user dan membuat feed iCalendar jadwal match.
+// Aplikasi kalender tidak bisa mengirim header Authorization, sehingga feed diautentikasi
+// dengan token panjang di URL yang bisa dicabut atau diganti kapan saja.
+type CalendarService struct {
+	tokenRepo       repository.CalendarTokenRepository
+	teamRepo        repository.TeamRepository
+	competitionRepo repository.CompetitionRepository
+	matchRepo       repository.MatchRepository
+}
+
+// NewCalendarService membuat instance CalendarService baru
+func NewCalendarService(
+	tokenRepo repository.CalendarTokenRepository,
+	teamRepo repository.TeamRepository,
+	competitionRepo repository.CompetitionRepository,
+	matchRepo repository.MatchRepository,
+) *CalendarService {
+	return &CalendarService{
+		tokenRepo:       tokenRepo,
+		teamRepo:        teamRepo,
+		competitionRepo: competitionRepo,
+		matchRepo:       matchRepo,
+	}
+}
+
+// IssueToken membuat token kalender baru untuk user. Token lama milik user langsung tidak berlaku.
+// Token hanya dikembalikan sekali; yang disimpan hanya hash-nya.
+func (s *CalendarService) IssueToken(username string) (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(secret)
+
+	if err := s.tokenRepo.Replace(&model.CalendarToken{Username: username, TokenHash: hashToken(token)}); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// RevokeToken mencabut token kalender milik user
+func (s *CalendarService) RevokeToken(username string) error {
+	return s.tokenRepo.DeleteByUsername(username)
+}
+
+// Authenticate mengembalikan username pemilik token
+func (s *CalendarService) Authenticate(token string) (string, error) {
+	if token == "" {
+		return "", ErrInvalidCalendarToken
+	}
+	stored, err := s.tokenRepo.FindByHash(hashToken(token))
+	if err != nil {
+		if isNotFound(err) {
+			return "", ErrInvalidCalendarToken
+		}
+		return "", err
+	}
+	return stored.Username, nil
+}
+
+// TeamFixtures membuat kalender semua match team (home dan away), termasuk yang dibatalkan
+func (s *CalendarService) TeamFixtures(teamID uint) (*ical.Calendar, error) {
+	team, err := s.teamRepo.FindByID(teamID)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, ErrTeamNotFound
+		}
+		return nil, err
+	}
+	return s.fixtures(team.Name, repository.MatchFilter{TeamID: &teamID})
+}
+
+// CompetitionFixtures membuat kalender semua match dalam satu kompetisi
+func (s *CalendarService) CompetitionFixtures(competitionID uint) (*ical.Calendar, error) {
+	competition, err := s.competitionRepo.FindByID(competitionID)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, ErrCompetitionNotFound
+		}
+		return nil, err
+	}
+	return s.fixtures(competition.Name, repository.MatchFilter{CompetitionID: &competitionID})
+}
+
+// fixtures membuat kalender dari match yang memenuhi filter, terurut berdasarkan waktu kick-off
+func (s *CalendarService) fixtures(name string, filter repository.MatchFilter) (*ical.Calendar, error) {
+	calendar := &ical.Calendar{ProductID: calendarProductID, Name: name}
+	err := s.matchRepo.FindInBatches(filter, func(matches []model.Match) error {
+		for _, match := range matches {
+			calendar.Events = append(calendar.Events, matchEvent(match))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(calendar.Events, func(a, b ical.Event) int {
+		return a.Start.Compare(b.Start)
+	})
+	return calendar, nil
+}
+
+// matchEvent mengubah match menjadi VEVENT. UID hanya bergantung pada ID match sehingga
+// perubahan jadwal memperbarui event yang sama; SEQUENCE adalah versi jadwal match.
+func matchEvent(match model.Match) ical.Event {
+	event := ical.Event{
+		UID:          fmt.Sprintf("match-%d@xyz-football-api", match.ID),
+		Sequence:     match.ScheduleVersion,
+		Stamp:        match.UpdatedAt,
+		Start:        match.MatchDatetime,
+		End:          match.MatchDatetime.Add(matchDuration),
+		LastModified: match.UpdatedAt,
+		Summary:      fmt.Sprintf("%s vs %s", match.HomeTeam.Name, match.AwayTeam.Name),
+		Status:       ical.StatusConfirmed,
+	}
+
+	switch match.Status {
+	case model.MatchStatusCompleted:
+		event.Summary = fmt.Sprintf("%s %d - %d %s", match.HomeTeam.Name, match.HomeScore, match.AwayScore, match.AwayTeam.Name)
+	case model.MatchStatusCancelled:
+		event.Status = ical.StatusCancelled
+	}
+	if match.Venue != nil {
+		event.Location = match.Venue.Name + ", " + match.Venue.City
+	}
+	return event
+}
+
+// hashToken mengembalikan hash SHA-256 (hex) dari token kalender
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
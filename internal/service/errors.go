@@ -0,0 +1,59 @@
+// Package service berisi aturan bisnis dan orkestrasi yang dipakai bersama oleh
+// HTTP handler, importer, dan CLI sehingga aturan yang sama berlaku di semua entry point.
+// Pelanggaran aturan dikembalikan sebagai *apperror.Error; error lain berasal dari repository.
+package service
+
+import (
+	"errors"
+	"xyz-football-api/internal/apperror"
+
+	"gorm.io/gorm"
+)
+
+// Error resource yang tidak ditemukan
+var (
+	ErrTeamNotFound         = apperror.NotFound("team_not_found", "Team tidak ditemukan")
+	ErrPlayerNotFound       = apperror.NotFound("player_not_found", "Player tidak ditemukan")
+	ErrMatchNotFound        = apperror.NotFound("match_not_found", "Match tidak ditemukan")
+	ErrVenueNotFound        = apperror.NotFound("venue_not_found", "Venue tidak ditemukan")
+	ErrOfficialNotFound     = apperror.NotFound("official_not_found", "Official tidak ditemukan")
+	ErrCompetitionNotFound  = apperror.NotFound("competition_not_found", "Kompetisi tidak ditemukan")
+	ErrAvailabilityNotFound = apperror.NotFound("availability_not_found", "Data ketersediaan tidak ditemukan")
+	ErrAssignmentNotFound   = apperror.NotFound("assignment_not_found", "Penugasan official tidak ditemukan")
+)
+
+// Error validasi untuk referensi di payload yang tidak ada
+var (
+	ErrUnknownTeam      = apperror.Validation("unknown_team", "Team tidak ditemukan", field("team_id", "team tidak ditemukan"))
+	ErrUnknownHomeTeam  = apperror.Validation("unknown_team", "Home team tidak ditemukan", field("home_team_id", "team tidak ditemukan"))
+	ErrUnknownAwayTeam  = apperror.Validation("unknown_team", "Away team tidak ditemukan", field("away_team_id", "team tidak ditemukan"))
+	ErrUnknownVenue     = apperror.Validation("unknown_venue", "Venue tidak ditemukan", field("venue_id", "venue tidak ditemukan"))
+	ErrUnknownHomeVenue = apperror.Validation("unknown_venue", "Home venue tidak ditemukan", field("home_venue_id", "venue tidak ditemukan"))
+	ErrUnknownOfficial  = apperror.Validation("unknown_official", "Official tidak ditemukan", field("official_id", "official tidak ditemukan"))
+)
+
+// Error aturan bisnis
+var (
+	ErrTeamNameRequired        = apperror.Validation("validation_failed", "Nama team wajib diisi", field("name", "wajib diisi"))
+	ErrPlayerNameRequired      = apperror.Validation("validation_failed", "Nama player wajib diisi", field("name", "wajib diisi"))
+	ErrInvalidPosition         = apperror.Validation("validation_failed", "Posisi player tidak valid (penyerang, gelandang, bertahan, penjaga gawang)", field("position", "harus salah satu dari: penyerang, gelandang, bertahan, penjaga gawang"))
+	ErrInvalidJerseyNumber     = apperror.Validation("validation_failed", "Nomor punggung harus antara 1 dan 99", field("jersey_number", "harus antara 1 dan 99"))
+	ErrSameTeams               = apperror.Validation("same_teams", "Home team dan away team tidak boleh sama", field("away_team_id", "tidak boleh sama dengan home_team_id"))
+	ErrJerseyNumberTaken       = apperror.Conflict("jersey_number_taken", "Nomor punggung sudah digunakan di tim ini")
+	ErrMatchAlreadyReported    = apperror.Conflict("match_already_reported", "Match sudah dilaporkan sebelumnya")
+	ErrMatchCancelled          = apperror.Conflict("match_cancelled", "Match sudah dibatalkan sehingga hasilnya tidak bisa dilaporkan")
+	ErrInvalidScheduleStatus   = apperror.Validation("validation_failed", "Status match hanya bisa diubah menjadi scheduled atau cancelled", field("status", "harus salah satu dari: scheduled, cancelled"))
+	ErrCancelCompetitionMatch  = apperror.Validation("competition_match", "Match kompetisi tidak bisa dibatalkan", field("status", "match kompetisi tidak bisa dibatalkan"))
+	ErrNoGroupStage            = apperror.Validation("no_group_stage", "Kompetisi ini tidak memiliki fase grup")
+	ErrCompetitionAlreadyDrawn = apperror.Validation("competition_already_drawn", "Bracket kompetisi sudah dibuat sebelumnya")
+)
+
+// field membuat detail kesalahan untuk satu field
+func field(name, message string) apperror.FieldError {
+	return apperror.FieldError{Field: name, Message: message}
+}
+
+// isNotFound memeriksa apakah error repository berarti record tidak ada
+func isNotFound(err error) bool {
+	return errors.Is(err, gorm.ErrRecordNotFound)
+}
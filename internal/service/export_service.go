@@ -0,0 +1,230 @@
+package service
+
+import (
+	"slices"
+	"strings"
+	"time"
+	"xyz-football-api/internal/apperror"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+	"xyz-football-api/pkg/spreadsheet"
+)
+
+// matchStatuses adalah status match yang valid untuk filter export
+var matchStatuses = []model.MatchStatus{model.MatchStatusScheduled, model.MatchStatusCompleted, model.MatchStatusCancelled}
+
+// exportColumn adalah satu kolom export: nama kolom dan nilai selnya untuk satu record
+type exportColumn[T any] struct {
+	name  string
+	value func(T) interface{}
+}
+
+// Kolom export team sama dengan kolom import sehingga file hasil export bisa di-import kembali
+var teamExportColumns = []exportColumn[model.Team]{
+	{"id", func(t model.Team) interface{} { return t.ID }},
+	{"name", func(t model.Team) interface{} { return t.Name }},
+	{"logo_url", func(t model.Team) interface{} { return t.LogoURL }},
+	{"founded_year", func(t model.Team) interface{} { return t.FoundedYear }},
+	{"headquarters_address", func(t model.Team) interface{} { return t.HeadquartersAddress }},
+	{"headquarters_city", func(t model.Team) interface{} { return t.HeadquartersCity }},
+	{"home_venue_id", func(t model.Team) interface{} { return t.HomeVenueID }},
+	{"created_at", func(t model.Team) interface{} { return t.CreatedAt }},
+	{"updated_at", func(t model.Team) interface{} { return t.UpdatedAt }},
+}
+
+var playerExportColumns = []exportColumn[model.Player]{
+	{"id", func(p model.Player) interface{} { return p.ID }},
+	{"team_id", func(p model.Player) interface{} { return p.TeamID }},
+	{"team_name", func(p model.Player) interface{} { return p.Team.Name }},
+	{"name", func(p model.Player) interface{} { return p.Name }},
+	{"position", func(p model.Player) interface{} { return p.Position }},
+	{"jersey_number", func(p model.Player) interface{} { return p.JerseyNumber }},
+	{"height_cm", func(p model.Player) interface{} { return p.HeightCm }},
+	{"weight_kg", func(p model.Player) interface{} { return p.WeightKg }},
+	{"created_at", func(p model.Player) interface{} { return p.CreatedAt }},
+	{"updated_at", func(p model.Player) interface{} { return p.UpdatedAt }},
+}
+
+var matchExportColumns = []exportColumn[model.Match]{
+	{"id", func(m model.Match) interface{} { return m.ID }},
+	{"match_datetime", func(m model.Match) interface{} { return m.MatchDatetime }},
+	{"status", func(m model.Match) interface{} { return m.Status }},
+	{"home_team_id", func(m model.Match) interface{} { return m.HomeTeamID }},
+	{"home_team", func(m model.Match) interface{} { return m.HomeTeam.Name }},
+	{"away_team_id", func(m model.Match) interface{} { return m.AwayTeamID }},
+	{"away_team", func(m model.Match) interface{} { return m.AwayTeam.Name }},
+	{"home_score", func(m model.Match) interface{} { return m.HomeScore }},
+	{"away_score", func(m model.Match) interface{} { return m.AwayScore }},
+	{"extra_time", func(m model.Match) interface{} { return m.ExtraTime }},
+	{"home_penalties", func(m model.Match) interface{} { return m.HomePenalties }},
+	{"away_penalties", func(m model.Match) interface{} { return m.AwayPenalties }},
+	{"match_result", func(m model.Match) interface{} { return m.Result() }},
+	{"venue_id", func(m model.Match) interface{} { return m.VenueID }},
+	{"venue", func(m model.Match) interface{} {
+		if m.Venue == nil {
+			return nil
+		}
+		return m.Venue.Name
+	}},
+	{"attendance", func(m model.Match) interface{} { return m.Attendance }},
+	{"competition_id", func(m model.Match) interface{} { return m.CompetitionID }},
+	{"leg", func(m model.Match) interface{} { return m.Leg }},
+}
+
+var goalExportColumns = []exportColumn[model.Goal]{
+	{"id", func(g model.Goal) interface{} { return g.ID }},
+	{"match_id", func(g model.Goal) interface{} { return g.MatchID }},
+	{"player_id", func(g model.Goal) interface{} { return g.PlayerID }},
+	{"player_name", func(g model.Goal) interface{} { return g.Player.Name }},
+	{"team_id", func(g model.Goal) interface{} { return g.Player.TeamID }},
+	{"goal_time", func(g model.Goal) interface{} { return g.GoalTime }},
+	{"created_at", func(g model.Goal) interface{} { return g.CreatedAt }},
+}
+
+// ExportService menulis data team, player, match dan goal ke spreadsheet.Writer.
+// Data dibaca dari repository per batch sehingga export besar tidak dimuat sekaligus ke memory.
+// Filter divalidasi sebelum baris pertama ditulis.
+type ExportService struct {
+	teamRepo         repository.TeamRepository
+	playerRepo       repository.PlayerRepository
+	matchRepo        repository.MatchRepository
+	goalRepo         repository.GoalRepository
+	availabilityRepo repository.PlayerAvailabilityRepository
+}
+
+// NewExportService membuat instance ExportService baru
+func NewExportService(
+	teamRepo repository.TeamRepository,
+	playerRepo repository.PlayerRepository,
+	matchRepo repository.MatchRepository,
+	goalRepo repository.GoalRepository,
+	availabilityRepo repository.PlayerAvailabilityRepository,
+) *ExportService {
+	return &ExportService{
+		teamRepo:         teamRepo,
+		playerRepo:       playerRepo,
+		matchRepo:        matchRepo,
+		goalRepo:         goalRepo,
+		availabilityRepo: availabilityRepo,
+	}
+}
+
+// PlayerExportFilter sama dengan filter GET /teams/:id/players; AvailableOn hanya berlaku jika TeamID diisi
+type PlayerExportFilter struct {
+	TeamID      *uint
+	AvailableOn *time.Time
+}
+
+// ExportTeams menulis semua team
+func (s *ExportService) ExportTeams(w spreadsheet.Writer) error {
+	return writeExport(w, teamExportColumns, s.teamRepo.FindInBatches)
+}
+
+// ExportPlayers menulis player, opsional hanya dari satu team dan hanya yang tersedia pada tanggal tertentu
+func (s *ExportService) ExportPlayers(filter PlayerExportFilter, w spreadsheet.Writer) error {
+	repoFilter := repository.PlayerFilter{TeamID: filter.TeamID}
+
+	if filter.TeamID != nil {
+		if err := s.checkTeam(*filter.TeamID); err != nil {
+			return err
+		}
+	}
+	if filter.AvailableOn != nil {
+		if filter.TeamID == nil {
+			return apperror.Validation("validation_failed", "Filter available_on membutuhkan team_id", field("team_id", "wajib diisi"))
+		}
+		unavailableIDs, err := s.availabilityRepo.FindUnavailablePlayerIDs(*filter.TeamID, *filter.AvailableOn)
+		if err != nil {
+			return err
+		}
+		repoFilter.ExcludeIDs = unavailableIDs
+	}
+
+	return writeExport(w, playerExportColumns, func(fn func([]model.Player) error) error {
+		return s.playerRepo.FindInBatches(repoFilter, fn)
+	})
+}
+
+// ExportMatches menulis match, opsional hanya milik satu team dan/atau dengan status tertentu
+func (s *ExportService) ExportMatches(filter repository.MatchFilter, w spreadsheet.Writer) error {
+	if filter.TeamID != nil {
+		if err := s.checkTeam(*filter.TeamID); err != nil {
+			return err
+		}
+	}
+	if filter.Status != "" && !slices.Contains(matchStatuses, filter.Status) {
+		names := make([]string, len(matchStatuses))
+		for i, status := range matchStatuses {
+			names[i] = string(status)
+		}
+		return apperror.Validation("validation_failed", "Status match tidak valid",
+			apperror.FieldError{Field: "status", Message: "harus salah satu dari: %s", Args: []interface{}{strings.Join(names, ", ")}})
+	}
+
+	return writeExport(w, matchExportColumns, func(fn func([]model.Match) error) error {
+		return s.matchRepo.FindInBatches(filter, fn)
+	})
+}
+
+// ExportGoals menulis goal, opsional hanya dari satu match dan/atau satu player
+func (s *ExportService) ExportGoals(filter repository.GoalFilter, w spreadsheet.Writer) error {
+	if filter.MatchID != nil {
+		if _, err := s.matchRepo.FindByID(*filter.MatchID); err != nil {
+			if isNotFound(err) {
+				return ErrMatchNotFound
+			}
+			return err
+		}
+	}
+	if filter.PlayerID != nil {
+		if _, err := s.playerRepo.FindByID(*filter.PlayerID); err != nil {
+			if isNotFound(err) {
+				return ErrPlayerNotFound
+			}
+			return err
+		}
+	}
+
+	return writeExport(w, goalExportColumns, func(fn func([]model.Goal) error) error {
+		return s.goalRepo.FindInBatches(filter, fn)
+	})
+}
+
+// checkTeam memastikan team pada filter ada
+func (s *ExportService) checkTeam(teamID uint) error {
+	if _, err := s.teamRepo.FindByID(teamID); err != nil {
+		if isNotFound(err) {
+			return ErrTeamNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// writeExport menulis header lalu setiap batch dari findInBatches; writer di-flush setiap batch
+func writeExport[T any](w spreadsheet.Writer, columns []exportColumn[T], findInBatches func(fn func([]T) error) error) error {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		names[i] = column.name
+	}
+	if err := w.WriteHeader(names); err != nil {
+		return err
+	}
+
+	values := make([]interface{}, len(columns))
+	err := findInBatches(func(records []T) error {
+		for _, record := range records {
+			for i, column := range columns {
+				values[i] = column.value(record)
+			}
+			if err := w.WriteRow(values); err != nil {
+				return err
+			}
+		}
+		return w.Flush()
+	})
+	if err != nil {
+		return err
+	}
+	return w.Close()
+}
@@ -0,0 +1,292 @@
+package service
+
+import (
+	"strconv"
+	"strings"
+	"xyz-football-api/internal/apperror"
+	"xyz-football-api/internal/event"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+	"xyz-football-api/pkg/spreadsheet"
+)
+
+// Kolom yang wajib ada di file import
+var (
+	teamImportColumns   = []string{"name"}
+	playerImportColumns = []string{"team_id", "name", "position", "jersey_number"}
+)
+
+// ImportService mengimpor team dan player secara massal dari file CSV/XLSX.
+// Setiap baris divalidasi dengan aturan yang sama seperti TeamService dan PlayerService;
+// baris hanya disimpan jika seluruh file valid.
+type ImportService struct {
+	teamRepo   repository.TeamRepository
+	venueRepo  repository.VenueRepository
+	playerRepo repository.PlayerRepository
+	tx         repository.Transactor
+}
+
+// NewImportService membuat instance ImportService baru
+func NewImportService(
+	teamRepo repository.TeamRepository,
+	venueRepo repository.VenueRepository,
+	playerRepo repository.PlayerRepository,
+	tx repository.Transactor,
+) *ImportService {
+	return &ImportService{
+		teamRepo:   teamRepo,
+		venueRepo:  venueRepo,
+		playerRepo: playerRepo,
+		tx:         tx,
+	}
+}
+
+// ImportRowError berisi kesalahan validasi pada satu baris file
+type ImportRowError struct {
+	Line   int
+	Fields []apperror.FieldError
+}
+
+// ImportResult adalah ringkasan proses import
+type ImportResult struct {
+	Rows     int
+	Imported int
+	Errors   []ImportRowError
+}
+
+// ImportTeams memvalidasi semua baris team. Jika dryRun false dan semua baris valid,
+// team disimpan dalam satu transaksi. Jika ada baris yang tidak valid, tidak ada yang disimpan
+// dan error import_rejected dikembalikan bersama result berisi detail per baris.
+func (s *ImportService) ImportTeams(table *spreadsheet.Table, dryRun bool) (*ImportResult, error) {
+	if err := checkImportTable(table, teamImportColumns); err != nil {
+		return nil, err
+	}
+
+	result := &ImportResult{Rows: len(table.Rows)}
+	venues := make(map[uint]bool)
+	teams := make([]model.Team, 0, len(table.Rows))
+
+	for _, row := range table.Rows {
+		team := model.Team{Name: row.Get("name")}
+		var fields []apperror.FieldError
+
+		if team.Name == "" {
+			fields = append(fields, ErrTeamNameRequired.Fields...)
+		}
+		team.FoundedYear = parseOptionalInt(row, "founded_year", &fields)
+		team.LogoURL = optionalString(row, "logo_url")
+		team.HeadquartersAddress = optionalString(row, "headquarters_address")
+		team.HeadquartersCity = optionalString(row, "headquarters_city")
+
+		if venueID := parseOptionalID(row, "home_venue_id", &fields); venueID != nil {
+			exists, err := s.lookup(venues, *venueID, func(id uint) error {
+				_, err := s.venueRepo.FindByID(id)
+				return err
+			})
+			if err != nil {
+				return nil, err
+			}
+			if !exists {
+				fields = append(fields, ErrUnknownHomeVenue.Fields...)
+			}
+			team.HomeVenueID = venueID
+		}
+
+		if len(fields) > 0 {
+			result.Errors = append(result.Errors, ImportRowError{Line: row.Line, Fields: fields})
+			continue
+		}
+		teams = append(teams, team)
+	}
+
+	return s.commit(result, dryRun, func() error {
+		return s.teamRepo.CreateBatch(teams)
+	})
+}
+
+// ImportPlayers memvalidasi semua baris player, termasuk nomor punggung yang sudah dipakai
+// di database maupun yang duplikat di dalam file. Perilaku dryRun sama seperti ImportTeams.
+func (s *ImportService) ImportPlayers(table *spreadsheet.Table, dryRun bool) (*ImportResult, error) {
+	if err := checkImportTable(table, playerImportColumns); err != nil {
+		return nil, err
+	}
+
+	type jerseyKey struct {
+		teamID uint
+		number int
+	}
+
+	result := &ImportResult{Rows: len(table.Rows)}
+	teams := make(map[uint]bool)
+	jerseys := make(map[jerseyKey]int)
+	players := make([]model.Player, 0, len(table.Rows))
+
+	for _, row := range table.Rows {
+		player := model.Player{Name: row.Get("name"), Position: row.Get("position")}
+		var fields []apperror.FieldError
+
+		teamID := parseOptionalID(row, "team_id", &fields)
+		if teamID == nil && row.Get("team_id") == "" {
+			fields = append(fields, field("team_id", "wajib diisi"))
+		}
+		if teamID != nil {
+			exists, err := s.lookup(teams, *teamID, func(id uint) error {
+				_, err := s.teamRepo.FindByID(id)
+				return err
+			})
+			if err != nil {
+				return nil, err
+			}
+			if !exists {
+				fields = append(fields, ErrUnknownTeam.Fields...)
+			}
+			player.TeamID = *teamID
+		}
+
+		if player.Name == "" {
+			fields = append(fields, ErrPlayerNameRequired.Fields...)
+		}
+		if validatePosition(player.Position) != nil {
+			fields = append(fields, ErrInvalidPosition.Fields...)
+		}
+		player.HeightCm = parseOptionalInt(row, "height_cm", &fields)
+		player.WeightKg = parseOptionalInt(row, "weight_kg", &fields)
+
+		jerseyNumber := parseOptionalInt(row, "jersey_number", &fields)
+		switch {
+		case jerseyNumber == nil:
+			if row.Get("jersey_number") == "" {
+				fields = append(fields, field("jersey_number", "wajib diisi"))
+			}
+		case *jerseyNumber < 1 || *jerseyNumber > 99:
+			fields = append(fields, ErrInvalidJerseyNumber.Fields...)
+		case teamID != nil:
+			player.JerseyNumber = *jerseyNumber
+			key := jerseyKey{teamID: *teamID, number: *jerseyNumber}
+			if line, ok := jerseys[key]; ok {
+				fields = append(fields, apperror.FieldError{Field: "jersey_number", Message: "sudah dipakai di baris %d", Args: []interface{}{line}})
+				break
+			}
+			jerseys[key] = row.Line
+
+			taken, err := s.playerRepo.CheckJerseyNumberExists(*teamID, *jerseyNumber, 0)
+			if err != nil {
+				return nil, err
+			}
+			if taken {
+				fields = append(fields, field("jersey_number", "sudah digunakan di tim ini"))
+			}
+		}
+
+		if len(fields) > 0 {
+			result.Errors = append(result.Errors, ImportRowError{Line: row.Line, Fields: fields})
+			continue
+		}
+		players = append(players, player)
+	}
+
+	return s.commit(result, dryRun, func() error {
+		return s.tx.Transaction(func(repos *repository.Repositories) error {
+			if err := repos.Players.CreateBatch(players); err != nil {
+				return err
+			}
+
+			// Player hasil import sama dengan player yang dibuat satu per satu bagi penerima event
+			events := make([]model.OutboxEvent, len(players))
+			for i := range players {
+				e, err := newOutboxEvent(event.PlayerCreated, &players[i])
+				if err != nil {
+					return err
+				}
+				events[i] = e
+			}
+			return repos.Outbox.Append(events)
+		})
+	})
+}
+
+// commit menyimpan hasil import jika bukan dry run dan tidak ada baris yang tidak valid
+func (s *ImportService) commit(result *ImportResult, dryRun bool, save func() error) (*ImportResult, error) {
+	if dryRun {
+		return result, nil
+	}
+	if len(result.Errors) > 0 {
+		return result, apperror.Validationf("import_rejected", "Import dibatalkan karena %d baris tidak valid", len(result.Errors))
+	}
+	if err := save(); err != nil {
+		return nil, err
+	}
+	result.Imported = result.Rows
+	return result, nil
+}
+
+// lookup memeriksa keberadaan record dengan cache agar ID yang sama tidak di-query berulang
+func (s *ImportService) lookup(cache map[uint]bool, id uint, find func(id uint) error) (bool, error) {
+	if exists, ok := cache[id]; ok {
+		return exists, nil
+	}
+
+	err := find(id)
+	if err != nil && !isNotFound(err) {
+		return false, err
+	}
+	cache[id] = err == nil
+	return cache[id], nil
+}
+
+// checkImportTable memastikan file berisi data dan memiliki semua kolom wajib
+func checkImportTable(table *spreadsheet.Table, columns []string) error {
+	var missing []string
+	for _, column := range columns {
+		if !table.HasColumn(column) {
+			missing = append(missing, column)
+		}
+	}
+	if len(missing) > 0 {
+		return apperror.Validationf("missing_columns", "Kolom wajib tidak ditemukan: %s", strings.Join(missing, ", "))
+	}
+	if len(table.Rows) == 0 {
+		return apperror.Validation("empty_import", "File tidak berisi data")
+	}
+	return nil
+}
+
+// parseOptionalInt membaca kolom angka opsional; nilai yang bukan angka dicatat sebagai kesalahan field
+func parseOptionalInt(row spreadsheet.Row, column string, fields *[]apperror.FieldError) *int {
+	value := row.Get(column)
+	if value == "" {
+		return nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		*fields = append(*fields, field(column, "harus berupa angka"))
+		return nil
+	}
+	return &n
+}
+
+// parseOptionalID membaca kolom ID opsional
+func parseOptionalID(row spreadsheet.Row, column string, fields *[]apperror.FieldError) *uint {
+	value := row.Get(column)
+	if value == "" {
+		return nil
+	}
+
+	id, err := strconv.ParseUint(value, 10, 32)
+	if err != nil || id == 0 {
+		*fields = append(*fields, field(column, "harus berupa ID yang valid"))
+		return nil
+	}
+	result := uint(id)
+	return &result
+}
+
+// optionalString mengembalikan pointer ke nilai kolom, atau nil jika kosong
+func optionalString(row spreadsheet.Row, column string) *string {
+	value := row.Get(column)
+	if value == "" {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"encoding/json"
+	"xyz-football-api/internal/event"
+	"xyz-football-api/internal/repository"
+)
+
+// LiveFeed menentukan topic setiap event (match, team, competition) lalu menyebarkannya
+// lewat event.Bus ke koneksi WebSocket. Memenuhi event.Publisher sehingga dipasang
+// sebagai salah satu publisher OutboxDispatcher.
+type LiveFeed struct {
+	matchRepo repository.MatchRepository
+	bus       *event.Bus
+}
+
+// NewLiveFeed membuat instance LiveFeed baru
+func NewLiveFeed(matchRepo repository.MatchRepository, bus *event.Bus) *LiveFeed {
+	return &LiveFeed{matchRepo: matchRepo, bus: bus}
+}
+
+// eventRefs berisi ID yang dipakai untuk menentukan topic dari data event
+type eventRefs struct {
+	ID            uint  `json:"id"`
+	MatchID       uint  `json:"match_id"`
+	TeamID        uint  `json:"team_id"`
+	HomeTeamID    uint  `json:"home_team_id"`
+	AwayTeamID    uint  `json:"away_team_id"`
+	CompetitionID *uint `json:"competition_id"`
+}
+
+// Publish menyebarkan event ke subscriber yang berlangganan salah satu topic-nya
+func (f *LiveFeed) Publish(e event.Event) error {
+	topics, err := f.topics(e)
+	if err != nil {
+		return err
+	}
+	if len(topics) > 0 {
+		f.bus.Broadcast(e, topics)
+	}
+	return nil
+}
+
+// topics menentukan topic event: skor, gol, dan jadwal knockout masuk ke topic match,
+// kedua team, dan kompetisinya; juara masuk ke topic kompetisi; perubahan roster masuk ke topic team
+func (f *LiveFeed) topics(e event.Event) ([]string, error) {
+	var refs eventRefs
+	if err := json.Unmarshal(e.Data, &refs); err != nil {
+		return nil, err
+	}
+
+	switch e.Type {
+	case event.MatchCompleted, event.MatchScheduled:
+		return matchTopics(refs.ID, refs.HomeTeamID, refs.AwayTeamID, refs.CompetitionID), nil
+	case event.CompetitionCompleted:
+		return []string{event.Topic(event.CompetitionTopicPrefix, refs.ID)}, nil
+	case event.GoalRecorded:
+		match, err := f.matchRepo.FindByID(refs.MatchID)
+		if isNotFound(err) {
+			return []string{event.Topic(event.MatchTopicPrefix, refs.MatchID)}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return matchTopics(match.ID, match.HomeTeamID, match.AwayTeamID, match.CompetitionID), nil
+	case event.PlayerCreated, event.PlayerUpdated, event.PlayerDeleted:
+		return []string{event.Topic(event.TeamTopicPrefix, refs.TeamID)}, nil
+	case event.TeamDeleted:
+		return []string{event.Topic(event.TeamTopicPrefix, refs.ID)}, nil
+	}
+	return nil, nil
+}
+
+// matchTopics mengembalikan topic untuk event yang terkait satu match
+func matchTopics(matchID, homeTeamID, awayTeamID uint, competitionID *uint) []string {
+	topics := []string{
+		event.Topic(event.MatchTopicPrefix, matchID),
+		event.Topic(event.TeamTopicPrefix, homeTeamID),
+		event.Topic(event.TeamTopicPrefix, awayTeamID),
+	}
+	if competitionID != nil {
+		topics = append(topics, event.Topic(event.CompetitionTopicPrefix, *competitionID))
+	}
+	return topics
+}
@@ -0,0 +1,535 @@
+package service
+
+import (
+	"fmt"
+	"slices"
+	"time"
+	"xyz-football-api/internal/apperror"
+	"xyz-football-api/internal/event"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+	"xyz-football-api/internal/tournament"
+)
+
+// MatchService mengatur aturan bisnis untuk jadwal dan hasil pertandingan
+type MatchService struct {
+	matchRepo        repository.MatchRepository
+	teamRepo         repository.TeamRepository
+	playerRepo       repository.PlayerRepository
+	goalRepo         repository.GoalRepository
+	venueRepo        repository.VenueRepository
+	competitionRepo  repository.CompetitionRepository
+	availabilityRepo repository.PlayerAvailabilityRepository
+	bracket          *BracketService
+	tx               repository.Transactor
+}
+
+// NewMatchService membuat instance MatchService baru
+func NewMatchService(
+	matchRepo repository.MatchRepository,
+	teamRepo repository.TeamRepository,
+	playerRepo repository.PlayerRepository,
+	goalRepo repository.GoalRepository,
+	venueRepo repository.VenueRepository,
+	competitionRepo repository.CompetitionRepository,
+	availabilityRepo repository.PlayerAvailabilityRepository,
+	bracket *BracketService,
+	tx repository.Transactor,
+) *MatchService {
+	return &MatchService{
+		matchRepo:        matchRepo,
+		teamRepo:         teamRepo,
+		playerRepo:       playerRepo,
+		goalRepo:         goalRepo,
+		venueRepo:        venueRepo,
+		competitionRepo:  competitionRepo,
+		availabilityRepo: availabilityRepo,
+		bracket:          bracket,
+		tx:               tx,
+	}
+}
+
+// CreateMatchInput berisi data untuk menjadwalkan match.
+// Jika VenueID nil, match dimainkan di home venue milik home team.
+type CreateMatchInput struct {
+	HomeTeamID    uint
+	AwayTeamID    uint
+	MatchDatetime time.Time
+	VenueID       *uint
+}
+
+// Batas menit gol, termasuk extra time
+const (
+	minGoalTime = 1
+	maxGoalTime = 120
+)
+
+// GoalInput berisi satu gol dalam laporan hasil pertandingan
+type GoalInput struct {
+	PlayerID uint
+	GoalTime int
+}
+
+// MatchResultInput berisi hasil pertandingan yang dilaporkan.
+// ExtraTime dan penalti hanya berlaku untuk leg penentu pada kompetisi knockout.
+type MatchResultInput struct {
+	HomeScore     int
+	AwayScore     int
+	Attendance    *int
+	ExtraTime     bool
+	HomePenalties *int
+	AwayPenalties *int
+	Goals         []GoalInput
+}
+
+// RescheduleMatchInput berisi perubahan jadwal match; field nil tidak diubah.
+// Status hanya boleh scheduled atau cancelled.
+type RescheduleMatchInput struct {
+	MatchDatetime *time.Time
+	VenueID       *uint
+	Status        *model.MatchStatus
+}
+
+// MatchReport berisi data laporan pertandingan
+type MatchReport struct {
+	Match *model.Match
+	// Round adalah nama babak knockout, kosong untuk match non-knockout
+	Round string
+	// GroupName adalah nama grup untuk match fase grup
+	GroupName string
+	// TopScorer nil jika belum ada gol
+	TopScorer         *model.Player
+	TopScorerGoals    int
+	HomeTeamTotalWins int64
+	AwayTeamTotalWins int64
+}
+
+// SquadPlayer adalah player dalam team sheet beserta ketersediaannya pada hari match
+type SquadPlayer struct {
+	model.Player
+	Available bool
+}
+
+// TeamSheet berisi skuad kedua team untuk satu match, terurut berdasarkan nomor punggung
+type TeamSheet struct {
+	Match *model.Match
+	Home  []SquadPlayer
+	Away  []SquadPlayer
+}
+
+// Create memvalidasi lalu menjadwalkan match baru
+func (s *MatchService) Create(input CreateMatchInput) (*model.Match, error) {
+	// Validasi home team exists
+	homeTeam, err := s.teamRepo.FindByID(input.HomeTeamID)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, ErrUnknownHomeTeam
+		}
+		return nil, err
+	}
+
+	// Validasi away team exists
+	if _, err := s.teamRepo.FindByID(input.AwayTeamID); err != nil {
+		if isNotFound(err) {
+			return nil, ErrUnknownAwayTeam
+		}
+		return nil, err
+	}
+
+	if input.HomeTeamID == input.AwayTeamID {
+		return nil, ErrSameTeams
+	}
+
+	match := &model.Match{
+		HomeTeamID:    input.HomeTeamID,
+		AwayTeamID:    input.AwayTeamID,
+		MatchDatetime: input.MatchDatetime,
+		Status:        model.MatchStatusScheduled,
+	}
+
+	// Tentukan venue: override dari input, atau home venue milik home team
+	if input.VenueID != nil {
+		if _, err := s.venueRepo.FindByID(*input.VenueID); err != nil {
+			if isNotFound(err) {
+				return nil, ErrUnknownVenue
+			}
+			return nil, err
+		}
+		match.VenueID = input.VenueID
+	} else {
+		match.VenueID = homeTeam.HomeVenueID
+	}
+
+	if err := s.matchRepo.Create(match); err != nil {
+		return nil, err
+	}
+	return match, nil
+}
+
+// Get mengambil match berdasarkan ID
+func (s *MatchService) Get(id uint) (*model.Match, error) {
+	match, err := s.matchRepo.FindByID(id)
+	if isNotFound(err) {
+		return nil, ErrMatchNotFound
+	}
+	return match, err
+}
+
+// ReportResult memvalidasi dan menyimpan hasil pertandingan beserta gol,
+// lalu memajukan pemenang tie atau membuat babak knockout jika fase grup selesai.
+// Setelah semuanya tersimpan, event match.completed dan goal.recorded dikirim.
+func (s *MatchService) ReportResult(id uint, input MatchResultInput) error {
+	if err := validateResultInput(input); err != nil {
+		return err
+	}
+
+	match, err := s.Get(id)
+	if err != nil {
+		return err
+	}
+	switch match.Status {
+	case model.MatchStatusCompleted:
+		return ErrMatchAlreadyReported
+	case model.MatchStatusCancelled:
+		return ErrMatchCancelled
+	}
+
+	// Validasi total gol
+	totalGoals := input.HomeScore + input.AwayScore
+	if len(input.Goals) != totalGoals {
+		return apperror.Validationf("goal_count_mismatch", "Jumlah gol tidak sesuai. Total skor: %d, jumlah detail gol: %d", totalGoals, len(input.Goals))
+	}
+
+	// Terapkan hasil ke match lalu validasi aturan knockout (extra time, adu penalti)
+	match.HomeScore = input.HomeScore
+	match.AwayScore = input.AwayScore
+	match.Attendance = input.Attendance
+	match.ExtraTime = input.ExtraTime
+	match.HomePenalties = input.HomePenalties
+	match.AwayPenalties = input.AwayPenalties
+	if err := s.validateKnockoutResult(match); err != nil {
+		return err
+	}
+
+	// Validasi attendance tidak melebihi kapasitas venue
+	// Pemeriksaan hanya dilewati jika match tidak punya venue
+	if input.Attendance != nil && match.VenueID != nil {
+		venue, err := s.venueRepo.FindByID(*match.VenueID)
+		if err != nil {
+			if isNotFound(err) {
+				return ErrUnknownVenue
+			}
+			return err
+		}
+		if *input.Attendance > venue.Capacity {
+			return apperror.Validationf("attendance_exceeds_capacity", "Jumlah penonton (%d) melebihi kapasitas venue %s (%d)", *input.Attendance, venue.Name, venue.Capacity)
+		}
+	}
+
+	// Validasi setiap player dalam goals
+	goals := make([]model.Goal, 0, len(input.Goals))
+	for _, g := range input.Goals {
+		player, err := s.playerRepo.FindByID(g.PlayerID)
+		if err != nil {
+			if isNotFound(err) {
+				return apperror.Validationf("unknown_player", "Player dengan ID %d tidak ditemukan", g.PlayerID)
+			}
+			return err
+		}
+
+		// Validasi player adalah bagian dari salah satu tim yang bertanding
+		if player.TeamID != match.HomeTeamID && player.TeamID != match.AwayTeamID {
+			return apperror.Validationf("player_not_in_match", "Player %s tidak termasuk dalam tim yang bertanding", player.Name)
+		}
+
+		goals = append(goals, model.Goal{MatchID: id, PlayerID: g.PlayerID, GoalTime: g.GoalTime})
+	}
+
+	return s.saveResult(match, goals)
+}
+
+// validateResultInput memeriksa rentang nilai hasil pertandingan: skor, penonton, dan
+// adu penalti tidak negatif, menit gol antara minGoalTime dan maxGoalTime. Nama field
+// sama dengan request body REST sehingga semua transport mendapat detail error yang sama.
+func validateResultInput(input MatchResultInput) error {
+	var fields []apperror.FieldError
+	notNegative := func(name string, value *int) {
+		if value != nil && *value < 0 {
+			fields = append(fields, apperror.FieldError{Field: name, Message: "minimal %s", Args: []interface{}{"0"}})
+		}
+	}
+	notNegative("home_score", &input.HomeScore)
+	notNegative("away_score", &input.AwayScore)
+	notNegative("attendance", input.Attendance)
+	notNegative("penalties.home", input.HomePenalties)
+	notNegative("penalties.away", input.AwayPenalties)
+
+	for i, g := range input.Goals {
+		name := fmt.Sprintf("goals[%d].goal_time", i)
+		switch {
+		case g.GoalTime < minGoalTime:
+			fields = append(fields, apperror.FieldError{Field: name, Message: "minimal %s", Args: []interface{}{fmt.Sprint(minGoalTime)}})
+		case g.GoalTime > maxGoalTime:
+			fields = append(fields, apperror.FieldError{Field: name, Message: "maksimal %s", Args: []interface{}{fmt.Sprint(maxGoalTime)}})
+		}
+	}
+
+	if len(fields) > 0 {
+		return apperror.Validation("validation_failed", "Data hasil match tidak valid", fields...)
+	}
+	return nil
+}
+
+// saveResult menyimpan skor dan goals match beserta event match.completed dan
+// goal.recorded untuk setiap gol, lalu memajukan bracket kompetisi, dalam satu transaksi.
+// Jika bracket gagal diperbarui, hasil match ikut dibatalkan sehingga bisa dilaporkan ulang.
+func (s *MatchService) saveResult(match *model.Match, goals []model.Goal) error {
+	return s.tx.Transaction(func(repos *repository.Repositories) error {
+		if err := repos.Matches.UpdateResult(match); err != nil {
+			return err
+		}
+
+		// Hapus goals lama jika ada (untuk update)
+		if err := repos.Goals.DeleteByMatchID(match.ID); err != nil {
+			return err
+		}
+		if err := repos.Goals.CreateBatch(goals); err != nil {
+			return err
+		}
+
+		saved, err := repos.Matches.FindByIDWithGoals(match.ID)
+		if err != nil {
+			return err
+		}
+		events := make([]model.OutboxEvent, 0, len(saved.Goals)+1)
+		e, err := newOutboxEvent(event.MatchCompleted, saved)
+		if err != nil {
+			return err
+		}
+		events = append(events, e)
+		for _, goal := range saved.Goals {
+			if e, err = newOutboxEvent(event.GoalRecorded, goal); err != nil {
+				return err
+			}
+			events = append(events, e)
+		}
+		if err := repos.Outbox.Append(events); err != nil {
+			return err
+		}
+
+		bracket := newTxBracketService(repos)
+
+		// Majukan pemenang tie ke babak berikutnya
+		if match.TieID != nil {
+			if err := bracket.Advance(*match.TieID); err != nil {
+				return err
+			}
+		}
+
+		// Buat babak knockout jika ini match grup terakhir
+		if match.GroupID != nil && match.CompetitionID != nil {
+			return bracket.CompleteGroupStage(*match.CompetitionID)
+		}
+		return nil
+	})
+}
+
+// Reschedule mengubah waktu kick-off, venue, atau status match yang belum dimainkan.
+// Match kompetisi tidak bisa dibatalkan karena pemenangnya dibutuhkan bracket.
+func (s *MatchService) Reschedule(id uint, input RescheduleMatchInput) (*model.Match, error) {
+	match, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if match.Status == model.MatchStatusCompleted {
+		return nil, ErrMatchAlreadyReported
+	}
+
+	changed := false
+	if input.MatchDatetime != nil && !input.MatchDatetime.Equal(match.MatchDatetime) {
+		match.MatchDatetime = *input.MatchDatetime
+		changed = true
+	}
+	if input.VenueID != nil && (match.VenueID == nil || *match.VenueID != *input.VenueID) {
+		if _, err := s.venueRepo.FindByID(*input.VenueID); err != nil {
+			if isNotFound(err) {
+				return nil, ErrUnknownVenue
+			}
+			return nil, err
+		}
+		match.VenueID = input.VenueID
+		changed = true
+	}
+	if input.Status != nil && *input.Status != match.Status {
+		switch *input.Status {
+		case model.MatchStatusScheduled:
+		case model.MatchStatusCancelled:
+			if match.CompetitionID != nil {
+				return nil, ErrCancelCompetitionMatch
+			}
+		default:
+			return nil, ErrInvalidScheduleStatus
+		}
+		match.Status = *input.Status
+		changed = true
+	}
+	if !changed {
+		return match, nil
+	}
+
+	match.ScheduleVersion++
+	if err := s.matchRepo.UpdateSchedule(match); err != nil {
+		return nil, err
+	}
+	return s.Get(id)
+}
+
+// Report mengambil data laporan pertandingan
+func (s *MatchService) Report(id uint) (*MatchReport, error) {
+	match, err := s.matchRepo.FindByIDWithGoals(id)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, ErrMatchNotFound
+		}
+		return nil, err
+	}
+
+	report := &MatchReport{Match: match}
+
+	if match.TieID != nil {
+		report.Round = s.roundName(*match.TieID)
+	} else if match.GroupID != nil {
+		if group, err := s.competitionRepo.FindGroupByID(*match.GroupID); err == nil {
+			report.GroupName = group.Name
+		}
+	}
+
+	if topScorer, goalCount, err := s.goalRepo.GetTopScorerInMatch(id); err == nil && topScorer != nil {
+		report.TopScorer = topScorer
+		report.TopScorerGoals = goalCount
+	}
+
+	if wins, err := s.teamRepo.CountWinsByTeamID(match.HomeTeamID); err == nil {
+		report.HomeTeamTotalWins = wins
+	}
+	if wins, err := s.teamRepo.CountWinsByTeamID(match.AwayTeamID); err == nil {
+		report.AwayTeamTotalWins = wins
+	}
+
+	return report, nil
+}
+
+// TeamSheet mengambil skuad kedua team untuk match. Player yang cedera, sakit, atau
+// berhalangan lain pada tanggal match tetap dicantumkan dengan Available false.
+func (s *MatchService) TeamSheet(id uint) (*TeamSheet, error) {
+	match, err := s.matchRepo.FindByIDWithGoals(id)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, ErrMatchNotFound
+		}
+		return nil, err
+	}
+
+	sheet := &TeamSheet{Match: match}
+	if sheet.Home, err = s.squad(match.HomeTeamID, match.MatchDatetime); err != nil {
+		return nil, err
+	}
+	if sheet.Away, err = s.squad(match.AwayTeamID, match.MatchDatetime); err != nil {
+		return nil, err
+	}
+	return sheet, nil
+}
+
+// squad mengambil player team beserta ketersediaannya pada tanggal tertentu
+func (s *MatchService) squad(teamID uint, date time.Time) ([]SquadPlayer, error) {
+	players, err := s.playerRepo.FindByTeamID(teamID)
+	if err != nil {
+		return nil, err
+	}
+	unavailableIDs, err := s.availabilityRepo.FindUnavailablePlayerIDs(teamID, date)
+	if err != nil {
+		return nil, err
+	}
+
+	squad := make([]SquadPlayer, len(players))
+	for i, player := range players {
+		squad[i] = SquadPlayer{Player: player, Available: !slices.Contains(unavailableIDs, player.ID)}
+	}
+	slices.SortStableFunc(squad, func(a, b SquadPlayer) int {
+		return a.JerseyNumber - b.JerseyNumber
+	})
+	return squad, nil
+}
+
+// validateKnockoutResult memvalidasi extra time dan adu penalti pada match yang hasilnya
+// sudah diterapkan
+func (s *MatchService) validateKnockoutResult(match *model.Match) error {
+	hasPenalties := match.HomePenalties != nil
+
+	if match.TieID == nil {
+		if match.ExtraTime || hasPenalties {
+			return apperror.Validationf("knockout_only", "Extra time dan adu penalti hanya berlaku untuk match kompetisi cup")
+		}
+		return nil
+	}
+
+	tie, err := s.competitionRepo.FindTieByID(*match.TieID)
+	if err != nil {
+		return apperror.Validationf("tie_not_found", "Tie kompetisi untuk match ini tidak ditemukan")
+	}
+	competition, err := s.competitionRepo.FindByID(tie.CompetitionID)
+	if err != nil {
+		return apperror.Validationf("competition_not_found", "Kompetisi untuk match ini tidak ditemukan")
+	}
+	rules := s.bracket.Rules(competition)
+
+	// Leg kedua hanya bisa dilaporkan setelah leg pertama selesai
+	legs := make([]model.Match, 0, len(tie.Matches))
+	for _, leg := range tie.Matches {
+		if leg.ID == match.ID {
+			continue
+		}
+		if leg.Status != model.MatchStatusCompleted && tournament.IsDecidingLeg(*match, rules) {
+			return apperror.Validationf("first_leg_pending", "Leg pertama harus dilaporkan terlebih dahulu")
+		}
+		legs = append(legs, leg)
+	}
+
+	if !tournament.IsDecidingLeg(*match, rules) {
+		if match.ExtraTime || hasPenalties {
+			return apperror.Validationf("deciding_leg_only", "Extra time dan adu penalti hanya berlaku untuk leg penentu")
+		}
+		return nil
+	}
+
+	// Cek apakah tie sudah ditentukan tanpa adu penalti
+	withoutPenalties := *match
+	withoutPenalties.Status = model.MatchStatusCompleted
+	withoutPenalties.HomePenalties = nil
+	withoutPenalties.AwayPenalties = nil
+	_, decided := tournament.TieWinner(*tie, append(legs, withoutPenalties), rules)
+
+	if decided && hasPenalties {
+		return apperror.Validationf("penalties_not_allowed", "Adu penalti hanya dicatat jika skor (agregat) imbang")
+	}
+	if !decided && !hasPenalties {
+		return apperror.Validationf("penalties_required", "Skor (agregat) imbang, hasil adu penalti wajib diisi")
+	}
+	if hasPenalties && *match.HomePenalties == *match.AwayPenalties {
+		return apperror.Validationf("penalties_tied", "Hasil adu penalti tidak boleh imbang")
+	}
+	return nil
+}
+
+// roundName mengembalikan nama babak cup (Final, Semi-final, dst) dari tie
+func (s *MatchService) roundName(tieID uint) string {
+	tie, err := s.competitionRepo.FindTieByID(tieID)
+	if err != nil {
+		return ""
+	}
+	totalRounds, err := s.competitionRepo.CountRounds(tie.CompetitionID)
+	if err != nil {
+		return ""
+	}
+	return tournament.RoundName(tie.Round, totalRounds)
+}
@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+	"xyz-football-api/internal/event"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+)
+
+const (
+	// outboxBatchSize adalah jumlah event yang dipublikasikan dispatcher per putaran
+	outboxBatchSize = 100
+	// outboxBaseBackoff dan outboxMaxBackoff membatasi jeda retry event yang gagal: 1, 2, 4 detik, ... maks. 5 menit
+	outboxBaseBackoff = time.Second
+	outboxMaxBackoff  = 5 * time.Minute
+)
+
+// newOutboxEvent membuat baris outbox untuk event baru
+func newOutboxEvent(eventType event.Type, data any) (model.OutboxEvent, error) {
+	e, err := event.New(eventType, data)
+	if err != nil {
+		return model.OutboxEvent{}, err
+	}
+	return model.OutboxEvent{
+		EventID:    e.ID,
+		EventType:  string(e.Type),
+		Payload:    string(e.Data),
+		OccurredAt: e.OccurredAt,
+	}, nil
+}
+
+// recordEvent menulis satu event ke outbox. Dipanggil di dalam Transaction agar
+// event hanya tersimpan jika perubahan datanya juga tersimpan.
+func recordEvent(outbox repository.OutboxRepository, eventType event.Type, data any) error {
+	e, err := newOutboxEvent(eventType, data)
+	if err != nil {
+		return err
+	}
+	return outbox.Append([]model.OutboxEvent{e})
+}
+
+// OutboxDispatcher membaca event yang belum dipublikasikan dari outbox dan meneruskannya
+// ke publisher sesuai urutan ID. Event ditandai terkirim setelah publisher berhasil,
+// jadi event bisa terkirim lebih dari sekali jika proses berhenti di antaranya (at-least-once).
+//
+// Urutan ID tidak selalu sama dengan urutan commit: di PostgreSQL dua transaksi yang berjalan
+// bersamaan bisa commit dalam urutan terbalik dari ID yang mereka dapat, sehingga event dengan ID
+// lebih kecil bisa baru terlihat (dan terkirim) setelah event dengan ID lebih besar. Event tidak
+// pernah terlewat, tetapi consumer tidak boleh mengandalkan urutan global antar transaksi.
+type OutboxDispatcher struct {
+	outboxRepo repository.OutboxRepository
+	publisher  event.Publisher
+	parkAfter  time.Duration
+}
+
+// NewOutboxDispatcher membuat instance OutboxDispatcher baru. Event yang terus gagal
+// dipublikasikan selama parkAfter diparkir dan dilewati sampai di-requeue lewat OutboxService.
+func NewOutboxDispatcher(outboxRepo repository.OutboxRepository, publisher event.Publisher, parkAfter time.Duration) *OutboxDispatcher {
+	return &OutboxDispatcher{outboxRepo: outboxRepo, publisher: publisher, parkAfter: parkAfter}
+}
+
+// Run memublikasikan outbox setiap interval sampai ctx dibatalkan
+func (d *OutboxDispatcher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		// Proses terus selama batch penuh agar antrean panjang tidak menunggu interval berikutnya
+		for ctx.Err() == nil {
+			published, err := d.DispatchPending(time.Now().UTC())
+			if err != nil {
+				log.Printf("WARNING: Gagal memublikasikan outbox event: %v", err)
+			}
+			if err != nil || published < outboxBatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchPending memublikasikan satu batch event pending dan mengembalikan jumlah
+// yang berhasil. Dispatcher berhenti pada event pertama yang gagal agar urutan event
+// tetap terjaga; event tersebut dicoba lagi dengan jeda eksponensial (next_attempt_at)
+// dan selama jeda itu event sesudahnya ikut menunggu. Jika event terus gagal selama
+// parkAfter sejak kegagalan pertama, event diparkir (tetap tersimpan dengan published_at
+// kosong, tetapi tidak diambil lagi sampai di-requeue) dan event sesudahnya dipublikasikan,
+// sehingga satu event yang selalu gagal tidak menahan seluruh outbox.
+func (d *OutboxDispatcher) DispatchPending(now time.Time) (int, error) {
+	pending, err := d.outboxRepo.FindPending(outboxBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	published := 0
+	for _, row := range pending {
+		if row.NextAttemptAt != nil && row.NextAttemptAt.After(now) {
+			return published, nil
+		}
+		e := event.Event{
+			ID:         row.EventID,
+			Type:       event.Type(row.EventType),
+			OccurredAt: row.OccurredAt.UTC(),
+			Data:       json.RawMessage(row.Payload),
+		}
+		if err := d.publisher.Publish(e); err != nil {
+			failingSince := now
+			if row.FailingSince != nil {
+				failingSince = *row.FailingSince
+			}
+			if now.Sub(failingSince) < d.parkAfter {
+				next := now.Add(outboxBackoff(row.Attempts + 1))
+				if recordErr := d.outboxRepo.RecordFailure(row.ID, err.Error(), failingSince, next); recordErr != nil {
+					log.Printf("WARNING: Gagal mencatat kegagalan outbox event %s: %v", row.EventID, recordErr)
+				}
+				return published, err
+			}
+			if parkErr := d.outboxRepo.Park(row.ID, err.Error(), now); parkErr != nil {
+				log.Printf("WARNING: Gagal memarkir outbox event %s: %v", row.EventID, parkErr)
+				return published, err
+			}
+			log.Printf("WARNING: Outbox event %s (%s) diparkir setelah gagal sejak %s (%d percobaan): %v",
+				row.EventID, row.EventType, failingSince.Format(time.RFC3339), row.Attempts+1, err)
+			continue
+		}
+		if err := d.outboxRepo.MarkPublished(row.ID, now); err != nil {
+			return published, err
+		}
+		published++
+	}
+	return published, nil
+}
+
+// outboxBackoff mengembalikan jeda sebelum percobaan berikutnya setelah attempts kali gagal
+func outboxBackoff(attempts int) time.Duration {
+	backoff := outboxBaseBackoff
+	for i := 1; i < attempts && backoff < outboxMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, outboxMaxBackoff)
+}
+
+// OutboxService menyediakan operasi admin untuk event outbox yang diparkir
+type OutboxService struct {
+	outboxRepo repository.OutboxRepository
+}
+
+// NewOutboxService membuat instance OutboxService baru
+func NewOutboxService(outboxRepo repository.OutboxRepository) *OutboxService {
+	return &OutboxService{outboxRepo: outboxRepo}
+}
+
+// Parked mengambil event yang diparkir dispatcher, paling banyak limit event
+func (s *OutboxService) Parked(limit int) ([]model.OutboxEvent, error) {
+	return s.outboxRepo.FindParked(limit)
+}
+
+// Requeue mengembalikan event yang diparkir ke antrean dispatcher dan mengembalikan jumlahnya.
+// ids kosong berarti semua event yang diparkir. Event dipublikasikan lagi pada putaran
+// dispatcher berikutnya sesuai urutan ID.
+func (s *OutboxService) Requeue(ids []uint) (int64, error) {
+	return s.outboxRepo.Requeue(ids)
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"slices"
+	"time"
+	"xyz-football-api/internal/event"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+)
+
+// PlayerService mengatur aturan bisnis untuk player
+type PlayerService struct {
+	playerRepo       repository.PlayerRepository
+	teamRepo         repository.TeamRepository
+	availabilityRepo repository.PlayerAvailabilityRepository
+	tx               repository.Transactor
+}
+
+// NewPlayerService membuat instance PlayerService baru
+func NewPlayerService(
+	playerRepo repository.PlayerRepository,
+	teamRepo repository.TeamRepository,
+	availabilityRepo repository.PlayerAvailabilityRepository,
+	tx repository.Transactor,
+) *PlayerService {
+	return &PlayerService{
+		playerRepo:       playerRepo,
+		teamRepo:         teamRepo,
+		availabilityRepo: availabilityRepo,
+		tx:               tx,
+	}
+}
+
+// PlayerUpdate berisi perubahan parsial untuk player; field nil tidak diubah
+type PlayerUpdate struct {
+	Name         *string
+	HeightCm     *int
+	WeightKg     *int
+	Position     *string
+	JerseyNumber *int
+}
+
+// Create memvalidasi lalu menyimpan player baru beserta event player.created dalam satu transaksi
+func (s *PlayerService) Create(player *model.Player) error {
+	if player.Name == "" {
+		return ErrPlayerNameRequired
+	}
+	if err := validatePosition(player.Position); err != nil {
+		return err
+	}
+
+	// Validasi team exists
+	if _, err := s.teamRepo.FindByID(player.TeamID); err != nil {
+		if isNotFound(err) {
+			return ErrUnknownTeam
+		}
+		return err
+	}
+
+	if err := s.checkJerseyNumber(player.TeamID, player.JerseyNumber, 0); err != nil {
+		return err
+	}
+
+	return s.tx.Transaction(func(repos *repository.Repositories) error {
+		if err := repos.Players.Create(player); err != nil {
+			return err
+		}
+		return recordEvent(repos.Outbox, event.PlayerCreated, player)
+	})
+}
+
+// ListByTeam mengambil player dalam team. Jika availableOn diisi, player yang
+// tidak tersedia pada tanggal tersebut tidak disertakan.
+func (s *PlayerService) ListByTeam(teamID uint, availableOn *time.Time) ([]model.Player, error) {
+	if _, err := s.teamRepo.FindByID(teamID); err != nil {
+		if isNotFound(err) {
+			return nil, ErrTeamNotFound
+		}
+		return nil, err
+	}
+
+	players, err := s.playerRepo.FindByTeamID(teamID)
+	if err != nil || availableOn == nil {
+		return players, err
+	}
+
+	unavailableIDs, err := s.availabilityRepo.FindUnavailablePlayerIDs(teamID, *availableOn)
+	if err != nil {
+		return nil, err
+	}
+
+	available := make([]model.Player, 0, len(players))
+	for _, player := range players {
+		if !slices.Contains(unavailableIDs, player.ID) {
+			available = append(available, player)
+		}
+	}
+	return available, nil
+}
+
+// Update menerapkan perubahan parsial pada player dan mencatat event player.updated
+func (s *PlayerService) Update(id uint, changes PlayerUpdate) (*model.Player, error) {
+	player, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if changes.Name != nil {
+		if *changes.Name == "" {
+			return nil, ErrPlayerNameRequired
+		}
+		player.Name = *changes.Name
+	}
+	if changes.HeightCm != nil {
+		player.HeightCm = changes.HeightCm
+	}
+	if changes.WeightKg != nil {
+		player.WeightKg = changes.WeightKg
+	}
+	if changes.Position != nil {
+		if err := validatePosition(*changes.Position); err != nil {
+			return nil, err
+		}
+		player.Position = *changes.Position
+	}
+	if changes.JerseyNumber != nil {
+		if err := s.checkJerseyNumber(player.TeamID, *changes.JerseyNumber, player.ID); err != nil {
+			return nil, err
+		}
+		player.JerseyNumber = *changes.JerseyNumber
+	}
+
+	err = s.tx.Transaction(func(repos *repository.Repositories) error {
+		if err := repos.Players.Update(player); err != nil {
+			return err
+		}
+		return recordEvent(repos.Outbox, event.PlayerUpdated, player)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return player, nil
+}
+
+// Get mengambil player berdasarkan ID
+func (s *PlayerService) Get(id uint) (*model.Player, error) {
+	player, err := s.playerRepo.FindByID(id)
+	if isNotFound(err) {
+		return nil, ErrPlayerNotFound
+	}
+	return player, err
+}
+
+// Delete menghapus player (soft delete) dan mencatat event player.deleted
+func (s *PlayerService) Delete(id uint) error {
+	player, err := s.Get(id)
+	if err != nil {
+		return err
+	}
+
+	return s.tx.Transaction(func(repos *repository.Repositories) error {
+		if err := repos.Players.Delete(id); err != nil {
+			return err
+		}
+		return recordEvent(repos.Outbox, event.PlayerDeleted, player)
+	})
+}
+
+// checkJerseyNumber memastikan nomor punggung valid dan belum dipakai player lain di team
+func (s *PlayerService) checkJerseyNumber(teamID uint, jerseyNumber int, excludeID uint) error {
+	if jerseyNumber < 1 || jerseyNumber > 99 {
+		return ErrInvalidJerseyNumber
+	}
+
+	exists, err := s.playerRepo.CheckJerseyNumberExists(teamID, jerseyNumber, excludeID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return ErrJerseyNumberTaken
+	}
+	return nil
+}
+
+// validatePosition memastikan posisi termasuk model.PlayerPositions
+func validatePosition(position string) error {
+	if !slices.Contains(model.PlayerPositions, position) {
+		return ErrInvalidPosition
+	}
+	return nil
+}
@@ -0,0 +1,415 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"time"
+	"xyz-football-api/internal/apperror"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+	"xyz-football-api/internal/tournament"
+)
+
+// Batas dan komposisi liga demo
+const (
+	MinSeedTeams = 2
+	squadSize    = 23
+)
+
+// MaxSeedTeams adalah jumlah team terbanyak yang bisa dibuat (satu team per kota)
+var MaxSeedTeams = len(seedCities)
+
+// Data acuan untuk liga demo. Urutan tidak boleh diubah karena menentukan hasil seed.
+var (
+	seedCities = []string{
+		"Jakarta", "Bandung", "Surabaya", "Malang", "Semarang", "Yogyakarta", "Medan", "Makassar",
+		"Palembang", "Padang", "Denpasar", "Balikpapan", "Pontianak", "Manado", "Banjarmasin", "Pekanbaru",
+		"Jayapura", "Kupang", "Surakarta", "Bogor", "Samarinda", "Jambi", "Lampung", "Mataram",
+	}
+	seedTeamFormats   = []string{"%s FC", "%s United", "Putra %s", "Persatuan %s", "Laskar %s", "Harimau %s", "Elang %s", "Banteng %s"}
+	seedStadiumPrefix = []string{"Gelora", "Patriot", "Mandala", "Pakansari", "Kebanggaan", "Merdeka", "Bumi", "Jalak"}
+	seedStreets       = []string{"Jl. Sudirman", "Jl. Merdeka", "Jl. Diponegoro", "Jl. Gatot Subroto", "Jl. Ahmad Yani", "Jl. Pahlawan", "Jl. Veteran", "Jl. Gajah Mada"}
+	seedFirstNames    = []string{
+		"Andi", "Bambang", "Budi", "Dimas", "Egy", "Evan", "Fachruddin", "Hansamu", "Ilham", "Irfan",
+		"Marselino", "Pratama", "Rachmat", "Ricky", "Rizky", "Saddil", "Stefano", "Syahrian", "Teja", "Witan",
+		"Yakob", "Yanto", "Zulfiandi", "Arhan", "Asnawi", "Boaz", "Dendy", "Febri", "Hendro", "Kurniawan",
+	}
+	seedLastNames = []string{
+		"Saputra", "Pratama", "Wijaya", "Santoso", "Hidayat", "Nugroho", "Siregar", "Simanjuntak", "Lubis", "Nasution",
+		"Sitompul", "Kurniawan", "Setiawan", "Firmansyah", "Ramadhan", "Putra", "Sulaiman", "Tampubolon", "Manuhutu", "Solossa",
+	}
+	// seedSquad adalah jumlah player per posisi dalam satu skuad (total squadSize)
+	seedSquad = []struct {
+		position string
+		count    int
+		weight   int // bobot peluang mencetak gol
+	}{
+		{"penjaga gawang", 3, 0},
+		{"bertahan", 8, 1},
+		{"gelandang", 8, 3},
+		{"penyerang", 4, 6},
+	}
+	seedKickoffTimes = [][2]int{{15, 30}, {19, 0}}
+)
+
+// ErrInvalidSeedTeamCount dikembalikan jika jumlah team liga demo di luar batas
+var ErrInvalidSeedTeamCount = apperror.Validationf("validation_failed", "Jumlah team harus antara %d dan %d", MinSeedTeams, MaxSeedTeams)
+
+// SeedOptions menentukan liga demo yang dibuat. Seed dan Teams yang sama selalu
+// menghasilkan liga yang sama.
+type SeedOptions struct {
+	Seed  int64
+	Teams int
+	// Start adalah tanggal matchday pertama; matchday berikutnya berselang satu minggu
+	Start time.Time
+	// Played adalah jumlah matchday yang hasilnya dilaporkan; negatif berarti semua
+	Played int
+}
+
+// SeedResult berisi jumlah data yang baru dibuat. Data yang sudah ada tidak dihitung.
+type SeedResult struct {
+	Venues  int
+	Teams   int
+	Players int
+	Matches int
+	Results int
+}
+
+// SeedService membuat liga demo (venue, team, skuad, jadwal double round-robin,
+// dan hasil pertandingan) lewat service yang sama dengan API sehingga semua aturan bisnis
+// dan event outbox tetap berlaku
+type SeedService struct {
+	tx repository.Transactor
+}
+
+// NewSeedService membuat instance SeedService baru
+func NewSeedService(tx repository.Transactor) *SeedService {
+	return &SeedService{tx: tx}
+}
+
+// seedLeague adalah rencana liga demo yang dihasilkan dari seed, sebelum disimpan
+type seedLeague struct {
+	venues   []model.Venue
+	teams    []seedTeam
+	fixtures []seedFixture
+}
+
+// seedTeam adalah satu team beserta skuadnya; venue menunjuk indeks di seedLeague.venues
+type seedTeam struct {
+	team    model.Team
+	venue   int
+	players []model.Player
+	scorers []int // indeks player, diulang sesuai bobot peluang mencetak gol
+}
+
+// seedFixture adalah satu pertandingan; home dan away menunjuk indeks di seedLeague.teams
+type seedFixture struct {
+	matchday   int
+	home, away int
+	kickoff    time.Time
+	played     bool // hasil dilaporkan
+	homeScore  int
+	awayScore  int
+	attendance float64 // persentase kapasitas venue yang terisi
+	goals      []seedGoal
+}
+
+// seedGoal adalah satu gol; player menunjuk indeks di skuad team yang mencetak gol
+type seedGoal struct {
+	home   bool
+	player int
+	minute int
+}
+
+// Seed membuat liga demo sesuai opts dalam satu transaksi. Data yang sudah ada dipakai ulang
+// (venue dan team berdasarkan nama, player berdasarkan nomor punggung, match berdasarkan
+// team dan waktu kick-off) sehingga menjalankan seed yang sama berulang kali aman.
+func (s *SeedService) Seed(opts SeedOptions) (*SeedResult, error) {
+	if opts.Teams < MinSeedTeams || opts.Teams > MaxSeedTeams {
+		return nil, ErrInvalidSeedTeamCount
+	}
+
+	league := generateLeague(opts)
+	result := &SeedResult{}
+	err := s.tx.Transaction(func(repos *repository.Repositories) error {
+		return applyLeague(repos, league, result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// generateLeague membuat rencana liga secara deterministik dari opts
+func generateLeague(opts SeedOptions) seedLeague {
+	rng := rand.New(rand.NewSource(opts.Seed))
+	var league seedLeague
+
+	cities := slices.Clone(seedCities)
+	rng.Shuffle(len(cities), func(i, j int) { cities[i], cities[j] = cities[j], cities[i] })
+
+	strength := make([]float64, opts.Teams)
+	for i, city := range cities[:opts.Teams] {
+		league.venues = append(league.venues, model.Venue{
+			Name:     fmt.Sprintf("Stadion %s %s", pick(rng, seedStadiumPrefix), city),
+			City:     city,
+			Capacity: 10000 + rng.Intn(111)*500,
+			Surface:  pick(rng, []string{"grass", "grass", "grass", "hybrid", "artificial"}),
+		})
+
+		founded := 1920 + rng.Intn(96)
+		address := fmt.Sprintf("%s No. %d, %s", pick(rng, seedStreets), 1+rng.Intn(200), city)
+		team := seedTeam{
+			team: model.Team{
+				Name:                fmt.Sprintf(pick(rng, seedTeamFormats), city),
+				FoundedYear:         &founded,
+				HeadquartersAddress: &address,
+				HeadquartersCity:    &city,
+			},
+			venue: i,
+		}
+		team.players, team.scorers = generateSquad(rng)
+		league.teams = append(league.teams, team)
+		strength[i] = 0.7 + rng.Float64()*0.6
+	}
+
+	// Double round-robin: putaran kedua mengulang putaran pertama dengan tuan rumah ditukar
+	ids := make([]uint, opts.Teams)
+	for i := range ids {
+		ids[i] = uint(i + 1)
+	}
+	firstLeg := tournament.RoundRobin(ids)
+	matchdays := firstLeg[len(firstLeg)-1].Matchday
+	legs := make([]tournament.Fixture, 0, 2*len(firstLeg))
+	legs = append(legs, firstLeg...)
+	for _, f := range firstLeg {
+		legs = append(legs, tournament.Fixture{Matchday: f.Matchday + matchdays, HomeTeamID: f.AwayTeamID, AwayTeamID: f.HomeTeamID})
+	}
+
+	played := opts.Played
+	if played < 0 {
+		played = 2 * matchdays
+	}
+	slot := 0
+	for i, f := range legs {
+		if i > 0 && f.Matchday != legs[i-1].Matchday {
+			slot = 0
+		}
+		fixture := seedFixture{
+			matchday: f.Matchday,
+			home:     int(f.HomeTeamID) - 1,
+			away:     int(f.AwayTeamID) - 1,
+			kickoff:  kickoff(opts.Start, f.Matchday, slot),
+		}
+		slot++
+
+		// Hasil selalu dibuat agar rencana tidak bergantung pada Played
+		fixture.homeScore = poisson(rng, 1.4*strength[fixture.home]/strength[fixture.away])
+		fixture.awayScore = poisson(rng, 1.1*strength[fixture.away]/strength[fixture.home])
+		fixture.attendance = 0.4 + rng.Float64()*0.6
+		fixture.goals = generateGoals(rng, league.teams[fixture.home], league.teams[fixture.away], fixture.homeScore, fixture.awayScore)
+		fixture.played = f.Matchday <= played
+		league.fixtures = append(league.fixtures, fixture)
+	}
+	return league
+}
+
+// generateSquad membuat satu skuad dengan posisi sesuai seedSquad dan nomor punggung unik.
+// Penjaga gawang pertama selalu bernomor 1.
+func generateSquad(rng *rand.Rand) ([]model.Player, []int) {
+	numbers := rng.Perm(98) // nomor 2..99
+	players := make([]model.Player, 0, squadSize)
+	var scorers []int
+	for _, slot := range seedSquad {
+		for i := 0; i < slot.count; i++ {
+			number := numbers[len(players)] + 2
+			if len(players) == 0 {
+				number = 1
+			}
+			height := 165 + rng.Intn(26)
+			weight := 58 + rng.Intn(25)
+			for w := 0; w < slot.weight; w++ {
+				scorers = append(scorers, len(players))
+			}
+			players = append(players, model.Player{
+				Name:         pick(rng, seedFirstNames) + " " + pick(rng, seedLastNames),
+				HeightCm:     &height,
+				WeightKg:     &weight,
+				Position:     slot.position,
+				JerseyNumber: number,
+			})
+		}
+	}
+	return players, scorers
+}
+
+// generateGoals membuat timeline gol yang jumlahnya sesuai skor, terurut berdasarkan menit
+func generateGoals(rng *rand.Rand, home, away seedTeam, homeScore, awayScore int) []seedGoal {
+	minutes := rng.Perm(90)[:homeScore+awayScore]
+	slices.Sort(minutes)
+
+	// Urutan gol home dan away di dalam timeline diacak
+	sides := make([]bool, 0, len(minutes))
+	for i := 0; i < homeScore; i++ {
+		sides = append(sides, true)
+	}
+	for i := 0; i < awayScore; i++ {
+		sides = append(sides, false)
+	}
+	rng.Shuffle(len(sides), func(i, j int) { sides[i], sides[j] = sides[j], sides[i] })
+
+	goals := make([]seedGoal, len(minutes))
+	for i, minute := range minutes {
+		team := away
+		if sides[i] {
+			team = home
+		}
+		goals[i] = seedGoal{home: sides[i], player: pick(rng, team.scorers), minute: minute + 1}
+	}
+	return goals
+}
+
+// kickoff mengembalikan waktu kick-off fixture ke-slot pada matchday. Matchday dimainkan
+// setiap akhir pekan (Sabtu dan Minggu) pukul 15.30 dan 19.00 WIB.
+func kickoff(start time.Time, matchday, slot int) time.Time {
+	wib := time.FixedZone("WIB", 7*60*60)
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, wib).AddDate(0, 0, 7*(matchday-1)+slot%2)
+	t := seedKickoffTimes[(slot/2)%len(seedKickoffTimes)]
+	return day.Add(time.Duration(t[0])*time.Hour + time.Duration(t[1])*time.Minute)
+}
+
+// poisson mengambil jumlah gol dari distribusi Poisson (metode Knuth), maksimal 7
+func poisson(rng *rand.Rand, lambda float64) int {
+	limit, p, k := math.Exp(-lambda), 1.0, 0
+	for {
+		p *= rng.Float64()
+		if p <= limit || k == 7 {
+			return k
+		}
+		k++
+	}
+}
+
+// pick memilih satu elemen secara acak
+func pick[T any](rng *rand.Rand, values []T) T {
+	return values[rng.Intn(len(values))]
+}
+
+// applyLeague menyimpan rencana liga lewat service di atas repos, melewati data yang sudah ada
+func applyLeague(repos *repository.Repositories, league seedLeague, result *SeedResult) error {
+	teams := NewTeamService(repos.Teams, repos.Venues, repos.Transactor)
+	players := NewPlayerService(repos.Players, repos.Teams, repos.Availabilities, repos.Transactor)
+	matches := NewMatchService(repos.Matches, repos.Teams, repos.Players, repos.Goals, repos.Venues, repos.Competitions,
+		repos.Availabilities, NewBracketService(repos.Competitions, repos.Teams, repos.Outbox, repos.Transactor), repos.Transactor)
+
+	// Venue
+	existingVenues, err := repos.Venues.FindAll()
+	if err != nil {
+		return err
+	}
+	venues := make([]model.Venue, len(league.venues))
+	for i, venue := range league.venues {
+		index := slices.IndexFunc(existingVenues, func(v model.Venue) bool { return v.Name == venue.Name && v.City == venue.City })
+		if index >= 0 {
+			venues[i] = existingVenues[index]
+			continue
+		}
+		if err := repos.Venues.Create(&venue); err != nil {
+			return err
+		}
+		venues[i] = venue
+		result.Venues++
+	}
+
+	// Team beserta skuad
+	existingTeams, err := teams.List()
+	if err != nil {
+		return err
+	}
+	teamIDs := make([]uint, len(league.teams))
+	squads := make([]map[int]uint, len(league.teams)) // nomor punggung -> player ID
+	for i, planned := range league.teams {
+		team := planned.team
+		index := slices.IndexFunc(existingTeams, func(t model.Team) bool { return t.Name == team.Name })
+		if index >= 0 {
+			team = existingTeams[index]
+		} else {
+			team.HomeVenueID = &venues[planned.venue].ID
+			if err := teams.Create(&team); err != nil {
+				return err
+			}
+			result.Teams++
+		}
+		teamIDs[i] = team.ID
+
+		squad, err := repos.Players.FindByTeamID(team.ID)
+		if err != nil {
+			return err
+		}
+		squads[i] = make(map[int]uint, squadSize)
+		for _, player := range squad {
+			squads[i][player.JerseyNumber] = player.ID
+		}
+		for _, player := range planned.players {
+			if _, ok := squads[i][player.JerseyNumber]; ok {
+				continue
+			}
+			player.TeamID = team.ID
+			if err := players.Create(&player); err != nil {
+				return err
+			}
+			squads[i][player.JerseyNumber] = player.ID
+			result.Players++
+		}
+	}
+
+	// Jadwal dan hasil
+	existingMatches, err := repos.Matches.FindByTeamIDs(teamIDs)
+	if err != nil {
+		return err
+	}
+	for _, fixture := range league.fixtures {
+		homeID, awayID := teamIDs[fixture.home], teamIDs[fixture.away]
+		index := slices.IndexFunc(existingMatches, func(m model.Match) bool {
+			return m.HomeTeamID == homeID && m.AwayTeamID == awayID && m.MatchDatetime.Equal(fixture.kickoff)
+		})
+		var match *model.Match
+		if index >= 0 {
+			match = &existingMatches[index]
+		} else {
+			match, err = matches.Create(CreateMatchInput{HomeTeamID: homeID, AwayTeamID: awayID, MatchDatetime: fixture.kickoff})
+			if err != nil {
+				return err
+			}
+			result.Matches++
+		}
+
+		if !fixture.played || match.Status != model.MatchStatusScheduled {
+			continue
+		}
+		input := MatchResultInput{HomeScore: fixture.homeScore, AwayScore: fixture.awayScore, Goals: []GoalInput{}}
+		if match.VenueID != nil {
+			venue, err := repos.Venues.FindByID(*match.VenueID)
+			if err != nil {
+				return err
+			}
+			attendance := int(float64(venue.Capacity) * fixture.attendance)
+			input.Attendance = &attendance
+		}
+		for _, goal := range fixture.goals {
+			team := fixture.away
+			if goal.home {
+				team = fixture.home
+			}
+			jersey := league.teams[team].players[goal.player].JerseyNumber
+			input.Goals = append(input.Goals, GoalInput{PlayerID: squads[team][jersey], GoalTime: goal.minute})
+		}
+		if err := matches.ReportResult(match.ID, input); err != nil {
+			return err
+		}
+		result.Results++
+	}
+	return nil
+}
@@ -0,0 +1,709 @@
+package service_test
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+	"xyz-football-api/internal/apperror"
+	"xyz-football-api/internal/event"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+	"xyz-football-api/internal/repository/memory"
+	"xyz-football-api/internal/service"
+)
+
+func TestPlayerServiceCreate(t *testing.T) {
+	repos := memory.NewRepositories()
+	teams := service.NewTeamService(repos.Teams, repos.Venues, repos.Transactor)
+	players := service.NewPlayerService(repos.Players, repos.Teams, repos.Availabilities, repos.Transactor)
+
+	team := &model.Team{Name: "Garuda FC"}
+	if err := teams.Create(team); err != nil {
+		t.Fatalf("gagal membuat team: %v", err)
+	}
+	if err := players.Create(&model.Player{Name: "Budi Santoso", TeamID: team.ID, Position: "penyerang", JerseyNumber: 10}); err != nil {
+		t.Fatalf("gagal membuat player: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		player model.Player
+		want   error
+	}{
+		{"unknown team", model.Player{Name: "Rudi", TeamID: 999, Position: "bertahan", JerseyNumber: 4}, service.ErrUnknownTeam},
+		{"invalid position", model.Player{Name: "Rudi", TeamID: team.ID, Position: "kiper", JerseyNumber: 4}, service.ErrInvalidPosition},
+		{"jersey out of range", model.Player{Name: "Rudi", TeamID: team.ID, Position: "bertahan", JerseyNumber: 100}, service.ErrInvalidJerseyNumber},
+		{"jersey taken", model.Player{Name: "Rudi", TeamID: team.ID, Position: "bertahan", JerseyNumber: 10}, service.ErrJerseyNumberTaken},
+		{"missing name", model.Player{TeamID: team.ID, Position: "bertahan", JerseyNumber: 4}, service.ErrPlayerNameRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			player := tt.player
+			if err := players.Create(&player); !errors.Is(err, tt.want) {
+				t.Errorf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchServiceReportResult(t *testing.T) {
+	repos := memory.NewRepositories()
+	teams := service.NewTeamService(repos.Teams, repos.Venues, repos.Transactor)
+	players := service.NewPlayerService(repos.Players, repos.Teams, repos.Availabilities, repos.Transactor)
+	matches := service.NewMatchService(repos.Matches, repos.Teams, repos.Players, repos.Goals, repos.Venues, repos.Competitions, repos.Availabilities,
+		service.NewBracketService(repos.Competitions, repos.Teams, repos.Outbox, repos.Transactor), repos.Transactor)
+
+	home, away := &model.Team{Name: "Garuda FC"}, &model.Team{Name: "Rajawali FC"}
+	for _, team := range []*model.Team{home, away} {
+		if err := teams.Create(team); err != nil {
+			t.Fatalf("gagal membuat team: %v", err)
+		}
+	}
+	scorer := &model.Player{Name: "Budi Santoso", TeamID: home.ID, Position: "penyerang", JerseyNumber: 9}
+	if err := players.Create(scorer); err != nil {
+		t.Fatalf("gagal membuat player: %v", err)
+	}
+
+	if _, err := matches.Create(service.CreateMatchInput{HomeTeamID: home.ID, AwayTeamID: home.ID, MatchDatetime: time.Now()}); !errors.Is(err, service.ErrSameTeams) {
+		t.Errorf("same teams: err = %v, want %v", err, service.ErrSameTeams)
+	}
+
+	match, err := matches.Create(service.CreateMatchInput{HomeTeamID: home.ID, AwayTeamID: away.ID, MatchDatetime: time.Now()})
+	if err != nil {
+		t.Fatalf("gagal membuat match: %v", err)
+	}
+
+	var appErr *apperror.Error
+	err = matches.ReportResult(match.ID, service.MatchResultInput{HomeScore: 2})
+	if !errors.As(err, &appErr) || appErr.Code != "goal_count_mismatch" {
+		t.Errorf("goal count mismatch: err = %v, want code goal_count_mismatch", err)
+	}
+
+	// Rentang nilai diperiksa service, bukan hanya tag binding REST, sehingga berlaku untuk semua transport
+	negative := -1
+	rangeTests := []struct {
+		name   string
+		result service.MatchResultInput
+		field  string
+	}{
+		{"negative score", service.MatchResultInput{HomeScore: 1, AwayScore: -1, Goals: []service.GoalInput{{PlayerID: scorer.ID, GoalTime: 30}}}, "away_score"},
+		{"negative attendance", service.MatchResultInput{Attendance: &negative}, "attendance"},
+		{"negative penalties", service.MatchResultInput{HomePenalties: &negative, AwayPenalties: &negative}, "penalties.home"},
+		{"goal minute too late", service.MatchResultInput{HomeScore: 1, Goals: []service.GoalInput{{PlayerID: scorer.ID, GoalTime: 500}}}, "goals[0].goal_time"},
+		{"goal minute negative", service.MatchResultInput{HomeScore: 1, Goals: []service.GoalInput{{PlayerID: scorer.ID, GoalTime: -3}}}, "goals[0].goal_time"},
+	}
+	for _, tt := range rangeTests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := matches.ReportResult(match.ID, tt.result)
+			var appErr *apperror.Error
+			if !errors.As(err, &appErr) || appErr.Code != "validation_failed" || len(appErr.Fields) == 0 || appErr.Fields[0].Field != tt.field {
+				t.Errorf("err = %#v, want validation_failed on %s", err, tt.field)
+			}
+		})
+	}
+
+	result := service.MatchResultInput{HomeScore: 1, Goals: []service.GoalInput{{PlayerID: scorer.ID, GoalTime: 30}}}
+	if err := matches.ReportResult(match.ID, result); err != nil {
+		t.Fatalf("gagal melaporkan hasil: %v", err)
+	}
+	if err := matches.ReportResult(match.ID, result); !errors.Is(err, service.ErrMatchAlreadyReported) {
+		t.Errorf("report twice: err = %v, want %v", err, service.ErrMatchAlreadyReported)
+	}
+	if err := matches.ReportResult(999, result); !errors.Is(err, service.ErrMatchNotFound) {
+		t.Errorf("unknown match: err = %v, want %v", err, service.ErrMatchNotFound)
+	}
+
+	report, err := matches.Report(match.ID)
+	if err != nil {
+		t.Fatalf("gagal mengambil laporan: %v", err)
+	}
+	if report.TopScorer == nil || report.TopScorer.ID != scorer.ID || report.HomeTeamTotalWins != 1 {
+		t.Errorf("report = %+v, want top scorer %d and 1 home win", report, scorer.ID)
+	}
+}
+
+// failingVenues adalah VenueRepository yang FindByID-nya selalu gagal
+type failingVenues struct {
+	repository.VenueRepository
+}
+
+func (failingVenues) FindByID(uint) (*model.Venue, error) {
+	return nil, errors.New("koneksi database terputus")
+}
+
+func TestMatchServiceAttendanceVenueLookup(t *testing.T) {
+	repos := memory.NewRepositories()
+	venue := &model.Venue{Name: "Stadion Garuda", City: "Jakarta", Capacity: 1000, Surface: "grass"}
+	if err := repos.Venues.Create(venue); err != nil {
+		t.Fatal(err)
+	}
+	home, away := &model.Team{Name: "Garuda FC", HomeVenueID: &venue.ID}, &model.Team{Name: "Rajawali FC"}
+	for _, team := range []*model.Team{home, away} {
+		if err := repos.Teams.Create(team); err != nil {
+			t.Fatal(err)
+		}
+	}
+	newMatches := func(venues repository.VenueRepository) *service.MatchService {
+		return service.NewMatchService(repos.Matches, repos.Teams, repos.Players, repos.Goals, venues, repos.Competitions, repos.Availabilities,
+			service.NewBracketService(repos.Competitions, repos.Teams, repos.Outbox, repos.Transactor), repos.Transactor)
+	}
+
+	match, err := newMatches(repos.Venues).Create(service.CreateMatchInput{HomeTeamID: home.ID, AwayTeamID: away.ID, MatchDatetime: time.Now()})
+	if err != nil {
+		t.Fatalf("gagal membuat match: %v", err)
+	}
+
+	// Kegagalan membaca venue tidak boleh membuat attendance lolos tanpa diperiksa
+	attendance := 5000
+	result := service.MatchResultInput{Attendance: &attendance, Goals: []service.GoalInput{}}
+	if err := newMatches(failingVenues{repos.Venues}).ReportResult(match.ID, result); err == nil || err.Error() != "koneksi database terputus" {
+		t.Errorf("err = %v, want error dari repository", err)
+	}
+	var appErr *apperror.Error
+	if err := newMatches(repos.Venues).ReportResult(match.ID, result); !errors.As(err, &appErr) || appErr.Code != "attendance_exceeds_capacity" {
+		t.Errorf("err = %v, want attendance_exceeds_capacity", err)
+	}
+	if stored, _ := repos.Matches.FindByID(match.ID); stored.Status != model.MatchStatusScheduled {
+		t.Errorf("status = %s, hasil tidak boleh tersimpan", stored.Status)
+	}
+}
+
+// failingTies adalah CompetitionRepository yang UpdateTie-nya selalu gagal
+type failingTies struct {
+	repository.CompetitionRepository
+}
+
+func (failingTies) UpdateTie(*model.CupTie) error {
+	return errors.New("gagal menyimpan tie")
+}
+
+// failingBracketTx menjalankan transaksi dengan repository kompetisi yang gagal memperbarui tie
+type failingBracketTx struct {
+	repository.Transactor
+}
+
+func (tx failingBracketTx) Transaction(fn func(repos *repository.Repositories) error) error {
+	return tx.Transactor.Transaction(func(repos *repository.Repositories) error {
+		scoped := *repos
+		scoped.Competitions = failingTies{repos.Competitions}
+		return fn(&scoped)
+	})
+}
+
+// pendingTypes mengembalikan tipe event outbox yang belum dipublikasikan
+func pendingTypes(t *testing.T, outbox repository.OutboxRepository) []string {
+	t.Helper()
+	pending, err := outbox.FindPending(100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	types := make([]string, 0, len(pending))
+	for _, e := range pending {
+		types = append(types, e.EventType)
+	}
+	return types
+}
+
+func TestMatchServiceBracketAdvance(t *testing.T) {
+	repos := memory.NewRepositories()
+	var teamIDs []uint
+	for _, name := range []string{"Garuda FC", "Elang FC", "Rajawali FC", "Merpati FC"} {
+		team := &model.Team{Name: name}
+		if err := repos.Teams.Create(team); err != nil {
+			t.Fatal(err)
+		}
+		teamIDs = append(teamIDs, team.ID)
+	}
+	competition := &model.Competition{Name: "Piala Nusantara", Format: model.CompetitionFormatCup, StartDatetime: time.Now(), RoundIntervalDays: 7}
+	if err := repos.Competitions.Create(competition); err != nil {
+		t.Fatal(err)
+	}
+	bracket := service.NewBracketService(repos.Competitions, repos.Teams, repos.Outbox, repos.Transactor)
+	if err := bracket.Generate(competition, teamIDs); err != nil {
+		t.Fatalf("gagal membuat bracket: %v", err)
+	}
+	if got := pendingTypes(t, repos.Outbox); !slices.Equal(got, []string{"match.scheduled", "match.scheduled"}) {
+		t.Fatalf("event setelah undian = %v", got)
+	}
+
+	newMatches := func(tx repository.Transactor) *service.MatchService {
+		return service.NewMatchService(repos.Matches, repos.Teams, repos.Players, repos.Goals, repos.Venues, repos.Competitions, repos.Availabilities, bracket, tx)
+	}
+	ties, err := repos.Competitions.FindTies(competition.ID)
+	if err != nil || len(ties) != 3 {
+		t.Fatalf("ties = %d, %v", len(ties), err)
+	}
+	semi := ties[0].Matches[0]
+	homePenalties, awayPenalties := 4, 2
+	homeWin := service.MatchResultInput{ExtraTime: true, HomePenalties: &homePenalties, AwayPenalties: &awayPenalties, Goals: []service.GoalInput{}}
+
+	// Kegagalan saat memajukan pemenang membatalkan hasil match beserta event-nya
+	if err := newMatches(failingBracketTx{repos.Transactor}).ReportResult(semi.ID, homeWin); err == nil || err.Error() != "gagal menyimpan tie" {
+		t.Fatalf("err = %v, want error dari bracket", err)
+	}
+	if stored, _ := repos.Matches.FindByID(semi.ID); stored.Status != model.MatchStatusScheduled {
+		t.Errorf("status = %s, hasil tidak boleh tersimpan", stored.Status)
+	}
+	if got := pendingTypes(t, repos.Outbox); len(got) != 2 {
+		t.Errorf("event setelah rollback = %v", got)
+	}
+
+	// Hasil yang sama bisa dilaporkan ulang setelah kegagalan
+	matches := newMatches(repos.Transactor)
+	for _, tie := range ties[:2] {
+		if err := matches.ReportResult(tie.Matches[0].ID, homeWin); err != nil {
+			t.Fatalf("gagal melaporkan semifinal: %v", err)
+		}
+	}
+	final, err := repos.Competitions.FindTieByID(ties[2].ID)
+	if err != nil || final.HomeTeamID == nil || *final.HomeTeamID != ties[0].Matches[0].HomeTeamID || len(final.Matches) != 1 {
+		t.Fatalf("final = %+v, %v", final, err)
+	}
+	want := []string{"match.scheduled", "match.scheduled", "match.completed", "match.completed", "match.scheduled"}
+	if got := pendingTypes(t, repos.Outbox); !slices.Equal(got, want) {
+		t.Errorf("event setelah semifinal = %v, want %v", got, want)
+	}
+
+	if err := matches.ReportResult(final.Matches[0].ID, homeWin); err != nil {
+		t.Fatalf("gagal melaporkan final: %v", err)
+	}
+	stored, _ := repos.Competitions.FindByID(competition.ID)
+	if stored.Status != model.CompetitionStatusCompleted || stored.ChampionTeamID == nil || *stored.ChampionTeamID != final.Matches[0].HomeTeamID {
+		t.Errorf("competition = %s, champion %v", stored.Status, stored.ChampionTeamID)
+	}
+	if got := pendingTypes(t, repos.Outbox); got[len(got)-1] != "competition.completed" {
+		t.Errorf("event terakhir = %v, want competition.completed", got)
+	}
+}
+
+// failingOutbox adalah OutboxRepository yang Append-nya selalu gagal
+type failingOutbox struct {
+	repository.OutboxRepository
+}
+
+func (failingOutbox) Append([]model.OutboxEvent) error {
+	return errors.New("gagal menyimpan event")
+}
+
+// failingOutboxTx menjalankan transaksi dengan outbox yang gagal menyimpan event
+type failingOutboxTx struct {
+	repository.Transactor
+}
+
+func (tx failingOutboxTx) Transaction(fn func(repos *repository.Repositories) error) error {
+	return tx.Transactor.Transaction(func(repos *repository.Repositories) error {
+		scoped := *repos
+		scoped.Outbox = failingOutbox{repos.Outbox}
+		return fn(&scoped)
+	})
+}
+
+func TestBracketServiceDraw(t *testing.T) {
+	repos := memory.NewRepositories()
+	var entrants []model.CompetitionTeam
+	for i, name := range []string{"Garuda FC", "Elang FC", "Rajawali FC", "Merpati FC"} {
+		team := &model.Team{Name: name}
+		if err := repos.Teams.Create(team); err != nil {
+			t.Fatal(err)
+		}
+		entrants = append(entrants, model.CompetitionTeam{TeamID: team.ID, Seed: i + 1})
+	}
+	newCompetition := func(format model.CompetitionFormat) *model.Competition {
+		competition := &model.Competition{
+			Name: "Piala Nusantara", Format: format, Status: model.CompetitionStatusDraft, StartDatetime: time.Now(),
+			RoundIntervalDays: 7, GroupCount: 2, QualifiersPerGroup: 1, Teams: slices.Clone(entrants),
+		}
+		if err := repos.Competitions.Create(competition); err != nil {
+			t.Fatal(err)
+		}
+		return competition
+	}
+
+	t.Run("failure rolls back the whole draw", func(t *testing.T) {
+		competition := newCompetition(model.CompetitionFormatCup)
+		bracket := service.NewBracketService(repos.Competitions, repos.Teams, repos.Outbox, failingOutboxTx{repos.Transactor})
+		if err := bracket.Draw(competition.ID, 1); err == nil || err.Error() != "gagal menyimpan event" {
+			t.Fatalf("err = %v, want error dari outbox", err)
+		}
+		stored, _ := repos.Competitions.FindByID(competition.ID)
+		ties, _ := repos.Competitions.FindTies(competition.ID)
+		if stored.Status != model.CompetitionStatusDraft || len(ties) != 0 {
+			t.Errorf("status = %s, ties = %d; undian harus dibatalkan seluruhnya", stored.Status, len(ties))
+		}
+
+		bracket = service.NewBracketService(repos.Competitions, repos.Teams, repos.Outbox, repos.Transactor)
+		if err := bracket.Draw(competition.ID, 1); err != nil {
+			t.Fatalf("undian ulang gagal: %v", err)
+		}
+		if err := bracket.Draw(competition.ID, 1); !errors.Is(err, service.ErrCompetitionAlreadyDrawn) {
+			t.Errorf("err = %v, want ErrCompetitionAlreadyDrawn", err)
+		}
+	})
+
+	t.Run("group fixtures are published", func(t *testing.T) {
+		before := len(pendingTypes(t, repos.Outbox))
+		competition := newCompetition(model.CompetitionFormatGroupKnockout)
+		bracket := service.NewBracketService(repos.Competitions, repos.Teams, repos.Outbox, repos.Transactor)
+		if err := bracket.Draw(competition.ID, 1); err != nil {
+			t.Fatalf("gagal mengundi fase grup: %v", err)
+		}
+		if got := pendingTypes(t, repos.Outbox)[before:]; !slices.Equal(got, []string{"match.scheduled", "match.scheduled"}) {
+			t.Errorf("event fase grup = %v, want satu match.scheduled per fixture", got)
+		}
+	})
+}
+
+// recordingPublisher mencatat event yang diterima dan gagal selama fail bernilai true
+type recordingPublisher struct {
+	fail   bool
+	events []event.Event
+}
+
+func (p *recordingPublisher) Publish(e event.Event) error {
+	if p.fail {
+		return errors.New("broker tidak tersedia")
+	}
+	p.events = append(p.events, e)
+	return nil
+}
+
+func TestOutboxDispatcher(t *testing.T) {
+	repos := memory.NewRepositories()
+	teams := service.NewTeamService(repos.Teams, repos.Venues, repos.Transactor)
+	players := service.NewPlayerService(repos.Players, repos.Teams, repos.Availabilities, repos.Transactor)
+	matches := service.NewMatchService(repos.Matches, repos.Teams, repos.Players, repos.Goals, repos.Venues, repos.Competitions, repos.Availabilities,
+		service.NewBracketService(repos.Competitions, repos.Teams, repos.Outbox, repos.Transactor), repos.Transactor)
+
+	home, away := &model.Team{Name: "Garuda FC"}, &model.Team{Name: "Rajawali FC"}
+	for _, team := range []*model.Team{home, away} {
+		if err := teams.Create(team); err != nil {
+			t.Fatalf("gagal membuat team: %v", err)
+		}
+	}
+	scorer := &model.Player{Name: "Budi Santoso", TeamID: home.ID, Position: "penyerang", JerseyNumber: 9}
+	if err := players.Create(scorer); err != nil {
+		t.Fatalf("gagal membuat player: %v", err)
+	}
+	match, err := matches.Create(service.CreateMatchInput{HomeTeamID: home.ID, AwayTeamID: away.ID, MatchDatetime: time.Now()})
+	if err != nil {
+		t.Fatalf("gagal membuat match: %v", err)
+	}
+	result := service.MatchResultInput{HomeScore: 1, Goals: []service.GoalInput{{PlayerID: scorer.ID, GoalTime: 30}}}
+	if err := matches.ReportResult(match.ID, result); err != nil {
+		t.Fatalf("gagal melaporkan hasil: %v", err)
+	}
+	if err := teams.Delete(away.ID); err != nil {
+		t.Fatalf("gagal menghapus team: %v", err)
+	}
+
+	publisher := &recordingPublisher{fail: true}
+	dispatcher := service.NewOutboxDispatcher(repos.Outbox, publisher, time.Hour)
+	now := time.Now().UTC()
+
+	// Event pertama gagal: dispatcher berhenti agar urutan tetap terjaga
+	if published, err := dispatcher.DispatchPending(now); err == nil || published != 0 {
+		t.Fatalf("DispatchPending = %d, %v; want 0 and error", published, err)
+	}
+	pending, _ := repos.Outbox.FindPending(10)
+	if len(pending) != 4 || pending[0].Attempts != 1 || pending[0].LastError == nil || pending[1].Attempts != 0 {
+		t.Fatalf("pending setelah gagal = %+v", pending)
+	}
+	if next := pending[0].NextAttemptAt; next == nil || !next.After(now) {
+		t.Fatalf("next_attempt_at = %v, want setelah %v", next, now)
+	}
+
+	// Selama jeda retry belum lewat tidak ada event yang dipublikasikan
+	publisher.fail = false
+	if published, err := dispatcher.DispatchPending(now); err != nil || published != 0 {
+		t.Fatalf("DispatchPending sebelum jeda lewat = %d, %v; want 0", published, err)
+	}
+	if published, err := dispatcher.DispatchPending(*pending[0].NextAttemptAt); err != nil || published != 4 {
+		t.Fatalf("DispatchPending = %d, %v; want 4", published, err)
+	}
+	var types []event.Type
+	for _, e := range publisher.events {
+		types = append(types, e.Type)
+	}
+	want := []event.Type{event.PlayerCreated, event.MatchCompleted, event.GoalRecorded, event.TeamDeleted}
+	if !slices.Equal(types, want) {
+		t.Errorf("event = %v, want %v", types, want)
+	}
+	if pending[0].EventID != publisher.events[0].ID {
+		t.Errorf("event ID berubah: %s -> %s", pending[0].EventID, publisher.events[0].ID)
+	}
+
+	if published, err := dispatcher.DispatchPending(now.Add(time.Minute)); err != nil || published != 0 {
+		t.Errorf("event yang sudah terkirim dipublikasikan lagi: %d, %v", published, err)
+	}
+}
+
+// poisonPublisher selalu gagal untuk satu tipe event dan mencatat event lainnya
+type poisonPublisher struct {
+	poison event.Type
+	events []event.Type
+}
+
+func (p *poisonPublisher) Publish(e event.Event) error {
+	if e.Type == p.poison {
+		return errors.New("payload tidak bisa diproses")
+	}
+	p.events = append(p.events, e.Type)
+	return nil
+}
+
+func TestOutboxDispatcherParksPoisonEvent(t *testing.T) {
+	repos := memory.NewRepositories()
+	var rows []model.OutboxEvent
+	for i, eventType := range []event.Type{event.PlayerCreated, event.TeamDeleted, event.PlayerUpdated, event.PlayerDeleted} {
+		rows = append(rows, model.OutboxEvent{EventID: fmt.Sprintf("event-%d", i), EventType: string(eventType), Payload: "{}", OccurredAt: time.Now()})
+	}
+	if err := repos.Outbox.Append(rows); err != nil {
+		t.Fatal(err)
+	}
+
+	publisher := &poisonPublisher{poison: event.TeamDeleted}
+	dispatcher := service.NewOutboxDispatcher(repos.Outbox, publisher, time.Minute)
+	start := time.Now().UTC()
+
+	// Percobaan pertama: event sesudah event yang gagal menunggu agar urutan terjaga
+	if published, err := dispatcher.DispatchPending(start); err == nil || published != 1 {
+		t.Fatalf("DispatchPending = %d, %v; want 1 and error", published, err)
+	}
+	if !slices.Equal(publisher.events, []event.Type{event.PlayerCreated}) {
+		t.Fatalf("event = %v", publisher.events)
+	}
+
+	// Masih dalam batas waktu parkir: event dicoba lagi setelah jeda dan tetap menahan antrean
+	if published, err := dispatcher.DispatchPending(start.Add(2 * time.Second)); err == nil || published != 0 {
+		t.Fatalf("DispatchPending = %d, %v; want 0 and error", published, err)
+	}
+
+	// Gagal terus melewati batas waktu: event diparkir dan sisanya tetap dipublikasikan
+	if published, err := dispatcher.DispatchPending(start.Add(2 * time.Minute)); err != nil || published != 2 {
+		t.Fatalf("DispatchPending = %d, %v; want 2", published, err)
+	}
+	want := []event.Type{event.PlayerCreated, event.PlayerUpdated, event.PlayerDeleted}
+	if !slices.Equal(publisher.events, want) {
+		t.Errorf("event = %v, want %v", publisher.events, want)
+	}
+
+	if pending, _ := repos.Outbox.FindPending(10); len(pending) != 0 {
+		t.Errorf("event diparkir masih diambil: %+v", pending)
+	}
+	outbox := service.NewOutboxService(repos.Outbox)
+	parked, err := outbox.Parked(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parked) != 1 || parked[0].EventID != "event-1" || parked[0].Attempts != 3 || parked[0].LastError == nil || parked[0].ParkedAt == nil {
+		t.Fatalf("event diparkir = %+v", parked)
+	}
+	if published, err := dispatcher.DispatchPending(start.Add(time.Hour)); err != nil || published != 0 {
+		t.Errorf("DispatchPending setelah parkir = %d, %v; want 0", published, err)
+	}
+
+	// Setelah penyebabnya diperbaiki, admin mengembalikan event ke antrean dan event terkirim
+	if requeued, err := outbox.Requeue([]uint{parked[0].ID + 100}); err != nil || requeued != 0 {
+		t.Errorf("Requeue ID lain = %d, %v; want 0", requeued, err)
+	}
+	if requeued, err := outbox.Requeue(nil); err != nil || requeued != 1 {
+		t.Fatalf("Requeue = %d, %v; want 1", requeued, err)
+	}
+	publisher.poison = ""
+	if published, err := dispatcher.DispatchPending(start.Add(time.Hour)); err != nil || published != 1 {
+		t.Fatalf("DispatchPending setelah requeue = %d, %v; want 1", published, err)
+	}
+	if got := publisher.events[len(publisher.events)-1]; got != event.TeamDeleted {
+		t.Errorf("event terakhir = %s, want %s", got, event.TeamDeleted)
+	}
+	if parked, _ := outbox.Parked(10); len(parked) != 0 {
+		t.Errorf("masih ada event diparkir: %+v", parked)
+	}
+}
+
+func TestTransactionRollback(t *testing.T) {
+	repos := memory.NewRepositories()
+	team := &model.Team{Name: "Garuda FC"}
+
+	err := repos.Transactor.Transaction(func(tx *repository.Repositories) error {
+		if err := tx.Teams.Create(team); err != nil {
+			return err
+		}
+		if err := tx.Outbox.Append([]model.OutboxEvent{{EventID: "abc", EventType: string(event.TeamDeleted), Payload: "{}"}}); err != nil {
+			return err
+		}
+		return errors.New("gagal")
+	})
+	if err == nil {
+		t.Fatal("Transaction harus mengembalikan error fn")
+	}
+
+	if _, err := repos.Teams.FindByID(team.ID); err == nil {
+		t.Error("team tetap tersimpan setelah rollback")
+	}
+	if pending, _ := repos.Outbox.FindPending(10); len(pending) != 0 {
+		t.Errorf("outbox tetap berisi %d event setelah rollback", len(pending))
+	}
+}
+
+func TestLiveFeed(t *testing.T) {
+	repos := memory.NewRepositories()
+	bus := event.NewBus()
+	feed := service.NewLiveFeed(repos.Matches, bus)
+
+	competitionID := uint(3)
+	match := &model.Match{HomeTeamID: 1, AwayTeamID: 2, CompetitionID: &competitionID, MatchDatetime: time.Now()}
+	if err := repos.Matches.Create(match); err != nil {
+		t.Fatalf("gagal membuat match: %v", err)
+	}
+
+	sub := bus.Subscribe(2)
+	defer sub.Close()
+	sub.Add("competition:3", "team:2")
+
+	publish := func(eventType event.Type, data any) {
+		t.Helper()
+		e, err := event.New(eventType, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := feed.Publish(e); err != nil {
+			t.Fatalf("Publish error: %v", err)
+		}
+	}
+
+	// Gol memakai team dan kompetisi dari match-nya; roster team lain tidak dikirim
+	publish(event.GoalRecorded, model.Goal{MatchID: match.ID, PlayerID: 7, GoalTime: 10})
+	publish(event.PlayerCreated, model.Player{TeamID: 1, Name: "Budi"})
+	publish(event.PlayerUpdated, model.Player{TeamID: 2, Name: "Rudi"})
+
+	want := []string{
+		fmt.Sprintf("goal.recorded %v", []string{"team:2", "competition:3"}),
+		fmt.Sprintf("player.updated %v", []string{"team:2"}),
+	}
+	for _, w := range want {
+		msg := <-sub.Messages()
+		if got := fmt.Sprintf("%s %v", msg.Event.Type, msg.Topics); got != w {
+			t.Errorf("pesan = %s, want %s", got, w)
+		}
+	}
+
+	// Buffer 2 penuh: subscriber dilepas, channel ditutup, dan Overflowed melaporkannya
+	for range 3 {
+		publish(event.TeamDeleted, model.Team{ID: 2})
+	}
+	count := 0
+	for range sub.Messages() {
+		count++
+	}
+	if count != 2 || !sub.Overflowed() {
+		t.Errorf("pesan sebelum dilepas = %d, overflowed = %v; want 2, true", count, sub.Overflowed())
+	}
+}
+
+func TestSeedService(t *testing.T) {
+	opts := service.SeedOptions{Seed: 42, Teams: 4, Start: time.Date(2024, 8, 3, 0, 0, 0, 0, time.UTC), Played: 3}
+
+	// league mengembalikan ringkasan semua match agar dua database bisa dibandingkan
+	league := func(repos *repository.Repositories) []string {
+		teams, err := repos.Teams.FindAll()
+		if err != nil {
+			t.Fatal(err)
+		}
+		names := make(map[uint]string)
+		for _, team := range teams {
+			names[team.ID] = team.Name
+		}
+		matches, err := repos.Matches.FindByTeamIDs(slices.Collect(maps.Keys(names)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var summary []string
+		for _, match := range matches {
+			summary = append(summary, fmt.Sprintf("%s %s %d-%d %s %s", match.MatchDatetime.Format(time.RFC3339),
+				names[match.HomeTeamID], match.HomeScore, match.AwayScore, names[match.AwayTeamID], match.Status))
+		}
+		return summary
+	}
+
+	repos := memory.NewRepositories()
+	seeder := service.NewSeedService(repos.Transactor)
+	result, err := seeder.Seed(opts)
+	if err != nil {
+		t.Fatalf("gagal seed: %v", err)
+	}
+	if want := (service.SeedResult{Venues: 4, Teams: 4, Players: 92, Matches: 12, Results: 6}); *result != want {
+		t.Errorf("result = %+v, want %+v", *result, want)
+	}
+
+	// Seed yang sama menghasilkan liga yang sama di database lain
+	other := memory.NewRepositories()
+	if _, err := service.NewSeedService(other.Transactor).Seed(opts); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := league(other), league(repos); !slices.Equal(got, want) {
+		t.Errorf("liga tidak deterministik:\n%v\n%v", got, want)
+	}
+
+	// Menjalankan ulang tidak membuat data ganda; menambah Played hanya melaporkan sisa hasil
+	if result, err := seeder.Seed(opts); err != nil || *result != (service.SeedResult{}) {
+		t.Errorf("rerun: result = %+v, err = %v", result, err)
+	}
+	opts.Played = -1
+	if result, err := seeder.Seed(opts); err != nil || *result != (service.SeedResult{Results: 6}) {
+		t.Errorf("played all: result = %+v, err = %v", result, err)
+	}
+
+	// Skuad memakai posisi valid dan nomor punggung unik
+	teams, _ := repos.Teams.FindAll()
+	for _, team := range teams {
+		squad, _ := repos.Players.FindByTeamID(team.ID)
+		jerseys := make(map[int]bool)
+		for _, player := range squad {
+			if !slices.Contains(model.PlayerPositions, player.Position) || jerseys[player.JerseyNumber] {
+				t.Errorf("player tidak valid di %s: %+v", team.Name, player)
+			}
+			jerseys[player.JerseyNumber] = true
+		}
+	}
+
+	// Timeline gol sesuai skor
+	for _, summary := range league(repos) {
+		if !strings.HasSuffix(summary, string(model.MatchStatusCompleted)) {
+			t.Errorf("match belum selesai: %s", summary)
+		}
+	}
+	matches, _ := repos.Matches.FindByTeamIDs([]uint{teams[0].ID})
+	for _, m := range matches {
+		match, err := repos.Matches.FindByIDWithGoals(m.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		home, away, last := 0, 0, 0
+		for _, goal := range match.Goals {
+			player, _ := repos.Players.FindByID(goal.PlayerID)
+			switch player.TeamID {
+			case match.HomeTeamID:
+				home++
+			case match.AwayTeamID:
+				away++
+			}
+			if goal.GoalTime < last || goal.GoalTime < 1 || goal.GoalTime > 90 {
+				t.Errorf("menit gol tidak valid di match %d: %+v", match.ID, match.Goals)
+			}
+			last = goal.GoalTime
+		}
+		if home != match.HomeScore || away != match.AwayScore {
+			t.Errorf("gol match %d = %d-%d, skor %d-%d", match.ID, home, away, match.HomeScore, match.AwayScore)
+		}
+	}
+
+	if _, err := seeder.Seed(service.SeedOptions{Teams: 1}); !errors.Is(err, service.ErrInvalidSeedTeamCount) {
+		t.Errorf("err = %v, want %v", err, service.ErrInvalidSeedTeamCount)
+	}
+}
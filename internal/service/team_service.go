@@ -0,0 +1,116 @@
+package service
+
+import (
+	"xyz-football-api/internal/event"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+)
+
+// TeamService mengatur aturan bisnis untuk team
+type TeamService struct {
+	teamRepo  repository.TeamRepository
+	venueRepo repository.VenueRepository
+	tx        repository.Transactor
+}
+
+// NewTeamService membuat instance TeamService baru
+func NewTeamService(teamRepo repository.TeamRepository, venueRepo repository.VenueRepository, tx repository.Transactor) *TeamService {
+	return &TeamService{
+		teamRepo:  teamRepo,
+		venueRepo: venueRepo,
+		tx:        tx,
+	}
+}
+
+// Create memvalidasi lalu menyimpan team baru
+func (s *TeamService) Create(team *model.Team) error {
+	if team.Name == "" {
+		return ErrTeamNameRequired
+	}
+
+	// Validasi home venue exists
+	if team.HomeVenueID != nil {
+		if _, err := s.findVenue(*team.HomeVenueID); err != nil {
+			return err
+		}
+	}
+	team.HomeVenue = nil
+
+	return s.teamRepo.Create(team)
+}
+
+// List mengambil semua team
+func (s *TeamService) List() ([]model.Team, error) {
+	return s.teamRepo.FindAll()
+}
+
+// Get mengambil team berdasarkan ID
+func (s *TeamService) Get(id uint) (*model.Team, error) {
+	team, err := s.teamRepo.FindByID(id)
+	if isNotFound(err) {
+		return nil, ErrTeamNotFound
+	}
+	return team, err
+}
+
+// Update memperbarui team. Name selalu diganti, field lain hanya jika diisi.
+func (s *TeamService) Update(id uint, changes model.Team) (*model.Team, error) {
+	team, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if changes.Name == "" {
+		return nil, ErrTeamNameRequired
+	}
+
+	team.Name = changes.Name
+	if changes.LogoURL != nil {
+		team.LogoURL = changes.LogoURL
+	}
+	if changes.FoundedYear != nil {
+		team.FoundedYear = changes.FoundedYear
+	}
+	if changes.HeadquartersAddress != nil {
+		team.HeadquartersAddress = changes.HeadquartersAddress
+	}
+	if changes.HeadquartersCity != nil {
+		team.HeadquartersCity = changes.HeadquartersCity
+	}
+	if changes.HomeVenueID != nil {
+		venue, err := s.findVenue(*changes.HomeVenueID)
+		if err != nil {
+			return nil, err
+		}
+		team.HomeVenueID = changes.HomeVenueID
+		team.HomeVenue = venue
+	}
+
+	if err := s.teamRepo.Update(team); err != nil {
+		return nil, err
+	}
+	return team, nil
+}
+
+// Delete menghapus team (soft delete) dan mencatat event team.deleted dalam satu transaksi
+func (s *TeamService) Delete(id uint) error {
+	team, err := s.Get(id)
+	if err != nil {
+		return err
+	}
+
+	return s.tx.Transaction(func(repos *repository.Repositories) error {
+		if err := repos.Teams.Delete(id); err != nil {
+			return err
+		}
+		return recordEvent(repos.Outbox, event.TeamDeleted, team)
+	})
+}
+
+// findVenue mengambil home venue yang dirujuk team
+func (s *TeamService) findVenue(venueID uint) (*model.Venue, error) {
+	venue, err := s.venueRepo.FindByID(venueID)
+	if isNotFound(err) {
+		return nil, ErrUnknownHomeVenue
+	}
+	return venue, err
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"xyz-football-api/internal/apperror"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// minPasswordLength adalah panjang minimal password user
+const minPasswordLength = 8
+
+// Error user
+var (
+	ErrUsernameRequired = apperror.Validation("validation_failed", "Username wajib diisi", field("username", "wajib diisi"))
+	ErrPasswordTooShort = apperror.Validation("validation_failed", "Password minimal 8 karakter", field("password", "minimal 8 karakter"))
+	ErrUsernameTaken    = apperror.Conflict("username_taken", "Username sudah digunakan")
+)
+
+// UserService mengelola akun user yang disimpan di database. Admin dari konfigurasi
+// tidak disimpan di tabel users sehingga username-nya tidak bisa didaftarkan ulang.
+type UserService struct {
+	userRepo      repository.UserRepository
+	adminUsername string
+}
+
+// NewUserService membuat instance UserService baru
+func NewUserService(userRepo repository.UserRepository, adminUsername string) *UserService {
+	return &UserService{userRepo: userRepo, adminUsername: adminUsername}
+}
+
+// Create memvalidasi lalu menyimpan user baru dengan password yang di-hash bcrypt
+func (s *UserService) Create(username, password string) (*model.User, error) {
+	username = strings.TrimSpace(username)
+	if username == "" {
+		return nil, ErrUsernameRequired
+	}
+	if len(password) < minPasswordLength {
+		return nil, ErrPasswordTooShort
+	}
+	if username == s.adminUsername {
+		return nil, ErrUsernameTaken
+	}
+
+	if _, err := s.userRepo.FindByUsername(username); err == nil {
+		return nil, ErrUsernameTaken
+	} else if !isNotFound(err) {
+		return nil, err
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	user := &model.User{Username: username, PasswordHash: string(hash)}
+	if err := s.userRepo.Create(user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// Authenticate memeriksa username dan password user di database.
+// Mengembalikan false tanpa error jika user tidak ada atau password salah.
+func (s *UserService) Authenticate(username, password string) (bool, error) {
+	user, err := s.userRepo.FindByUsername(username)
+	if err != nil {
+		if isNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	return err == nil, err
+}
@@ -0,0 +1,394 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+	"xyz-football-api/internal/apperror"
+	"xyz-football-api/internal/event"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+)
+
+// Header yang dikirim bersama setiap pengiriman webhook
+const (
+	// WebhookSignatureHeader berisi "sha256=" + HMAC-SHA256 hex dari body dengan secret subscription
+	WebhookSignatureHeader = "X-Webhook-Signature"
+	WebhookEventHeader     = "X-Webhook-Event"
+	WebhookDeliveryHeader  = "X-Webhook-Delivery"
+)
+
+const (
+	// minWebhookSecretLength adalah panjang minimal secret subscription
+	minWebhookSecretLength = 16
+	// webhookBatchSize adalah jumlah pengiriman yang diproses worker per putaran
+	webhookBatchSize = 50
+	// webhookBaseBackoff dan webhookMaxBackoff membatasi jeda retry: 30 detik, 1, 2, 4 menit, ... maks. 1 jam
+	webhookBaseBackoff = 30 * time.Second
+	webhookMaxBackoff  = time.Hour
+	// maxWebhookErrorLength membatasi panjang pesan error yang disimpan di log pengiriman
+	maxWebhookErrorLength = 500
+)
+
+// Error webhook
+var (
+	ErrWebhookNotFound         = apperror.NotFound("webhook_not_found", "Webhook tidak ditemukan")
+	ErrWebhookDeliveryNotFound = apperror.NotFound("webhook_delivery_not_found", "Pengiriman webhook tidak ditemukan")
+	ErrInvalidWebhookURL       = apperror.Validation("validation_failed", "URL webhook harus berupa URL http atau https", field("url", "harus berupa URL http atau https"))
+	ErrWebhookSecretTooShort   = apperror.Validation("validation_failed", "Secret webhook minimal 16 karakter", field("secret", "minimal 16 karakter"))
+	ErrInvalidWebhookEvents    = apperror.Validation("validation_failed", "Tipe event webhook tidak valid", field("events", "harus berisi satu atau lebih dari: match.completed, goal.recorded, player.created, player.updated, player.deleted, team.deleted, match.scheduled, competition.completed"))
+)
+
+// WebhookInput berisi data subscription dari request. Pada update, Secret kosong
+// berarti secret lama dipakai dan Active nil berarti status tidak diubah.
+type WebhookInput struct {
+	URL    string
+	Secret string
+	Events []string
+	Active *bool
+}
+
+// WebhookService mengelola subscription webhook dan mengantrekan pengiriman untuk setiap event.
+// Pengiriman dilakukan oleh WebhookWorker di background sehingga request yang memicu event
+// tidak menunggu sistem penerima.
+type WebhookService struct {
+	webhookRepo repository.WebhookRepository
+}
+
+// Pastikan WebhookService bisa dipakai sebagai tujuan event
+var _ event.Publisher = (*WebhookService)(nil)
+
+// NewWebhookService membuat instance WebhookService baru
+func NewWebhookService(webhookRepo repository.WebhookRepository) *WebhookService {
+	return &WebhookService{webhookRepo: webhookRepo}
+}
+
+// Create memvalidasi lalu menyimpan subscription baru (selalu aktif)
+func (s *WebhookService) Create(input WebhookInput) (*model.WebhookSubscription, error) {
+	if len(input.Secret) < minWebhookSecretLength {
+		return nil, ErrWebhookSecretTooShort
+	}
+	subscription := &model.WebhookSubscription{Secret: input.Secret, Active: true}
+	if err := applyWebhookInput(subscription, input); err != nil {
+		return nil, err
+	}
+
+	if err := s.webhookRepo.CreateSubscription(subscription); err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+// List mengambil semua subscription
+func (s *WebhookService) List() ([]model.WebhookSubscription, error) {
+	return s.webhookRepo.FindAllSubscriptions()
+}
+
+// Get mengambil subscription berdasarkan ID
+func (s *WebhookService) Get(id uint) (*model.WebhookSubscription, error) {
+	subscription, err := s.webhookRepo.FindSubscriptionByID(id)
+	if isNotFound(err) {
+		return nil, ErrWebhookNotFound
+	}
+	return subscription, err
+}
+
+// Update mengganti URL dan daftar event subscription, serta secret dan status aktif jika diisi
+func (s *WebhookService) Update(id uint, input WebhookInput) (*model.WebhookSubscription, error) {
+	subscription, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if input.Secret != "" {
+		if len(input.Secret) < minWebhookSecretLength {
+			return nil, ErrWebhookSecretTooShort
+		}
+		subscription.Secret = input.Secret
+	}
+	if err := applyWebhookInput(subscription, input); err != nil {
+		return nil, err
+	}
+	if input.Active != nil {
+		subscription.Active = *input.Active
+	}
+
+	if err := s.webhookRepo.UpdateSubscription(subscription); err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+// Delete menghapus subscription beserta log pengirimannya
+func (s *WebhookService) Delete(id uint) error {
+	if _, err := s.Get(id); err != nil {
+		return err
+	}
+	return s.webhookRepo.DeleteSubscription(id)
+}
+
+// Deliveries mengambil log pengiriman subscription, terbaru lebih dulu
+func (s *WebhookService) Deliveries(id uint, limit int) ([]model.WebhookDelivery, error) {
+	if _, err := s.Get(id); err != nil {
+		return nil, err
+	}
+	return s.webhookRepo.FindDeliveriesBySubscription(id, limit)
+}
+
+// Redeliver mengantrekan ulang payload sebuah pengiriman sebagai pengiriman baru.
+// Pengiriman lama tetap ada di log apa pun statusnya.
+func (s *WebhookService) Redeliver(id, deliveryID uint) (*model.WebhookDelivery, error) {
+	if _, err := s.Get(id); err != nil {
+		return nil, err
+	}
+	original, err := s.webhookRepo.FindDeliveryByID(deliveryID)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, ErrWebhookDeliveryNotFound
+		}
+		return nil, err
+	}
+	if original.SubscriptionID != id {
+		return nil, ErrWebhookDeliveryNotFound
+	}
+
+	now := time.Now().UTC()
+	deliveries := []model.WebhookDelivery{{
+		SubscriptionID: id,
+		EventID:        original.EventID,
+		EventType:      original.EventType,
+		Payload:        original.Payload,
+		Status:         model.WebhookDeliveryPending,
+		NextAttemptAt:  &now,
+	}}
+	if err := s.webhookRepo.CreateDeliveries(deliveries); err != nil {
+		return nil, err
+	}
+	return &deliveries[0], nil
+}
+
+// Publish mengantrekan pengiriman event ke setiap subscription aktif yang berlangganan tipenya.
+// Event yang sudah pernah diantrekan diabaikan, karena dispatcher outbox bisa
+// mempublikasikan event yang sama lebih dari sekali. Memenuhi event.Publisher.
+func (s *WebhookService) Publish(e event.Event) error {
+	queued, err := s.webhookRepo.HasDeliveriesForEvent(e.ID)
+	if err != nil || queued {
+		return err
+	}
+
+	subscriptions, err := s.webhookRepo.FindActiveSubscriptions()
+	if err != nil {
+		return err
+	}
+
+	var payload []byte
+	var deliveries []model.WebhookDelivery
+	now := time.Now().UTC()
+	for _, subscription := range subscriptions {
+		if !subscription.Events.Has(string(e.Type)) {
+			continue
+		}
+		if payload == nil {
+			if payload, err = json.Marshal(e); err != nil {
+				return err
+			}
+		}
+		deliveries = append(deliveries, model.WebhookDelivery{
+			SubscriptionID: subscription.ID,
+			EventID:        e.ID,
+			EventType:      string(e.Type),
+			Payload:        string(payload),
+			Status:         model.WebhookDeliveryPending,
+			NextAttemptAt:  &now,
+		})
+	}
+	return s.webhookRepo.CreateDeliveries(deliveries)
+}
+
+// applyWebhookInput memvalidasi URL dan daftar event lalu menerapkannya ke subscription
+func applyWebhookInput(subscription *model.WebhookSubscription, input WebhookInput) error {
+	target, err := url.Parse(input.URL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return ErrInvalidWebhookURL
+	}
+	if len(input.Events) == 0 {
+		return ErrInvalidWebhookEvents
+	}
+
+	var events model.WebhookEvents
+	for _, name := range input.Events {
+		if !event.Type(name).Valid() {
+			return ErrInvalidWebhookEvents
+		}
+		if !events.Has(name) {
+			events = append(events, name)
+		}
+	}
+
+	subscription.URL = input.URL
+	subscription.Events = events
+	return nil
+}
+
+// SignWebhookPayload menghitung nilai header X-Webhook-Signature untuk body dengan secret
+func SignWebhookPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookWorker mengirim pengiriman webhook yang sudah jatuh tempo. Respons 2xx dianggap berhasil;
+// selain itu pengiriman dicoba lagi dengan jeda eksponensial sampai MaxAttempts, lalu ditandai failed.
+// Pengiriman bersifat at-least-once: penerima sebaiknya mengabaikan event ID yang sudah diproses.
+type WebhookWorker struct {
+	webhookRepo repository.WebhookRepository
+	client      *http.Client
+	maxAttempts int
+}
+
+// NewWebhookWorker membuat instance WebhookWorker baru
+func NewWebhookWorker(webhookRepo repository.WebhookRepository, client *http.Client, maxAttempts int) *WebhookWorker {
+	return &WebhookWorker{
+		webhookRepo: webhookRepo,
+		client:      client,
+		maxAttempts: max(maxAttempts, 1),
+	}
+}
+
+// Run memproses antrean setiap interval sampai ctx dibatalkan. Run baru kembali setelah
+// pengiriman yang sedang berjalan selesai, sehingga pemanggil bisa menunggunya saat shutdown.
+func (w *WebhookWorker) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		// Proses terus selama batch penuh agar antrean panjang tidak menunggu interval berikutnya
+		for {
+			processed, err := w.DeliverDue(ctx, time.Now())
+			if err != nil {
+				log.Printf("WARNING: Gagal memproses antrean webhook: %v", err)
+			}
+			if err != nil || processed < webhookBatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeliverDue mengirim satu batch pengiriman yang jadwalnya sudah lewat pada waktu now
+// dan mengembalikan jumlah pengiriman yang diproses. Jika ctx dibatalkan (server dimatikan),
+// sisa batch ditinggalkan untuk putaran berikutnya tanpa dihitung sebagai percobaan gagal,
+// sedangkan pengiriman yang sedang berjalan diselesaikan dalam batas timeout client.
+func (w *WebhookWorker) DeliverDue(ctx context.Context, now time.Time) (int, error) {
+	deliveries, err := w.webhookRepo.FindDueDeliveries(now.UTC(), webhookBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	subscriptions := make(map[uint]*model.WebhookSubscription)
+	for i := range deliveries {
+		if ctx.Err() != nil {
+			return i, nil
+		}
+		delivery := &deliveries[i]
+		subscription, ok := subscriptions[delivery.SubscriptionID]
+		if !ok {
+			if subscription, err = w.webhookRepo.FindSubscriptionByID(delivery.SubscriptionID); err != nil && !isNotFound(err) {
+				return i, err
+			}
+			subscriptions[delivery.SubscriptionID] = subscription
+		}
+
+		if subscription == nil {
+			// Subscription dihapus saat batch sedang diproses
+			continue
+		}
+		w.attempt(context.WithoutCancel(ctx), subscription, delivery, now.UTC())
+		if err := w.webhookRepo.UpdateDelivery(delivery); err != nil {
+			return i, err
+		}
+	}
+	return len(deliveries), nil
+}
+
+// attempt mengirim satu pengiriman dan mencatat hasilnya beserta jadwal retry berikutnya
+func (w *WebhookWorker) attempt(ctx context.Context, subscription *model.WebhookSubscription, delivery *model.WebhookDelivery, now time.Time) {
+	delivery.Attempts++
+	delivery.LastAttemptAt = &now
+	delivery.ResponseStatus = nil
+	delivery.LastError = nil
+
+	status, err := w.send(ctx, subscription, delivery)
+	if status != 0 {
+		delivery.ResponseStatus = &status
+	}
+	if err == nil {
+		delivery.Status = model.WebhookDeliverySucceeded
+		delivery.DeliveredAt = &now
+		delivery.NextAttemptAt = nil
+		return
+	}
+
+	message := err.Error()
+	if len(message) > maxWebhookErrorLength {
+		message = message[:maxWebhookErrorLength]
+	}
+	delivery.LastError = &message
+	if delivery.Attempts >= w.maxAttempts {
+		delivery.Status = model.WebhookDeliveryFailed
+		delivery.NextAttemptAt = nil
+		return
+	}
+	next := now.Add(webhookBackoff(delivery.Attempts))
+	delivery.NextAttemptAt = &next
+}
+
+// send melakukan HTTP POST payload ke URL subscription dan mengembalikan status code respons
+func (w *WebhookWorker) send(ctx context.Context, subscription *model.WebhookSubscription, delivery *model.WebhookDelivery) (int, error) {
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "xyz-football-api-webhook")
+	req.Header.Set(WebhookEventHeader, delivery.EventType)
+	req.Header.Set(WebhookDeliveryHeader, fmt.Sprint(delivery.ID))
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(subscription.Secret, body))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// Baca sebagian body agar koneksi bisa dipakai ulang
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("HTTP %d %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+	return resp.StatusCode, nil
+}
+
+// webhookBackoff mengembalikan jeda sebelum percobaan berikutnya setelah attempts kali gagal
+func webhookBackoff(attempts int) time.Duration {
+	backoff := webhookBaseBackoff
+	for i := 1; i < attempts && backoff < webhookMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, webhookMaxBackoff)
+}
@@ -0,0 +1,102 @@
+package tournament
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// BracketSize mengembalikan ukuran bracket (kelipatan dua terdekat) untuk n tim
+func BracketSize(n int) int {
+	size := 1
+	for size < n {
+		size *= 2
+	}
+	return size
+}
+
+// TotalRounds mengembalikan jumlah babak untuk bracket berukuran size
+func TotalRounds(size int) int {
+	rounds := 0
+	for size > 1 {
+		size /= 2
+		rounds++
+	}
+	return rounds
+}
+
+// SeedOrder mengembalikan urutan seed (dimulai dari 1) pada slot bracket berukuran size.
+// Slot 2i dan 2i+1 saling bertemu di babak pertama, sehingga seed 1 dan 2 baru
+// bisa bertemu di final dan seed teratas mendapat bye lebih dulu.
+func SeedOrder(size int) []int {
+	order := []int{1}
+	for len(order) < size {
+		n := len(order) * 2
+		next := make([]int, 0, n)
+		for _, seed := range order {
+			next = append(next, seed, n+1-seed)
+		}
+		order = next
+	}
+	return order
+}
+
+// Pairing merepresentasikan satu pertemuan di babak pertama.
+// Nilai 0 berarti slot kosong (bye).
+type Pairing struct {
+	Position   int
+	HomeTeamID uint
+	AwayTeamID uint
+}
+
+// FirstRound menyusun pertemuan babak pertama dari daftar tim yang sudah diurutkan sesuai seed
+func FirstRound(seededTeamIDs []uint) ([]Pairing, error) {
+	if len(seededTeamIDs) < 2 {
+		return nil, fmt.Errorf("minimal 2 tim dibutuhkan untuk membuat bracket")
+	}
+
+	size := BracketSize(len(seededTeamIDs))
+	order := SeedOrder(size)
+
+	teamAt := func(slot int) uint {
+		seed := order[slot]
+		if seed > len(seededTeamIDs) {
+			return 0
+		}
+		return seededTeamIDs[seed-1]
+	}
+
+	pairings := make([]Pairing, 0, size/2)
+	for i := 0; i < size; i += 2 {
+		pairings = append(pairings, Pairing{
+			Position:   i / 2,
+			HomeTeamID: teamAt(i),
+			AwayTeamID: teamAt(i + 1),
+		})
+	}
+	return pairings, nil
+}
+
+// Draw mengacak urutan tim secara deterministik berdasarkan seed undian
+func Draw(teamIDs []uint, drawSeed int64) []uint {
+	drawn := make([]uint, len(teamIDs))
+	copy(drawn, teamIDs)
+	rng := rand.New(rand.NewSource(drawSeed))
+	rng.Shuffle(len(drawn), func(i, j int) {
+		drawn[i], drawn[j] = drawn[j], drawn[i]
+	})
+	return drawn
+}
+
+// RoundName mengembalikan nama babak (Final, Semi-final, dst)
+func RoundName(round, totalRounds int) string {
+	switch totalRounds - round {
+	case 0:
+		return "Final"
+	case 1:
+		return "Semi-final"
+	case 2:
+		return "Quarter-final"
+	default:
+		return fmt.Sprintf("Round of %d", 1<<(totalRounds-round+1))
+	}
+}
@@ -0,0 +1,350 @@
+package tournament
+
+import (
+	"sort"
+	"xyz-football-api/internal/model"
+)
+
+// Poin yang didapat tim pada fase grup
+const (
+	PointsWin  = 3
+	PointsDraw = 1
+)
+
+// GroupName mengembalikan nama grup berdasarkan indeks (0 = "A", 1 = "B", dst)
+func GroupName(index int) string {
+	return string(rune('A' + index))
+}
+
+// DistributeGroups membagi tim yang sudah diurutkan sesuai seed ke dalam grup
+// dengan pola pot: seed 1..groupCount masing-masing menjadi unggulan di grup berbeda
+func DistributeGroups(seededTeamIDs []uint, groupCount int) [][]uint {
+	groups := make([][]uint, groupCount)
+	for i, teamID := range seededTeamIDs {
+		groups[i%groupCount] = append(groups[i%groupCount], teamID)
+	}
+	return groups
+}
+
+// Fixture merepresentasikan satu pertandingan round-robin
+type Fixture struct {
+	Matchday   int
+	HomeTeamID uint
+	AwayTeamID uint
+}
+
+// RoundRobin membuat jadwal round-robin satu putaran dengan metode circle.
+// Matchday dimulai dari 1; tim yang mendapat bye pada suatu matchday tidak dijadwalkan.
+func RoundRobin(teamIDs []uint) []Fixture {
+	teams := make([]uint, len(teamIDs))
+	copy(teams, teamIDs)
+	if len(teams)%2 == 1 {
+		teams = append(teams, 0)
+	}
+
+	n := len(teams)
+	var fixtures []Fixture
+	for round := 0; round < n-1; round++ {
+		for i := 0; i < n/2; i++ {
+			home, away := teams[i], teams[n-1-i]
+			if home == 0 || away == 0 {
+				continue
+			}
+			// Selang-seling tuan rumah agar jumlah laga kandang seimbang
+			if (round+i)%2 == 1 {
+				home, away = away, home
+			}
+			fixtures = append(fixtures, Fixture{Matchday: round + 1, HomeTeamID: home, AwayTeamID: away})
+		}
+
+		// Rotasi semua tim kecuali tim pertama
+		last := teams[n-1]
+		copy(teams[2:], teams[1:n-1])
+		teams[1] = last
+	}
+	return fixtures
+}
+
+// StandingRow merepresentasikan satu baris klasemen grup
+type StandingRow struct {
+	TeamID         uint `json:"team_id"`
+	Played         int  `json:"played"`
+	Won            int  `json:"won"`
+	Drawn          int  `json:"drawn"`
+	Lost           int  `json:"lost"`
+	GoalsFor       int  `json:"goals_for"`
+	GoalsAgainst   int  `json:"goals_against"`
+	GoalDifference int  `json:"goal_difference"`
+	Points         int  `json:"points"`
+}
+
+// GroupTable menghitung klasemen grup dari match yang sudah selesai.
+// Urutan: poin, selisih gol, gol memasukkan, lalu ID tim agar hasilnya stabil.
+func GroupTable(teamIDs []uint, matches []model.Match) []StandingRow {
+	rows := make(map[uint]*StandingRow, len(teamIDs))
+	for _, teamID := range teamIDs {
+		rows[teamID] = &StandingRow{TeamID: teamID}
+	}
+
+	for _, m := range matches {
+		if m.Status != model.MatchStatusCompleted {
+			continue
+		}
+		home, okHome := rows[m.HomeTeamID]
+		away, okAway := rows[m.AwayTeamID]
+		if !okHome || !okAway {
+			continue
+		}
+		home.record(m.HomeScore, m.AwayScore)
+		away.record(m.AwayScore, m.HomeScore)
+	}
+
+	table := make([]StandingRow, 0, len(rows))
+	for _, teamID := range teamIDs {
+		table = append(table, *rows[teamID])
+	}
+	sort.SliceStable(table, func(i, j int) bool {
+		a, b := table[i], table[j]
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+		if a.GoalDifference != b.GoalDifference {
+			return a.GoalDifference > b.GoalDifference
+		}
+		if a.GoalsFor != b.GoalsFor {
+			return a.GoalsFor > b.GoalsFor
+		}
+		return a.TeamID < b.TeamID
+	})
+	return table
+}
+
+func (r *StandingRow) record(scored, conceded int) {
+	r.Played++
+	r.GoalsFor += scored
+	r.GoalsAgainst += conceded
+	r.GoalDifference = r.GoalsFor - r.GoalsAgainst
+	switch {
+	case scored > conceded:
+		r.Won++
+		r.Points += PointsWin
+	case scored == conceded:
+		r.Drawn++
+		r.Points += PointsDraw
+	default:
+		r.Lost++
+	}
+}
+
+// KnockoutSeeds menyusun urutan seed babak knockout dari klasemen akhir setiap grup:
+// semua juara grup lebih dulu (A1, B1, ...), lalu runner-up (A2, B2, ...), dan seterusnya.
+// Di dalam setiap peringkat urutan tim digeser seperlunya agar tidak ada dua tim dari grup
+// yang sama bertemu di babak pertama (misalnya 3 grup dengan 2 tim lolos menjadi
+// A1, B1, C1, A2, C2, B2 sehingga C1 tidak bertemu C2). Jika itu tidak mungkin, misalnya
+// hanya ada satu grup, urutan dasar yang dipakai.
+func KnockoutSeeds(tables [][]StandingRow, qualifiersPerGroup int) []uint {
+	var base []knockoutSeed
+	for position := 0; position < qualifiersPerGroup; position++ {
+		for group, table := range tables {
+			if position < len(table) {
+				base = append(base, knockoutSeed{teamID: table[position].TeamID, group: group, position: position})
+			}
+		}
+	}
+
+	placed, ok := separateGroups(base)
+	if !ok {
+		placed = base
+	}
+	seeds := make([]uint, len(placed))
+	for i, seed := range placed {
+		seeds[i] = seed.teamID
+	}
+	return seeds
+}
+
+// knockoutSeed adalah tim yang lolos dari fase grup beserta asal grup dan peringkatnya
+type knockoutSeed struct {
+	teamID   uint
+	group    int
+	position int
+}
+
+// separateGroups mencari urutan seed yang mempertahankan peringkat setiap tim tetapi tidak
+// mempertemukan dua tim dari grup yang sama di babak pertama. Pada bracket SeedOrder, seed
+// ke-i (mulai dari 0) bertemu seed ke-(size-1-i); seed tanpa lawan mendapat bye.
+func separateGroups(base []knockoutSeed) ([]knockoutSeed, bool) {
+	size := BracketSize(len(base))
+	placed := make([]knockoutSeed, len(base))
+	used := make([]bool, len(base))
+
+	var place func(i int) bool
+	place = func(i int) bool {
+		if i == len(base) {
+			return true
+		}
+		opponent := size - 1 - i
+		for j, candidate := range base {
+			if used[j] || candidate.position != base[i].position {
+				continue
+			}
+			if opponent < i && placed[opponent].group == candidate.group {
+				continue
+			}
+			used[j] = true
+			placed[i] = candidate
+			if place(i + 1) {
+				return true
+			}
+			used[j] = false
+		}
+		return false
+	}
+	return placed, place(0)
+}
+
+// Status kualifikasi tim pada fase grup
+const (
+	QualificationQualified    = "qualified"
+	QualificationEliminated   = "eliminated"
+	QualificationInContention = "in_contention"
+)
+
+// maxEnumeratedMatches membatasi jumlah sisa match yang semua kemungkinan hasilnya
+// disimulasikan (3^n kombinasi). Di atas batas ini dipakai perkiraan batas poin.
+const maxEnumeratedMatches = 10
+
+// Scenario merepresentasikan peluang kualifikasi satu tim berdasarkan sisa pertandingan
+type Scenario struct {
+	TeamID          uint   `json:"team_id"`
+	CurrentPosition int    `json:"current_position"`
+	Points          int    `json:"points"`
+	MaxPoints       int    `json:"max_points"`
+	BestPosition    int    `json:"best_position"`
+	WorstPosition   int    `json:"worst_position"`
+	Status          string `json:"status"`
+}
+
+// QualificationScenarios menghitung posisi terbaik/terburuk yang masih mungkin dicapai setiap tim
+// berdasarkan poin saja. Tim yang poinnya sama dianggap bisa berada di atas maupun di bawah,
+// sehingga status qualified/eliminated hanya diberikan jika sudah pasti secara matematis.
+func QualificationScenarios(table []StandingRow, remaining []model.Match, qualifiers int) []Scenario {
+	index := make(map[uint]int, len(table))
+	points := make([]int, len(table))
+	maxPoints := make([]int, len(table))
+	for i, row := range table {
+		index[row.TeamID] = i
+		points[i] = row.Points
+		maxPoints[i] = row.Points
+	}
+
+	type pairing struct{ home, away int }
+	var pairings []pairing
+	for _, m := range remaining {
+		home, okHome := index[m.HomeTeamID]
+		away, okAway := index[m.AwayTeamID]
+		if !okHome || !okAway {
+			continue
+		}
+		pairings = append(pairings, pairing{home, away})
+		maxPoints[home] += PointsWin
+		maxPoints[away] += PointsWin
+	}
+
+	best := make([]int, len(table))
+	worst := make([]int, len(table))
+	for i := range table {
+		best[i] = len(table)
+		worst[i] = 1
+	}
+
+	// evaluate memperbarui posisi terbaik/terburuk setiap tim untuk satu kombinasi poin
+	evaluate := func(pts []int) {
+		for i := range pts {
+			above, atLeast := 0, 0
+			for j := range pts {
+				if i == j {
+					continue
+				}
+				if pts[j] > pts[i] {
+					above++
+				}
+				if pts[j] >= pts[i] {
+					atLeast++
+				}
+			}
+			if above+1 < best[i] {
+				best[i] = above + 1
+			}
+			if atLeast+1 > worst[i] {
+				worst[i] = atLeast + 1
+			}
+		}
+	}
+
+	if len(pairings) == 0 {
+		// Fase grup selesai: posisi ditentukan klasemen akhir (termasuk tie-breaker)
+		for i := range table {
+			best[i] = i + 1
+			worst[i] = i + 1
+		}
+	} else if len(pairings) <= maxEnumeratedMatches {
+		pts := make([]int, len(points))
+		copy(pts, points)
+		var simulate func(k int)
+		simulate = func(k int) {
+			if k == len(pairings) {
+				evaluate(pts)
+				return
+			}
+			p := pairings[k]
+			outcomes := [][2]int{{PointsWin, 0}, {PointsDraw, PointsDraw}, {0, PointsWin}}
+			for _, o := range outcomes {
+				pts[p.home] += o[0]
+				pts[p.away] += o[1]
+				simulate(k + 1)
+				pts[p.home] -= o[0]
+				pts[p.away] -= o[1]
+			}
+		}
+		simulate(0)
+	} else {
+		// Perkiraan: bandingkan poin maksimal dengan poin saat ini
+		for i := range table {
+			above, atLeast := 0, 0
+			for j := range table {
+				if i == j {
+					continue
+				}
+				if points[j] > maxPoints[i] {
+					above++
+				}
+				if maxPoints[j] >= points[i] {
+					atLeast++
+				}
+			}
+			best[i] = above + 1
+			worst[i] = atLeast + 1
+		}
+	}
+
+	scenarios := make([]Scenario, 0, len(table))
+	for i, row := range table {
+		status := QualificationInContention
+		if worst[i] <= qualifiers {
+			status = QualificationQualified
+		} else if best[i] > qualifiers {
+			status = QualificationEliminated
+		}
+		scenarios = append(scenarios, Scenario{
+			TeamID:          row.TeamID,
+			CurrentPosition: i + 1,
+			Points:          row.Points,
+			MaxPoints:       maxPoints[i],
+			BestPosition:    best[i],
+			WorstPosition:   worst[i],
+			Status:          status,
+		})
+	}
+	return scenarios
+}
@@ -0,0 +1,326 @@
+package tournament
+
+import (
+	"reflect"
+	"testing"
+	"xyz-football-api/internal/model"
+)
+
+// played membuat match yang sudah selesai dengan skor tertentu
+func played(home, away uint, homeScore, awayScore int) model.Match {
+	return model.Match{
+		HomeTeamID: home,
+		AwayTeamID: away,
+		HomeScore:  homeScore,
+		AwayScore:  awayScore,
+		Status:     model.MatchStatusCompleted,
+	}
+}
+
+// scheduled membuat match yang belum dimainkan
+func scheduled(home, away uint) model.Match {
+	return model.Match{HomeTeamID: home, AwayTeamID: away, Status: model.MatchStatusScheduled}
+}
+
+func TestRoundRobin(t *testing.T) {
+	tests := []struct {
+		name      string
+		teams     []uint
+		matchdays int
+	}{
+		{"two teams", []uint{1, 2}, 1},
+		{"three teams with bye", []uint{1, 2, 3}, 3},
+		{"four teams", []uint{1, 2, 3, 4}, 3},
+		{"five teams with bye", []uint{1, 2, 3, 4, 5}, 5},
+		{"six teams", []uint{10, 20, 30, 40, 50, 60}, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fixtures := RoundRobin(tt.teams)
+
+			n := len(tt.teams)
+			if len(fixtures) != n*(n-1)/2 {
+				t.Fatalf("fixtures = %d, want %d", len(fixtures), n*(n-1)/2)
+			}
+
+			type pair struct{ a, b uint }
+			met := make(map[pair]bool)
+			busy := make(map[int]map[uint]bool)
+			lastMatchday := 0
+			for _, f := range fixtures {
+				if f.HomeTeamID == 0 || f.AwayTeamID == 0 || f.HomeTeamID == f.AwayTeamID {
+					t.Fatalf("fixture tidak valid: %+v", f)
+				}
+				p := pair{min(f.HomeTeamID, f.AwayTeamID), max(f.HomeTeamID, f.AwayTeamID)}
+				if met[p] {
+					t.Errorf("tim %d dan %d bertemu lebih dari sekali", p.a, p.b)
+				}
+				met[p] = true
+
+				if busy[f.Matchday] == nil {
+					busy[f.Matchday] = make(map[uint]bool)
+				}
+				for _, team := range []uint{f.HomeTeamID, f.AwayTeamID} {
+					if busy[f.Matchday][team] {
+						t.Errorf("tim %d bermain dua kali di matchday %d", team, f.Matchday)
+					}
+					busy[f.Matchday][team] = true
+				}
+				lastMatchday = max(lastMatchday, f.Matchday)
+			}
+			if lastMatchday != tt.matchdays {
+				t.Errorf("matchday terakhir = %d, want %d", lastMatchday, tt.matchdays)
+			}
+		})
+	}
+}
+
+func TestGroupTable(t *testing.T) {
+	tests := []struct {
+		name    string
+		teams   []uint
+		matches []model.Match
+		order   []uint
+		points  []int
+	}{
+		{
+			name:   "no results keeps team id order",
+			teams:  []uint{3, 1, 2},
+			order:  []uint{1, 2, 3},
+			points: []int{0, 0, 0},
+		},
+		{
+			name:    "points first",
+			teams:   []uint{1, 2, 3},
+			matches: []model.Match{played(1, 2, 0, 1), played(3, 1, 2, 2), played(2, 3, 0, 0)},
+			order:   []uint{2, 3, 1},
+			points:  []int{4, 2, 1},
+		},
+		{
+			name:    "goal difference breaks equal points",
+			teams:   []uint{1, 2, 3},
+			matches: []model.Match{played(1, 3, 1, 0), played(2, 3, 4, 0), played(1, 2, 1, 1)},
+			order:   []uint{2, 1, 3},
+			points:  []int{4, 4, 0},
+		},
+		{
+			name:    "goals scored breaks equal goal difference",
+			teams:   []uint{1, 2, 3},
+			matches: []model.Match{played(1, 3, 1, 0), played(2, 3, 3, 2), played(1, 2, 2, 2)},
+			order:   []uint{2, 1, 3},
+			points:  []int{4, 4, 0},
+		},
+		{
+			name:    "team id breaks a complete tie",
+			teams:   []uint{2, 1},
+			matches: []model.Match{played(2, 1, 1, 1)},
+			order:   []uint{1, 2},
+			points:  []int{1, 1},
+		},
+		{
+			name:  "only completed matches between group teams count",
+			teams: []uint{1, 2},
+			matches: []model.Match{
+				scheduled(1, 2),
+				{HomeTeamID: 2, AwayTeamID: 1, HomeScore: 5, Status: model.MatchStatusCancelled},
+				played(2, 9, 3, 0),
+				played(1, 2, 1, 0),
+			},
+			order:  []uint{1, 2},
+			points: []int{3, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := GroupTable(tt.teams, tt.matches)
+			var order []uint
+			var points []int
+			for _, row := range table {
+				order = append(order, row.TeamID)
+				points = append(points, row.Points)
+			}
+			if !reflect.DeepEqual(order, tt.order) {
+				t.Errorf("order = %v, want %v", order, tt.order)
+			}
+			if !reflect.DeepEqual(points, tt.points) {
+				t.Errorf("points = %v, want %v", points, tt.points)
+			}
+		})
+	}
+
+	t.Run("row totals", func(t *testing.T) {
+		table := GroupTable([]uint{1, 2}, []model.Match{played(1, 2, 3, 1), played(2, 1, 2, 2)})
+		want := StandingRow{TeamID: 1, Played: 2, Won: 1, Drawn: 1, GoalsFor: 5, GoalsAgainst: 3, GoalDifference: 2, Points: 4}
+		if table[0] != want {
+			t.Errorf("row = %+v, want %+v", table[0], want)
+		}
+	})
+}
+
+// standings membuat klasemen dengan urutan dan poin tertentu
+func standings(points map[uint]int, order ...uint) []StandingRow {
+	table := make([]StandingRow, 0, len(order))
+	for _, teamID := range order {
+		table = append(table, StandingRow{TeamID: teamID, Points: points[teamID]})
+	}
+	return table
+}
+
+func TestQualificationScenarios(t *testing.T) {
+	type outcome struct {
+		best, worst int
+		status      string
+	}
+	tests := []struct {
+		name       string
+		table      []StandingRow
+		remaining  []model.Match
+		qualifiers int
+		want       map[uint]outcome
+	}{
+		{
+			name:       "group finished uses final positions",
+			table:      standings(map[uint]int{1: 6, 2: 6, 3: 3, 4: 3}, 1, 2, 3, 4),
+			qualifiers: 2,
+			want: map[uint]outcome{
+				1: {1, 1, QualificationQualified},
+				2: {2, 2, QualificationQualified},
+				3: {3, 3, QualificationEliminated},
+				4: {4, 4, QualificationEliminated},
+			},
+		},
+		{
+			name:       "clinched and eliminated with one match left",
+			table:      standings(map[uint]int{1: 9, 2: 6, 3: 1, 4: 0}, 1, 2, 3, 4),
+			remaining:  []model.Match{scheduled(3, 4)},
+			qualifiers: 2,
+			want: map[uint]outcome{
+				1: {1, 1, QualificationQualified},
+				2: {2, 2, QualificationQualified},
+				3: {3, 4, QualificationEliminated},
+				4: {3, 4, QualificationEliminated},
+			},
+		},
+		{
+			name:       "equal points count as possibly above",
+			table:      standings(map[uint]int{1: 6, 2: 4, 3: 3, 4: 3}, 1, 2, 3, 4),
+			remaining:  []model.Match{scheduled(2, 3)},
+			qualifiers: 2,
+			want: map[uint]outcome{
+				1: {1, 2, QualificationQualified},
+				2: {1, 3, QualificationInContention},
+				3: {1, 4, QualificationInContention},
+				4: {3, 4, QualificationEliminated},
+			},
+		},
+		{
+			name:       "matches against other groups are ignored",
+			table:      standings(map[uint]int{1: 3, 2: 0}, 1, 2),
+			remaining:  []model.Match{scheduled(2, 7)},
+			qualifiers: 1,
+			want: map[uint]outcome{
+				1: {1, 1, QualificationQualified},
+				2: {2, 2, QualificationEliminated},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scenarios := QualificationScenarios(tt.table, tt.remaining, tt.qualifiers)
+			if len(scenarios) != len(tt.table) {
+				t.Fatalf("scenarios = %d, want %d", len(scenarios), len(tt.table))
+			}
+			for i, s := range scenarios {
+				if s.CurrentPosition != i+1 {
+					t.Errorf("tim %d current position = %d, want %d", s.TeamID, s.CurrentPosition, i+1)
+				}
+				got := outcome{s.BestPosition, s.WorstPosition, s.Status}
+				if want := tt.want[s.TeamID]; got != want {
+					t.Errorf("tim %d = %+v, want %+v", s.TeamID, got, want)
+				}
+			}
+		})
+	}
+
+	t.Run("max points include remaining matches", func(t *testing.T) {
+		scenarios := QualificationScenarios(standings(map[uint]int{1: 4, 2: 1}, 1, 2), []model.Match{scheduled(1, 2), scheduled(2, 1)}, 1)
+		if scenarios[0].MaxPoints != 10 || scenarios[1].MaxPoints != 7 {
+			t.Errorf("max points = %d, %d, want 10, 7", scenarios[0].MaxPoints, scenarios[1].MaxPoints)
+		}
+	})
+
+	t.Run("many remaining matches use the estimate", func(t *testing.T) {
+		var remaining []model.Match
+		for i := 0; i <= maxEnumeratedMatches; i++ {
+			remaining = append(remaining, scheduled(2, 3))
+		}
+		scenarios := QualificationScenarios(standings(map[uint]int{1: 40, 2: 0, 3: 0}, 1, 2, 3), remaining, 1)
+		if scenarios[0].Status != QualificationQualified {
+			t.Errorf("tim 1 = %s, want qualified (poin maksimal lawan %d)", scenarios[0].Status, scenarios[1].MaxPoints)
+		}
+		if scenarios[1].Status != QualificationEliminated || scenarios[2].Status != QualificationEliminated {
+			t.Errorf("status = %s, %s, want eliminated", scenarios[1].Status, scenarios[2].Status)
+		}
+	})
+}
+
+// groupTables membuat klasemen akhir tiap grup; tim grup ke-g posisi ke-p memiliki ID (g+1)*10+p+1
+func groupTables(groups, size int) [][]StandingRow {
+	tables := make([][]StandingRow, groups)
+	for g := range tables {
+		for p := 0; p < size; p++ {
+			tables[g] = append(tables[g], StandingRow{TeamID: uint((g+1)*10 + p + 1)})
+		}
+	}
+	return tables
+}
+
+func TestKnockoutSeeds(t *testing.T) {
+	tests := []struct {
+		name       string
+		groups     int
+		qualifiers int
+		want       []uint
+	}{
+		{"two groups", 2, 2, []uint{11, 21, 12, 22}},
+		{"three groups keeps C1 away from C2", 3, 2, []uint{11, 21, 31, 12, 32, 22}},
+		{"four groups", 4, 2, []uint{11, 21, 31, 41, 12, 22, 32, 42}},
+		{"single group falls back to standings order", 1, 4, []uint{11, 12, 13, 14}},
+		{"winners only", 3, 1, []uint{11, 21, 31}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tables := groupTables(tt.groups, 4)
+			seeds := KnockoutSeeds(tables, tt.qualifiers)
+			if !reflect.DeepEqual(seeds, tt.want) {
+				t.Fatalf("seeds = %v, want %v", seeds, tt.want)
+			}
+			if tt.groups < 2 {
+				return
+			}
+
+			pairings, err := FirstRound(seeds)
+			if err != nil {
+				t.Fatalf("FirstRound: %v", err)
+			}
+			for _, p := range pairings {
+				if p.HomeTeamID != 0 && p.AwayTeamID != 0 && p.HomeTeamID/10 == p.AwayTeamID/10 {
+					t.Errorf("tim %d dan %d dari grup yang sama bertemu di babak pertama", p.HomeTeamID, p.AwayTeamID)
+				}
+			}
+		})
+	}
+
+	t.Run("qualifiers beyond a short group are skipped", func(t *testing.T) {
+		tables := [][]StandingRow{groupTables(1, 3)[0], groupTables(2, 2)[1]}
+		seeds := KnockoutSeeds(tables, 3)
+		want := []uint{11, 21, 12, 22, 13}
+		if !reflect.DeepEqual(seeds, want) {
+			t.Errorf("seeds = %v, want %v", seeds, want)
+		}
+	})
+}
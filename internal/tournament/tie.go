@@ -0,0 +1,121 @@
+package tournament
+
+import (
+	"sort"
+	"xyz-football-api/internal/model"
+)
+
+// TieRules berisi aturan penentuan pemenang sebuah tie knockout
+type TieRules struct {
+	TwoLegged     bool
+	AwayGoalsRule bool
+}
+
+// Aggregate menghitung skor agregat kedua tim dari sudut pandang home team tie
+// (tim yang menjadi tuan rumah di leg pertama)
+func Aggregate(tie model.CupTie, legs []model.Match) (home, away int) {
+	if tie.HomeTeamID == nil {
+		return 0, 0
+	}
+	for _, m := range legs {
+		if m.Status != model.MatchStatusCompleted {
+			continue
+		}
+		if m.HomeTeamID == *tie.HomeTeamID {
+			home += m.HomeScore
+			away += m.AwayScore
+		} else {
+			home += m.AwayScore
+			away += m.HomeScore
+		}
+	}
+	return home, away
+}
+
+// TieWinner menentukan pemenang tie berdasarkan leg yang sudah selesai.
+// Mengembalikan false jika tie belum dapat ditentukan (leg belum selesai,
+// atau skor imbang dan adu penalti belum dicatat).
+func TieWinner(tie model.CupTie, legs []model.Match, rules TieRules) (uint, bool) {
+	// Bye: hanya ada satu tim di tie
+	if tie.HomeTeamID != nil && tie.AwayTeamID == nil {
+		return *tie.HomeTeamID, true
+	}
+	if tie.HomeTeamID == nil && tie.AwayTeamID != nil {
+		return *tie.AwayTeamID, true
+	}
+	if tie.HomeTeamID == nil || tie.AwayTeamID == nil {
+		return 0, false
+	}
+
+	expectedLegs := 1
+	if rules.TwoLegged {
+		expectedLegs = 2
+	}
+
+	sorted := make([]model.Match, len(legs))
+	copy(sorted, legs)
+	sort.Slice(sorted, func(i, j int) bool { return legNumber(sorted[i]) < legNumber(sorted[j]) })
+
+	completed := 0
+	for _, m := range sorted {
+		if m.Status == model.MatchStatusCompleted {
+			completed++
+		}
+	}
+	if len(sorted) < expectedLegs || completed < expectedLegs {
+		return 0, false
+	}
+
+	homeID, awayID := *tie.HomeTeamID, *tie.AwayTeamID
+
+	home, away := Aggregate(tie, sorted)
+	if home > away {
+		return homeID, true
+	}
+	if away > home {
+		return awayID, true
+	}
+
+	// Aturan gol tandang: leg 1 dimainkan di kandang home team, leg 2 di kandang away team
+	if rules.TwoLegged && rules.AwayGoalsRule {
+		homeAwayGoals := sorted[1].AwayScore
+		awayAwayGoals := sorted[0].AwayScore
+		if homeAwayGoals > awayAwayGoals {
+			return homeID, true
+		}
+		if awayAwayGoals > homeAwayGoals {
+			return awayID, true
+		}
+	}
+
+	// Adu penalti dicatat di leg terakhir
+	decider := sorted[len(sorted)-1]
+	if decider.HomePenalties == nil || decider.AwayPenalties == nil {
+		return 0, false
+	}
+	if *decider.HomePenalties == *decider.AwayPenalties {
+		return 0, false
+	}
+	if *decider.HomePenalties > *decider.AwayPenalties {
+		return decider.HomeTeamID, true
+	}
+	return decider.AwayTeamID, true
+}
+
+// IsDecidingLeg mengembalikan true jika match adalah leg terakhir dari tie
+func IsDecidingLeg(match model.Match, rules TieRules) bool {
+	if match.TieID == nil {
+		return false
+	}
+	if !rules.TwoLegged {
+		return true
+	}
+	return legNumber(match) == 2
+}
+
+func legNumber(m model.Match) int {
+	if m.Leg == nil {
+		return 1
+	}
+	return *m.Leg
+}
@@ -0,0 +1,90 @@
+package tournament
+
+import (
+	"testing"
+	"xyz-football-api/internal/model"
+)
+
+// leg membuat leg tie yang sudah selesai; penalti opsional dalam format {home, away}
+func leg(number int, home, away uint, homeScore, awayScore int, penalties ...int) model.Match {
+	m := played(home, away, homeScore, awayScore)
+	m.Leg = &number
+	if len(penalties) == 2 {
+		m.HomePenalties = &penalties[0]
+		m.AwayPenalties = &penalties[1]
+	}
+	return m
+}
+
+func TestTieWinner(t *testing.T) {
+	home, away := uint(1), uint(2)
+	tie := model.CupTie{HomeTeamID: &home, AwayTeamID: &away}
+	single := TieRules{}
+	twoLegged := TieRules{TwoLegged: true}
+	awayGoals := TieRules{TwoLegged: true, AwayGoalsRule: true}
+
+	tests := []struct {
+		name    string
+		tie     model.CupTie
+		legs    []model.Match
+		rules   TieRules
+		winner  uint
+		decided bool
+	}{
+		{"bye for home team", model.CupTie{HomeTeamID: &home}, nil, single, home, true},
+		{"bye for away team", model.CupTie{AwayTeamID: &away}, nil, single, away, true},
+		{"empty tie", model.CupTie{}, nil, single, 0, false},
+		{"single leg not played", tie, []model.Match{scheduled(home, away)}, single, 0, false},
+		{"single leg home win", tie, []model.Match{leg(1, home, away, 2, 1)}, single, home, true},
+		{"single leg away win", tie, []model.Match{leg(1, home, away, 0, 3)}, single, away, true},
+		{"single leg draw without penalties", tie, []model.Match{leg(1, home, away, 1, 1)}, single, 0, false},
+		{"single leg penalties", tie, []model.Match{leg(1, home, away, 1, 1, 3, 4)}, single, away, true},
+		{"level penalties are not decided", tie, []model.Match{leg(1, home, away, 1, 1, 4, 4)}, single, 0, false},
+		{"second leg missing", tie, []model.Match{leg(1, home, away, 3, 0)}, twoLegged, 0, false},
+		{"aggregate across legs", tie, []model.Match{leg(1, home, away, 2, 0), leg(2, away, home, 3, 0)}, twoLegged, away, true},
+		{"legs sorted by leg number", tie, []model.Match{leg(2, away, home, 1, 1), leg(1, home, away, 1, 0)}, twoLegged, home, true},
+		{"level aggregate without away goals rule goes to penalties", tie,
+			[]model.Match{leg(1, home, away, 1, 2), leg(2, away, home, 0, 1, 5, 3)}, twoLegged, away, true},
+		{"away goals favour home team of the tie", tie,
+			[]model.Match{leg(1, home, away, 1, 1), leg(2, away, home, 2, 2)}, awayGoals, home, true},
+		{"away goals favour away team of the tie", tie,
+			[]model.Match{leg(1, home, away, 2, 2), leg(2, away, home, 1, 1)}, awayGoals, away, true},
+		{"equal away goals go to penalties on the second leg", tie,
+			[]model.Match{leg(1, home, away, 1, 1), leg(2, away, home, 1, 1, 2, 4)}, awayGoals, home, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			winner, decided := TieWinner(tt.tie, tt.legs, tt.rules)
+			if winner != tt.winner || decided != tt.decided {
+				t.Errorf("TieWinner = (%d, %v), want (%d, %v)", winner, decided, tt.winner, tt.decided)
+			}
+		})
+	}
+}
+
+func TestIsDecidingLeg(t *testing.T) {
+	tieID := uint(1)
+	first, second := leg(1, 1, 2, 0, 0), leg(2, 2, 1, 0, 0)
+	first.TieID, second.TieID = &tieID, &tieID
+
+	tests := []struct {
+		name  string
+		match model.Match
+		rules TieRules
+		want  bool
+	}{
+		{"league match", played(1, 2, 0, 0), TieRules{}, false},
+		{"single leg", first, TieRules{}, true},
+		{"first of two legs", first, TieRules{TwoLegged: true}, false},
+		{"second of two legs", second, TieRules{TwoLegged: true}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsDecidingLeg(tt.match, tt.rules); got != tt.want {
+				t.Errorf("IsDecidingLeg = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
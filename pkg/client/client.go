@@ -0,0 +1,403 @@
+// Package client adalah SDK Go untuk XYZ Football API.
+//
+// Client menyediakan method bertipe untuk setiap endpoint, login otomatis dengan
+// username/password (token diperbarui sebelum kedaluwarsa dan sekali lagi jika server
+// membalas 401), error bertipe *Error yang dibaca dari body error API, dan retry
+// untuk request idempotent (GET, PUT, DELETE) saat jaringan gagal atau server sibuk.
+//
+//	c, err := client.New(client.Config{
+//		BaseURL:  "http://localhost:8080",
+//		Username: "admin",
+//		Password: "admin123",
+//	})
+//	team, err := c.CreateTeam(ctx, client.TeamRequest{Name: "Persija Jakarta"})
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries adalah jumlah retry request idempotent jika Config.MaxRetries nol
+	DefaultMaxRetries = 2
+	// DefaultRetryBackoff adalah jeda sebelum retry pertama; jeda berikutnya dua kali lipat
+	DefaultRetryBackoff = 200 * time.Millisecond
+	// DefaultTimeout adalah timeout HTTP client default jika Config.HTTPClient kosong
+	DefaultTimeout = 30 * time.Second
+
+	// tokenRefreshMargin adalah sisa masa berlaku token yang memicu login ulang
+	tokenRefreshMargin = 30 * time.Second
+	// maxErrorBodyBytes membatasi body error yang dibaca
+	maxErrorBodyBytes = 1 << 20
+	// maxRetryAfter membatasi jeda dari header Retry-After
+	maxRetryAfter = 30 * time.Second
+)
+
+// Config adalah konfigurasi Client
+type Config struct {
+	// BaseURL adalah alamat server, mis. http://localhost:8080
+	BaseURL string
+	// Username dan Password dipakai untuk login otomatis. Jika kosong, Token dipakai apa adanya
+	// dan tidak diperbarui.
+	Username string
+	Password string
+	// Token adalah JWT awal (opsional)
+	Token string
+	// HTTPClient dipakai untuk semua request; default http.Client dengan DefaultTimeout
+	HTTPClient *http.Client
+	// MaxRetries adalah jumlah retry request idempotent. Nol berarti DefaultMaxRetries,
+	// nilai negatif mematikan retry.
+	MaxRetries int
+	// RetryBackoff adalah jeda sebelum retry pertama; nol berarti DefaultRetryBackoff
+	RetryBackoff time.Duration
+	// Language dikirim sebagai header Accept-Language (id atau en) dan menentukan bahasa pesan error
+	Language string
+}
+
+// Client adalah client XYZ Football API. Aman dipakai dari banyak goroutine.
+type Client struct {
+	baseURL      *url.URL
+	username     string
+	password     string
+	httpClient   *http.Client
+	maxRetries   int
+	retryBackoff time.Duration
+	language     string
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+// New membuat Client baru dari cfg
+func New(cfg Config) (*Client, error) {
+	baseURL, err := url.Parse(strings.TrimRight(cfg.BaseURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("client: BaseURL tidak valid: %w", err)
+	}
+	if baseURL.Scheme != "http" && baseURL.Scheme != "https" || baseURL.Host == "" {
+		return nil, fmt.Errorf("client: BaseURL harus berupa URL http atau https: %q", cfg.BaseURL)
+	}
+
+	c := &Client{
+		baseURL:      baseURL,
+		username:     cfg.Username,
+		password:     cfg.Password,
+		httpClient:   cfg.HTTPClient,
+		maxRetries:   cfg.MaxRetries,
+		retryBackoff: cfg.RetryBackoff,
+		language:     cfg.Language,
+	}
+	if c.httpClient == nil {
+		c.httpClient = &http.Client{Timeout: DefaultTimeout}
+	}
+	if c.maxRetries == 0 {
+		c.maxRetries = DefaultMaxRetries
+	} else if c.maxRetries < 0 {
+		c.maxRetries = 0
+	}
+	if c.retryBackoff <= 0 {
+		c.retryBackoff = DefaultRetryBackoff
+	}
+	if cfg.Token != "" {
+		c.setToken(cfg.Token)
+	}
+	return c, nil
+}
+
+// LoginRequest adalah body request POST /login
+type LoginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// LoginResponse adalah response POST /login
+type LoginResponse struct {
+	Token string `json:"token"`
+}
+
+// Login menukar username dan password dari Config dengan token baru (POST /login).
+// Biasanya tidak perlu dipanggil karena Client login otomatis saat dibutuhkan.
+func (c *Client) Login(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.login(ctx)
+}
+
+// Token mengembalikan token yang sedang dipakai, login lebih dulu jika perlu.
+// Berguna untuk koneksi yang tidak lewat Client, mis. gRPC.
+func (c *Client) Token(ctx context.Context) (string, error) {
+	return c.currentToken(ctx)
+}
+
+// login meminta token baru. Pemanggil harus memegang c.mu.
+func (c *Client) login(ctx context.Context) error {
+	if !c.hasCredentials() {
+		return errors.New("client: Username dan Password belum diatur")
+	}
+
+	var res LoginResponse
+	err := c.do(ctx, request{
+		method: http.MethodPost,
+		path:   "/login",
+		body:   LoginRequest{Username: c.username, Password: c.password},
+	}, &res)
+	if err != nil {
+		return err
+	}
+	c.setTokenLocked(res.Token)
+	return nil
+}
+
+// hasCredentials memeriksa apakah Client bisa login sendiri
+func (c *Client) hasCredentials() bool {
+	return c.username != "" && c.password != ""
+}
+
+// currentToken mengembalikan token yang masih berlaku lebih dari tokenRefreshMargin,
+// login ulang jika tidak ada token atau token hampir kedaluwarsa
+func (c *Client) currentToken(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	fresh := c.token != "" && (c.expiresAt.IsZero() || time.Until(c.expiresAt) > tokenRefreshMargin)
+	if fresh || !c.hasCredentials() {
+		return c.token, nil
+	}
+	if err := c.login(ctx); err != nil {
+		return "", err
+	}
+	return c.token, nil
+}
+
+// refreshToken login ulang setelah server menolak token stale. Jika goroutine lain
+// sudah memperbarui token, token baru itu yang dipakai.
+func (c *Client) refreshToken(ctx context.Context, stale string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token != stale && c.token != "" {
+		return c.token, nil
+	}
+	if err := c.login(ctx); err != nil {
+		return "", err
+	}
+	return c.token, nil
+}
+
+// setToken menyimpan token beserta waktu kedaluwarsanya
+func (c *Client) setToken(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.setTokenLocked(token)
+}
+
+// setTokenLocked seperti setToken tetapi pemanggil sudah memegang c.mu
+func (c *Client) setTokenLocked(token string) {
+	c.token = token
+	c.expiresAt = tokenExpiry(token)
+}
+
+// tokenExpiry membaca claim exp dari payload JWT tanpa memverifikasi tanda tangan
+// (verifikasi dilakukan server). Mengembalikan waktu nol jika tidak bisa dibaca.
+func tokenExpiry(token string) time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}
+	}
+	var claims struct {
+		ExpiresAt int64 `json:"exp"`
+	}
+	if json.Unmarshal(payload, &claims) != nil || claims.ExpiresAt == 0 {
+		return time.Time{}
+	}
+	return time.Unix(claims.ExpiresAt, 0)
+}
+
+// request adalah satu panggilan API
+type request struct {
+	method string
+	path   string
+	query  url.Values
+	// body di-encode ke JSON; rawBody dipakai apa adanya dengan contentType
+	body        interface{}
+	rawBody     []byte
+	contentType string
+	// auth menambahkan header Authorization dari token login
+	auth bool
+	// passStatus adalah status error yang body-nya tetap dikembalikan ke pemanggil
+	// (mis. 400 pada import dan GraphQL yang memakai bentuk response sendiri)
+	passStatus int
+}
+
+// idempotent memeriksa apakah request aman diulang
+func (r request) idempotent() bool {
+	switch r.method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// do mengirim request lalu men-decode body JSON response ke out (boleh nil)
+func (c *Client) do(ctx context.Context, req request, out interface{}) error {
+	res, err := c.send(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if out == nil {
+		_, err = io.Copy(io.Discard, res.Body)
+		return err
+	}
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return fmt.Errorf("client: gagal membaca response %s %s: %w", req.method, req.path, err)
+	}
+	return nil
+}
+
+// send mengirim request dengan autentikasi dan retry. Response error diubah menjadi *Error
+// kecuali statusnya req.passStatus. Pemanggil wajib menutup body response.
+func (c *Client) send(ctx context.Context, req request) (*http.Response, error) {
+	body := req.rawBody
+	contentType := req.contentType
+	if req.body != nil {
+		encoded, err := json.Marshal(req.body)
+		if err != nil {
+			return nil, fmt.Errorf("client: gagal meng-encode body %s %s: %w", req.method, req.path, err)
+		}
+		body, contentType = encoded, "application/json"
+	}
+
+	var token string
+	if req.auth {
+		var err error
+		if token, err = c.currentToken(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	retries := 0
+	if req.idempotent() {
+		retries = c.maxRetries
+	}
+	refreshed := false
+	for attempt := 0; ; attempt++ {
+		httpReq, err := http.NewRequestWithContext(ctx, req.method, c.url(req.path, req.query), bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		if contentType != "" {
+			httpReq.Header.Set("Content-Type", contentType)
+		}
+		if c.language != "" {
+			httpReq.Header.Set("Accept-Language", c.language)
+		}
+		if token != "" {
+			httpReq.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		res, err := c.httpClient.Do(httpReq)
+		if err != nil {
+			if attempt < retries && ctx.Err() == nil {
+				if err := c.wait(ctx, attempt, nil); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			return nil, err
+		}
+
+		switch {
+		case res.StatusCode < http.StatusBadRequest || res.StatusCode == req.passStatus:
+			return res, nil
+		case res.StatusCode == http.StatusUnauthorized && req.auth && !refreshed && c.hasCredentials():
+			// Token ditolak (kedaluwarsa atau secret server berganti): login ulang sekali
+			drain(res)
+			refreshed = true
+			if token, err = c.refreshToken(ctx, token); err != nil {
+				return nil, err
+			}
+			attempt--
+			continue
+		case retryableStatus(res.StatusCode) && attempt < retries:
+			drain(res)
+			if err := c.wait(ctx, attempt, res); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		defer res.Body.Close()
+		return nil, parseError(res)
+	}
+}
+
+// url menggabungkan BaseURL dengan path dan query
+func (c *Client) url(path string, query url.Values) string {
+	u := *c.baseURL
+	u.Path = strings.TrimRight(u.Path, "/") + path
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// wait menunggu sebelum retry ke-(attempt+1): Retry-After dari server jika ada,
+// selain itu backoff eksponensial
+func (c *Client) wait(ctx context.Context, attempt int, res *http.Response) error {
+	delay := c.retryBackoff << attempt
+	if res != nil {
+		if seconds, err := strconv.Atoi(res.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			delay = min(time.Duration(seconds)*time.Second, maxRetryAfter)
+		}
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// retryableStatus memeriksa apakah status menandakan gangguan sementara di server
+func retryableStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// drain membuang sisa body agar koneksi bisa dipakai ulang
+func drain(res *http.Response) {
+	io.Copy(io.Discard, io.LimitReader(res.Body, maxErrorBodyBytes))
+	res.Body.Close()
+}
+
+// idPath membuat path dengan ID di setiap segmen %d
+func idPath(format string, ids ...uint) string {
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+	return fmt.Sprintf(format, args...)
+}
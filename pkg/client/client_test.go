@@ -0,0 +1,883 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"xyz-football-api/config"
+	"xyz-football-api/internal/api"
+	"xyz-football-api/internal/event"
+	"xyz-football-api/internal/model"
+	"xyz-football-api/internal/repository"
+	"xyz-football-api/internal/repository/memory"
+	"xyz-football-api/internal/service"
+	"xyz-football-api/pkg/client"
+	"xyz-football-api/pkg/utils"
+)
+
+// testConfig adalah konfigurasi server yang dipakai semua test SDK
+var testConfig = &config.Config{
+	JWT:    config.JWTConfig{Secret: "test-secret", ExpirationHours: 1},
+	Admin:  config.AdminConfig{Username: "admin", Password: "admin123"},
+	Outbox: config.OutboxConfig{ParkAfter: time.Hour},
+	WebSocket: config.WebSocketConfig{
+		PingInterval: 100 * time.Millisecond,
+		WriteTimeout: time.Second,
+		BufferSize:   16,
+	},
+	GraphQL: config.GraphQLConfig{MaxDepth: 6, MaxComplexity: 5000},
+}
+
+func TestMain(m *testing.M) {
+	log.SetOutput(io.Discard)
+	os.Exit(m.Run())
+}
+
+// testServer menjalankan router asli di httptest dan mencatat request yang masuk
+type testServer struct {
+	*httptest.Server
+	repos *repository.Repositories
+	bus   *event.Bus
+
+	mu sync.Mutex
+	// hits menghitung request per "METHOD path"
+	hits map[string]int
+	// failures adalah jumlah response 503 yang dikirim sebelum request diteruskan, per "METHOD path"
+	failures map[string]int
+}
+
+// newTestServer membuat server dengan repository in-memory kosong
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+
+	s := &testServer{
+		repos:    memory.NewRepositories(),
+		bus:      event.NewBus(),
+		hits:     make(map[string]int),
+		failures: make(map[string]int),
+	}
+	router := api.SetupRouter(testConfig, s.repos, s.bus)
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Method + " " + r.URL.Path
+		s.mu.Lock()
+		s.hits[key]++
+		fail := s.failures[key] > 0
+		if fail {
+			s.failures[key]--
+		}
+		s.mu.Unlock()
+
+		if fail {
+			http.Error(w, "service unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		router.ServeHTTP(w, r)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// failNext membuat n request berikutnya ke key dibalas 503
+func (s *testServer) failNext(key string, n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[key] = n
+}
+
+// hitCount mengembalikan jumlah request ke key
+func (s *testServer) hitCount(key string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.hits[key]
+}
+
+// newClient membuat client yang login dengan kredensial admin
+func (s *testServer) newClient(t *testing.T, modify ...func(*client.Config)) *client.Client {
+	t.Helper()
+
+	cfg := client.Config{
+		BaseURL:      s.URL,
+		Username:     testConfig.Admin.Username,
+		Password:     testConfig.Admin.Password,
+		RetryBackoff: time.Millisecond,
+	}
+	for _, fn := range modify {
+		fn(&cfg)
+	}
+	c, err := client.New(cfg)
+	if err != nil {
+		t.Fatalf("New error: %v", err)
+	}
+	return c
+}
+
+// dispatch memublikasikan event di outbox ke WebSocket, seperti OutboxDispatcher di background
+func (s *testServer) dispatch(t *testing.T) {
+	t.Helper()
+	publishers := event.Publishers{service.NewLiveFeed(s.repos.Matches, s.bus)}
+	if _, err := service.NewOutboxDispatcher(s.repos.Outbox, publishers, testConfig.Outbox.ParkAfter).DispatchPending(time.Now().UTC()); err != nil {
+		t.Fatalf("DispatchPending error: %v", err)
+	}
+}
+
+// expectAPIError memastikan err adalah *client.Error dengan status dan code tertentu
+func expectAPIError(t *testing.T, err error, status int, code string) *client.Error {
+	t.Helper()
+	var apiErr *client.Error
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("error = %v, want *client.Error", err)
+	}
+	if apiErr.StatusCode != status || apiErr.Code != code {
+		t.Fatalf("error = %d %s (%s), want %d %s", apiErr.StatusCode, apiErr.Code, apiErr.Message, status, code)
+	}
+	return apiErr
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestNewValidatesBaseURL(t *testing.T) {
+	for _, baseURL := range []string{"", "localhost:8080", "ftp://example.com"} {
+		if _, err := client.New(client.Config{BaseURL: baseURL}); err == nil {
+			t.Errorf("New(%q) error = nil", baseURL)
+		}
+	}
+}
+
+func TestAutomaticLogin(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+
+	t.Run("logs in once on first call", func(t *testing.T) {
+		c := s.newClient(t)
+		for i := 0; i < 3; i++ {
+			if _, err := c.ListTeams(ctx); err != nil {
+				t.Fatalf("ListTeams error: %v", err)
+			}
+		}
+		if got := s.hitCount("POST /login"); got != 1 {
+			t.Errorf("login = %d, want 1", got)
+		}
+	})
+
+	t.Run("refreshes expired token", func(t *testing.T) {
+		expired, err := utils.GenerateJWT("admin", testConfig.JWT.Secret, -1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		before := s.hitCount("POST /login")
+		c := s.newClient(t, func(cfg *client.Config) { cfg.Token = expired })
+
+		if _, err := c.ListTeams(ctx); err != nil {
+			t.Fatalf("ListTeams error: %v", err)
+		}
+		token, _ := c.Token(ctx)
+		if token == expired || s.hitCount("POST /login") != before+1 {
+			t.Error("token kedaluwarsa harus diganti lewat login sebelum request dikirim")
+		}
+	})
+
+	t.Run("retries once after 401", func(t *testing.T) {
+		foreign, err := utils.GenerateJWT("admin", "secret-lain", 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		before := s.hitCount("GET /teams")
+		c := s.newClient(t, func(cfg *client.Config) { cfg.Token = foreign })
+
+		if _, err := c.ListTeams(ctx); err != nil {
+			t.Fatalf("ListTeams error: %v", err)
+		}
+		if got := s.hitCount("GET /teams") - before; got != 2 {
+			t.Errorf("GET /teams = %d, want 2 (ditolak lalu diulang dengan token baru)", got)
+		}
+	})
+
+	t.Run("token without credentials", func(t *testing.T) {
+		c := s.newClient(t, func(cfg *client.Config) {
+			cfg.Username, cfg.Password, cfg.Token = "", "", "bukan-jwt"
+		})
+		_, err := c.ListTeams(ctx)
+		if !client.IsUnauthorized(err) {
+			t.Errorf("error = %v, want unauthorized", err)
+		}
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		c := s.newClient(t, func(cfg *client.Config) { cfg.Password = "salah" })
+		err := c.Login(ctx)
+		expectAPIError(t, err, http.StatusUnauthorized, "unauthorized")
+		if _, err := c.ListTeams(ctx); !client.IsUnauthorized(err) {
+			t.Errorf("ListTeams error = %v, want unauthorized", err)
+		}
+	})
+}
+
+func TestUsers(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	admin := s.newClient(t)
+
+	user, err := admin.CreateUser(ctx, client.UserRequest{Username: "operator", Password: "rahasia123"})
+	if err != nil {
+		t.Fatalf("CreateUser error: %v", err)
+	}
+	if user.ID == 0 || user.Username != "operator" {
+		t.Errorf("user = %+v", user)
+	}
+
+	_, err = admin.CreateUser(ctx, client.UserRequest{Username: "operator", Password: "rahasia123"})
+	if !client.IsConflict(err) {
+		t.Errorf("duplicate CreateUser error = %v, want conflict", err)
+	}
+
+	operator := s.newClient(t, func(cfg *client.Config) { cfg.Username, cfg.Password = "operator", "rahasia123" })
+	if _, err := operator.ListTeams(ctx); err != nil {
+		t.Errorf("ListTeams sebagai user baru error: %v", err)
+	}
+}
+
+func TestOutbox(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	admin := s.newClient(t)
+
+	rows := []model.OutboxEvent{{EventID: "event-parkir", EventType: client.EventTeamDeleted, Payload: `{"id":7}`, OccurredAt: time.Now().UTC()}}
+	if err := s.repos.Outbox.Append(rows); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.repos.Outbox.Park(rows[0].ID, "payload tidak bisa diproses", time.Now().UTC()); err != nil {
+		t.Fatal(err)
+	}
+
+	parked, err := admin.ListParkedEvents(ctx, 10)
+	if err != nil {
+		t.Fatalf("ListParkedEvents error: %v", err)
+	}
+	if len(parked) != 1 || parked[0].EventID != "event-parkir" || parked[0].ParkedAt == nil || string(parked[0].Payload) != `{"id":7}` {
+		t.Fatalf("parked = %+v", parked)
+	}
+
+	if requeued, err := admin.RequeueEvents(ctx); err != nil || requeued != 1 {
+		t.Fatalf("RequeueEvents = %d, %v; want 1", requeued, err)
+	}
+	if parked, err := admin.ListParkedEvents(ctx, 0); err != nil || len(parked) != 0 {
+		t.Errorf("ListParkedEvents setelah requeue = %+v, %v", parked, err)
+	}
+
+	if _, err := admin.CreateUser(ctx, client.UserRequest{Username: "operator", Password: "rahasia123"}); err != nil {
+		t.Fatal(err)
+	}
+	operator := s.newClient(t, func(cfg *client.Config) { cfg.Username, cfg.Password = "operator", "rahasia123" })
+	if _, err := operator.RequeueEvents(ctx, rows[0].ID); client.ErrorCode(err) != "forbidden" {
+		t.Errorf("RequeueEvents sebagai user biasa error = %v, want forbidden", err)
+	}
+}
+
+func TestTypedErrors(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	c := s.newClient(t, func(cfg *client.Config) { cfg.Language = "en" })
+
+	_, err := c.GetTeam(ctx, 999)
+	if apiErr := expectAPIError(t, err, http.StatusNotFound, "team_not_found"); apiErr.Message != "Team not found" {
+		t.Errorf("message = %q, want English", apiErr.Message)
+	}
+	if !client.IsNotFound(err) || client.ErrorCode(err) != "team_not_found" {
+		t.Errorf("IsNotFound/ErrorCode tidak cocok untuk %v", err)
+	}
+
+	_, err = c.CreatePlayer(ctx, client.CreatePlayerRequest{Position: "kiper"})
+	apiErr := expectAPIError(t, err, http.StatusBadRequest, "validation_failed")
+	fields := map[string]bool{}
+	for _, field := range apiErr.Fields {
+		fields[field.Field] = true
+	}
+	for _, name := range []string{"name", "team_id", "position", "jersey_number"} {
+		if !fields[name] {
+			t.Errorf("field %s tidak ada di %+v", name, apiErr.Fields)
+		}
+	}
+	if !client.IsValidation(err) || !strings.Contains(err.Error(), "validation_failed") {
+		t.Errorf("error = %v", err)
+	}
+
+	team, err := c.CreateTeam(ctx, client.TeamRequest{Name: "Persija Jakarta"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	player := client.CreatePlayerRequest{Name: "Bambang", TeamID: team.ID, Position: client.PositionForward, JerseyNumber: 9}
+	if _, err := c.CreatePlayer(ctx, player); err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.CreatePlayer(ctx, player)
+	expectAPIError(t, err, http.StatusConflict, "jersey_number_taken")
+	if !client.IsConflict(err) {
+		t.Errorf("IsConflict(%v) = false", err)
+	}
+}
+
+func TestRetries(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	c := s.newClient(t)
+	if err := c.Login(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("idempotent call is retried", func(t *testing.T) {
+		s.failNext("GET /teams", 2)
+		before := s.hitCount("GET /teams")
+		if _, err := c.ListTeams(ctx); err != nil {
+			t.Fatalf("ListTeams error: %v", err)
+		}
+		if got := s.hitCount("GET /teams") - before; got != 3 {
+			t.Errorf("GET /teams = %d, want 3", got)
+		}
+	})
+
+	t.Run("gives up after max retries", func(t *testing.T) {
+		s.failNext("GET /venues", 5)
+		_, err := c.ListVenues(ctx)
+		apiErr := expectAPIError(t, err, http.StatusServiceUnavailable, "")
+		if apiErr.Message != "service unavailable" {
+			t.Errorf("message = %q", apiErr.Message)
+		}
+		if got := s.hitCount("GET /venues"); got != client.DefaultMaxRetries+1 {
+			t.Errorf("GET /venues = %d, want %d", got, client.DefaultMaxRetries+1)
+		}
+		s.failNext("GET /venues", 0)
+	})
+
+	t.Run("POST is not retried", func(t *testing.T) {
+		s.failNext("POST /teams", 1)
+		_, err := c.CreateTeam(ctx, client.TeamRequest{Name: "Persib Bandung"})
+		expectAPIError(t, err, http.StatusServiceUnavailable, "")
+		if got := s.hitCount("POST /teams"); got != 1 {
+			t.Errorf("POST /teams = %d, want 1", got)
+		}
+	})
+
+	t.Run("retries disabled", func(t *testing.T) {
+		noRetry := s.newClient(t, func(cfg *client.Config) { cfg.MaxRetries = -1 })
+		s.failNext("GET /officials", 1)
+		if _, err := noRetry.ListOfficials(ctx); !errors.As(err, new(*client.Error)) {
+			t.Errorf("error = %v, want *client.Error", err)
+		}
+	})
+}
+
+func TestTeamsPlayersAndVenues(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	c := s.newClient(t)
+
+	venue, err := c.CreateVenue(ctx, client.VenueRequest{Name: "GBK", City: "Jakarta", Capacity: 77000})
+	if err != nil || venue.Surface != client.SurfaceGrass {
+		t.Fatalf("CreateVenue = %+v, %v", venue, err)
+	}
+	if venue, err = c.UpdateVenue(ctx, venue.ID, client.VenueRequest{Name: "GBK", City: "Jakarta", Capacity: 78000, Surface: client.SurfaceHybrid}); err != nil || venue.Capacity != 78000 {
+		t.Fatalf("UpdateVenue = %+v, %v", venue, err)
+	}
+
+	team, err := c.CreateTeam(ctx, client.TeamRequest{Name: "Persija Jakarta", FoundedYear: ptr(1928), HomeVenueID: &venue.ID})
+	if err != nil {
+		t.Fatalf("CreateTeam error: %v", err)
+	}
+	if team, err = c.UpdateTeam(ctx, team.ID, client.TeamRequest{Name: "Persija", HeadquartersCity: ptr("Jakarta")}); err != nil {
+		t.Fatalf("UpdateTeam error: %v", err)
+	}
+	if team.Name != "Persija" || team.FoundedYear == nil || *team.FoundedYear != 1928 {
+		t.Errorf("team = %+v", team)
+	}
+
+	player, err := c.CreatePlayer(ctx, client.CreatePlayerRequest{Name: "Bambang", TeamID: team.ID, Position: client.PositionForward, JerseyNumber: 20})
+	if err != nil {
+		t.Fatalf("CreatePlayer error: %v", err)
+	}
+	if player, err = c.UpdatePlayer(ctx, player.ID, client.UpdatePlayerRequest{JerseyNumber: ptr(10)}); err != nil || player.JerseyNumber != 10 {
+		t.Fatalf("UpdatePlayer = %+v, %v", player, err)
+	}
+
+	start, _ := client.ParseDate("2024-08-01")
+	back, _ := client.ParseDate("2024-08-15")
+	availability, err := c.CreateAvailability(ctx, player.ID, client.PlayerAvailabilityRequest{
+		Status:             client.AvailabilityInjured,
+		StartDate:          start,
+		ExpectedReturnDate: &back,
+	})
+	if err != nil || availability.StartDate != start || *availability.ExpectedReturnDate != back {
+		t.Fatalf("CreateAvailability = %+v, %v", availability, err)
+	}
+
+	injuredOn, _ := client.ParseDate("2024-08-10")
+	if players, err := c.ListTeamPlayers(ctx, team.ID, &injuredOn); err != nil || len(players) != 0 {
+		t.Errorf("ListTeamPlayers(available_on) = %+v, %v", players, err)
+	}
+	if players, err := c.ListTeamPlayers(ctx, team.ID, nil); err != nil || len(players) != 1 {
+		t.Errorf("ListTeamPlayers = %+v, %v", players, err)
+	}
+
+	if _, err := c.UpdateAvailability(ctx, player.ID, availability.ID, client.PlayerAvailabilityRequest{Status: client.AvailabilityIll, StartDate: start}); err != nil {
+		t.Errorf("UpdateAvailability error: %v", err)
+	}
+	if list, err := c.ListAvailability(ctx, player.ID); err != nil || len(list) != 1 || list[0].Status != client.AvailabilityIll {
+		t.Errorf("ListAvailability = %+v, %v", list, err)
+	}
+	if err := c.DeleteAvailability(ctx, player.ID, availability.ID); err != nil {
+		t.Errorf("DeleteAvailability error: %v", err)
+	}
+
+	if err := c.DeletePlayer(ctx, player.ID); err != nil {
+		t.Errorf("DeletePlayer error: %v", err)
+	}
+	if err := c.DeleteTeam(ctx, team.ID); err != nil {
+		t.Errorf("DeleteTeam error: %v", err)
+	}
+	if _, err := c.GetTeam(ctx, team.ID); !client.IsNotFound(err) {
+		t.Errorf("GetTeam after delete error = %v", err)
+	}
+	if err := c.DeleteVenue(ctx, venue.ID); err != nil {
+		t.Errorf("DeleteVenue error: %v", err)
+	}
+	if venues, err := c.ListVenues(ctx); err != nil || len(venues) != 0 {
+		t.Errorf("ListVenues = %+v, %v", venues, err)
+	}
+}
+
+// matchFixture membuat dua team dengan satu player di masing-masing team dan satu match terjadwal
+func matchFixture(t *testing.T, c *client.Client) (*client.Match, *client.Player, *client.Player) {
+	t.Helper()
+	ctx := context.Background()
+
+	home, err := c.CreateTeam(ctx, client.TeamRequest{Name: "Persija Jakarta"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	away, err := c.CreateTeam(ctx, client.TeamRequest{Name: "Persib Bandung"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	striker, err := c.CreatePlayer(ctx, client.CreatePlayerRequest{Name: "Bambang", TeamID: home.ID, Position: client.PositionForward, JerseyNumber: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	winger, err := c.CreatePlayer(ctx, client.CreatePlayerRequest{Name: "Febri", TeamID: away.ID, Position: client.PositionMidfielder, JerseyNumber: 13})
+	if err != nil {
+		t.Fatal(err)
+	}
+	match, err := c.CreateMatch(ctx, client.CreateMatchRequest{
+		HomeTeamID:    home.ID,
+		AwayTeamID:    away.ID,
+		MatchDatetime: time.Date(2024, 8, 17, 19, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("CreateMatch error: %v", err)
+	}
+	return match, striker, winger
+}
+
+func TestMatches(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	c := s.newClient(t)
+	match, striker, winger := matchFixture(t, c)
+
+	kickoff := time.Date(2024, 8, 18, 15, 30, 0, 0, time.UTC)
+	rescheduled, err := c.RescheduleMatch(ctx, match.ID, client.RescheduleMatchRequest{MatchDatetime: &kickoff})
+	if err != nil || !rescheduled.MatchDatetime.Equal(kickoff) || rescheduled.ScheduleVersion != 1 {
+		t.Fatalf("RescheduleMatch = %+v, %v", rescheduled, err)
+	}
+
+	referee, err := c.CreateOfficial(ctx, client.OfficialRequest{Name: "Thoriq Alkatiri", City: ptr("Jakarta")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if referee, err = c.UpdateOfficial(ctx, referee.ID, client.OfficialRequest{Name: "Thoriq Alkatiri", LicenseLevel: ptr("FIFA")}); err != nil {
+		t.Fatalf("UpdateOfficial error: %v", err)
+	}
+	assignment, err := c.AssignOfficial(ctx, match.ID, client.AssignOfficialRequest{OfficialID: referee.ID, Role: client.RoleReferee})
+	if err != nil {
+		t.Fatalf("AssignOfficial error: %v", err)
+	}
+
+	result := client.ReportMatchResultRequest{
+		HomeScore:  2,
+		AwayScore:  1,
+		Attendance: ptr(65000),
+		Goals: []client.GoalRequest{
+			{PlayerID: striker.ID, GoalTime: 12},
+			{PlayerID: winger.ID, GoalTime: 50},
+			{PlayerID: striker.ID, GoalTime: 88},
+		},
+	}
+	if err := c.ReportMatchResult(ctx, match.ID, result); err != nil {
+		t.Fatalf("ReportMatchResult error: %v", err)
+	}
+	expectAPIError(t, c.ReportMatchResult(ctx, match.ID, result), http.StatusConflict, "match_already_reported")
+
+	report, err := c.GetMatchReport(ctx, match.ID)
+	if err != nil {
+		t.Fatalf("GetMatchReport error: %v", err)
+	}
+	if report.FinalScore != "2-1" || report.MatchResult != client.ResultHomeWin || report.TopScorerInMatch != "Bambang (2 gol)" {
+		t.Errorf("report = %+v", report)
+	}
+	if len(report.Officials) != 1 || report.Officials[0].Name != "Thoriq Alkatiri" {
+		t.Errorf("officials = %+v", report.Officials)
+	}
+
+	for name, download := range map[string]func(context.Context, uint) ([]byte, error){
+		"report":    c.MatchReportPDF,
+		"teamsheet": c.TeamSheetPDF,
+	} {
+		if pdf, err := download(ctx, match.ID); err != nil || !strings.HasPrefix(string(pdf), "%PDF") {
+			t.Errorf("%s PDF = %.20q, %v", name, pdf, err)
+		}
+	}
+
+	if officials, err := c.ListMatchOfficials(ctx, match.ID); err != nil || len(officials) != 1 {
+		t.Errorf("ListMatchOfficials = %+v, %v", officials, err)
+	}
+	if err := c.UnassignOfficial(ctx, match.ID, assignment.ID); err != nil {
+		t.Errorf("UnassignOfficial error: %v", err)
+	}
+	if official, err := c.GetOfficial(ctx, referee.ID); err != nil || *official.LicenseLevel != "FIFA" {
+		t.Errorf("GetOfficial = %+v, %v", official, err)
+	}
+	if err := c.DeleteOfficial(ctx, referee.ID); err != nil {
+		t.Errorf("DeleteOfficial error: %v", err)
+	}
+	if officials, err := c.ListOfficials(ctx); err != nil || len(officials) != 0 {
+		t.Errorf("ListOfficials = %+v, %v", officials, err)
+	}
+}
+
+func TestCompetitionsAndCalendar(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	c := s.newClient(t)
+
+	var teamIDs []uint
+	for _, name := range []string{"Persija", "Persib", "Arema", "Bali United"} {
+		team, err := c.CreateTeam(ctx, client.TeamRequest{Name: name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		teamIDs = append(teamIDs, team.ID)
+	}
+
+	competition, err := c.CreateCompetition(ctx, client.CreateCompetitionRequest{
+		Name:          "Piala Presiden",
+		Format:        client.FormatCup,
+		StartDatetime: time.Date(2024, 9, 1, 19, 0, 0, 0, time.UTC),
+		TeamIDs:       teamIDs,
+	})
+	if err != nil {
+		t.Fatalf("CreateCompetition error: %v", err)
+	}
+	draw, err := c.DrawCompetition(ctx, competition.ID, nil)
+	if err != nil || draw.Groups != nil || len(draw.Bracket.Rounds) != 2 || len(draw.Bracket.Rounds[0].Ties) != 2 {
+		t.Fatalf("DrawCompetition = %+v, %v", draw, err)
+	}
+	if got, err := c.GetBracket(ctx, competition.ID); err != nil || got.Competition.Name != "Piala Presiden" {
+		t.Errorf("GetBracket = %+v, %v", got, err)
+	}
+	if got, err := c.GetCompetition(ctx, competition.ID); err != nil || len(got.Teams) != 4 {
+		t.Errorf("GetCompetition = %+v, %v", got, err)
+	}
+	if list, err := c.ListCompetitions(ctx); err != nil || len(list) != 1 {
+		t.Errorf("ListCompetitions = %+v, %v", list, err)
+	}
+	if _, err := c.GetGroups(ctx, competition.ID); err == nil {
+		t.Error("GetGroups untuk cup harus ditolak")
+	}
+	if _, err := c.GetQualification(ctx, competition.ID); err == nil {
+		t.Error("GetQualification untuk cup harus ditolak")
+	}
+
+	token, err := c.CreateCalendarToken(ctx)
+	if err != nil {
+		t.Fatalf("CreateCalendarToken error: %v", err)
+	}
+	feed, err := c.CompetitionFixtures(ctx, competition.ID, token.Token)
+	if err != nil || !strings.Contains(string(feed), "BEGIN:VCALENDAR") || strings.Count(string(feed), "BEGIN:VEVENT") != 2 {
+		t.Errorf("CompetitionFixtures = %q, %v", feed, err)
+	}
+	if feed, err := c.TeamFixtures(ctx, teamIDs[0], token.Token); err != nil || !strings.Contains(string(feed), "BEGIN:VEVENT") {
+		t.Errorf("TeamFixtures = %q, %v", feed, err)
+	}
+	if err := c.RevokeCalendarToken(ctx); err != nil {
+		t.Fatalf("RevokeCalendarToken error: %v", err)
+	}
+	if _, err := c.TeamFixtures(ctx, teamIDs[0], token.Token); !client.IsUnauthorized(err) {
+		t.Errorf("TeamFixtures with revoked token error = %v", err)
+	}
+}
+
+func TestGroupStage(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	c := s.newClient(t)
+
+	var teamIDs []uint
+	for _, name := range []string{"Persija", "Persib", "Arema", "Bali United"} {
+		team, err := c.CreateTeam(ctx, client.TeamRequest{Name: name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		teamIDs = append(teamIDs, team.ID)
+	}
+	competition, err := c.CreateCompetition(ctx, client.CreateCompetitionRequest{
+		Name:               "Liga Mini",
+		Format:             client.FormatGroupKnockout,
+		StartDatetime:      time.Date(2024, 9, 1, 19, 0, 0, 0, time.UTC),
+		GroupCount:         1,
+		QualifiersPerGroup: 2,
+		TeamIDs:            teamIDs,
+	})
+	if err != nil {
+		t.Fatalf("CreateCompetition error: %v", err)
+	}
+	draw, err := c.DrawCompetition(ctx, competition.ID, ptr(int64(7)))
+	if err != nil || draw.Bracket != nil || len(draw.Groups) != 1 {
+		t.Fatalf("DrawCompetition = %+v, %v", draw, err)
+	}
+
+	groups, err := c.GetGroups(ctx, competition.ID)
+	if err != nil || len(groups) != 1 || len(groups[0].Table) != 4 || len(groups[0].Matches) != 6 {
+		t.Fatalf("GetGroups = %+v, %v", groups, err)
+	}
+	if groups[0].Table[0].Position != 1 || groups[0].Table[0].TeamName == "" {
+		t.Errorf("table = %+v", groups[0].Table)
+	}
+	qualification, err := c.GetQualification(ctx, competition.ID)
+	if err != nil || len(qualification) != 1 || qualification[0].RemainingMatches != 6 || len(qualification[0].Teams) != 4 {
+		t.Errorf("GetQualification = %+v, %v", qualification, err)
+	}
+}
+
+func TestImportAndExport(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	c := s.newClient(t)
+
+	valid := "name,founded_year\nGaruda FC,1990\nElang FC,\n"
+	result, err := c.ImportTeams(ctx, "teams.csv", strings.NewReader(valid), client.ImportOptions{DryRun: true})
+	if err != nil || !result.DryRun || result.TotalRows != 2 || result.Imported != 0 {
+		t.Fatalf("ImportTeams dry run = %+v, %v", result, err)
+	}
+	if result, err = c.ImportTeams(ctx, "teams.csv", strings.NewReader(valid), client.ImportOptions{}); err != nil || result.Imported != 2 {
+		t.Fatalf("ImportTeams = %+v, %v", result, err)
+	}
+
+	result, err = c.ImportPlayers(ctx, "players.csv", strings.NewReader("name,team_id,position,jersey_number\nBambang,999,kiper,100\n"), client.ImportOptions{})
+	apiErr := expectAPIError(t, err, http.StatusBadRequest, "import_rejected")
+	if result == nil || len(result.Errors) != 1 || result.Errors[0].Row != 2 || len(result.Errors[0].Fields) == 0 {
+		t.Errorf("result = %+v (error %v)", result, apiErr)
+	}
+
+	_, err = c.ImportTeams(ctx, "teams.txt", strings.NewReader(valid), client.ImportOptions{})
+	expectAPIError(t, err, http.StatusBadRequest, "unsupported_format")
+
+	export, err := c.ExportTeams(ctx, client.ExportOptions{Format: client.ExportJSONL})
+	if err != nil {
+		t.Fatalf("ExportTeams error: %v", err)
+	}
+	data, err := io.ReadAll(export)
+	export.Close()
+	if err != nil || strings.Count(string(data), "\n") != 2 || !strings.Contains(string(data), "Garuda FC") {
+		t.Errorf("export = %q, %v", data, err)
+	}
+
+	for name, fn := range map[string]func(context.Context, client.ExportOptions) (io.ReadCloser, error){
+		"players": c.ExportPlayers,
+		"matches": c.ExportMatches,
+		"goals":   c.ExportGoals,
+	} {
+		body, err := fn(ctx, client.ExportOptions{TeamID: 1, Status: client.MatchCompleted})
+		if err != nil {
+			t.Errorf("export %s error: %v", name, err)
+			continue
+		}
+		header, _ := io.ReadAll(body)
+		body.Close()
+		if !strings.HasPrefix(string(header), "id,") {
+			t.Errorf("export %s = %q, want CSV header", name, header)
+		}
+	}
+
+	_, err = c.ExportTeams(ctx, client.ExportOptions{Format: "pdf"})
+	expectAPIError(t, err, http.StatusBadRequest, "bad_request")
+}
+
+func TestWebhooks(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	c := s.newClient(t)
+
+	_, err := c.CreateWebhook(ctx, client.WebhookRequest{URL: "ftp://example.com", Secret: "s3cr3t-yang-panjang", Events: []string{client.EventGoalRecorded}})
+	expectAPIError(t, err, http.StatusBadRequest, "validation_failed")
+
+	webhook, err := c.CreateWebhook(ctx, client.WebhookRequest{
+		URL:    "https://example.com/hooks",
+		Secret: "s3cr3t-yang-panjang",
+		Events: []string{client.EventMatchCompleted},
+	})
+	if err != nil || !webhook.Active {
+		t.Fatalf("CreateWebhook = %+v, %v", webhook, err)
+	}
+	webhook, err = c.UpdateWebhook(ctx, webhook.ID, client.WebhookRequest{
+		URL:    webhook.URL,
+		Events: []string{client.EventMatchCompleted, client.EventGoalRecorded},
+		Active: ptr(false),
+	})
+	if err != nil || webhook.Active || len(webhook.Events) != 2 {
+		t.Fatalf("UpdateWebhook = %+v, %v", webhook, err)
+	}
+	if got, err := c.GetWebhook(ctx, webhook.ID); err != nil || got.URL != webhook.URL {
+		t.Errorf("GetWebhook = %+v, %v", got, err)
+	}
+	if list, err := c.ListWebhooks(ctx); err != nil || len(list) != 1 {
+		t.Errorf("ListWebhooks = %+v, %v", list, err)
+	}
+	if deliveries, err := c.ListDeliveries(ctx, webhook.ID, 10); err != nil || len(deliveries) != 0 {
+		t.Errorf("ListDeliveries = %+v, %v", deliveries, err)
+	}
+	_, err = c.Redeliver(ctx, webhook.ID, 999)
+	if !client.IsNotFound(err) {
+		t.Errorf("Redeliver error = %v, want not found", err)
+	}
+	if err := c.DeleteWebhook(ctx, webhook.ID); err != nil {
+		t.Errorf("DeleteWebhook error: %v", err)
+	}
+}
+
+func TestGraphQL(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	c := s.newClient(t)
+
+	if _, err := c.CreateTeam(ctx, client.TeamRequest{Name: "Persija Jakarta"}); err != nil {
+		t.Fatal(err)
+	}
+
+	var data struct {
+		Teams struct {
+			Items []struct {
+				Name string `json:"name"`
+			} `json:"items"`
+		} `json:"teams"`
+	}
+	err := c.GraphQL(ctx, client.GraphQLRequest{Query: "query($first: Int) { teams(first: $first) { items { name } } }", Variables: map[string]interface{}{"first": 5}}, &data)
+	if err != nil || len(data.Teams.Items) != 1 || data.Teams.Items[0].Name != "Persija Jakarta" {
+		t.Fatalf("GraphQL = %+v, %v", data, err)
+	}
+
+	err = c.GraphQL(ctx, client.GraphQLRequest{Query: "mutation { deleteTeam(id: 999) }"}, nil)
+	var gqlErrs client.GraphQLErrors
+	if !errors.As(err, &gqlErrs) || gqlErrs[0].Code() != "team_not_found" {
+		t.Errorf("error = %v, want team_not_found", err)
+	}
+
+	err = c.GraphQL(ctx, client.GraphQLRequest{Query: "{ teams { unknownField } }"}, nil)
+	if !errors.As(err, &gqlErrs) || len(gqlErrs) == 0 {
+		t.Errorf("error = %v, want GraphQLErrors", err)
+	}
+}
+
+func TestLive(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	c := s.newClient(t)
+	match, striker, _ := matchFixture(t, c)
+
+	live, err := c.Live(ctx)
+	if err != nil {
+		t.Fatalf("Live error: %v", err)
+	}
+	defer live.Close()
+	live.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	if err := live.Subscribe(client.MatchTopic(match.ID), "stadion:1"); err != nil {
+		t.Fatal(err)
+	}
+	msg, err := live.Receive()
+	if err != nil || msg.Type != client.LiveError || msg.Code != "invalid_topic" {
+		t.Fatalf("Receive = %+v, %v", msg, err)
+	}
+	if err := live.Subscribe(client.MatchTopic(match.ID)); err != nil {
+		t.Fatal(err)
+	}
+	if msg, err = live.Receive(); err != nil || msg.Type != client.LiveSubscribed {
+		t.Fatalf("Receive = %+v, %v", msg, err)
+	}
+
+	// Receive berjalan terus di background sehingga heartbeat server dibalas otomatis
+	messages := make(chan *client.LiveMessage)
+	go func() {
+		defer close(messages)
+		for {
+			msg, err := live.Receive()
+			if err != nil {
+				return
+			}
+			messages <- msg
+		}
+	}()
+	time.Sleep(3 * testConfig.WebSocket.PingInterval)
+
+	result := client.ReportMatchResultRequest{HomeScore: 1, Goals: []client.GoalRequest{{PlayerID: striker.ID, GoalTime: 30}}}
+	if err := c.ReportMatchResult(ctx, match.ID, result); err != nil {
+		t.Fatal(err)
+	}
+	s.dispatch(t)
+
+	var types []string
+	for msg := range messages {
+		if msg.Type != client.LiveEvent || msg.Event == nil {
+			t.Fatalf("message = %+v", msg)
+		}
+		if types = append(types, msg.Event.Type); len(types) == 2 {
+			break
+		}
+	}
+	if len(types) != 2 {
+		t.Fatalf("koneksi tertutup sebelum semua event diterima: %v", types)
+	}
+	if types[0] != client.EventMatchCompleted || types[1] != client.EventGoalRecorded {
+		t.Errorf("events = %v", types)
+	}
+}
+
+func TestSystemEndpoints(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	c, err := client.New(client.Config{BaseURL: s.URL + "/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if health, err := c.Health(ctx); err != nil || health.Status != "ok" {
+		t.Errorf("Health = %+v, %v", health, err)
+	}
+	if spec, err := c.OpenAPISpec(ctx); err != nil || !strings.Contains(string(spec), `"openapi"`) {
+		t.Errorf("OpenAPISpec = %.40q, %v", spec, err)
+	}
+	if page, err := c.DocsPage(ctx); err != nil || !strings.Contains(string(page), "<html") {
+		t.Errorf("DocsPage = %.40q, %v", page, err)
+	}
+	if s.hitCount("POST /login") != 0 {
+		t.Error("endpoint publik tidak boleh memicu login")
+	}
+}
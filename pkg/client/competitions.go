@@ -0,0 +1,97 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// CreateCompetition membuat kompetisi beserta pesertanya (POST /competitions)
+func (c *Client) CreateCompetition(ctx context.Context, req CreateCompetitionRequest) (*Competition, error) {
+	var competition Competition
+	if err := c.do(ctx, request{method: http.MethodPost, path: "/competitions", body: req, auth: true}, &competition); err != nil {
+		return nil, err
+	}
+	return &competition, nil
+}
+
+// ListCompetitions mengambil semua kompetisi (GET /competitions)
+func (c *Client) ListCompetitions(ctx context.Context) ([]Competition, error) {
+	var competitions []Competition
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/competitions", auth: true}, &competitions); err != nil {
+		return nil, err
+	}
+	return competitions, nil
+}
+
+// GetCompetition mengambil satu kompetisi (GET /competitions/{id})
+func (c *Client) GetCompetition(ctx context.Context, id uint) (*Competition, error) {
+	var competition Competition
+	if err := c.do(ctx, request{method: http.MethodGet, path: idPath("/competitions/%d", id), auth: true}, &competition); err != nil {
+		return nil, err
+	}
+	return &competition, nil
+}
+
+// drawRequest adalah body POST /competitions/{id}/draw
+type drawRequest struct {
+	DrawSeed *int64 `json:"draw_seed,omitempty"`
+}
+
+// DrawResult adalah hasil undian: Bracket terisi untuk format cup, Groups untuk format group_knockout
+type DrawResult struct {
+	Bracket *Bracket
+	Groups  []Group
+}
+
+// DrawCompetition melakukan undian dan membuat jadwal babak pertama atau fase grup
+// (POST /competitions/{id}/draw). drawSeed (opsional) membuat hasil undian kompetisi
+// dengan seeding drawn dapat direproduksi.
+func (c *Client) DrawCompetition(ctx context.Context, id uint, drawSeed *int64) (*DrawResult, error) {
+	var raw json.RawMessage
+	path := idPath("/competitions/%d/draw", id)
+	if err := c.do(ctx, request{method: http.MethodPost, path: path, body: drawRequest{DrawSeed: drawSeed}, auth: true}, &raw); err != nil {
+		return nil, err
+	}
+
+	// Format group_knockout membalas dengan daftar grup, format cup dengan bracket
+	var result DrawResult
+	target := interface{}(&result.Bracket)
+	if trimmed := bytes.TrimSpace(raw); len(trimmed) > 0 && trimmed[0] == '[' {
+		target = &result.Groups
+	}
+	if err := json.Unmarshal(raw, target); err != nil {
+		return nil, fmt.Errorf("client: gagal membaca response POST %s: %w", path, err)
+	}
+	return &result, nil
+}
+
+// GetBracket mengambil bracket knockout (GET /competitions/{id}/bracket)
+func (c *Client) GetBracket(ctx context.Context, id uint) (*Bracket, error) {
+	var bracket Bracket
+	if err := c.do(ctx, request{method: http.MethodGet, path: idPath("/competitions/%d/bracket", id), auth: true}, &bracket); err != nil {
+		return nil, err
+	}
+	return &bracket, nil
+}
+
+// GetGroups mengambil klasemen dan jadwal setiap grup (GET /competitions/{id}/groups)
+func (c *Client) GetGroups(ctx context.Context, id uint) ([]Group, error) {
+	var groups []Group
+	if err := c.do(ctx, request{method: http.MethodGet, path: idPath("/competitions/%d/groups", id), auth: true}, &groups); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// GetQualification mengambil skenario kualifikasi setiap grup (GET /competitions/{id}/qualification)
+func (c *Client) GetQualification(ctx context.Context, id uint) ([]Qualification, error) {
+	var qualification []Qualification
+	err := c.do(ctx, request{method: http.MethodGet, path: idPath("/competitions/%d/qualification", id), auth: true}, &qualification)
+	if err != nil {
+		return nil, err
+	}
+	return qualification, nil
+}
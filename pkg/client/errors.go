@@ -0,0 +1,102 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Error adalah response error dari API (format utils.ErrorResponse di server).
+// Code adalah identifier stabil seperti team_not_found atau jersey_number_taken;
+// Message sudah diterjemahkan sesuai Config.Language.
+type Error struct {
+	StatusCode int
+	Code       string
+	Message    string
+	Fields     []FieldError
+}
+
+// FieldError adalah kesalahan validasi pada satu field request
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// errorResponse adalah bentuk body error di server
+type errorResponse struct {
+	Error  string       `json:"error"`
+	Code   string       `json:"code"`
+	Fields []FieldError `json:"fields"`
+}
+
+func (e *Error) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "client: %d", e.StatusCode)
+	if e.Code != "" {
+		fmt.Fprintf(&b, " %s", e.Code)
+	}
+	fmt.Fprintf(&b, ": %s", e.Message)
+	for _, field := range e.Fields {
+		fmt.Fprintf(&b, "; %s: %s", field.Field, field.Message)
+	}
+	return b.String()
+}
+
+// parseError membaca body response error. Body yang bukan JSON (mis. dari proxy)
+// tetap menghasilkan *Error dengan teks status sebagai pesan.
+func parseError(res *http.Response) *Error {
+	apiErr := &Error{StatusCode: res.StatusCode}
+
+	data, _ := io.ReadAll(io.LimitReader(res.Body, maxErrorBodyBytes))
+	var body errorResponse
+	if json.Unmarshal(data, &body) == nil && body.Error != "" {
+		apiErr.Code = body.Code
+		apiErr.Message = body.Error
+		apiErr.Fields = body.Fields
+		return apiErr
+	}
+
+	apiErr.Message = http.StatusText(res.StatusCode)
+	if text := strings.TrimSpace(string(data)); text != "" && len(text) <= 200 {
+		apiErr.Message = text
+	}
+	return apiErr
+}
+
+// ErrorCode mengembalikan code error API dari err, atau string kosong jika err bukan *Error
+func ErrorCode(err error) string {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Code
+	}
+	return ""
+}
+
+// IsNotFound memeriksa apakah resource yang diminta tidak ada (404)
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsConflict memeriksa apakah operasi bentrok dengan data yang ada (409)
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+// IsValidation memeriksa apakah request ditolak karena input tidak valid (400)
+func IsValidation(err error) bool {
+	return hasStatus(err, http.StatusBadRequest)
+}
+
+// IsUnauthorized memeriksa apakah token tidak ada, tidak valid, atau login gagal (401)
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+// hasStatus memeriksa status HTTP dari *Error di dalam err
+func hasStatus(err error, status int) bool {
+	var apiErr *Error
+	return errors.As(err, &apiErr) && apiErr.StatusCode == status
+}
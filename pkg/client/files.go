@@ -0,0 +1,204 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Format file export
+const (
+	ExportCSV   = "csv"
+	ExportJSONL = "jsonl"
+	ExportXLSX  = "xlsx"
+)
+
+// ExportOptions adalah filter export. Format kosong berarti csv.
+// TeamID berlaku untuk player dan match, AvailableOn untuk player, Status untuk match,
+// MatchID dan PlayerID untuk goal; filter yang tidak berlaku diabaikan server.
+type ExportOptions struct {
+	Format      string
+	TeamID      uint
+	AvailableOn *Date
+	Status      MatchStatus
+	MatchID     uint
+	PlayerID    uint
+}
+
+// query mengubah opsi menjadi parameter query
+func (o ExportOptions) query() url.Values {
+	query := url.Values{}
+	if o.Format != "" {
+		query.Set("format", o.Format)
+	}
+	if o.TeamID != 0 {
+		query.Set("team_id", strconv.FormatUint(uint64(o.TeamID), 10))
+	}
+	if o.AvailableOn != nil {
+		query.Set("available_on", o.AvailableOn.String())
+	}
+	if o.Status != "" {
+		query.Set("status", string(o.Status))
+	}
+	if o.MatchID != 0 {
+		query.Set("match_id", strconv.FormatUint(uint64(o.MatchID), 10))
+	}
+	if o.PlayerID != 0 {
+		query.Set("player_id", strconv.FormatUint(uint64(o.PlayerID), 10))
+	}
+	return query
+}
+
+// ExportTeams mengunduh semua team (GET /export/teams). File di-stream; pemanggil wajib menutupnya.
+func (c *Client) ExportTeams(ctx context.Context, opts ExportOptions) (io.ReadCloser, error) {
+	return c.export(ctx, "/export/teams", opts)
+}
+
+// ExportPlayers mengunduh player (GET /export/players). File di-stream; pemanggil wajib menutupnya.
+func (c *Client) ExportPlayers(ctx context.Context, opts ExportOptions) (io.ReadCloser, error) {
+	return c.export(ctx, "/export/players", opts)
+}
+
+// ExportMatches mengunduh match (GET /export/matches). File di-stream; pemanggil wajib menutupnya.
+func (c *Client) ExportMatches(ctx context.Context, opts ExportOptions) (io.ReadCloser, error) {
+	return c.export(ctx, "/export/matches", opts)
+}
+
+// ExportGoals mengunduh goal (GET /export/goals). File di-stream; pemanggil wajib menutupnya.
+func (c *Client) ExportGoals(ctx context.Context, opts ExportOptions) (io.ReadCloser, error) {
+	return c.export(ctx, "/export/goals", opts)
+}
+
+// export menjalankan satu endpoint export dan mengembalikan body response
+func (c *Client) export(ctx context.Context, path string, opts ExportOptions) (io.ReadCloser, error) {
+	res, err := c.send(ctx, request{method: http.MethodGet, path: path, query: opts.query(), auth: true})
+	if err != nil {
+		return nil, err
+	}
+	return res.Body, nil
+}
+
+// ImportOptions adalah opsi import. DryRun hanya memvalidasi file tanpa menyimpan.
+type ImportOptions struct {
+	DryRun bool
+}
+
+// ImportTeams mengimpor team dari file CSV atau XLSX (POST /import/teams).
+// filename menentukan format file dari ekstensinya (.csv atau .xlsx).
+//
+// Jika ada baris yang tidak valid, tidak ada data yang disimpan: error bertipe *Error
+// dikembalikan bersama ImportResult yang berisi kesalahan per baris.
+func (c *Client) ImportTeams(ctx context.Context, filename string, file io.Reader, opts ImportOptions) (*ImportResult, error) {
+	return c.importFile(ctx, "/import/teams", filename, file, opts)
+}
+
+// ImportPlayers mengimpor player dari file CSV atau XLSX (POST /import/players).
+// Perilakunya sama dengan ImportTeams.
+func (c *Client) ImportPlayers(ctx context.Context, filename string, file io.Reader, opts ImportOptions) (*ImportResult, error) {
+	return c.importFile(ctx, "/import/players", filename, file, opts)
+}
+
+// importResponse adalah body response import; saat ditolak, field error mengikuti format error API
+type importResponse struct {
+	ImportResult
+	errorResponse
+}
+
+// importFile meng-upload file sebagai multipart form field "file"
+func (c *Client) importFile(ctx context.Context, path, filename string, file io.Reader, opts ImportOptions) (*ImportResult, error) {
+	var form bytes.Buffer
+	writer := multipart.NewWriter(&form)
+	part, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(part, file); err != nil {
+		return nil, fmt.Errorf("client: gagal membaca file import: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	if opts.DryRun {
+		query.Set("dry_run", "true")
+	}
+	res, err := c.send(ctx, request{
+		method:      http.MethodPost,
+		path:        path,
+		query:       query,
+		rawBody:     form.Bytes(),
+		contentType: writer.FormDataContentType(),
+		auth:        true,
+		passStatus:  http.StatusBadRequest,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	var body importResponse
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("client: gagal membaca response %s: %w", path, err)
+	}
+	if res.StatusCode < http.StatusBadRequest {
+		return &body.ImportResult, nil
+	}
+
+	apiErr := &Error{StatusCode: res.StatusCode, Code: body.Code, Message: body.errorResponse.Error, Fields: body.Fields}
+	if len(body.Errors) == 0 {
+		return nil, apiErr
+	}
+	return &body.ImportResult, apiErr
+}
+
+// CreateCalendarToken membuat token feed kalender baru untuk user yang login (POST /calendar/token).
+// Token lama langsung tidak berlaku.
+func (c *Client) CreateCalendarToken(ctx context.Context) (*CalendarToken, error) {
+	var token CalendarToken
+	if err := c.do(ctx, request{method: http.MethodPost, path: "/calendar/token", auth: true}, &token); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// RevokeCalendarToken mencabut token feed kalender (DELETE /calendar/token)
+func (c *Client) RevokeCalendarToken(ctx context.Context) error {
+	return c.do(ctx, request{method: http.MethodDelete, path: "/calendar/token", auth: true}, nil)
+}
+
+// TeamFixtures mengunduh feed iCalendar jadwal team (GET /teams/{id}/fixtures.ics).
+// Feed diautentikasi dengan token kalender, bukan JWT.
+func (c *Client) TeamFixtures(ctx context.Context, teamID uint, calendarToken string) ([]byte, error) {
+	return c.download(ctx, request{
+		method: http.MethodGet,
+		path:   idPath("/teams/%d/fixtures.ics", teamID),
+		query:  url.Values{"token": {calendarToken}},
+	})
+}
+
+// CompetitionFixtures mengunduh feed iCalendar jadwal kompetisi (GET /competitions/{id}/fixtures.ics).
+// Feed diautentikasi dengan token kalender, bukan JWT.
+func (c *Client) CompetitionFixtures(ctx context.Context, competitionID uint, calendarToken string) ([]byte, error) {
+	return c.download(ctx, request{
+		method: http.MethodGet,
+		path:   idPath("/competitions/%d/fixtures.ics", competitionID),
+		query:  url.Values{"token": {calendarToken}},
+	})
+}
+
+// download membaca seluruh body response non-JSON (PDF, iCalendar, dll.)
+func (c *Client) download(ctx context.Context, req request) ([]byte, error) {
+	res, err := c.send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	return io.ReadAll(res.Body)
+}
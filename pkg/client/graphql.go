@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GraphQLRequest adalah body POST /graphql
+type GraphQLRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+// GraphQLError adalah satu error GraphQL. Extensions["code"] berisi code yang sama dengan
+// REST (mis. team_not_found, query_too_deep) dan Extensions["fields"] detail validasi.
+type GraphQLError struct {
+	Message    string                 `json:"message"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// Code mengembalikan extensions.code, atau string kosong jika tidak ada
+func (e GraphQLError) Code() string {
+	code, _ := e.Extensions["code"].(string)
+	return code
+}
+
+// GraphQLErrors adalah daftar error dari response GraphQL
+type GraphQLErrors []GraphQLError
+
+func (e GraphQLErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Message
+		if code := err.Code(); code != "" {
+			messages[i] = code + ": " + err.Message
+		}
+	}
+	return "client: graphql: " + strings.Join(messages, "; ")
+}
+
+// graphQLResponse adalah body response POST /graphql
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors GraphQLErrors   `json:"errors"`
+}
+
+// GraphQL menjalankan query atau mutation (POST /graphql) dan men-decode field data ke out
+// (boleh nil). Jika response berisi errors, GraphQLErrors dikembalikan; data parsial tetap
+// di-decode ke out.
+func (c *Client) GraphQL(ctx context.Context, req GraphQLRequest, out interface{}) error {
+	res, err := c.send(ctx, request{
+		method:     http.MethodPost,
+		path:       "/graphql",
+		body:       req,
+		auth:       true,
+		passStatus: http.StatusBadRequest,
+	})
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	var body graphQLResponse
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return fmt.Errorf("client: gagal membaca response /graphql: %w", err)
+	}
+	if out != nil && len(body.Data) > 0 && string(body.Data) != "null" {
+		if err := json.Unmarshal(body.Data, out); err != nil {
+			return fmt.Errorf("client: gagal membaca data GraphQL: %w", err)
+		}
+	}
+	if len(body.Errors) > 0 {
+		return body.Errors
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+// Tipe pesan yang dikirim server lewat koneksi live
+const (
+	LiveSubscribed = "subscribed"
+	LiveEvent      = "event"
+	LiveError      = "error"
+)
+
+// Tipe event domain
+const (
+	EventMatchCompleted       = "match.completed"
+	EventGoalRecorded         = "goal.recorded"
+	EventPlayerCreated        = "player.created"
+	EventPlayerUpdated        = "player.updated"
+	EventPlayerDeleted        = "player.deleted"
+	EventTeamDeleted          = "team.deleted"
+	EventMatchScheduled       = "match.scheduled"
+	EventCompetitionCompleted = "competition.completed"
+)
+
+// Event adalah satu kejadian domain; Data berisi entitas yang berubah dalam bentuk JSON
+type Event struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// LiveMessage adalah pesan dari server. Type LiveSubscribed membawa semua topic yang
+// di-subscribe, LiveEvent membawa Event beserta topic yang cocok, LiveError membawa Code dan Message.
+type LiveMessage struct {
+	Type    string   `json:"type"`
+	Topics  []string `json:"topics,omitempty"`
+	Event   *Event   `json:"event,omitempty"`
+	Code    string   `json:"code,omitempty"`
+	Message string   `json:"message,omitempty"`
+}
+
+// liveRequest adalah pesan dari client
+type liveRequest struct {
+	Action string   `json:"action"`
+	Topics []string `json:"topics,omitempty"`
+}
+
+// MatchTopic adalah topic event satu pertandingan
+func MatchTopic(id uint) string { return fmt.Sprintf("match:%d", id) }
+
+// TeamTopic adalah topic event satu team
+func TeamTopic(id uint) string { return fmt.Sprintf("team:%d", id) }
+
+// CompetitionTopic adalah topic event satu kompetisi
+func CompetitionTopic(id uint) string { return fmt.Sprintf("competition:%d", id) }
+
+// LiveConn adalah koneksi WebSocket ke GET /ws. Receive harus dipanggil terus-menerus
+// karena heartbeat server dibalas di dalamnya; koneksi yang diam ditutup server.
+type LiveConn struct {
+	ws *websocket.Conn
+	// writeMu menjaga agar Subscribe dan balasan heartbeat tidak menulis bersamaan
+	writeMu sync.Mutex
+}
+
+// Live membuka koneksi WebSocket untuk menerima event match, team, dan kompetisi
+func (c *Client) Live(ctx context.Context) (*LiveConn, error) {
+	token, err := c.currentToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	location := *c.baseURL
+	location.Scheme = "ws"
+	if c.baseURL.Scheme == "https" {
+		location.Scheme = "wss"
+	}
+	location.Path += "/ws"
+
+	config, err := websocket.NewConfig(location.String(), c.baseURL.String())
+	if err != nil {
+		return nil, err
+	}
+	config.Header = http.Header{}
+	if token != "" {
+		config.Header.Set("Authorization", "Bearer "+token)
+	}
+	if c.language != "" {
+		config.Header.Set("Accept-Language", c.language)
+	}
+
+	ws, err := config.DialContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("client: gagal membuka koneksi live: %w", err)
+	}
+	return &LiveConn{ws: ws}, nil
+}
+
+// Subscribe menambah topic (lihat MatchTopic, TeamTopic, CompetitionTopic).
+// Server membalas dengan pesan LiveSubscribed atau LiveError yang dibaca lewat Receive.
+func (l *LiveConn) Subscribe(topics ...string) error {
+	return l.send(liveRequest{Action: "subscribe", Topics: topics})
+}
+
+// Unsubscribe berhenti menerima event dari topic
+func (l *LiveConn) Unsubscribe(topics ...string) error {
+	return l.send(liveRequest{Action: "unsubscribe", Topics: topics})
+}
+
+// Receive menunggu pesan berikutnya. Ping heartbeat dibalas otomatis dan tidak dikembalikan.
+func (l *LiveConn) Receive() (*LiveMessage, error) {
+	for {
+		var msg LiveMessage
+		if err := websocket.JSON.Receive(l.ws, &msg); err != nil {
+			return nil, err
+		}
+		if msg.Type != "ping" {
+			return &msg, nil
+		}
+		if err := l.send(liveRequest{Action: "pong"}); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// SetReadDeadline membatasi waktu tunggu Receive
+func (l *LiveConn) SetReadDeadline(deadline time.Time) error {
+	return l.ws.SetReadDeadline(deadline)
+}
+
+// Close menutup koneksi
+func (l *LiveConn) Close() error {
+	return l.ws.Close()
+}
+
+// send menulis satu pesan ke server
+func (l *LiveConn) send(req liveRequest) error {
+	l.writeMu.Lock()
+	defer l.writeMu.Unlock()
+	return websocket.JSON.Send(l.ws, req)
+}
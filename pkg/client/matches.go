@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// CreateMatch menjadwalkan pertandingan (POST /matches)
+func (c *Client) CreateMatch(ctx context.Context, req CreateMatchRequest) (*Match, error) {
+	var match Match
+	if err := c.do(ctx, request{method: http.MethodPost, path: "/matches", body: req, auth: true}, &match); err != nil {
+		return nil, err
+	}
+	return &match, nil
+}
+
+// RescheduleMatch mengubah jadwal, venue, atau status pertandingan (PATCH /matches/{id})
+func (c *Client) RescheduleMatch(ctx context.Context, id uint, req RescheduleMatchRequest) (*Match, error) {
+	var match Match
+	if err := c.do(ctx, request{method: http.MethodPatch, path: idPath("/matches/%d", id), body: req, auth: true}, &match); err != nil {
+		return nil, err
+	}
+	return &match, nil
+}
+
+// ReportMatchResult melaporkan skor akhir dan pencetak gol (POST /matches/{id}/result).
+// Hasil yang sudah dilaporkan ditolak dengan code match_already_reported.
+func (c *Client) ReportMatchResult(ctx context.Context, id uint, req ReportMatchResultRequest) error {
+	if req.Goals == nil {
+		req.Goals = []GoalRequest{}
+	}
+	return c.do(ctx, request{method: http.MethodPost, path: idPath("/matches/%d/result", id), body: req, auth: true}, nil)
+}
+
+// GetMatchReport mengambil laporan pertandingan (GET /matches/{id}/report)
+func (c *Client) GetMatchReport(ctx context.Context, id uint) (*MatchReport, error) {
+	var report MatchReport
+	if err := c.do(ctx, request{method: http.MethodGet, path: idPath("/matches/%d/report", id), auth: true}, &report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+// MatchReportPDF mengunduh laporan pertandingan dalam bentuk PDF (GET /matches/{id}/report.pdf)
+func (c *Client) MatchReportPDF(ctx context.Context, id uint) ([]byte, error) {
+	return c.download(ctx, request{method: http.MethodGet, path: idPath("/matches/%d/report.pdf", id), auth: true})
+}
+
+// TeamSheetPDF mengunduh lembar susunan pemain dalam bentuk PDF (GET /matches/{id}/teamsheet.pdf)
+func (c *Client) TeamSheetPDF(ctx context.Context, id uint) ([]byte, error) {
+	return c.download(ctx, request{method: http.MethodGet, path: idPath("/matches/%d/teamsheet.pdf", id), auth: true})
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// AssignOfficial menugaskan official ke pertandingan (POST /matches/{id}/officials)
+func (c *Client) AssignOfficial(ctx context.Context, matchID uint, req AssignOfficialRequest) (*MatchOfficial, error) {
+	var assignment MatchOfficial
+	err := c.do(ctx, request{method: http.MethodPost, path: idPath("/matches/%d/officials", matchID), body: req, auth: true}, &assignment)
+	if err != nil {
+		return nil, err
+	}
+	return &assignment, nil
+}
+
+// ListMatchOfficials mengambil official yang ditugaskan ke pertandingan (GET /matches/{id}/officials)
+func (c *Client) ListMatchOfficials(ctx context.Context, matchID uint) ([]MatchOfficial, error) {
+	var assignments []MatchOfficial
+	err := c.do(ctx, request{method: http.MethodGet, path: idPath("/matches/%d/officials", matchID), auth: true}, &assignments)
+	if err != nil {
+		return nil, err
+	}
+	return assignments, nil
+}
+
+// UnassignOfficial membatalkan penugasan official (DELETE /matches/{id}/officials/{assignment_id})
+func (c *Client) UnassignOfficial(ctx context.Context, matchID, assignmentID uint) error {
+	path := idPath("/matches/%d/officials/%d", matchID, assignmentID)
+	return c.do(ctx, request{method: http.MethodDelete, path: path, auth: true}, nil)
+}
+
+// CreateOfficial mendaftarkan official baru (POST /officials)
+func (c *Client) CreateOfficial(ctx context.Context, req OfficialRequest) (*Official, error) {
+	var official Official
+	if err := c.do(ctx, request{method: http.MethodPost, path: "/officials", body: req, auth: true}, &official); err != nil {
+		return nil, err
+	}
+	return &official, nil
+}
+
+// ListOfficials mengambil semua official (GET /officials)
+func (c *Client) ListOfficials(ctx context.Context) ([]Official, error) {
+	var officials []Official
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/officials", auth: true}, &officials); err != nil {
+		return nil, err
+	}
+	return officials, nil
+}
+
+// GetOfficial mengambil satu official (GET /officials/{id})
+func (c *Client) GetOfficial(ctx context.Context, id uint) (*Official, error) {
+	var official Official
+	if err := c.do(ctx, request{method: http.MethodGet, path: idPath("/officials/%d", id), auth: true}, &official); err != nil {
+		return nil, err
+	}
+	return &official, nil
+}
+
+// UpdateOfficial memperbarui official (PUT /officials/{id})
+func (c *Client) UpdateOfficial(ctx context.Context, id uint, req OfficialRequest) (*Official, error) {
+	var official Official
+	if err := c.do(ctx, request{method: http.MethodPut, path: idPath("/officials/%d", id), body: req, auth: true}, &official); err != nil {
+		return nil, err
+	}
+	return &official, nil
+}
+
+// DeleteOfficial menghapus official (DELETE /officials/{id})
+func (c *Client) DeleteOfficial(ctx context.Context, id uint) error {
+	return c.do(ctx, request{method: http.MethodDelete, path: idPath("/officials/%d", id), auth: true}, nil)
+}
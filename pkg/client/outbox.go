@@ -0,0 +1,34 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ListParkedEvents mengambil event outbox yang diparkir, urut ID (GET /outbox/parked).
+// limit nol berarti default server (50). Hanya untuk admin dari konfigurasi server.
+func (c *Client) ListParkedEvents(ctx context.Context, limit int) ([]OutboxEvent, error) {
+	query := url.Values{}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+
+	var events []OutboxEvent
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/outbox/parked", query: query, auth: true}, &events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// RequeueEvents mengembalikan event outbox yang diparkir ke antrean dispatcher
+// (POST /outbox/requeue) dan mengembalikan jumlahnya. Tanpa ids semua event yang diparkir
+// dikembalikan. Hanya untuk admin dari konfigurasi server.
+func (c *Client) RequeueEvents(ctx context.Context, ids ...uint) (int64, error) {
+	var result RequeueOutboxResult
+	if err := c.do(ctx, request{method: http.MethodPost, path: "/outbox/requeue", body: RequeueOutboxRequest{IDs: ids}, auth: true}, &result); err != nil {
+		return 0, err
+	}
+	return result.Requeued, nil
+}
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// CreatePlayer membuat player baru (POST /players)
+func (c *Client) CreatePlayer(ctx context.Context, req CreatePlayerRequest) (*Player, error) {
+	var player Player
+	if err := c.do(ctx, request{method: http.MethodPost, path: "/players", body: req, auth: true}, &player); err != nil {
+		return nil, err
+	}
+	return &player, nil
+}
+
+// ListTeamPlayers mengambil player sebuah team (GET /teams/{id}/players).
+// Jika availableOn diisi, hanya player yang tersedia pada tanggal itu yang dikembalikan.
+func (c *Client) ListTeamPlayers(ctx context.Context, teamID uint, availableOn *Date) ([]Player, error) {
+	query := url.Values{}
+	if availableOn != nil {
+		query.Set("available_on", availableOn.String())
+	}
+
+	var players []Player
+	err := c.do(ctx, request{method: http.MethodGet, path: idPath("/teams/%d/players", teamID), query: query, auth: true}, &players)
+	if err != nil {
+		return nil, err
+	}
+	return players, nil
+}
+
+// UpdatePlayer memperbarui sebagian data player (PUT /players/{id})
+func (c *Client) UpdatePlayer(ctx context.Context, id uint, req UpdatePlayerRequest) (*Player, error) {
+	var player Player
+	if err := c.do(ctx, request{method: http.MethodPut, path: idPath("/players/%d", id), body: req, auth: true}, &player); err != nil {
+		return nil, err
+	}
+	return &player, nil
+}
+
+// DeletePlayer menghapus player (DELETE /players/{id})
+func (c *Client) DeletePlayer(ctx context.Context, id uint) error {
+	return c.do(ctx, request{method: http.MethodDelete, path: idPath("/players/%d", id), auth: true}, nil)
+}
+
+// CreateAvailability mencatat periode ketersediaan player (POST /players/{id}/availability)
+func (c *Client) CreateAvailability(ctx context.Context, playerID uint, req PlayerAvailabilityRequest) (*PlayerAvailability, error) {
+	var availability PlayerAvailability
+	err := c.do(ctx, request{method: http.MethodPost, path: idPath("/players/%d/availability", playerID), body: req, auth: true}, &availability)
+	if err != nil {
+		return nil, err
+	}
+	return &availability, nil
+}
+
+// ListAvailability mengambil riwayat ketersediaan player (GET /players/{id}/availability)
+func (c *Client) ListAvailability(ctx context.Context, playerID uint) ([]PlayerAvailability, error) {
+	var availabilities []PlayerAvailability
+	err := c.do(ctx, request{method: http.MethodGet, path: idPath("/players/%d/availability", playerID), auth: true}, &availabilities)
+	if err != nil {
+		return nil, err
+	}
+	return availabilities, nil
+}
+
+// UpdateAvailability memperbarui periode ketersediaan (PUT /players/{id}/availability/{availability_id})
+func (c *Client) UpdateAvailability(ctx context.Context, playerID, availabilityID uint, req PlayerAvailabilityRequest) (*PlayerAvailability, error) {
+	var availability PlayerAvailability
+	path := idPath("/players/%d/availability/%d", playerID, availabilityID)
+	if err := c.do(ctx, request{method: http.MethodPut, path: path, body: req, auth: true}, &availability); err != nil {
+		return nil, err
+	}
+	return &availability, nil
+}
+
+// DeleteAvailability menghapus periode ketersediaan (DELETE /players/{id}/availability/{availability_id})
+func (c *Client) DeleteAvailability(ctx context.Context, playerID, availabilityID uint) error {
+	path := idPath("/players/%d/availability/%d", playerID, availabilityID)
+	return c.do(ctx, request{method: http.MethodDelete, path: path, auth: true}, nil)
+}
@@ -0,0 +1,25 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// Health memeriksa apakah server berjalan (GET /health, tanpa autentikasi)
+func (c *Client) Health(ctx context.Context) (*HealthResponse, error) {
+	var res HealthResponse
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/health"}, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// OpenAPISpec mengunduh dokumen OpenAPI 3 API (GET /openapi.json, tanpa autentikasi)
+func (c *Client) OpenAPISpec(ctx context.Context) ([]byte, error) {
+	return c.download(ctx, request{method: http.MethodGet, path: "/openapi.json"})
+}
+
+// DocsPage mengunduh halaman HTML dokumentasi interaktif (GET /docs, tanpa autentikasi)
+func (c *Client) DocsPage(ctx context.Context) ([]byte, error) {
+	return c.download(ctx, request{method: http.MethodGet, path: "/docs"})
+}
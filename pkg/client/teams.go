@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// CreateTeam membuat team baru (POST /teams)
+func (c *Client) CreateTeam(ctx context.Context, req TeamRequest) (*Team, error) {
+	var team Team
+	if err := c.do(ctx, request{method: http.MethodPost, path: "/teams", body: req, auth: true}, &team); err != nil {
+		return nil, err
+	}
+	return &team, nil
+}
+
+// ListTeams mengambil semua team (GET /teams)
+func (c *Client) ListTeams(ctx context.Context) ([]Team, error) {
+	var teams []Team
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/teams", auth: true}, &teams); err != nil {
+		return nil, err
+	}
+	return teams, nil
+}
+
+// GetTeam mengambil satu team (GET /teams/{id})
+func (c *Client) GetTeam(ctx context.Context, id uint) (*Team, error) {
+	var team Team
+	if err := c.do(ctx, request{method: http.MethodGet, path: idPath("/teams/%d", id), auth: true}, &team); err != nil {
+		return nil, err
+	}
+	return &team, nil
+}
+
+// UpdateTeam memperbarui team (PUT /teams/{id})
+func (c *Client) UpdateTeam(ctx context.Context, id uint, req TeamRequest) (*Team, error) {
+	var team Team
+	if err := c.do(ctx, request{method: http.MethodPut, path: idPath("/teams/%d", id), body: req, auth: true}, &team); err != nil {
+		return nil, err
+	}
+	return &team, nil
+}
+
+// DeleteTeam menghapus team (DELETE /teams/{id})
+func (c *Client) DeleteTeam(ctx context.Context, id uint) error {
+	return c.do(ctx, request{method: http.MethodDelete, path: idPath("/teams/%d", id), auth: true}, nil)
+}
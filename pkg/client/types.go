@@ -0,0 +1,601 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Date adalah tanggal tanpa jam, dikirim sebagai YYYY-MM-DD
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// dateLayout adalah format tanggal yang diterima API
+const dateLayout = "2006-01-02"
+
+// DateOf mengambil tanggal dari t sesuai zona waktu t
+func DateOf(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{Year: year, Month: month, Day: day}
+}
+
+// ParseDate membaca tanggal berformat YYYY-MM-DD
+func ParseDate(value string) (Date, error) {
+	t, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return Date{}, err
+	}
+	return DateOf(t), nil
+}
+
+// IsZero memeriksa apakah tanggal belum diisi
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// String mengembalikan tanggal berformat YYYY-MM-DD
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// MarshalJSON meng-encode tanggal sebagai "YYYY-MM-DD"
+func (d Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON membaca "YYYY-MM-DD" atau timestamp RFC3339 (bentuk yang dikirim server untuk kolom date)
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		*d = DateOf(t)
+		return nil
+	}
+	parsed, err := ParseDate(strings.TrimSpace(value))
+	if err != nil {
+		return fmt.Errorf("client: tanggal tidak valid: %q", value)
+	}
+	*d = parsed
+	return nil
+}
+
+// HealthResponse adalah response GET /health
+type HealthResponse struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// Team adalah data team
+type Team struct {
+	ID                  uint      `json:"id"`
+	Name                string    `json:"name"`
+	LogoURL             *string   `json:"logo_url,omitempty"`
+	FoundedYear         *int      `json:"founded_year,omitempty"`
+	HeadquartersAddress *string   `json:"headquarters_address,omitempty"`
+	HeadquartersCity    *string   `json:"headquarters_city,omitempty"`
+	HomeVenueID         *uint     `json:"home_venue_id,omitempty"`
+	HomeVenue           *Venue    `json:"home_venue,omitempty"`
+	Players             []Player  `json:"players,omitempty"`
+	CreatedAt           time.Time `json:"created_at"`
+	UpdatedAt           time.Time `json:"updated_at"`
+}
+
+// TeamRequest adalah body create/update team. Saat update, field pointer yang nil tidak diubah.
+type TeamRequest struct {
+	Name                string  `json:"name"`
+	LogoURL             *string `json:"logo_url,omitempty"`
+	FoundedYear         *int    `json:"founded_year,omitempty"`
+	HeadquartersAddress *string `json:"headquarters_address,omitempty"`
+	HeadquartersCity    *string `json:"headquarters_city,omitempty"`
+	HomeVenueID         *uint   `json:"home_venue_id,omitempty"`
+}
+
+// Posisi player yang valid
+const (
+	PositionForward    = "penyerang"
+	PositionMidfielder = "gelandang"
+	PositionDefender   = "bertahan"
+	PositionGoalkeeper = "penjaga gawang"
+)
+
+// Player adalah data player
+type Player struct {
+	ID           uint      `json:"id"`
+	TeamID       uint      `json:"team_id"`
+	Name         string    `json:"name"`
+	HeightCm     *int      `json:"height_cm,omitempty"`
+	WeightKg     *int      `json:"weight_kg,omitempty"`
+	Position     string    `json:"position"`
+	JerseyNumber int       `json:"jersey_number"`
+	Team         *Team     `json:"team,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// CreatePlayerRequest adalah body POST /players
+type CreatePlayerRequest struct {
+	Name         string `json:"name"`
+	TeamID       uint   `json:"team_id"`
+	Position     string `json:"position"`
+	JerseyNumber int    `json:"jersey_number"`
+	HeightCm     *int   `json:"height_cm,omitempty"`
+	WeightKg     *int   `json:"weight_kg,omitempty"`
+}
+
+// UpdatePlayerRequest adalah body PUT /players/{id}; field yang nil tidak diubah
+type UpdatePlayerRequest struct {
+	Name         *string `json:"name,omitempty"`
+	HeightCm     *int    `json:"height_cm,omitempty"`
+	WeightKg     *int    `json:"weight_kg,omitempty"`
+	Position     *string `json:"position,omitempty"`
+	JerseyNumber *int    `json:"jersey_number,omitempty"`
+}
+
+// AvailabilityStatus adalah status ketersediaan player
+type AvailabilityStatus string
+
+const (
+	AvailabilityAvailable         AvailabilityStatus = "available"
+	AvailabilityInjured           AvailabilityStatus = "injured"
+	AvailabilityIll               AvailabilityStatus = "ill"
+	AvailabilityInternationalDuty AvailabilityStatus = "international_duty"
+	AvailabilitySuspended         AvailabilityStatus = "suspended"
+)
+
+// PlayerAvailability adalah satu periode ketersediaan player, dari StartDate sampai
+// ExpectedReturnDate (eksklusif)
+type PlayerAvailability struct {
+	ID                 uint               `json:"id"`
+	PlayerID           uint               `json:"player_id"`
+	Status             AvailabilityStatus `json:"status"`
+	Notes              *string            `json:"notes,omitempty"`
+	StartDate          Date               `json:"start_date"`
+	ExpectedReturnDate *Date              `json:"expected_return_date,omitempty"`
+	CreatedAt          time.Time          `json:"created_at"`
+	UpdatedAt          time.Time          `json:"updated_at"`
+}
+
+// PlayerAvailabilityRequest adalah body create/update ketersediaan player
+type PlayerAvailabilityRequest struct {
+	Status             AvailabilityStatus `json:"status"`
+	Notes              *string            `json:"notes,omitempty"`
+	StartDate          Date               `json:"start_date"`
+	ExpectedReturnDate *Date              `json:"expected_return_date,omitempty"`
+}
+
+// Permukaan lapangan venue
+const (
+	SurfaceGrass      = "grass"
+	SurfaceArtificial = "artificial"
+	SurfaceHybrid     = "hybrid"
+)
+
+// Venue adalah data stadion
+type Venue struct {
+	ID        uint      `json:"id"`
+	Name      string    `json:"name"`
+	City      string    `json:"city"`
+	Capacity  int       `json:"capacity"`
+	Surface   string    `json:"surface"`
+	Latitude  *float64  `json:"latitude,omitempty"`
+	Longitude *float64  `json:"longitude,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// VenueRequest adalah body create/update venue. Surface kosong berarti grass (create) atau tidak diubah (update).
+type VenueRequest struct {
+	Name      string   `json:"name"`
+	City      string   `json:"city"`
+	Capacity  int      `json:"capacity"`
+	Surface   string   `json:"surface,omitempty"`
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+}
+
+// MatchStatus adalah status pertandingan
+type MatchStatus string
+
+const (
+	MatchScheduled MatchStatus = "scheduled"
+	MatchCompleted MatchStatus = "completed"
+	MatchCancelled MatchStatus = "cancelled"
+)
+
+// MatchResult adalah hasil akhir pertandingan
+type MatchResult string
+
+const (
+	ResultNotFinished      MatchResult = "not_finished"
+	ResultHomeWin          MatchResult = "home_win"
+	ResultAwayWin          MatchResult = "away_win"
+	ResultHomeWinPenalties MatchResult = "home_win_penalties"
+	ResultAwayWinPenalties MatchResult = "away_win_penalties"
+	ResultDraw             MatchResult = "draw"
+)
+
+// Match adalah data pertandingan
+type Match struct {
+	ID              uint            `json:"id"`
+	HomeTeamID      uint            `json:"home_team_id"`
+	AwayTeamID      uint            `json:"away_team_id"`
+	MatchDatetime   time.Time       `json:"match_datetime"`
+	Status          MatchStatus     `json:"status"`
+	HomeScore       int             `json:"home_score"`
+	AwayScore       int             `json:"away_score"`
+	VenueID         *uint           `json:"venue_id,omitempty"`
+	Attendance      *int            `json:"attendance,omitempty"`
+	CompetitionID   *uint           `json:"competition_id,omitempty"`
+	GroupID         *uint           `json:"group_id,omitempty"`
+	TieID           *uint           `json:"tie_id,omitempty"`
+	Leg             *int            `json:"leg,omitempty"`
+	ExtraTime       bool            `json:"extra_time"`
+	HomePenalties   *int            `json:"home_penalties,omitempty"`
+	AwayPenalties   *int            `json:"away_penalties,omitempty"`
+	ScheduleVersion int             `json:"schedule_version"`
+	HomeTeam        *Team           `json:"home_team,omitempty"`
+	AwayTeam        *Team           `json:"away_team,omitempty"`
+	Venue           *Venue          `json:"venue,omitempty"`
+	Goals           []Goal          `json:"goals,omitempty"`
+	Officials       []MatchOfficial `json:"officials,omitempty"`
+	CreatedAt       time.Time       `json:"created_at"`
+	UpdatedAt       time.Time       `json:"updated_at"`
+}
+
+// Goal adalah satu gol dalam pertandingan
+type Goal struct {
+	ID        uint      `json:"id"`
+	MatchID   uint      `json:"match_id"`
+	PlayerID  uint      `json:"player_id"`
+	GoalTime  int       `json:"goal_time"`
+	Player    *Player   `json:"player,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CreateMatchRequest adalah body POST /matches
+type CreateMatchRequest struct {
+	HomeTeamID    uint      `json:"home_team_id"`
+	AwayTeamID    uint      `json:"away_team_id"`
+	MatchDatetime time.Time `json:"match_datetime"`
+	VenueID       *uint     `json:"venue_id,omitempty"`
+}
+
+// RescheduleMatchRequest adalah body PATCH /matches/{id}; field yang nil tidak diubah.
+// Status hanya boleh MatchScheduled atau MatchCancelled.
+type RescheduleMatchRequest struct {
+	MatchDatetime *time.Time   `json:"match_datetime,omitempty"`
+	VenueID       *uint        `json:"venue_id,omitempty"`
+	Status        *MatchStatus `json:"status,omitempty"`
+}
+
+// ReportMatchResultRequest adalah body POST /matches/{id}/result.
+// ExtraTime dan Penalties hanya berlaku untuk leg penentu pada kompetisi cup.
+type ReportMatchResultRequest struct {
+	HomeScore  int              `json:"home_score"`
+	AwayScore  int              `json:"away_score"`
+	Attendance *int             `json:"attendance,omitempty"`
+	ExtraTime  bool             `json:"extra_time,omitempty"`
+	Penalties  *PenaltyShootout `json:"penalties,omitempty"`
+	Goals      []GoalRequest    `json:"goals"`
+}
+
+// PenaltyShootout adalah skor adu penalti
+type PenaltyShootout struct {
+	Home int `json:"home"`
+	Away int `json:"away"`
+}
+
+// GoalRequest adalah satu gol dalam laporan hasil pertandingan
+type GoalRequest struct {
+	PlayerID uint `json:"player_id"`
+	GoalTime int  `json:"goal_time"`
+}
+
+// MatchReport adalah laporan pertandingan (GET /matches/{id}/report)
+type MatchReport struct {
+	Schedule          string                `json:"schedule"`
+	HomeTeam          string                `json:"home_team"`
+	AwayTeam          string                `json:"away_team"`
+	Round             string                `json:"round,omitempty"`
+	Venue             string                `json:"venue,omitempty"`
+	Attendance        *int                  `json:"attendance,omitempty"`
+	FinalScore        string                `json:"final_score"`
+	ExtraTime         bool                  `json:"extra_time,omitempty"`
+	Penalties         string                `json:"penalties,omitempty"`
+	MatchResult       MatchResult           `json:"match_result"`
+	MatchResultLabel  string                `json:"match_result_label"`
+	TopScorerInMatch  string                `json:"top_scorer_in_match"`
+	HomeTeamTotalWins int64                 `json:"home_team_total_wins"`
+	AwayTeamTotalWins int64                 `json:"away_team_total_wins"`
+	Officials         []MatchReportOfficial `json:"officials,omitempty"`
+}
+
+// MatchReportOfficial adalah official dalam laporan pertandingan
+type MatchReportOfficial struct {
+	Role         OfficialRole `json:"role"`
+	Name         string       `json:"name"`
+	LicenseLevel *string      `json:"license_level,omitempty"`
+}
+
+// OfficialRole adalah peran perangkat pertandingan
+type OfficialRole string
+
+const (
+	RoleReferee          OfficialRole = "referee"
+	RoleAssistantReferee OfficialRole = "assistant_referee"
+	RoleFourthOfficial   OfficialRole = "fourth_official"
+	RoleVAR              OfficialRole = "var"
+)
+
+// Official adalah wasit atau perangkat pertandingan
+type Official struct {
+	ID              uint      `json:"id"`
+	Name            string    `json:"name"`
+	LicenseLevel    *string   `json:"license_level,omitempty"`
+	City            *string   `json:"city,omitempty"`
+	ConflictedTeams []Team    `json:"conflicted_teams,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// OfficialRequest adalah body create/update official
+type OfficialRequest struct {
+	Name              string  `json:"name"`
+	LicenseLevel      *string `json:"license_level,omitempty"`
+	City              *string `json:"city,omitempty"`
+	ConflictedTeamIDs []uint  `json:"conflicted_team_ids,omitempty"`
+}
+
+// MatchOfficial adalah penugasan official ke pertandingan
+type MatchOfficial struct {
+	ID         uint         `json:"id"`
+	MatchID    uint         `json:"match_id"`
+	OfficialID uint         `json:"official_id"`
+	Role       OfficialRole `json:"role"`
+	Official   *Official    `json:"official,omitempty"`
+	CreatedAt  time.Time    `json:"created_at"`
+}
+
+// AssignOfficialRequest adalah body POST /matches/{id}/officials
+type AssignOfficialRequest struct {
+	OfficialID uint         `json:"official_id"`
+	Role       OfficialRole `json:"role"`
+}
+
+// Format dan cara seeding kompetisi
+const (
+	FormatCup           = "cup"
+	FormatGroupKnockout = "group_knockout"
+	SeedingSeeded       = "seeded"
+	SeedingDrawn        = "drawn"
+)
+
+// Competition adalah data kompetisi (cup atau fase grup + knockout)
+type Competition struct {
+	ID                    uint              `json:"id"`
+	Name                  string            `json:"name"`
+	Format                string            `json:"format"`
+	Seeding               string            `json:"seeding"`
+	TwoLegged             bool              `json:"two_legged"`
+	AwayGoalsRule         bool              `json:"away_goals_rule"`
+	StartDatetime         time.Time         `json:"start_datetime"`
+	RoundIntervalDays     int               `json:"round_interval_days"`
+	LegIntervalDays       int               `json:"leg_interval_days"`
+	GroupCount            int               `json:"group_count,omitempty"`
+	QualifiersPerGroup    int               `json:"qualifiers_per_group,omitempty"`
+	KnockoutStartDatetime *time.Time        `json:"knockout_start_datetime,omitempty"`
+	Status                string            `json:"status"`
+	ChampionTeamID        *uint             `json:"champion_team_id,omitempty"`
+	ChampionTeam          *Team             `json:"champion_team,omitempty"`
+	Teams                 []CompetitionTeam `json:"teams,omitempty"`
+	CreatedAt             time.Time         `json:"created_at"`
+	UpdatedAt             time.Time         `json:"updated_at"`
+}
+
+// CompetitionTeam adalah peserta kompetisi
+type CompetitionTeam struct {
+	ID            uint  `json:"id"`
+	CompetitionID uint  `json:"competition_id"`
+	TeamID        uint  `json:"team_id"`
+	Seed          int   `json:"seed"`
+	GroupID       *uint `json:"group_id,omitempty"`
+	Team          *Team `json:"team,omitempty"`
+}
+
+// CreateCompetitionRequest adalah body POST /competitions.
+// TeamIDs diurutkan sesuai seed; GroupCount dan QualifiersPerGroup wajib untuk FormatGroupKnockout.
+type CreateCompetitionRequest struct {
+	Name               string    `json:"name"`
+	Format             string    `json:"format"`
+	Seeding            string    `json:"seeding,omitempty"`
+	TwoLegged          bool      `json:"two_legged,omitempty"`
+	AwayGoalsRule      bool      `json:"away_goals_rule,omitempty"`
+	StartDatetime      time.Time `json:"start_datetime"`
+	RoundIntervalDays  int       `json:"round_interval_days,omitempty"`
+	LegIntervalDays    int       `json:"leg_interval_days,omitempty"`
+	GroupCount         int       `json:"group_count,omitempty"`
+	QualifiersPerGroup int       `json:"qualifiers_per_group,omitempty"`
+	TeamIDs            []uint    `json:"team_ids"`
+}
+
+// Bracket adalah bracket knockout kompetisi
+type Bracket struct {
+	Competition Competition    `json:"competition"`
+	Rounds      []BracketRound `json:"rounds"`
+}
+
+// BracketRound adalah satu babak dalam bracket
+type BracketRound struct {
+	Round int          `json:"round"`
+	Name  string       `json:"name"`
+	Ties  []BracketTie `json:"ties"`
+}
+
+// BracketTie adalah satu pertemuan (satu atau dua leg) dalam bracket
+type BracketTie struct {
+	TieID      uint           `json:"tie_id"`
+	Position   int            `json:"position"`
+	HomeTeam   *Team          `json:"home_team"`
+	AwayTeam   *Team          `json:"away_team"`
+	Aggregate  string         `json:"aggregate,omitempty"`
+	WinnerTeam *Team          `json:"winner_team,omitempty"`
+	Matches    []BracketMatch `json:"matches"`
+}
+
+// BracketMatch adalah satu leg dalam bracket
+type BracketMatch struct {
+	MatchID       uint        `json:"match_id"`
+	Leg           int         `json:"leg"`
+	MatchDatetime time.Time   `json:"match_datetime"`
+	Status        MatchStatus `json:"status"`
+	HomeTeamID    uint        `json:"home_team_id"`
+	AwayTeamID    uint        `json:"away_team_id"`
+	HomeScore     int         `json:"home_score"`
+	AwayScore     int         `json:"away_score"`
+	ExtraTime     bool        `json:"extra_time"`
+	HomePenalties *int        `json:"home_penalties,omitempty"`
+	AwayPenalties *int        `json:"away_penalties,omitempty"`
+}
+
+// Group adalah klasemen dan jadwal satu grup
+type Group struct {
+	GroupID uint          `json:"group_id"`
+	Name    string        `json:"name"`
+	Table   []StandingRow `json:"table"`
+	Matches []Match       `json:"matches"`
+}
+
+// StandingRow adalah satu baris klasemen grup
+type StandingRow struct {
+	Position       int    `json:"position"`
+	TeamID         uint   `json:"team_id"`
+	TeamName       string `json:"team_name"`
+	Played         int    `json:"played"`
+	Won            int    `json:"won"`
+	Drawn          int    `json:"drawn"`
+	Lost           int    `json:"lost"`
+	GoalsFor       int    `json:"goals_for"`
+	GoalsAgainst   int    `json:"goals_against"`
+	GoalDifference int    `json:"goal_difference"`
+	Points         int    `json:"points"`
+}
+
+// Qualification adalah skenario kualifikasi semua tim dalam satu grup
+type Qualification struct {
+	GroupName        string              `json:"group_name"`
+	RemainingMatches int                 `json:"remaining_matches"`
+	Teams            []QualificationTeam `json:"teams"`
+}
+
+// QualificationTeam adalah posisi terbaik/terburuk yang masih mungkin dicapai satu tim
+type QualificationTeam struct {
+	TeamID          uint   `json:"team_id"`
+	TeamName        string `json:"team_name"`
+	CurrentPosition int    `json:"current_position"`
+	Points          int    `json:"points"`
+	MaxPoints       int    `json:"max_points"`
+	BestPosition    int    `json:"best_position"`
+	WorstPosition   int    `json:"worst_position"`
+	Status          string `json:"status"`
+}
+
+// Webhook adalah subscription webhook. Secret tidak pernah dikembalikan API.
+type Webhook struct {
+	ID        uint      `json:"id"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// WebhookRequest adalah body create/update webhook. Secret wajib saat membuat (minimal
+// 16 karakter); kosongkan saat update untuk memakai secret lama. Active hanya dipakai saat update.
+type WebhookRequest struct {
+	URL    string   `json:"url"`
+	Secret string   `json:"secret,omitempty"`
+	Events []string `json:"events"`
+	Active *bool    `json:"active,omitempty"`
+}
+
+// WebhookDelivery adalah satu entri log pengiriman webhook beserta payload-nya
+type WebhookDelivery struct {
+	ID             uint            `json:"id"`
+	SubscriptionID uint            `json:"subscription_id"`
+	EventID        string          `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  *time.Time      `json:"next_attempt_at,omitempty"`
+	LastAttemptAt  *time.Time      `json:"last_attempt_at,omitempty"`
+	ResponseStatus *int            `json:"response_status,omitempty"`
+	LastError      *string         `json:"last_error,omitempty"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+	Payload        json.RawMessage `json:"payload"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+// CalendarToken adalah token feed kalender beserta path feed-nya ({id} diganti ID team/kompetisi)
+type CalendarToken struct {
+	Token           string `json:"token"`
+	TeamFeed        string `json:"team_feed"`
+	CompetitionFeed string `json:"competition_feed"`
+}
+
+// ImportResult adalah hasil import file. Errors berisi kesalahan per baris (row = nomor baris di file, header = 1).
+type ImportResult struct {
+	DryRun    bool             `json:"dry_run"`
+	TotalRows int              `json:"total_rows"`
+	Imported  int              `json:"imported"`
+	Errors    []ImportRowError `json:"errors,omitempty"`
+}
+
+// ImportRowError adalah kesalahan validasi pada satu baris file import
+type ImportRowError struct {
+	Row    int          `json:"row"`
+	Fields []FieldError `json:"fields"`
+}
+
+// User adalah akun yang bisa login selain admin dari konfigurasi server
+type User struct {
+	ID        uint      `json:"id"`
+	Username  string    `json:"username"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// UserRequest adalah body POST /users. Password minimal 8 karakter.
+type UserRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// OutboxEvent adalah event outbox yang diparkir dispatcher setelah terus gagal dipublikasikan
+type OutboxEvent struct {
+	ID           uint            `json:"id"`
+	EventID      string          `json:"event_id"`
+	EventType    string          `json:"event_type"`
+	OccurredAt   time.Time       `json:"occurred_at"`
+	Attempts     int             `json:"attempts"`
+	LastError    *string         `json:"last_error,omitempty"`
+	FailingSince *time.Time      `json:"failing_since,omitempty"`
+	ParkedAt     *time.Time      `json:"parked_at,omitempty"`
+	Payload      json.RawMessage `json:"payload"`
+	CreatedAt    time.Time       `json:"created_at"`
+}
+
+// RequeueOutboxRequest adalah body POST /outbox/requeue. IDs kosong berarti semua event yang diparkir.
+type RequeueOutboxRequest struct {
+	IDs []uint `json:"ids,omitempty"`
+}
+
+// RequeueOutboxResult berisi jumlah event yang dikembalikan ke antrean
+type RequeueOutboxResult struct {
+	Requeued int64 `json:"requeued"`
+}
@@ -0,0 +1,15 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// CreateUser membuat user yang bisa login lewat Login (POST /users)
+func (c *Client) CreateUser(ctx context.Context, req UserRequest) (*User, error) {
+	var user User
+	if err := c.do(ctx, request{method: http.MethodPost, path: "/users", body: req, auth: true}, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// CreateVenue membuat venue baru (POST /venues)
+func (c *Client) CreateVenue(ctx context.Context, req VenueRequest) (*Venue, error) {
+	var venue Venue
+	if err := c.do(ctx, request{method: http.MethodPost, path: "/venues", body: req, auth: true}, &venue); err != nil {
+		return nil, err
+	}
+	return &venue, nil
+}
+
+// ListVenues mengambil semua venue (GET /venues)
+func (c *Client) ListVenues(ctx context.Context) ([]Venue, error) {
+	var venues []Venue
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/venues", auth: true}, &venues); err != nil {
+		return nil, err
+	}
+	return venues, nil
+}
+
+// GetVenue mengambil satu venue (GET /venues/{id})
+func (c *Client) GetVenue(ctx context.Context, id uint) (*Venue, error) {
+	var venue Venue
+	if err := c.do(ctx, request{method: http.MethodGet, path: idPath("/venues/%d", id), auth: true}, &venue); err != nil {
+		return nil, err
+	}
+	return &venue, nil
+}
+
+// UpdateVenue memperbarui venue (PUT /venues/{id})
+func (c *Client) UpdateVenue(ctx context.Context, id uint, req VenueRequest) (*Venue, error) {
+	var venue Venue
+	if err := c.do(ctx, request{method: http.MethodPut, path: idPath("/venues/%d", id), body: req, auth: true}, &venue); err != nil {
+		return nil, err
+	}
+	return &venue, nil
+}
+
+// DeleteVenue menghapus venue (DELETE /venues/{id})
+func (c *Client) DeleteVenue(ctx context.Context, id uint) error {
+	return c.do(ctx, request{method: http.MethodDelete, path: idPath("/venues/%d", id), auth: true}, nil)
+}
@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// CreateWebhook mendaftarkan subscription webhook (POST /webhooks)
+func (c *Client) CreateWebhook(ctx context.Context, req WebhookRequest) (*Webhook, error) {
+	var webhook Webhook
+	if err := c.do(ctx, request{method: http.MethodPost, path: "/webhooks", body: req, auth: true}, &webhook); err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// ListWebhooks mengambil semua subscription webhook (GET /webhooks)
+func (c *Client) ListWebhooks(ctx context.Context) ([]Webhook, error) {
+	var webhooks []Webhook
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/webhooks", auth: true}, &webhooks); err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+// GetWebhook mengambil satu subscription webhook (GET /webhooks/{id})
+func (c *Client) GetWebhook(ctx context.Context, id uint) (*Webhook, error) {
+	var webhook Webhook
+	if err := c.do(ctx, request{method: http.MethodGet, path: idPath("/webhooks/%d", id), auth: true}, &webhook); err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// UpdateWebhook memperbarui subscription webhook (PUT /webhooks/{id})
+func (c *Client) UpdateWebhook(ctx context.Context, id uint, req WebhookRequest) (*Webhook, error) {
+	var webhook Webhook
+	if err := c.do(ctx, request{method: http.MethodPut, path: idPath("/webhooks/%d", id), body: req, auth: true}, &webhook); err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// DeleteWebhook menghapus subscription webhook (DELETE /webhooks/{id})
+func (c *Client) DeleteWebhook(ctx context.Context, id uint) error {
+	return c.do(ctx, request{method: http.MethodDelete, path: idPath("/webhooks/%d", id), auth: true}, nil)
+}
+
+// ListDeliveries mengambil log pengiriman terbaru (GET /webhooks/{id}/deliveries).
+// limit nol berarti default server (50).
+func (c *Client) ListDeliveries(ctx context.Context, webhookID uint, limit int) ([]WebhookDelivery, error) {
+	query := url.Values{}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+
+	var deliveries []WebhookDelivery
+	err := c.do(ctx, request{method: http.MethodGet, path: idPath("/webhooks/%d/deliveries", webhookID), query: query, auth: true}, &deliveries)
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// Redeliver menjadwalkan ulang pengiriman yang gagal
+// (POST /webhooks/{id}/deliveries/{delivery_id}/redeliver)
+func (c *Client) Redeliver(ctx context.Context, webhookID, deliveryID uint) (*WebhookDelivery, error) {
+	var delivery WebhookDelivery
+	path := idPath("/webhooks/%d/deliveries/%d/redeliver", webhookID, deliveryID)
+	if err := c.do(ctx, request{method: http.MethodPost, path: path, auth: true}, &delivery); err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
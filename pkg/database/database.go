@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"log"
 	"xyz-football-api/config"
-	"xyz-football-api/internal/model"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -14,12 +13,22 @@ import (
 // DB adalah instance global database
 var DB *gorm.DB
 
-// InitDatabase menginisialisasi koneksi ke database PostgreSQL
+// InitDatabase menginisialisasi koneksi ke database sesuai DB_DRIVER (PostgreSQL atau SQLite)
 func InitDatabase(cfg *config.DatabaseConfig) error {
-	dsn := cfg.GetDSN()
+	var dialector gorm.Dialector
+	switch cfg.Driver {
+	case config.DriverSQLite:
+		var err error
+		dialector, err = openSQLite(cfg.SQLitePath)
+		if err != nil {
+			return fmt.Errorf("gagal membuka database SQLite: %w", err)
+		}
+	default:
+		dialector = postgres.Open(cfg.GetDSN())
+	}
 
 	var err error
-	DB, err = gorm.Open(postgres.Open(dsn), &gorm.Config{
+	DB, err = gorm.Open(dialector, &gorm.Config{
 		Logger: logger.Default.LogMode(logger.Info),
 	})
 
@@ -27,31 +36,31 @@ func InitDatabase(cfg *config.DatabaseConfig) error {
 		return fmt.Errorf("gagal koneksi ke database: %w", err)
 	}
 
-	log.Println("✓ Koneksi database berhasil")
+	log.Printf("✓ Koneksi database berhasil (%s)", DB.Dialector.Name())
 
 	return nil
 }
 
-// AutoMigrate menjalankan migrasi otomatis untuk semua model
-func AutoMigrate() error {
-	log.Println("Menjalankan auto migration...")
-
-	err := DB.AutoMigrate(
-		&model.Team{},
-		&model.Player{},
-		&model.Match{},
-		&model.Goal{},
-	)
-
+// CheckSchema memastikan semua migrasi sudah dijalankan sebelum server melayani request
+func CheckSchema() error {
+	migrator, err := NewMigrator(DB)
 	if err != nil {
-		return fmt.Errorf("gagal melakukan auto migrate: %w", err)
+		return err
 	}
-
-	log.Println("✓ Auto migration selesai")
-	return nil
+	return migrator.CheckSchema()
 }
 
 // GetDB mengembalikan instance database
 func GetDB() *gorm.DB {
 	return DB
 }
+
+// Close menutup connection pool database. Dipanggil paling akhir saat shutdown,
+// setelah server dan worker tidak lagi memakai database.
+func Close() error {
+	sqlDB, err := DB.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
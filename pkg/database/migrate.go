@@ -0,0 +1,352 @@
+package database
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// migrationFiles berisi file migrasi SQL setiap dialect yang ikut di-embed ke dalam binary
+//
+//go:embed migrations/postgres/*.sql migrations/sqlite/*.sql
+var migrationFiles embed.FS
+
+// MigrationsDir adalah lokasi default file migrasi di source tree (dipakai oleh `migrate create`).
+// Setiap dialect memiliki subdirektori sendiri dengan nomor versi yang sama.
+const MigrationsDir = "pkg/database/migrations"
+
+// dialects adalah dialect database yang memiliki file migrasi
+var dialects = []string{"postgres", "sqlite"}
+
+// schemaMigrationsDDL adalah definisi tabel schema_migrations untuk setiap dialect
+var schemaMigrationsDDL = map[string]string{
+	"postgres": `CREATE TABLE IF NOT EXISTS schema_migrations (
+    version BIGINT PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    applied_at TIMESTAMPTZ NOT NULL
+)`,
+	"sqlite": `CREATE TABLE IF NOT EXISTS schema_migrations (
+    version BIGINT PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    applied_at DATETIME NOT NULL
+)`,
+}
+
+// migrationFilePattern mencocokkan nama file seperti 0001_create_core_tables.up.sql
+var migrationFilePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// migrationNameReplacer mengganti karakter yang tidak valid pada nama migrasi baru
+var migrationNameReplacer = regexp.MustCompile(`[^a-z0-9]+`)
+
+// ErrSchemaBehind dikembalikan jika masih ada migrasi yang belum dijalankan
+var ErrSchemaBehind = errors.New("skema database belum up to date")
+
+// Migration merepresentasikan satu migrasi bernomor beserta SQL up dan down
+type Migration struct {
+	Version uint
+	Name    string
+	Up      string
+	Down    string
+}
+
+// SchemaMigration merepresentasikan tabel schema_migrations yang mencatat migrasi yang sudah dijalankan
+type SchemaMigration struct {
+	Version   uint      `gorm:"primaryKey;autoIncrement:false" json:"version"`
+	Name      string    `gorm:"type:varchar(255);not null" json:"name"`
+	AppliedAt time.Time `gorm:"not null" json:"applied_at"`
+}
+
+// TableName menentukan nama tabel untuk model SchemaMigration
+func (SchemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// MigrationStatus adalah status satu migrasi terhadap database
+type MigrationStatus struct {
+	Version   uint
+	Name      string
+	Applied   bool
+	AppliedAt *time.Time
+}
+
+// Migrator menjalankan migrasi bernomor terhadap database
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+// NewMigrator membuat instance Migrator baru dengan migrasi yang di-embed di binary
+// untuk dialect koneksi db
+func NewMigrator(db *gorm.DB) (*Migrator, error) {
+	dialect := db.Dialector.Name()
+	if _, ok := schemaMigrationsDDL[dialect]; !ok {
+		return nil, fmt.Errorf("dialect database %s tidak memiliki migrasi", dialect)
+	}
+
+	sub, err := fs.Sub(migrationFiles, "migrations/"+dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	migrations, err := LoadMigrations(sub)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// LoadMigrations membaca dan memvalidasi pasangan file up/down di root filesystem
+func LoadMigrations(fsys fs.FS) ([]Migration, error) {
+	paths, err := fs.Glob(fsys, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[uint]*Migration)
+	for _, path := range paths {
+		matches := migrationFilePattern.FindStringSubmatch(path)
+		if matches == nil {
+			return nil, fmt.Errorf("nama file migrasi tidak valid: %s", path)
+		}
+
+		version, err := strconv.ParseUint(matches[1], 10, 32)
+		if err != nil || version == 0 {
+			return nil, fmt.Errorf("versi migrasi tidak valid: %s", path)
+		}
+
+		content, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[uint(version)]
+		if !ok {
+			migration = &Migration{Version: uint(version), Name: matches[2]}
+			byVersion[uint(version)] = migration
+		} else if migration.Name != matches[2] {
+			return nil, fmt.Errorf("versi migrasi %d dipakai oleh lebih dari satu nama", version)
+		}
+
+		if matches[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if strings.TrimSpace(migration.Up) == "" || strings.TrimSpace(migration.Down) == "" {
+			return nil, fmt.Errorf("migrasi %04d_%s harus memiliki file up dan down", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// ensureTable membuat tabel schema_migrations jika belum ada
+func (m *Migrator) ensureTable() error {
+	return m.db.Exec(schemaMigrationsDDL[m.db.Dialector.Name()]).Error
+}
+
+// run menjalankan SQL migrasi dan fn (pencatatan schema_migrations) dalam satu transaksi.
+// Pada SQLite foreign key dinonaktifkan selama migrasi agar tabel bisa dibangun ulang
+// tanpa memicu ON DELETE CASCADE, lalu diperiksa dengan foreign_key_check sebelum commit.
+func (m *Migrator) run(sql string, fn func(tx *gorm.DB) error) error {
+	sqlite := m.db.Dialector.Name() == "sqlite"
+	if sqlite {
+		if err := m.db.Exec("PRAGMA foreign_keys = OFF").Error; err != nil {
+			return err
+		}
+		defer m.db.Exec("PRAGMA foreign_keys = ON")
+	}
+
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(sql).Error; err != nil {
+			return err
+		}
+		if sqlite {
+			var violations []map[string]interface{}
+			if err := tx.Raw("PRAGMA foreign_key_check").Scan(&violations).Error; err != nil {
+				return err
+			}
+			if len(violations) > 0 {
+				return fmt.Errorf("migrasi melanggar %d foreign key", len(violations))
+			}
+		}
+		return fn(tx)
+	})
+}
+
+// applied mengambil migrasi yang sudah dijalankan, dikelompokkan berdasarkan versi
+func (m *Migrator) applied() (map[uint]SchemaMigration, error) {
+	if err := m.ensureTable(); err != nil {
+		return nil, fmt.Errorf("gagal menyiapkan tabel schema_migrations: %w", err)
+	}
+
+	var records []SchemaMigration
+	if err := m.db.Order("version").Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("gagal membaca schema_migrations: %w", err)
+	}
+
+	applied := make(map[uint]SchemaMigration, len(records))
+	for _, record := range records {
+		applied[record.Version] = record
+	}
+	return applied, nil
+}
+
+// Up menjalankan semua migrasi yang belum dijalankan secara berurutan.
+// Setiap migrasi berjalan di dalam transaksi sendiri.
+func (m *Migrator) Up() ([]Migration, error) {
+	applied, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+
+	var ran []Migration
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; ok {
+			continue
+		}
+
+		err := m.run(migration.Up, func(tx *gorm.DB) error {
+			return tx.Create(&SchemaMigration{
+				Version:   migration.Version,
+				Name:      migration.Name,
+				AppliedAt: time.Now(),
+			}).Error
+		})
+		if err != nil {
+			return ran, fmt.Errorf("gagal menjalankan migrasi %04d_%s: %w", migration.Version, migration.Name, err)
+		}
+
+		log.Printf("✓ Migrasi %04d_%s dijalankan", migration.Version, migration.Name)
+		ran = append(ran, migration)
+	}
+
+	return ran, nil
+}
+
+// Down me-rollback sejumlah steps migrasi terakhir yang sudah dijalankan
+func (m *Migrator) Down(steps int) ([]Migration, error) {
+	applied, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+
+	var rolledBack []Migration
+	for i := len(m.migrations) - 1; i >= 0 && len(rolledBack) < steps; i-- {
+		migration := m.migrations[i]
+		if _, ok := applied[migration.Version]; !ok {
+			continue
+		}
+
+		err := m.run(migration.Down, func(tx *gorm.DB) error {
+			return tx.Delete(&SchemaMigration{}, migration.Version).Error
+		})
+		if err != nil {
+			return rolledBack, fmt.Errorf("gagal rollback migrasi %04d_%s: %w", migration.Version, migration.Name, err)
+		}
+
+		log.Printf("✓ Migrasi %04d_%s di-rollback", migration.Version, migration.Name)
+		rolledBack = append(rolledBack, migration)
+	}
+
+	return rolledBack, nil
+}
+
+// Status mengembalikan status setiap migrasi yang dikenal binary
+func (m *Migrator) Status() ([]MigrationStatus, error) {
+	applied, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]MigrationStatus, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := MigrationStatus{Version: migration.Version, Name: migration.Name}
+		if record, ok := applied[migration.Version]; ok {
+			appliedAt := record.AppliedAt
+			status.Applied = true
+			status.AppliedAt = &appliedAt
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// CheckSchema memastikan semua migrasi sudah dijalankan.
+// Mengembalikan ErrSchemaBehind jika masih ada migrasi yang tertunda.
+func (m *Migrator) CheckSchema() error {
+	statuses, err := m.Status()
+	if err != nil {
+		return err
+	}
+
+	var pending []string
+	for _, status := range statuses {
+		if !status.Applied {
+			pending = append(pending, fmt.Sprintf("%04d_%s", status.Version, status.Name))
+		}
+	}
+
+	if len(pending) > 0 {
+		return fmt.Errorf("%w: %d migrasi tertunda (%s)", ErrSchemaBehind, len(pending), strings.Join(pending, ", "))
+	}
+	return nil
+}
+
+// CreateMigration membuat pasangan file up/down kosong dengan versi berikutnya
+// di subdirektori setiap dialect di dir
+func CreateMigration(dir, name string) ([]string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = migrationNameReplacer.ReplaceAllString(name, "_")
+	name = strings.Trim(name, "_")
+	if name == "" {
+		return nil, errors.New("nama migrasi tidak boleh kosong")
+	}
+
+	// Versi berikutnya diambil dari dialect dengan versi tertinggi agar nomor tetap sejajar
+	var next uint = 1
+	for _, dialect := range dialects {
+		migrations, err := LoadMigrations(os.DirFS(filepath.Join(dir, dialect)))
+		if err != nil {
+			return nil, err
+		}
+		if len(migrations) > 0 && migrations[len(migrations)-1].Version >= next {
+			next = migrations[len(migrations)-1].Version + 1
+		}
+	}
+
+	var created []string
+	for _, dialect := range dialects {
+		for _, direction := range []string{"up", "down"} {
+			path := filepath.Join(dir, dialect, fmt.Sprintf("%04d_%s.%s.sql", next, name, direction))
+			content := fmt.Sprintf("-- %04d_%s (%s, %s)\n", next, name, dialect, direction)
+			if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+				return created, fmt.Errorf("gagal membuat file migrasi: %w", err)
+			}
+			created = append(created, path)
+		}
+	}
+
+	return created, nil
+}
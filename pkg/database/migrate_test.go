@@ -0,0 +1,152 @@
+package database
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSQLiteMigrationsUpDown(t *testing.T) {
+	db, err := OpenSQLite(sqliteMemoryPath)
+	if err != nil {
+		t.Fatalf("OpenSQLite: %v", err)
+	}
+
+	migrator, err := NewMigrator(db)
+	if err != nil {
+		t.Fatalf("NewMigrator: %v", err)
+	}
+
+	if err := migrator.CheckSchema(); !errors.Is(err, ErrSchemaBehind) {
+		t.Fatalf("CheckSchema sebelum migrasi = %v, want ErrSchemaBehind", err)
+	}
+
+	ran, err := migrator.Up()
+	if err != nil {
+		t.Fatalf("Up: %v", err)
+	}
+	if len(ran) != len(migrator.migrations) {
+		t.Fatalf("Up menjalankan %d migrasi, want %d", len(ran), len(migrator.migrations))
+	}
+	if err := migrator.CheckSchema(); err != nil {
+		t.Fatalf("CheckSchema setelah Up: %v", err)
+	}
+
+	// Data harus bertahan saat tabel dibangun ulang oleh rollback dan migrasi ulang
+	kickoff := time.Date(2025, 3, 1, 19, 0, 0, 0, time.FixedZone("WIB", 7*3600))
+	statements := []struct {
+		sql  string
+		args []interface{}
+	}{
+		{"INSERT INTO venues (id, name, city, capacity) VALUES (1, 'Stadion Utama', 'Jakarta', 50000)", nil},
+		{"INSERT INTO teams (id, name, home_venue_id) VALUES (1, 'Garuda FC', 1), (2, 'Elang FC', NULL)", nil},
+		{"INSERT INTO players (id, team_id, name, position, jersey_number) VALUES (1, 1, 'Budi', 'penyerang', 9)", nil},
+		{"INSERT INTO matches (id, home_team_id, away_team_id, match_datetime, venue_id) VALUES (1, 1, 2, ?, 1)", []interface{}{kickoff}},
+		{"INSERT INTO goals (match_id, player_id, goal_time) VALUES (1, 1, 10)", nil},
+		{"INSERT INTO competitions (id, name, format, start_datetime) VALUES (1, 'Piala', 'cup', ?)", []interface{}{kickoff}},
+		{"INSERT INTO competition_teams (competition_id, team_id, seed) VALUES (1, 1, 1), (1, 2, 2)", nil},
+	}
+	for _, stmt := range statements {
+		if err := db.Exec(stmt.sql, stmt.args...).Error; err != nil {
+			t.Fatalf("%s: %v", stmt.sql, err)
+		}
+	}
+
+	if _, err := migrator.Down(len(migrator.migrations) - 2); err != nil {
+		t.Fatalf("Down: %v", err)
+	}
+
+	var goals int64
+	if err := db.Table("goals").Count(&goals).Error; err != nil || goals != 1 {
+		t.Fatalf("goals setelah rollback = %d (err %v), want 1", goals, err)
+	}
+	var storedKickoff string
+	if err := db.Raw("SELECT match_datetime || '' FROM matches WHERE id = 1").Scan(&storedKickoff).Error; err != nil {
+		t.Fatalf("select match: %v", err)
+	}
+	if storedKickoff != "2025-03-01 12:00:00+00:00" {
+		t.Errorf("match_datetime = %q, want disimpan dalam UTC", storedKickoff)
+	}
+
+	if _, err := migrator.Up(); err != nil {
+		t.Fatalf("Up setelah rollback: %v", err)
+	}
+	if err := db.Exec("INSERT INTO competitions (name, format, start_datetime) VALUES ('Liga', 'group_knockout', ?)", kickoff).Error; err != nil {
+		t.Fatalf("format group_knockout setelah migrasi ulang: %v", err)
+	}
+
+	if _, err := migrator.Down(len(migrator.migrations)); err == nil {
+		t.Fatal("Down seharusnya gagal selama masih ada kompetisi group_knockout")
+	}
+	if err := db.Exec("DELETE FROM competitions WHERE format = 'group_knockout'").Error; err != nil {
+		t.Fatalf("delete competition: %v", err)
+	}
+	if _, err := migrator.Down(len(migrator.migrations)); err != nil {
+		t.Fatalf("Down semua migrasi: %v", err)
+	}
+
+	var tables int64
+	db.Raw("SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name NOT IN ('schema_migrations', 'sqlite_sequence')").Scan(&tables)
+	if tables != 0 {
+		t.Errorf("masih ada %d tabel setelah rollback semua migrasi", tables)
+	}
+}
+
+func TestDialectMigrationsInSync(t *testing.T) {
+	versions := make(map[string][]string)
+	for _, dialect := range dialects {
+		migrations, err := LoadMigrations(os.DirFS(filepath.Join("migrations", dialect)))
+		if err != nil {
+			t.Fatalf("LoadMigrations(%s): %v", dialect, err)
+		}
+		for _, migration := range migrations {
+			versions[dialect] = append(versions[dialect], migration.Name)
+		}
+	}
+
+	postgres, sqlite := versions["postgres"], versions["sqlite"]
+	if len(postgres) != len(sqlite) {
+		t.Fatalf("jumlah migrasi postgres (%d) dan sqlite (%d) berbeda", len(postgres), len(sqlite))
+	}
+	for i := range postgres {
+		if postgres[i] != sqlite[i] {
+			t.Errorf("migrasi %d: postgres %q, sqlite %q", i+1, postgres[i], sqlite[i])
+		}
+	}
+}
+
+func TestCreateMigration(t *testing.T) {
+	dir := t.TempDir()
+	for _, dialect := range dialects {
+		if err := os.MkdirAll(filepath.Join(dir, dialect), 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(dir, "sqlite", "0003_existing.up.sql"), []byte("SELECT 1;"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "sqlite", "0003_existing.down.sql"), []byte("SELECT 1;"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := CreateMigration(dir, "Add Seasons")
+	if err != nil {
+		t.Fatalf("CreateMigration: %v", err)
+	}
+	want := []string{
+		filepath.Join(dir, "postgres", "0004_add_seasons.up.sql"),
+		filepath.Join(dir, "postgres", "0004_add_seasons.down.sql"),
+		filepath.Join(dir, "sqlite", "0004_add_seasons.up.sql"),
+		filepath.Join(dir, "sqlite", "0004_add_seasons.down.sql"),
+	}
+	if len(files) != len(want) {
+		t.Fatalf("files = %v, want %v", files, want)
+	}
+	for i := range want {
+		if files[i] != want[i] {
+			t.Errorf("files[%d] = %s, want %s", i, files[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,16 @@
+-- Register cedera/ketersediaan pemain
+
+CREATE TABLE IF NOT EXISTS player_availabilities (
+    id SERIAL PRIMARY KEY,
+    player_id INT NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    status VARCHAR(50) NOT NULL CONSTRAINT chk_player_availabilities_status CHECK (status IN ('available', 'injured', 'ill', 'international_duty', 'suspended')),
+    notes TEXT,
+    start_date DATE NOT NULL,
+    expected_return_date DATE,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_player_availabilities_player_id ON player_availabilities(player_id);
+CREATE INDEX IF NOT EXISTS idx_player_availabilities_deleted_at ON player_availabilities(deleted_at);
@@ -0,0 +1,21 @@
+-- Stadion, home venue team, serta venue dan jumlah penonton match
+
+CREATE TABLE IF NOT EXISTS venues (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    city VARCHAR(100) NOT NULL,
+    capacity INT NOT NULL,
+    surface VARCHAR(50) NOT NULL DEFAULT 'grass' CONSTRAINT chk_venues_surface CHECK (surface IN ('grass', 'artificial', 'hybrid')),
+    latitude DOUBLE PRECISION,
+    longitude DOUBLE PRECISION,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_venues_deleted_at ON venues(deleted_at);
+
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS home_venue_id INT REFERENCES venues(id);
+
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS venue_id INT REFERENCES venues(id);
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS attendance INT;
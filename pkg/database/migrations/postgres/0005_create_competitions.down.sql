@@ -0,0 +1,9 @@
+ALTER TABLE matches DROP COLUMN IF EXISTS away_penalties;
+ALTER TABLE matches DROP COLUMN IF EXISTS home_penalties;
+ALTER TABLE matches DROP COLUMN IF EXISTS extra_time;
+ALTER TABLE matches DROP COLUMN IF EXISTS leg;
+ALTER TABLE matches DROP COLUMN IF EXISTS tie_id;
+ALTER TABLE matches DROP COLUMN IF EXISTS competition_id;
+DROP TABLE IF EXISTS cup_ties;
+DROP TABLE IF EXISTS competition_teams;
+DROP TABLE IF EXISTS competitions;
@@ -0,0 +1,51 @@
+-- Kompetisi cup/knockout: peserta, slot bracket, dan relasi match ke tie
+
+CREATE TABLE IF NOT EXISTS competitions (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    format VARCHAR(50) NOT NULL CONSTRAINT chk_competitions_format CHECK (format IN ('cup')),
+    seeding VARCHAR(50) NOT NULL DEFAULT 'seeded' CONSTRAINT chk_competitions_seeding CHECK (seeding IN ('seeded', 'drawn')),
+    two_legged BOOLEAN NOT NULL DEFAULT FALSE,
+    away_goals_rule BOOLEAN NOT NULL DEFAULT FALSE,
+    start_datetime TIMESTAMPTZ NOT NULL,
+    round_interval_days INT NOT NULL DEFAULT 7,
+    leg_interval_days INT NOT NULL DEFAULT 3,
+    status VARCHAR(50) NOT NULL DEFAULT 'draft' CONSTRAINT chk_competitions_status CHECK (status IN ('draft', 'in_progress', 'completed')),
+    champion_team_id INT REFERENCES teams(id),
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_competitions_deleted_at ON competitions(deleted_at);
+
+CREATE TABLE IF NOT EXISTS competition_teams (
+    id SERIAL PRIMARY KEY,
+    competition_id INT NOT NULL REFERENCES competitions(id) ON DELETE CASCADE,
+    team_id INT NOT NULL REFERENCES teams(id),
+    seed INT NOT NULL,
+    CONSTRAINT idx_competition_teams_competition_team UNIQUE (competition_id, team_id)
+);
+
+CREATE TABLE IF NOT EXISTS cup_ties (
+    id SERIAL PRIMARY KEY,
+    competition_id INT NOT NULL REFERENCES competitions(id) ON DELETE CASCADE,
+    round INT NOT NULL,
+    position INT NOT NULL,
+    home_team_id INT REFERENCES teams(id),
+    away_team_id INT REFERENCES teams(id),
+    winner_team_id INT REFERENCES teams(id),
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    CONSTRAINT idx_cup_ties_slot UNIQUE (competition_id, round, position)
+);
+
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS competition_id INT REFERENCES competitions(id);
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS tie_id INT REFERENCES cup_ties(id);
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS leg INT;
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS extra_time BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS home_penalties INT;
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS away_penalties INT;
+
+CREATE INDEX IF NOT EXISTS idx_matches_competition_id ON matches(competition_id);
+CREATE INDEX IF NOT EXISTS idx_matches_tie_id ON matches(tie_id);
@@ -0,0 +1,11 @@
+ALTER TABLE matches DROP COLUMN IF EXISTS group_id;
+ALTER TABLE competition_teams DROP COLUMN IF EXISTS group_id;
+DROP TABLE IF EXISTS competition_groups;
+
+ALTER TABLE competitions DROP COLUMN IF EXISTS knockout_start_datetime;
+ALTER TABLE competitions DROP COLUMN IF EXISTS qualifiers_per_group;
+ALTER TABLE competitions DROP COLUMN IF EXISTS group_count;
+
+-- NOT VALID agar kompetisi group_knockout yang sudah ada tidak menggagalkan rollback
+ALTER TABLE competitions DROP CONSTRAINT IF EXISTS chk_competitions_format;
+ALTER TABLE competitions ADD CONSTRAINT chk_competitions_format CHECK (format IN ('cup')) NOT VALID;
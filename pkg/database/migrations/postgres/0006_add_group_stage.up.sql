@@ -0,0 +1,21 @@
+-- Format fase grup + knockout
+
+ALTER TABLE competitions DROP CONSTRAINT IF EXISTS chk_competitions_format;
+ALTER TABLE competitions ADD CONSTRAINT chk_competitions_format CHECK (format IN ('cup', 'group_knockout'));
+
+ALTER TABLE competitions ADD COLUMN IF NOT EXISTS group_count INT NOT NULL DEFAULT 0;
+ALTER TABLE competitions ADD COLUMN IF NOT EXISTS qualifiers_per_group INT NOT NULL DEFAULT 0;
+ALTER TABLE competitions ADD COLUMN IF NOT EXISTS knockout_start_datetime TIMESTAMPTZ;
+
+CREATE TABLE IF NOT EXISTS competition_groups (
+    id SERIAL PRIMARY KEY,
+    competition_id INT NOT NULL REFERENCES competitions(id) ON DELETE CASCADE,
+    name VARCHAR(50) NOT NULL,
+    CONSTRAINT idx_competition_groups_name UNIQUE (competition_id, name)
+);
+
+ALTER TABLE competition_teams ADD COLUMN IF NOT EXISTS group_id INT REFERENCES competition_groups(id);
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS group_id INT REFERENCES competition_groups(id);
+
+CREATE INDEX IF NOT EXISTS idx_competition_teams_group_id ON competition_teams(group_id);
+CREATE INDEX IF NOT EXISTS idx_matches_group_id ON matches(group_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS calendar_tokens;
+ALTER TABLE matches DROP COLUMN IF EXISTS schedule_version;
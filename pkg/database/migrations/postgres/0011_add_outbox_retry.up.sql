@@ -0,0 +1,10 @@
+-- Backoff per event dan parkir berdasarkan lama gagal. Event yang sebelumnya dilewati karena
+-- jumlah percobaan (OUTBOX_MAX_ATTEMPTS) kembali diantrekan.
+
+ALTER TABLE outbox_events ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMPTZ;
+ALTER TABLE outbox_events ADD COLUMN IF NOT EXISTS failing_since TIMESTAMPTZ;
+ALTER TABLE outbox_events ADD COLUMN IF NOT EXISTS parked_at TIMESTAMPTZ;
+
+DROP INDEX IF EXISTS idx_outbox_events_pending;
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending ON outbox_events(id) WHERE published_at IS NULL AND parked_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_outbox_events_parked ON outbox_events(id) WHERE parked_at IS NOT NULL;
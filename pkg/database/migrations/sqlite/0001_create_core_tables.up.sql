@@ -0,0 +1,58 @@
+-- Tabel inti: teams, players, matches, goals
+
+CREATE TABLE IF NOT EXISTS teams (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(255) NOT NULL,
+    logo_url VARCHAR(255),
+    founded_year INT,
+    headquarters_address TEXT,
+    headquarters_city VARCHAR(100),
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    deleted_at DATETIME NULL
+);
+
+CREATE TABLE IF NOT EXISTS players (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    team_id INT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    height_cm INT,
+    weight_kg INT,
+    position VARCHAR(50) NOT NULL CONSTRAINT chk_players_position CHECK (position IN ('penyerang', 'gelandang', 'bertahan', 'penjaga gawang')),
+    jersey_number INT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    deleted_at DATETIME NULL
+);
+
+-- Nomor punggung unik per team hanya untuk player yang belum dihapus
+CREATE UNIQUE INDEX IF NOT EXISTS idx_players_team_jersey ON players(team_id, jersey_number) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS matches (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    home_team_id INT NOT NULL REFERENCES teams(id),
+    away_team_id INT NOT NULL REFERENCES teams(id),
+    match_datetime DATETIME NOT NULL,
+    status VARCHAR(50) NOT NULL DEFAULT 'scheduled' CONSTRAINT chk_matches_status CHECK (status IN ('scheduled', 'completed', 'cancelled')),
+    home_score INT DEFAULT 0,
+    away_score INT DEFAULT 0,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    deleted_at DATETIME NULL
+);
+
+CREATE TABLE IF NOT EXISTS goals (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    match_id INT NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    player_id INT NOT NULL REFERENCES players(id),
+    goal_time INT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_teams_deleted_at ON teams(deleted_at);
+CREATE INDEX IF NOT EXISTS idx_players_deleted_at ON players(deleted_at);
+CREATE INDEX IF NOT EXISTS idx_players_team_id ON players(team_id);
+CREATE INDEX IF NOT EXISTS idx_matches_deleted_at ON matches(deleted_at);
+CREATE INDEX IF NOT EXISTS idx_matches_status ON matches(status);
+CREATE INDEX IF NOT EXISTS idx_goals_match_id ON goals(match_id);
+CREATE INDEX IF NOT EXISTS idx_goals_player_id ON goals(player_id);
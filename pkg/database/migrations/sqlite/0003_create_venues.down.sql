@@ -0,0 +1,46 @@
+-- SQLite tidak bisa DROP COLUMN yang menjadi foreign key, sehingga teams dan matches
+-- dibangun ulang tanpa kolom venue (foreign key dinonaktifkan oleh migrator)
+
+CREATE TABLE matches_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    home_team_id INT NOT NULL REFERENCES teams(id),
+    away_team_id INT NOT NULL REFERENCES teams(id),
+    match_datetime DATETIME NOT NULL,
+    status VARCHAR(50) NOT NULL DEFAULT 'scheduled' CONSTRAINT chk_matches_status CHECK (status IN ('scheduled', 'completed', 'cancelled')),
+    home_score INT DEFAULT 0,
+    away_score INT DEFAULT 0,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    deleted_at DATETIME NULL
+);
+
+INSERT INTO matches_new (id, home_team_id, away_team_id, match_datetime, status, home_score, away_score, created_at, updated_at, deleted_at)
+SELECT id, home_team_id, away_team_id, match_datetime, status, home_score, away_score, created_at, updated_at, deleted_at FROM matches;
+
+DROP TABLE matches;
+ALTER TABLE matches_new RENAME TO matches;
+
+CREATE INDEX IF NOT EXISTS idx_matches_deleted_at ON matches(deleted_at);
+CREATE INDEX IF NOT EXISTS idx_matches_status ON matches(status);
+
+CREATE TABLE teams_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(255) NOT NULL,
+    logo_url VARCHAR(255),
+    founded_year INT,
+    headquarters_address TEXT,
+    headquarters_city VARCHAR(100),
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    deleted_at DATETIME NULL
+);
+
+INSERT INTO teams_new (id, name, logo_url, founded_year, headquarters_address, headquarters_city, created_at, updated_at, deleted_at)
+SELECT id, name, logo_url, founded_year, headquarters_address, headquarters_city, created_at, updated_at, deleted_at FROM teams;
+
+DROP TABLE teams;
+ALTER TABLE teams_new RENAME TO teams;
+
+CREATE INDEX IF NOT EXISTS idx_teams_deleted_at ON teams(deleted_at);
+
+DROP TABLE IF EXISTS venues;
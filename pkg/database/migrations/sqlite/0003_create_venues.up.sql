@@ -0,0 +1,21 @@
+-- Stadion, home venue team, serta venue dan jumlah penonton match
+
+CREATE TABLE IF NOT EXISTS venues (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(255) NOT NULL,
+    city VARCHAR(100) NOT NULL,
+    capacity INT NOT NULL,
+    surface VARCHAR(50) NOT NULL DEFAULT 'grass' CONSTRAINT chk_venues_surface CHECK (surface IN ('grass', 'artificial', 'hybrid')),
+    latitude DOUBLE PRECISION,
+    longitude DOUBLE PRECISION,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    deleted_at DATETIME NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_venues_deleted_at ON venues(deleted_at);
+
+ALTER TABLE teams ADD COLUMN home_venue_id INT REFERENCES venues(id);
+
+ALTER TABLE matches ADD COLUMN venue_id INT REFERENCES venues(id);
+ALTER TABLE matches ADD COLUMN attendance INT;
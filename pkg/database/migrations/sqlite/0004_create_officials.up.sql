@@ -0,0 +1,28 @@
+-- Wasit/perangkat pertandingan, konflik kepentingan, dan penugasan ke match
+
+CREATE TABLE IF NOT EXISTS officials (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(255) NOT NULL,
+    license_level VARCHAR(50),
+    city VARCHAR(100),
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    deleted_at DATETIME NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_officials_deleted_at ON officials(deleted_at);
+
+CREATE TABLE IF NOT EXISTS official_team_conflicts (
+    official_id INT NOT NULL REFERENCES officials(id) ON DELETE CASCADE,
+    team_id INT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    PRIMARY KEY (official_id, team_id)
+);
+
+CREATE TABLE IF NOT EXISTS match_officials (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    match_id INT NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    official_id INT NOT NULL REFERENCES officials(id),
+    role VARCHAR(50) NOT NULL CONSTRAINT chk_match_officials_role CHECK (role IN ('referee', 'assistant_referee', 'fourth_official', 'var')),
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT idx_match_officials_match_official UNIQUE (match_id, official_id)
+);
@@ -0,0 +1,29 @@
+-- matches dibangun ulang tanpa kolom kompetisi (SQLite tidak bisa DROP COLUMN foreign key)
+
+CREATE TABLE matches_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    home_team_id INT NOT NULL REFERENCES teams(id),
+    away_team_id INT NOT NULL REFERENCES teams(id),
+    match_datetime DATETIME NOT NULL,
+    status VARCHAR(50) NOT NULL DEFAULT 'scheduled' CONSTRAINT chk_matches_status CHECK (status IN ('scheduled', 'completed', 'cancelled')),
+    home_score INT DEFAULT 0,
+    away_score INT DEFAULT 0,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    deleted_at DATETIME NULL,
+    venue_id INT REFERENCES venues(id),
+    attendance INT
+);
+
+INSERT INTO matches_new (id, home_team_id, away_team_id, match_datetime, status, home_score, away_score, created_at, updated_at, deleted_at, venue_id, attendance)
+SELECT id, home_team_id, away_team_id, match_datetime, status, home_score, away_score, created_at, updated_at, deleted_at, venue_id, attendance FROM matches;
+
+DROP TABLE matches;
+ALTER TABLE matches_new RENAME TO matches;
+
+CREATE INDEX IF NOT EXISTS idx_matches_deleted_at ON matches(deleted_at);
+CREATE INDEX IF NOT EXISTS idx_matches_status ON matches(status);
+
+DROP TABLE IF EXISTS cup_ties;
+DROP TABLE IF EXISTS competition_teams;
+DROP TABLE IF EXISTS competitions;
@@ -0,0 +1,76 @@
+-- matches, competition_teams, dan competitions dibangun ulang tanpa kolom fase grup.
+-- SQLite tidak mendukung constraint NOT VALID, sehingga rollback gagal (CHECK constraint)
+-- selama masih ada kompetisi group_knockout.
+
+CREATE TABLE matches_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    home_team_id INT NOT NULL REFERENCES teams(id),
+    away_team_id INT NOT NULL REFERENCES teams(id),
+    match_datetime DATETIME NOT NULL,
+    status VARCHAR(50) NOT NULL DEFAULT 'scheduled' CONSTRAINT chk_matches_status CHECK (status IN ('scheduled', 'completed', 'cancelled')),
+    home_score INT DEFAULT 0,
+    away_score INT DEFAULT 0,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    deleted_at DATETIME NULL,
+    venue_id INT REFERENCES venues(id),
+    attendance INT,
+    competition_id INT REFERENCES competitions(id),
+    tie_id INT REFERENCES cup_ties(id),
+    leg INT,
+    extra_time BOOLEAN NOT NULL DEFAULT FALSE,
+    home_penalties INT,
+    away_penalties INT
+);
+
+INSERT INTO matches_new (id, home_team_id, away_team_id, match_datetime, status, home_score, away_score, created_at, updated_at, deleted_at, venue_id, attendance, competition_id, tie_id, leg, extra_time, home_penalties, away_penalties)
+SELECT id, home_team_id, away_team_id, match_datetime, status, home_score, away_score, created_at, updated_at, deleted_at, venue_id, attendance, competition_id, tie_id, leg, extra_time, home_penalties, away_penalties FROM matches;
+
+DROP TABLE matches;
+ALTER TABLE matches_new RENAME TO matches;
+
+CREATE INDEX IF NOT EXISTS idx_matches_deleted_at ON matches(deleted_at);
+CREATE INDEX IF NOT EXISTS idx_matches_status ON matches(status);
+CREATE INDEX IF NOT EXISTS idx_matches_competition_id ON matches(competition_id);
+CREATE INDEX IF NOT EXISTS idx_matches_tie_id ON matches(tie_id);
+
+CREATE TABLE competition_teams_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    competition_id INT NOT NULL REFERENCES competitions(id) ON DELETE CASCADE,
+    team_id INT NOT NULL REFERENCES teams(id),
+    seed INT NOT NULL,
+    CONSTRAINT idx_competition_teams_competition_team UNIQUE (competition_id, team_id)
+);
+
+INSERT INTO competition_teams_new (id, competition_id, team_id, seed)
+SELECT id, competition_id, team_id, seed FROM competition_teams;
+
+DROP TABLE competition_teams;
+ALTER TABLE competition_teams_new RENAME TO competition_teams;
+
+DROP TABLE IF EXISTS competition_groups;
+
+CREATE TABLE competitions_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(255) NOT NULL,
+    format VARCHAR(50) NOT NULL CONSTRAINT chk_competitions_format CHECK (format IN ('cup')),
+    seeding VARCHAR(50) NOT NULL DEFAULT 'seeded' CONSTRAINT chk_competitions_seeding CHECK (seeding IN ('seeded', 'drawn')),
+    two_legged BOOLEAN NOT NULL DEFAULT FALSE,
+    away_goals_rule BOOLEAN NOT NULL DEFAULT FALSE,
+    start_datetime DATETIME NOT NULL,
+    round_interval_days INT NOT NULL DEFAULT 7,
+    leg_interval_days INT NOT NULL DEFAULT 3,
+    status VARCHAR(50) NOT NULL DEFAULT 'draft' CONSTRAINT chk_competitions_status CHECK (status IN ('draft', 'in_progress', 'completed')),
+    champion_team_id INT REFERENCES teams(id),
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    deleted_at DATETIME NULL
+);
+
+INSERT INTO competitions_new (id, name, format, seeding, two_legged, away_goals_rule, start_datetime, round_interval_days, leg_interval_days, status, champion_team_id, created_at, updated_at, deleted_at)
+SELECT id, name, format, seeding, two_legged, away_goals_rule, start_datetime, round_interval_days, leg_interval_days, status, champion_team_id, created_at, updated_at, deleted_at FROM competitions;
+
+DROP TABLE competitions;
+ALTER TABLE competitions_new RENAME TO competitions;
+
+CREATE INDEX IF NOT EXISTS idx_competitions_deleted_at ON competitions(deleted_at);
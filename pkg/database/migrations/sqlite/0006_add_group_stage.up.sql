@@ -0,0 +1,44 @@
+-- Format fase grup + knockout
+-- SQLite tidak bisa mengubah CHECK constraint, sehingga competitions dibangun ulang
+-- dengan constraint format baru dan kolom fase grup (foreign key dinonaktifkan oleh migrator)
+
+CREATE TABLE competitions_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(255) NOT NULL,
+    format VARCHAR(50) NOT NULL CONSTRAINT chk_competitions_format CHECK (format IN ('cup', 'group_knockout')),
+    seeding VARCHAR(50) NOT NULL DEFAULT 'seeded' CONSTRAINT chk_competitions_seeding CHECK (seeding IN ('seeded', 'drawn')),
+    two_legged BOOLEAN NOT NULL DEFAULT FALSE,
+    away_goals_rule BOOLEAN NOT NULL DEFAULT FALSE,
+    start_datetime DATETIME NOT NULL,
+    round_interval_days INT NOT NULL DEFAULT 7,
+    leg_interval_days INT NOT NULL DEFAULT 3,
+    status VARCHAR(50) NOT NULL DEFAULT 'draft' CONSTRAINT chk_competitions_status CHECK (status IN ('draft', 'in_progress', 'completed')),
+    champion_team_id INT REFERENCES teams(id),
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    deleted_at DATETIME NULL,
+    group_count INT NOT NULL DEFAULT 0,
+    qualifiers_per_group INT NOT NULL DEFAULT 0,
+    knockout_start_datetime DATETIME
+);
+
+INSERT INTO competitions_new (id, name, format, seeding, two_legged, away_goals_rule, start_datetime, round_interval_days, leg_interval_days, status, champion_team_id, created_at, updated_at, deleted_at)
+SELECT id, name, format, seeding, two_legged, away_goals_rule, start_datetime, round_interval_days, leg_interval_days, status, champion_team_id, created_at, updated_at, deleted_at FROM competitions;
+
+DROP TABLE competitions;
+ALTER TABLE competitions_new RENAME TO competitions;
+
+CREATE INDEX IF NOT EXISTS idx_competitions_deleted_at ON competitions(deleted_at);
+
+CREATE TABLE IF NOT EXISTS competition_groups (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    competition_id INT NOT NULL REFERENCES competitions(id) ON DELETE CASCADE,
+    name VARCHAR(50) NOT NULL,
+    CONSTRAINT idx_competition_groups_name UNIQUE (competition_id, name)
+);
+
+ALTER TABLE competition_teams ADD COLUMN group_id INT REFERENCES competition_groups(id);
+ALTER TABLE matches ADD COLUMN group_id INT REFERENCES competition_groups(id);
+
+CREATE INDEX IF NOT EXISTS idx_competition_teams_group_id ON competition_teams(group_id);
+CREATE INDEX IF NOT EXISTS idx_matches_group_id ON matches(group_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS calendar_tokens;
+ALTER TABLE matches DROP COLUMN schedule_version;
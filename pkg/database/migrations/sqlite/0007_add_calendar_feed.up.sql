@@ -0,0 +1,13 @@
+-- Feed kalender: versi jadwal match (SEQUENCE iCalendar) dan token feed per user
+-- (aplikasi kalender tidak bisa mengirim header Authorization)
+
+ALTER TABLE matches ADD COLUMN schedule_version INT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS calendar_tokens (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    username VARCHAR(255) NOT NULL,
+    token_hash VARCHAR(64) NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT idx_calendar_tokens_username UNIQUE (username),
+    CONSTRAINT idx_calendar_tokens_token_hash UNIQUE (token_hash)
+);
@@ -0,0 +1,9 @@
+-- Akun user tambahan selain admin dari konfigurasi; password disimpan sebagai hash bcrypt
+
+CREATE TABLE IF NOT EXISTS users (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    username VARCHAR(255) NOT NULL,
+    password_hash VARCHAR(255) NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT idx_users_username UNIQUE (username)
+);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_outbox_events_parked;
+DROP INDEX IF EXISTS idx_outbox_events_pending;
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending ON outbox_events(id) WHERE published_at IS NULL;
+
+ALTER TABLE outbox_events DROP COLUMN parked_at;
+ALTER TABLE outbox_events DROP COLUMN failing_since;
+ALTER TABLE outbox_events DROP COLUMN next_attempt_at;
@@ -0,0 +1,10 @@
+-- Backoff per event dan parkir berdasarkan lama gagal. Event yang sebelumnya dilewati karena
+-- jumlah percobaan (OUTBOX_MAX_ATTEMPTS) kembali diantrekan.
+
+ALTER TABLE outbox_events ADD COLUMN next_attempt_at DATETIME;
+ALTER TABLE outbox_events ADD COLUMN failing_since DATETIME;
+ALTER TABLE outbox_events ADD COLUMN parked_at DATETIME;
+
+DROP INDEX IF EXISTS idx_outbox_events_pending;
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending ON outbox_events(id) WHERE published_at IS NULL AND parked_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_outbox_events_parked ON outbox_events(id) WHERE parked_at IS NOT NULL;
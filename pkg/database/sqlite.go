@@ -0,0 +1,129 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+)
+
+// sqliteMemoryPath adalah nilai DB_SQLITE_PATH untuk database SQLite in-memory
+const sqliteMemoryPath = ":memory:"
+
+// OpenSQLite membuka database SQLite di path (atau ":memory:") dengan foreign key aktif.
+// Dipakai oleh InitDatabase dan oleh test yang membutuhkan database sungguhan tanpa server.
+func OpenSQLite(path string) (*gorm.DB, error) {
+	dialector, err := openSQLite(path)
+	if err != nil {
+		return nil, err
+	}
+	return gorm.Open(dialector, &gorm.Config{})
+}
+
+// openSQLite menyiapkan dialector SQLite. Koneksi dibatasi satu karena SQLite hanya
+// mengizinkan satu penulis, dan database in-memory hanya hidup selama koneksinya terbuka.
+func openSQLite(path string) (gorm.Dialector, error) {
+	params := url.Values{}
+	params.Add("_pragma", "foreign_keys(1)")
+	params.Add("_pragma", "busy_timeout(5000)")
+	if path != sqliteMemoryPath {
+		params.Add("_pragma", "journal_mode(WAL)")
+	}
+
+	db, err := sql.Open(sqlite.DriverName, "file:"+path+"?"+params.Encode())
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxOpenConns(1)
+
+	return &sqlite.Dialector{Conn: &utcConnPool{db: db}}, nil
+}
+
+// utcConnPool membungkus koneksi SQLite agar semua parameter waktu disimpan dalam UTC.
+// SQLite menyimpan waktu sebagai teks, sehingga perbandingan dan pengurutan kolom waktu
+// hanya benar jika semua nilai memakai offset yang sama.
+type utcConnPool struct {
+	db *sql.DB
+}
+
+func (p *utcConnPool) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return p.db.PrepareContext(ctx, query)
+}
+
+func (p *utcConnPool) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return p.db.ExecContext(ctx, query, toUTC(args)...)
+}
+
+func (p *utcConnPool) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return p.db.QueryContext(ctx, query, toUTC(args)...)
+}
+
+func (p *utcConnPool) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return p.db.QueryRowContext(ctx, query, toUTC(args)...)
+}
+
+// BeginTx memulai transaksi yang juga menormalisasi parameter waktu
+func (p *utcConnPool) BeginTx(ctx context.Context, opts *sql.TxOptions) (gorm.ConnPool, error) {
+	tx, err := p.db.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &utcTx{tx: tx}, nil
+}
+
+// GetDBConn mengembalikan *sql.DB asli (dipakai oleh gorm.DB.DB())
+func (p *utcConnPool) GetDBConn() (*sql.DB, error) {
+	return p.db, nil
+}
+
+// utcTx adalah transaksi SQLite yang menormalisasi parameter waktu ke UTC
+type utcTx struct {
+	tx *sql.Tx
+}
+
+func (t *utcTx) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return t.tx.PrepareContext(ctx, query)
+}
+
+func (t *utcTx) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return t.tx.ExecContext(ctx, query, toUTC(args)...)
+}
+
+func (t *utcTx) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return t.tx.QueryContext(ctx, query, toUTC(args)...)
+}
+
+func (t *utcTx) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return t.tx.QueryRowContext(ctx, query, toUTC(args)...)
+}
+
+func (t *utcTx) Commit() error {
+	return t.tx.Commit()
+}
+
+func (t *utcTx) Rollback() error {
+	return t.tx.Rollback()
+}
+
+// toUTC mengembalikan salinan args dengan parameter time.Time dan *time.Time dalam UTC
+func toUTC(args []interface{}) []interface{} {
+	converted := make([]interface{}, len(args))
+	for i, arg := range args {
+		switch v := arg.(type) {
+		case time.Time:
+			converted[i] = v.UTC()
+		case *time.Time:
+			if v != nil {
+				converted[i] = v.UTC()
+			} else {
+				converted[i] = v
+			}
+		default:
+			converted[i] = arg
+		}
+	}
+	return converted
+}
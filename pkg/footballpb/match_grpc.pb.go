@@ -0,0 +1,342 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             (unknown)
+// source: football/v1/match.proto
+
+package footballpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	MatchService_CreateMatch_FullMethodName       = "/football.v1.MatchService/CreateMatch"
+	MatchService_GetMatch_FullMethodName          = "/football.v1.MatchService/GetMatch"
+	MatchService_ListMatches_FullMethodName       = "/football.v1.MatchService/ListMatches"
+	MatchService_RescheduleMatch_FullMethodName   = "/football.v1.MatchService/RescheduleMatch"
+	MatchService_ReportMatchResult_FullMethodName = "/football.v1.MatchService/ReportMatchResult"
+	MatchService_WatchMatch_FullMethodName        = "/football.v1.MatchService/WatchMatch"
+)
+
+// MatchServiceClient is the client API for MatchService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// MatchService menjadwalkan match, mencatat hasil, dan mengirim update live
+type MatchServiceClient interface {
+	CreateMatch(ctx context.Context, in *CreateMatchRequest, opts ...grpc.CallOption) (*Match, error)
+	// GetMatch mengembalikan match beserta goals
+	GetMatch(ctx context.Context, in *GetMatchRequest, opts ...grpc.CallOption) (*Match, error)
+	// ListMatches mengembalikan match (tanpa goals) terurut berdasarkan waktu kick-off
+	ListMatches(ctx context.Context, in *ListMatchesRequest, opts ...grpc.CallOption) (*ListMatchesResponse, error)
+	RescheduleMatch(ctx context.Context, in *RescheduleMatchRequest, opts ...grpc.CallOption) (*Match, error)
+	// ReportMatchResult mencatat skor dan goals lalu mengembalikan match beserta goals
+	ReportMatchResult(ctx context.Context, in *ReportMatchResultRequest, opts ...grpc.CallOption) (*Match, error)
+	// WatchMatch mengirim kondisi match saat ini lalu setiap perubahan skor dan goal sampai
+	// client menutup stream. Stream ditutup dengan RESOURCE_EXHAUSTED jika client terlalu lambat membaca.
+	WatchMatch(ctx context.Context, in *WatchMatchRequest, opts ...grpc.CallOption) (MatchService_WatchMatchClient, error)
+}
+
+type matchServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMatchServiceClient(cc grpc.ClientConnInterface) MatchServiceClient {
+	return &matchServiceClient{cc}
+}
+
+func (c *matchServiceClient) CreateMatch(ctx context.Context, in *CreateMatchRequest, opts ...grpc.CallOption) (*Match, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Match)
+	err := c.cc.Invoke(ctx, MatchService_CreateMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchServiceClient) GetMatch(ctx context.Context, in *GetMatchRequest, opts ...grpc.CallOption) (*Match, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Match)
+	err := c.cc.Invoke(ctx, MatchService_GetMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchServiceClient) ListMatches(ctx context.Context, in *ListMatchesRequest, opts ...grpc.CallOption) (*ListMatchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMatchesResponse)
+	err := c.cc.Invoke(ctx, MatchService_ListMatches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchServiceClient) RescheduleMatch(ctx context.Context, in *RescheduleMatchRequest, opts ...grpc.CallOption) (*Match, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Match)
+	err := c.cc.Invoke(ctx, MatchService_RescheduleMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchServiceClient) ReportMatchResult(ctx context.Context, in *ReportMatchResultRequest, opts ...grpc.CallOption) (*Match, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Match)
+	err := c.cc.Invoke(ctx, MatchService_ReportMatchResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchServiceClient) WatchMatch(ctx context.Context, in *WatchMatchRequest, opts ...grpc.CallOption) (MatchService_WatchMatchClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MatchService_ServiceDesc.Streams[0], MatchService_WatchMatch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &matchServiceWatchMatchClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MatchService_WatchMatchClient interface {
+	Recv() (*MatchUpdate, error)
+	grpc.ClientStream
+}
+
+type matchServiceWatchMatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *matchServiceWatchMatchClient) Recv() (*MatchUpdate, error) {
+	m := new(MatchUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// MatchServiceServer is the server API for MatchService service.
+// All implementations must embed UnimplementedMatchServiceServer
+// for forward compatibility
+//
+// MatchService menjadwalkan match, mencatat hasil, dan mengirim update live
+type MatchServiceServer interface {
+	CreateMatch(context.Context, *CreateMatchRequest) (*Match, error)
+	// GetMatch mengembalikan match beserta goals
+	GetMatch(context.Context, *GetMatchRequest) (*Match, error)
+	// ListMatches mengembalikan match (tanpa goals) terurut berdasarkan waktu kick-off
+	ListMatches(context.Context, *ListMatchesRequest) (*ListMatchesResponse, error)
+	RescheduleMatch(context.Context, *RescheduleMatchRequest) (*Match, error)
+	// ReportMatchResult mencatat skor dan goals lalu mengembalikan match beserta goals
+	ReportMatchResult(context.Context, *ReportMatchResultRequest) (*Match, error)
+	// WatchMatch mengirim kondisi match saat ini lalu setiap perubahan skor dan goal sampai
+	// client menutup stream. Stream ditutup dengan RESOURCE_EXHAUSTED jika client terlalu lambat membaca.
+	WatchMatch(*WatchMatchRequest, MatchService_WatchMatchServer) error
+	mustEmbedUnimplementedMatchServiceServer()
+}
+
+// UnimplementedMatchServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedMatchServiceServer struct {
+}
+
+func (UnimplementedMatchServiceServer) CreateMatch(context.Context, *CreateMatchRequest) (*Match, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMatch not implemented")
+}
+func (UnimplementedMatchServiceServer) GetMatch(context.Context, *GetMatchRequest) (*Match, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMatch not implemented")
+}
+func (UnimplementedMatchServiceServer) ListMatches(context.Context, *ListMatchesRequest) (*ListMatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMatches not implemented")
+}
+func (UnimplementedMatchServiceServer) RescheduleMatch(context.Context, *RescheduleMatchRequest) (*Match, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleMatch not implemented")
+}
+func (UnimplementedMatchServiceServer) ReportMatchResult(context.Context, *ReportMatchResultRequest) (*Match, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportMatchResult not implemented")
+}
+func (UnimplementedMatchServiceServer) WatchMatch(*WatchMatchRequest, MatchService_WatchMatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchMatch not implemented")
+}
+func (UnimplementedMatchServiceServer) mustEmbedUnimplementedMatchServiceServer() {}
+
+// UnsafeMatchServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MatchServiceServer will
+// result in compilation errors.
+type UnsafeMatchServiceServer interface {
+	mustEmbedUnimplementedMatchServiceServer()
+}
+
+func RegisterMatchServiceServer(s grpc.ServiceRegistrar, srv MatchServiceServer) {
+	s.RegisterService(&MatchService_ServiceDesc, srv)
+}
+
+func _MatchService_CreateMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchServiceServer).CreateMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchService_CreateMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchServiceServer).CreateMatch(ctx, req.(*CreateMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchService_GetMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchServiceServer).GetMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchService_GetMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchServiceServer).GetMatch(ctx, req.(*GetMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchService_ListMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchServiceServer).ListMatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchService_ListMatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchServiceServer).ListMatches(ctx, req.(*ListMatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchService_RescheduleMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchServiceServer).RescheduleMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchService_RescheduleMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchServiceServer).RescheduleMatch(ctx, req.(*RescheduleMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchService_ReportMatchResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportMatchResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchServiceServer).ReportMatchResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchService_ReportMatchResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchServiceServer).ReportMatchResult(ctx, req.(*ReportMatchResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchService_WatchMatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchMatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MatchServiceServer).WatchMatch(m, &matchServiceWatchMatchServer{ServerStream: stream})
+}
+
+type MatchService_WatchMatchServer interface {
+	Send(*MatchUpdate) error
+	grpc.ServerStream
+}
+
+type matchServiceWatchMatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *matchServiceWatchMatchServer) Send(m *MatchUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// MatchService_ServiceDesc is the grpc.ServiceDesc for MatchService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MatchService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "football.v1.MatchService",
+	HandlerType: (*MatchServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateMatch",
+			Handler:    _MatchService_CreateMatch_Handler,
+		},
+		{
+			MethodName: "GetMatch",
+			Handler:    _MatchService_GetMatch_Handler,
+		},
+		{
+			MethodName: "ListMatches",
+			Handler:    _MatchService_ListMatches_Handler,
+		},
+		{
+			MethodName: "RescheduleMatch",
+			Handler:    _MatchService_RescheduleMatch_Handler,
+		},
+		{
+			MethodName: "ReportMatchResult",
+			Handler:    _MatchService_ReportMatchResult_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchMatch",
+			Handler:       _MatchService_WatchMatch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "football/v1/match.proto",
+}
@@ -0,0 +1,301 @@
+package i18n
+
+// english berisi terjemahan bahasa Inggris, dikelompokkan per area
+var english = map[string]string{
+	// Umum & autentikasi
+	"Terjadi kesalahan pada server":                 "An internal server error occurred",
+	"Data request tidak valid":                      "Invalid request data",
+	"Token tidak ditemukan":                         "Token not found",
+	"Format token tidak valid":                      "Invalid token format",
+	"Token tidak valid atau sudah expired":          "Token is invalid or has expired",
+	"Username atau password salah":                  "Invalid username or password",
+	"Gagal membuat token":                           "Failed to create token",
+	"Hanya admin yang dapat mengakses endpoint ini": "Only the admin can access this endpoint",
+	"tipe data harus %s":                            "data type must be %s",
+
+	// Validasi field
+	"wajib diisi":               "is required",
+	"minimal %s":                "must be at least %s",
+	"maksimal %s":               "must be at most %s",
+	"harus salah satu dari: %s": "must be one of: %s",
+	"tidak valid (%s)":          "is invalid (%s)",
+	"harus antara 1 dan 99":     "must be between 1 and 99",
+	"harus salah satu dari: penyerang, gelandang, bertahan, penjaga gawang": "must be one of: penyerang, gelandang, bertahan, penjaga gawang",
+	"team tidak ditemukan":                 "team not found",
+	"venue tidak ditemukan":                "venue not found",
+	"official tidak ditemukan":             "official not found",
+	"tidak boleh sama dengan home_team_id": "must not be the same as home_team_id",
+
+	// Team
+	"ID team tidak valid":                           "Invalid team ID",
+	"Data team tidak valid":                         "Invalid team data",
+	"Team tidak ditemukan":                          "Team not found",
+	"Team dengan ID %d tidak ditemukan":             "Team with ID %d not found",
+	"Team dengan ID %d terdaftar lebih dari sekali": "Team with ID %d is listed more than once",
+	"Nama team wajib diisi":                         "Team name is required",
+	"Team berhasil dihapus":                         "Team deleted successfully",
+	"Gagal membuat team":                            "Failed to create team",
+	"Gagal memperbarui team":                        "Failed to update team",
+	"Gagal menghapus team":                          "Failed to delete team",
+	"Gagal mengambil data team":                     "Failed to retrieve team",
+	"Gagal mengambil data teams":                    "Failed to retrieve teams",
+	"Home team tidak ditemukan":                     "Home team not found",
+	"Away team tidak ditemukan":                     "Away team not found",
+	"Home team dan away team tidak boleh sama":      "Home team and away team must be different",
+
+	// Player
+	"ID player tidak valid":                                                      "Invalid player ID",
+	"Data player tidak valid":                                                    "Invalid player data",
+	"Player tidak ditemukan":                                                     "Player not found",
+	"Player dengan ID %d tidak ditemukan":                                        "Player with ID %d not found",
+	"Player %s tidak termasuk dalam tim yang bertanding":                         "Player %s does not belong to either team in this match",
+	"Nama player wajib diisi":                                                    "Player name is required",
+	"Nomor punggung harus antara 1 dan 99":                                       "Jersey number must be between 1 and 99",
+	"Nomor punggung sudah digunakan di tim ini":                                  "Jersey number is already taken in this team",
+	"Posisi player tidak valid (penyerang, gelandang, bertahan, penjaga gawang)": "Invalid player position (penyerang, gelandang, bertahan, penjaga gawang)",
+	"Player berhasil dihapus":                                                    "Player deleted successfully",
+	"Gagal membuat player":                                                       "Failed to create player",
+	"Gagal memperbarui player":                                                   "Failed to update player",
+	"Gagal menghapus player":                                                     "Failed to delete player",
+	"Gagal mengambil data player":                                                "Failed to retrieve player",
+	"Gagal mengambil data players":                                               "Failed to retrieve players",
+
+	// Ketersediaan player
+	"ID ketersediaan tidak valid":                                  "Invalid availability ID",
+	"Data ketersediaan tidak valid":                                "Invalid availability data",
+	"Data ketersediaan tidak ditemukan":                            "Availability record not found",
+	"Data ketersediaan player berhasil dihapus":                    "Player availability record deleted successfully",
+	"Format start_date tidak valid (gunakan YYYY-MM-DD)":           "Invalid start_date format (use YYYY-MM-DD)",
+	"Format expected_return_date tidak valid (gunakan YYYY-MM-DD)": "Invalid expected_return_date format (use YYYY-MM-DD)",
+	"Format available_on tidak valid (gunakan YYYY-MM-DD)":         "Invalid available_on format (use YYYY-MM-DD)",
+	"expected_return_date harus setelah start_date":                "expected_return_date must be after start_date",
+	"Gagal mencatat ketersediaan player":                           "Failed to record player availability",
+	"Gagal memperbarui ketersediaan player":                        "Failed to update player availability",
+	"Gagal menghapus ketersediaan player":                          "Failed to delete player availability",
+	"Gagal mengambil data ketersediaan":                            "Failed to retrieve availability records",
+
+	// Venue
+	"ID venue tidak valid":        "Invalid venue ID",
+	"Data venue tidak valid":      "Invalid venue data",
+	"Venue tidak ditemukan":       "Venue not found",
+	"Home venue tidak ditemukan":  "Home venue not found",
+	"Venue berhasil dihapus":      "Venue deleted successfully",
+	"Gagal membuat venue":         "Failed to create venue",
+	"Gagal memperbarui venue":     "Failed to update venue",
+	"Gagal menghapus venue":       "Failed to delete venue",
+	"Gagal mengambil data venue":  "Failed to retrieve venue",
+	"Gagal mengambil data venues": "Failed to retrieve venues",
+
+	// Match
+	"ID match tidak valid":                                               "Invalid match ID",
+	"Data match tidak valid":                                             "Invalid match data",
+	"Data hasil match tidak valid":                                       "Invalid match result data",
+	"Match tidak ditemukan":                                              "Match not found",
+	"Match sudah dilaporkan sebelumnya":                                  "Match result has already been reported",
+	"Match sudah dibatalkan sehingga hasilnya tidak bisa dilaporkan":     "Match has been cancelled so its result cannot be reported",
+	"Hasil match berhasil dilaporkan":                                    "Match result reported successfully",
+	"Format match_datetime tidak valid (gunakan ISO 8601/RFC3339)":       "Invalid match_datetime format (use ISO 8601/RFC3339)",
+	"Jumlah gol tidak sesuai. Total skor: %d, jumlah detail gol: %d":     "Goal count mismatch. Total score: %d, goal details: %d",
+	"Jumlah penonton (%d) melebihi kapasitas venue %s (%d)":              "Attendance (%d) exceeds the capacity of venue %s (%d)",
+	"Extra time dan adu penalti hanya berlaku untuk match kompetisi cup": "Extra time and penalties only apply to cup competition matches",
+	"Extra time dan adu penalti hanya berlaku untuk leg penentu":         "Extra time and penalties only apply to the deciding leg",
+	"Adu penalti hanya dicatat jika skor (agregat) imbang":               "Penalties are only recorded when the (aggregate) score is level",
+	"Skor (agregat) imbang, hasil adu penalti wajib diisi":               "The (aggregate) score is level, a penalty shoot-out result is required",
+	"Hasil adu penalti tidak boleh imbang":                               "The penalty shoot-out result cannot be a draw",
+	"Leg pertama harus dilaporkan terlebih dahulu":                       "The first leg must be reported first",
+	"Tie kompetisi untuk match ini tidak ditemukan":                      "Competition tie for this match not found",
+	"Kompetisi untuk match ini tidak ditemukan":                          "Competition for this match not found",
+	"Gagal membuat match":                                                "Failed to create match",
+	"Gagal menyimpan hasil match":                                        "Failed to save match result",
+	"Gagal mengambil data match":                                         "Failed to retrieve match",
+	"Gagal mengambil laporan match":                                      "Failed to retrieve match report",
+
+	// Laporan match
+	"Belum Selesai":                 "Not Finished",
+	"Tim Home Menang":               "Home Team Won",
+	"Tim Away Menang":               "Away Team Won",
+	"Tim Home Menang (Adu Penalti)": "Home Team Won (Penalties)",
+	"Tim Away Menang (Adu Penalti)": "Away Team Won (Penalties)",
+	"Seri":                          "Draw",
+	"Grup %s":                       "Group %s",
+	"%s (%d gol)":                   "%s (goals: %d)",
+	"Belum ada gol":                 "No goals yet",
+
+	// Official
+	"ID official tidak valid":                                               "Invalid official ID",
+	"ID penugasan tidak valid":                                              "Invalid assignment ID",
+	"Data official tidak valid":                                             "Invalid official data",
+	"Data penugasan tidak valid":                                            "Invalid assignment data",
+	"Official tidak ditemukan":                                              "Official not found",
+	"Penugasan official tidak ditemukan":                                    "Official assignment not found",
+	"Official berhasil dihapus":                                             "Official deleted successfully",
+	"Penugasan official berhasil dibatalkan":                                "Official assignment cancelled successfully",
+	"Official hanya dapat ditugaskan ke match yang masih scheduled":         "Officials can only be assigned to scheduled matches",
+	"%s sudah ditugaskan sebagai %s di match ini":                           "%s is already assigned as %s in this match",
+	"Peran %s sudah terisi penuh untuk match ini":                           "The %s role is already full for this match",
+	"%s memiliki konflik kepentingan dengan salah satu tim yang bertanding": "%s has a conflict of interest with one of the teams in this match",
+	"%s sudah bertugas di match lain pada waktu yang berdekatan":            "%s is already assigned to another match at a nearby time",
+	"Format start_datetime tidak valid (gunakan ISO 8601/RFC3339)":          "Invalid start_datetime format (use ISO 8601/RFC3339)",
+	"Gagal membuat official":                                                "Failed to create official",
+	"Gagal memperbarui official":                                            "Failed to update official",
+	"Gagal menghapus official":                                              "Failed to delete official",
+	"Gagal mengambil data official":                                         "Failed to retrieve official",
+	"Gagal mengambil data officials":                                        "Failed to retrieve officials",
+	"Gagal mengambil data penugasan":                                        "Failed to retrieve assignments",
+	"Gagal menugaskan official":                                             "Failed to assign official",
+	"Gagal menghapus penugasan official":                                    "Failed to delete official assignment",
+	"Gagal validasi jadwal official":                                        "Failed to validate official schedule",
+	"Gagal validasi konflik official":                                       "Failed to validate official conflicts",
+	"Gagal validasi peran official":                                         "Failed to validate official role",
+
+	// Kompetisi
+	"ID kompetisi tidak valid":                                                     "Invalid competition ID",
+	"Data kompetisi tidak valid":                                                   "Invalid competition data",
+	"Data undian tidak valid":                                                      "Invalid draw data",
+	"Kompetisi tidak ditemukan":                                                    "Competition not found",
+	"Kompetisi ini tidak memiliki fase grup":                                       "This competition has no group stage",
+	"Bracket kompetisi sudah dibuat sebelumnya":                                    "The competition bracket has already been generated",
+	"Aturan gol tandang hanya berlaku untuk tie dua leg":                           "The away goals rule only applies to two-legged ties",
+	"Minimal %d tim dibutuhkan untuk %d grup":                                      "At least %d teams are required for %d groups",
+	"Minimal 2 tim harus lolos ke babak knockout":                                  "At least 2 teams must qualify for the knockout stage",
+	"group_count dan qualifiers_per_group wajib diisi untuk format group_knockout": "group_count and qualifiers_per_group are required for the group_knockout format",
+	"qualifiers_per_group harus lebih kecil dari jumlah tim per grup (%d)":         "qualifiers_per_group must be smaller than the number of teams per group (%d)",
+	"Gagal membuat kompetisi":                                                      "Failed to create competition",
+	"Gagal membuat bracket":                                                        "Failed to generate bracket",
+	"Gagal mengambil data kompetisi":                                               "Failed to retrieve competition",
+	"Gagal mengambil data bracket":                                                 "Failed to retrieve bracket",
+	"Gagal mengambil data grup":                                                    "Failed to retrieve groups",
+
+	// Import
+	"Parameter dry_run tidak valid":                 "Invalid dry_run parameter",
+	"File import wajib diunggah":                    "An import file must be uploaded",
+	"Ukuran file maksimal %d MB":                    "File size must not exceed %d MB",
+	"Format file harus CSV atau XLSX":               "File format must be CSV or XLSX",
+	"harus berekstensi .csv atau .xlsx":             "must have a .csv or .xlsx extension",
+	"File tidak dapat dibaca sebagai CSV/XLSX":      "The file could not be read as CSV/XLSX",
+	"Kolom wajib tidak ditemukan: %s":               "Required columns are missing: %s",
+	"File tidak berisi data":                        "The file contains no data",
+	"Import dibatalkan karena %d baris tidak valid": "Import cancelled because %d rows are invalid",
+	"harus berupa angka":                            "must be a number",
+	"harus berupa ID yang valid":                    "must be a valid ID",
+	"sudah dipakai di baris %d":                     "is already used on row %d",
+	"sudah digunakan di tim ini":                    "is already taken in this team",
+	"Gagal membaca file import":                     "Failed to read import file",
+	"Gagal mengimpor data":                          "Failed to import data",
+
+	// Export
+	"Format export tidak didukung (csv, jsonl, xlsx)": "Unsupported export format (csv, jsonl, xlsx)",
+	"Parameter %s tidak valid":                        "Invalid %s parameter",
+	"Filter available_on membutuhkan team_id":         "The available_on filter requires team_id",
+	"Status match tidak valid":                        "Invalid match status",
+	"Gagal mengekspor data":                           "Failed to export data",
+
+	// Jadwal ulang match
+	"Status match hanya bisa diubah menjadi scheduled atau cancelled": "Match status can only be changed to scheduled or cancelled",
+	"harus salah satu dari: scheduled, cancelled":                     "must be one of: scheduled, cancelled",
+	"Match kompetisi tidak bisa dibatalkan":                           "Competition matches cannot be cancelled",
+	"match kompetisi tidak bisa dibatalkan":                           "competition matches cannot be cancelled",
+	"Gagal mengubah jadwal match":                                     "Failed to reschedule match",
+
+	// Kalender
+	"Token kalender tidak valid atau sudah dicabut": "Calendar token is invalid or has been revoked",
+	"Gagal membuat token kalender":                  "Failed to create calendar token",
+	"Gagal mencabut token kalender":                 "Failed to revoke calendar token",
+	"Token kalender berhasil dicabut":               "Calendar token revoked successfully",
+	"Gagal membuat feed kalender":                   "Failed to build calendar feed",
+
+	// Dokumen PDF
+	"Laporan Pertandingan":       "Match Report",
+	"Jadwal":                     "Schedule",
+	"Babak":                      "Round",
+	"Stadion":                    "Venue",
+	"Penonton":                   "Attendance",
+	"(perpanjangan waktu)":       "(extra time)",
+	"Skor Akhir":                 "Final Score",
+	"Adu Penalti":                "Penalties",
+	"Hasil":                      "Result",
+	"Top Scorer":                 "Top Scorer",
+	"Urutan Gol":                 "Goal Timeline",
+	"Menit":                      "Minute",
+	"Pencetak Gol":               "Scorer",
+	"Team":                       "Team",
+	"Total Kemenangan":           "Total Wins",
+	"Perangkat Pertandingan":     "Match Officials",
+	"Wasit":                      "Referee",
+	"Asisten Wasit":              "Assistant Referee",
+	"Ofisial Keempat":            "Fourth Official",
+	"VAR":                        "VAR",
+	"Manajer %s":                 "%s Manager",
+	"Team Sheet":                 "Team Sheet",
+	"No":                         "No",
+	"Nama":                       "Name",
+	"Posisi":                     "Position",
+	"Status":                     "Status",
+	"Tersedia":                   "Available",
+	"Tidak tersedia":             "Unavailable",
+	"Belum ada player terdaftar": "No players registered yet",
+	"Penyerang":                  "Forward",
+	"Gelandang":                  "Midfielder",
+	"Bertahan":                   "Defender",
+	"Penjaga Gawang":             "Goalkeeper",
+	"Gagal mengambil team sheet": "Failed to retrieve team sheet",
+	"Gagal membuat dokumen PDF":  "Failed to generate PDF document",
+
+	// Webhook
+	"Webhook tidak ditemukan":                      "Webhook not found",
+	"Pengiriman webhook tidak ditemukan":           "Webhook delivery not found",
+	"URL webhook harus berupa URL http atau https": "Webhook URL must be an http or https URL",
+	"harus berupa URL http atau https":             "must be an http or https URL",
+	"Secret webhook minimal 16 karakter":           "Webhook secret must be at least 16 characters",
+	"minimal 16 karakter":                          "must be at least 16 characters",
+	"Tipe event webhook tidak valid":               "Invalid webhook event type",
+	"harus berisi satu atau lebih dari: match.completed, goal.recorded, player.created, player.updated, player.deleted, team.deleted, match.scheduled, competition.completed": "must contain one or more of: match.completed, goal.recorded, player.created, player.updated, player.deleted, team.deleted, match.scheduled, competition.completed",
+	"Data webhook tidak valid":               "Invalid webhook data",
+	"Gagal membuat webhook":                  "Failed to create webhook",
+	"Gagal mengambil data webhook":           "Failed to retrieve webhook data",
+	"Gagal memperbarui webhook":              "Failed to update webhook",
+	"Gagal menghapus webhook":                "Failed to delete webhook",
+	"Webhook berhasil dihapus":               "Webhook deleted successfully",
+	"Gagal mengambil log pengiriman webhook": "Failed to retrieve webhook deliveries",
+	"ID pengiriman webhook tidak valid":      "Invalid webhook delivery ID",
+	"Gagal mengirim ulang webhook":           "Failed to redeliver webhook",
+	"ID webhook tidak valid":                 "Invalid webhook ID",
+
+	// Outbox
+	"Gagal mengambil event outbox yang diparkir":  "Failed to retrieve parked outbox events",
+	"Data requeue outbox tidak valid":             "Invalid outbox requeue data",
+	"Gagal mengembalikan event outbox ke antrean": "Failed to requeue outbox events",
+
+	// WebSocket
+	"Endpoint ini memerlukan koneksi WebSocket":              "This endpoint requires a WebSocket connection",
+	"Koneksi terlalu lambat menerima event dan akan ditutup": "Connection is too slow to receive events and will be closed",
+	"Server sedang dimatikan, silakan sambung ulang":         "Server is shutting down, please reconnect",
+	"Pesan tidak valid":             "Invalid message",
+	"Aksi %s tidak dikenal":         "Unknown action %s",
+	"Topic %s tidak valid":          "Invalid topic %s",
+	"Maksimal %d topic per koneksi": "At most %d topics per connection",
+
+	// GraphQL
+	"Body request GraphQL tidak valid":        "Invalid GraphQL request body",
+	"Query GraphQL wajib diisi":               "GraphQL query is required",
+	"Kedalaman query %d melebihi batas %d":    "Query depth %d exceeds the limit of %d",
+	"Kompleksitas query %d melebihi batas %d": "Query complexity %d exceeds the limit of %d",
+	"Parameter first harus antara 1 dan %d":   "Parameter first must be between 1 and %d",
+	"Parameter offset tidak boleh negatif":    "Parameter offset must not be negative",
+	"Gagal mengambil data matches":            "Failed to retrieve matches data",
+
+	// Users
+	"Gagal memeriksa credentials": "Failed to verify credentials",
+	"Username wajib diisi":        "Username is required",
+	"Password minimal 8 karakter": "Password must be at least 8 characters",
+	"minimal 8 karakter":          "must be at least 8 characters",
+	"Username sudah digunakan":    "Username is already taken",
+	"Data user tidak valid":       "Invalid user data",
+	"Gagal membuat user":          "Failed to create user",
+
+	// Seed
+	"Jumlah team harus antara %d dan %d": "Number of teams must be between %d and %d",
+
+	// gRPC
+	"Parameter page_size harus antara 0 dan %d": "Parameter page_size must be between 0 and %d",
+}
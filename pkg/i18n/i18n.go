@@ -0,0 +1,100 @@
+// Package i18n menyediakan katalog pesan API dalam beberapa bahasa.
+//
+// Teks bahasa Indonesia (boleh berisi verb fmt seperti %d) dipakai langsung sebagai message ID,
+// sehingga kode tetap bisa dibaca tanpa membuka katalog. Bahasa lain memetakan ID tersebut ke
+// terjemahannya; ID yang belum diterjemahkan dikirim dalam bahasa Indonesia.
+package i18n
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Bahasa yang didukung
+const (
+	Indonesian = "id"
+	English    = "en"
+)
+
+// DefaultLanguage dipakai jika Accept-Language kosong atau tidak ada bahasa yang didukung
+const DefaultLanguage = Indonesian
+
+// catalogs berisi terjemahan per bahasa. Bahasa Indonesia tidak memerlukan katalog
+// karena message ID sudah dalam bahasa Indonesia.
+var catalogs = map[string]map[string]string{
+	English: english,
+}
+
+// Languages mengembalikan semua bahasa yang didukung
+func Languages() []string {
+	return []string{Indonesian, English}
+}
+
+// Translate menerjemahkan message ID ke lang lalu memformatnya dengan args
+func Translate(lang, id string, args ...interface{}) string {
+	format := id
+	if translated, ok := catalogs[lang][id]; ok {
+		format = translated
+	}
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// Has melaporkan apakah message ID memiliki terjemahan dalam lang
+func Has(lang, id string) bool {
+	if lang == Indonesian {
+		return true
+	}
+	_, ok := catalogs[lang][id]
+	return ok
+}
+
+// Negotiate memilih bahasa yang didukung dari header Accept-Language berdasarkan q-value.
+// Subtag wilayah diabaikan (en-US cocok dengan en).
+func Negotiate(acceptLanguage string) string {
+	type candidate struct {
+		lang string
+		q    float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		lang := strings.ToLower(strings.TrimSpace(tag))
+		lang, _, _ = strings.Cut(lang, "-")
+		if lang == "" {
+			continue
+		}
+
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		candidates = append(candidates, candidate{lang: lang, q: q})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].q > candidates[j].q
+	})
+
+	for _, c := range candidates {
+		if c.q <= 0 {
+			break
+		}
+		if c.lang == Indonesian {
+			return Indonesian
+		}
+		if _, ok := catalogs[c.lang]; ok {
+			return c.lang
+		}
+	}
+	return DefaultLanguage
+}
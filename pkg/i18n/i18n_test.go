@@ -0,0 +1,41 @@
+package i18n
+
+import "testing"
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		header string
+		want   string
+	}{
+		{"", Indonesian},
+		{"en", English},
+		{"en-US,en;q=0.9", English},
+		{"id-ID", Indonesian},
+		{"fr, en;q=0.8, id;q=0.5", English},
+		{"id;q=0.4, en;q=0.6", English},
+		{"fr, de", DefaultLanguage},
+		{"en;q=0", DefaultLanguage},
+		{"*", DefaultLanguage},
+	}
+
+	for _, tt := range tests {
+		if got := Negotiate(tt.header); got != tt.want {
+			t.Errorf("Negotiate(%q) = %q, want %q", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	if got := Translate(English, "Team tidak ditemukan"); got != "Team not found" {
+		t.Errorf("got %q", got)
+	}
+	if got := Translate(Indonesian, "Team dengan ID %d tidak ditemukan", 7); got != "Team dengan ID 7 tidak ditemukan" {
+		t.Errorf("got %q", got)
+	}
+	if got := Translate(English, "Team dengan ID %d tidak ditemukan", 7); got != "Team with ID 7 not found" {
+		t.Errorf("got %q", got)
+	}
+	if got := Translate(English, "pesan tanpa terjemahan"); got != "pesan tanpa terjemahan" {
+		t.Errorf("untranslated id should fall back to Indonesian, got %q", got)
+	}
+}
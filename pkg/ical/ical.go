@@ -0,0 +1,138 @@
+// Package ical menulis kalender iCalendar (RFC 5545) tanpa dependency eksternal.
+// Hanya komponen VEVENT yang didukung, cukup untuk feed jadwal yang di-subscribe aplikasi kalender.
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ContentType adalah media type untuk response iCalendar
+const ContentType = "text/calendar; charset=utf-8"
+
+// maxLineOctets adalah panjang maksimum satu baris sebelum dilipat (RFC 5545 bagian 3.1)
+const maxLineOctets = 75
+
+// utcLayout adalah format DATE-TIME dalam UTC
+const utcLayout = "20060102T150405Z"
+
+// Status adalah status event (properti STATUS)
+type Status string
+
+const (
+	StatusConfirmed Status = "CONFIRMED"
+	StatusCancelled Status = "CANCELLED"
+)
+
+// Event adalah satu VEVENT.
+// UID harus stabil untuk event yang sama agar aplikasi kalender memperbarui event lama
+// alih-alih membuat duplikat; Sequence harus naik setiap kali event berubah.
+type Event struct {
+	UID          string
+	Sequence     int
+	Stamp        time.Time
+	Start        time.Time
+	End          time.Time
+	LastModified time.Time
+	Summary      string
+	Location     string
+	Description  string
+	Status       Status
+}
+
+// Calendar adalah satu VCALENDAR berisi daftar event
+type Calendar struct {
+	ProductID string
+	// Name ditampilkan aplikasi kalender sebagai nama kalender (X-WR-CALNAME)
+	Name   string
+	Events []Event
+}
+
+// Encode menulis kalender ke w dengan akhir baris CRLF dan baris panjang dilipat
+func (c *Calendar) Encode(w io.Writer) error {
+	e := &encoder{w: bufio.NewWriter(w)}
+
+	e.line("BEGIN", "VCALENDAR")
+	e.line("VERSION", "2.0")
+	e.line("PRODID", c.ProductID)
+	e.line("CALSCALE", "GREGORIAN")
+	e.line("METHOD", "PUBLISH")
+	if c.Name != "" {
+		e.line("X-WR-CALNAME", escapeText(c.Name))
+	}
+
+	for _, event := range c.Events {
+		e.line("BEGIN", "VEVENT")
+		e.line("UID", event.UID)
+		e.line("DTSTAMP", formatTime(event.Stamp))
+		e.line("DTSTART", formatTime(event.Start))
+		e.line("DTEND", formatTime(event.End))
+		e.line("SEQUENCE", fmt.Sprint(event.Sequence))
+		if !event.LastModified.IsZero() {
+			e.line("LAST-MODIFIED", formatTime(event.LastModified))
+		}
+		e.line("SUMMARY", escapeText(event.Summary))
+		if event.Location != "" {
+			e.line("LOCATION", escapeText(event.Location))
+		}
+		if event.Description != "" {
+			e.line("DESCRIPTION", escapeText(event.Description))
+		}
+		if event.Status != "" {
+			e.line("STATUS", string(event.Status))
+		}
+		e.line("END", "VEVENT")
+	}
+
+	e.line("END", "VCALENDAR")
+	if e.err != nil {
+		return e.err
+	}
+	return e.w.Flush()
+}
+
+// encoder menulis content line dan menyimpan error pertama
+type encoder struct {
+	w   *bufio.Writer
+	err error
+}
+
+// line menulis "NAME:value" yang sudah di-escape, dilipat setiap maxLineOctets byte
+func (e *encoder) line(name, value string) {
+	if e.err != nil {
+		return
+	}
+
+	content := name + ":" + value
+	// Baris lanjutan diawali satu spasi yang ikut dihitung dalam batas panjang
+	limit := maxLineOctets
+	for len(content) > limit {
+		// Jangan memotong di tengah karakter UTF-8
+		cut := limit
+		for !utf8.RuneStart(content[cut]) {
+			cut--
+		}
+		if _, e.err = e.w.WriteString(content[:cut] + "\r\n "); e.err != nil {
+			return
+		}
+		content = content[cut:]
+		limit = maxLineOctets - 1
+	}
+	_, e.err = e.w.WriteString(content + "\r\n")
+}
+
+// textEscaper meng-escape nilai bertipe TEXT (RFC 5545 bagian 3.3.11)
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+// formatTime menulis waktu sebagai DATE-TIME UTC
+func formatTime(t time.Time) string {
+	return t.UTC().Format(utcLayout)
+}
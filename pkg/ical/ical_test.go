@@ -0,0 +1,90 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCalendarEncode(t *testing.T) {
+	jakarta := time.FixedZone("WIB", 7*60*60)
+	start := time.Date(2025, 3, 1, 19, 0, 0, 0, jakarta)
+
+	cal := Calendar{
+		ProductID: "-//Test//Fixtures//ID",
+		Name:      "Garuda FC",
+		Events: []Event{
+			{
+				UID:          "match-1@test",
+				Sequence:     2,
+				Stamp:        start.Add(-time.Hour),
+				Start:        start,
+				End:          start.Add(2 * time.Hour),
+				LastModified: start.Add(-time.Hour),
+				Summary:      "Garuda FC vs Elang FC",
+				Location:     "Stadion Utama, Jakarta",
+				Status:       StatusConfirmed,
+			},
+			{
+				UID:     "match-2@test",
+				Stamp:   start,
+				Start:   start,
+				End:     start,
+				Summary: "Batal; diganti\nhari lain",
+				Status:  StatusCancelled,
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := cal.Encode(&buf); err != nil {
+		t.Fatalf("Encode error: %v", err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//Test//Fixtures//ID\r\n",
+		"X-WR-CALNAME:Garuda FC\r\n",
+		"UID:match-1@test\r\nDTSTAMP:20250301T110000Z\r\nDTSTART:20250301T120000Z\r\nDTEND:20250301T140000Z\r\nSEQUENCE:2\r\n",
+		`LOCATION:Stadion Utama\, Jakarta` + "\r\n",
+		"STATUS:CONFIRMED\r\n",
+		`SUMMARY:Batal\; diganti\nhari lain` + "\r\n",
+		"STATUS:CANCELLED\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output tidak berisi %q\n%s", want, out)
+		}
+	}
+	if strings.Count(out, "BEGIN:VEVENT") != 2 {
+		t.Errorf("jumlah VEVENT salah:\n%s", out)
+	}
+	if strings.Contains(out, "LAST-MODIFIED:00") {
+		t.Errorf("LAST-MODIFIED kosong ikut ditulis:\n%s", out)
+	}
+}
+
+func TestLineFolding(t *testing.T) {
+	summary := strings.Repeat("é", 100) // 200 byte
+	cal := Calendar{ProductID: "p", Events: []Event{{UID: "u", Summary: summary}}}
+
+	var buf bytes.Buffer
+	if err := cal.Encode(&buf); err != nil {
+		t.Fatalf("Encode error: %v", err)
+	}
+
+	var unfolded strings.Builder
+	for i, line := range strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n") {
+		if len(line) > maxLineOctets {
+			t.Errorf("baris %d panjangnya %d byte", i, len(line))
+		}
+		if strings.HasPrefix(line, " ") {
+			unfolded.WriteString(line[1:])
+			continue
+		}
+		unfolded.WriteString("\n" + line)
+	}
+	if !strings.Contains(unfolded.String(), "\nSUMMARY:"+summary+"\n") {
+		t.Errorf("SUMMARY rusak setelah dilipat:\n%s", buf.String())
+	}
+}
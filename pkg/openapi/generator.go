@@ -0,0 +1,418 @@
+package openapi
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Sources menunjuk file sumber anotasi, relatif terhadap root module
+type Sources struct {
+	// General adalah file berisi anotasi umum (@title, @version, @description, @securityDefinitions)
+	General string
+	// Dirs adalah direktori package berisi fungsi handler beranotasi @Router
+	Dirs []string
+}
+
+// generator menyimpan state satu kali generate
+type generator struct {
+	root     string
+	module   string
+	fset     *token.FileSet
+	packages map[string]*goPackage
+	doc      *Document
+	// operationIDs mencegah dua handler dengan nama fungsi sama (operationId harus unik)
+	operationIDs map[string]bool
+}
+
+// Generate membaca anotasi dari sources di dalam module root lalu membuat dokumen OpenAPI
+func Generate(root string, sources Sources) (*Document, error) {
+	module, err := modulePath(root)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{
+		root:         root,
+		module:       module,
+		fset:         token.NewFileSet(),
+		packages:     make(map[string]*goPackage),
+		operationIDs: make(map[string]bool),
+		doc: &Document{
+			OpenAPI: Version,
+			Paths:   make(map[string]PathItem),
+			Components: Components{
+				Schemas:         make(map[string]*Schema),
+				SecuritySchemes: make(map[string]SecurityScheme),
+			},
+		},
+	}
+
+	if sources.General != "" {
+		if err := g.parseGeneral(filepath.Join(root, filepath.FromSlash(sources.General))); err != nil {
+			return nil, err
+		}
+	}
+	for _, dir := range sources.Dirs {
+		pkg, err := g.loadPackage(strings.TrimSuffix(module+"/"+filepath.ToSlash(dir), "/"))
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range pkg.files {
+			for _, decl := range file.Decls {
+				fn, ok := decl.(*ast.FuncDecl)
+				if !ok || fn.Doc == nil {
+					continue
+				}
+				if err := g.parseOperation(pkg, file, fn); err != nil {
+					return nil, fmt.Errorf("%s: %w", g.fset.Position(fn.Pos()), err)
+				}
+			}
+		}
+	}
+	return g.doc, nil
+}
+
+// MarshalIndent menulis dokumen dengan indentasi dan newline di akhir (format file yang di-commit)
+func (d *Document) MarshalIndent() ([]byte, error) {
+	data, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// modulePath membaca path module dari go.mod di root
+func modulePath(root string) (string, error) {
+	file, err := os.Open(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if module, ok := strings.CutPrefix(strings.TrimSpace(scanner.Text()), "module "); ok {
+			return strings.Trim(strings.TrimSpace(module), `"`), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("direktif module tidak ditemukan di %s", file.Name())
+}
+
+// annotation adalah satu baris komentar @Nama nilai
+type annotation struct {
+	name  string
+	value string
+}
+
+// annotations mengambil baris anotasi dari komentar
+func annotations(group *ast.CommentGroup) []annotation {
+	var result []annotation
+	for _, line := range strings.Split(group.Text(), "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "@") {
+			continue
+		}
+		name, value, _ := strings.Cut(line[1:], " ")
+		result = append(result, annotation{name: name, value: strings.TrimSpace(value)})
+	}
+	return result
+}
+
+// parseGeneral membaca anotasi umum dari semua komentar di file (biasanya di atas func main)
+func (g *generator) parseGeneral(filename string) error {
+	file, err := parser.ParseFile(g.fset, filename, nil, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+
+	var description []string
+	var scheme *SecurityScheme
+	var schemeName string
+	flush := func() {
+		if scheme != nil {
+			g.doc.Components.SecuritySchemes[schemeName] = *scheme
+			scheme = nil
+		}
+	}
+	for _, group := range file.Comments {
+		for _, a := range annotations(group) {
+			switch strings.ToLower(a.name) {
+			case "title":
+				g.doc.Info.Title = a.value
+			case "version":
+				g.doc.Info.Version = a.value
+			case "description":
+				if scheme != nil {
+					scheme.Description = a.value
+				} else {
+					description = append(description, a.value)
+				}
+			case "securitydefinitions.apikey":
+				flush()
+				schemeName, scheme = a.value, &SecurityScheme{Type: "apiKey"}
+			case "securitydefinitions.bearer":
+				flush()
+				schemeName, scheme = a.value, &SecurityScheme{Type: "http", Scheme: "bearer"}
+			case "in", "name", "bearerformat":
+				if scheme == nil {
+					return fmt.Errorf("@%s harus berada setelah @securityDefinitions", a.name)
+				}
+				switch strings.ToLower(a.name) {
+				case "in":
+					scheme.In = a.value
+				case "name":
+					scheme.Name = a.value
+				default:
+					scheme.BearerFormat = a.value
+				}
+			}
+		}
+	}
+	flush()
+	g.doc.Info.Description = strings.Join(description, "\n")
+
+	if g.doc.Info.Title == "" || g.doc.Info.Version == "" {
+		return fmt.Errorf("%s: anotasi @title dan @version wajib ada", filename)
+	}
+	return nil
+}
+
+var (
+	// paramPattern: nama lokasi type wajib "deskripsi" atribut
+	paramPattern = regexp.MustCompile(`^(\S+)\s+(\w+)\s+(\S+)\s+(true|false)\s+"([^"]*)"\s*(.*)$`)
+	// responsePattern: code {jenis} type "deskripsi"
+	responsePattern = regexp.MustCompile(`^(\d{3})\s+\{(\w+)\}\s+(\S+)(?:\s+"([^"]*)")?$`)
+	// routerPattern: /path [method]
+	routerPattern = regexp.MustCompile(`^(\S+)\s+\[(\w+)\]$`)
+	enumsPattern  = regexp.MustCompile(`Enums\(([^)]*)\)`)
+	// mimeAliases adalah singkatan content type yang dipakai swag pada @Accept/@Produce
+	mimeAliases = map[string]string{
+		"json":         "application/json",
+		"mpfd":         "multipart/form-data",
+		"plain":        "text/plain",
+		"html":         "text/html",
+		"octet-stream": "application/octet-stream",
+	}
+)
+
+// mimeTypes memecah daftar content type pada @Accept/@Produce
+func mimeTypes(value string) []string {
+	var result []string
+	for _, mime := range strings.Split(value, ",") {
+		mime = strings.TrimSpace(mime)
+		if alias, ok := mimeAliases[mime]; ok {
+			mime = alias
+		}
+		if mime != "" {
+			result = append(result, mime)
+		}
+	}
+	return result
+}
+
+// parseOperation membaca anotasi satu fungsi handler; fungsi tanpa @Router diabaikan
+func (g *generator) parseOperation(pkg *goPackage, file *ast.File, fn *ast.FuncDecl) error {
+	lines := annotations(fn.Doc)
+	op := &Operation{OperationID: fn.Name.Name, Responses: make(map[string]Response)}
+	var description, accept, produce []string
+	var route, method string
+	var formData []annotation
+
+	// @Accept dan @Produce dibaca lebih dulu karena menentukan content type body dan response
+	for _, a := range lines {
+		switch a.name {
+		case "Accept":
+			accept = mimeTypes(a.value)
+		case "Produce":
+			produce = mimeTypes(a.value)
+		}
+	}
+	if len(accept) == 0 {
+		accept = []string{"application/json"}
+	}
+
+	for _, a := range lines {
+		switch a.name {
+		case "Summary":
+			op.Summary = a.value
+		case "Description":
+			description = append(description, a.value)
+		case "Tags":
+			for _, tag := range strings.Split(a.value, ",") {
+				op.Tags = append(op.Tags, strings.TrimSpace(tag))
+			}
+		case "Security":
+			op.Security = append(op.Security, map[string][]string{a.value: {}})
+		case "Param":
+			match := paramPattern.FindStringSubmatch(a.value)
+			if match == nil {
+				return fmt.Errorf("format @Param tidak valid: %q", a.value)
+			}
+			switch match[2] {
+			case "body":
+				schema, err := g.typeRef(pkg, file, match[3])
+				if err != nil {
+					return err
+				}
+				body := &RequestBody{Description: match[5], Required: match[4] == "true", Content: make(map[string]MediaType)}
+				for _, mime := range accept {
+					body.Content[mime] = MediaType{Schema: schema}
+				}
+				op.RequestBody = body
+			case "formData":
+				formData = append(formData, a)
+			case "path", "query", "header":
+				schema, ok := primitiveSchema(match[3])
+				if !ok {
+					return fmt.Errorf("type parameter %q tidak didukung", match[3])
+				}
+				if enums := enumsPattern.FindStringSubmatch(match[6]); enums != nil {
+					for _, value := range strings.Split(enums[1], ",") {
+						schema.Enum = append(schema.Enum, exampleValue(schema, strings.TrimSpace(value)))
+					}
+				}
+				op.Parameters = append(op.Parameters, Parameter{
+					Name:        match[1],
+					In:          match[2],
+					Description: match[5],
+					// Parameter path selalu wajib menurut OpenAPI
+					Required: match[4] == "true" || match[2] == "path",
+					Schema:   schema,
+				})
+			default:
+				return fmt.Errorf("lokasi parameter %q tidak didukung", match[2])
+			}
+		case "Success", "Failure":
+			match := responsePattern.FindStringSubmatch(a.value)
+			if match == nil {
+				return fmt.Errorf("format @%s tidak valid: %q", a.name, a.value)
+			}
+			if _, exists := op.Responses[match[1]]; exists {
+				return fmt.Errorf("response %s ditulis lebih dari sekali", match[1])
+			}
+			response, err := g.response(pkg, file, match, produce)
+			if err != nil {
+				return err
+			}
+			op.Responses[match[1]] = response
+		case "Router":
+			match := routerPattern.FindStringSubmatch(a.value)
+			if match == nil {
+				return fmt.Errorf("format @Router tidak valid: %q", a.value)
+			}
+			route, method = match[1], strings.ToLower(match[2])
+		}
+	}
+	if route == "" {
+		return nil
+	}
+
+	if len(formData) > 0 {
+		body, err := formBody(formData)
+		if err != nil {
+			return err
+		}
+		op.RequestBody = body
+	}
+	op.Description = strings.Join(description, "\n")
+	if len(op.Responses) == 0 {
+		return fmt.Errorf("%s %s tidak memiliki @Success atau @Failure", method, route)
+	}
+
+	item, ok := g.doc.Paths[route]
+	if !ok {
+		item = make(PathItem)
+		g.doc.Paths[route] = item
+	}
+	if _, exists := item[method]; exists {
+		return fmt.Errorf("%s %s sudah didefinisikan handler lain", method, route)
+	}
+	if g.operationIDs[op.OperationID] {
+		return fmt.Errorf("operationId %s dipakai lebih dari satu handler", op.OperationID)
+	}
+	g.operationIDs[op.OperationID] = true
+	item[method] = op
+	return nil
+}
+
+// response membuat response dari hasil responsePattern. Response {file} dan {string} memakai content type
+// @Produce selain JSON; response {object}/{array} selalu JSON (termasuk error pada endpoint file).
+func (g *generator) response(pkg *goPackage, file *ast.File, match []string, produce []string) (Response, error) {
+	code, _ := strconv.Atoi(match[1])
+	response := Response{Description: match[4]}
+	if response.Description == "" {
+		response.Description = http.StatusText(code)
+	}
+
+	var schema *Schema
+	mimes := []string{"application/json"}
+	switch match[2] {
+	case "object", "array":
+		var err error
+		if schema, err = g.typeRef(pkg, file, match[3]); err != nil {
+			return Response{}, err
+		}
+		if match[2] == "array" {
+			schema = &Schema{Type: "array", Items: schema}
+		}
+	case "file", "string":
+		schema, mimes = &Schema{Type: "string"}, nil
+		for _, mime := range produce {
+			if mime != "application/json" {
+				mimes = append(mimes, mime)
+			}
+		}
+		if match[2] == "file" {
+			schema.Format = "binary"
+			if len(mimes) == 0 {
+				mimes = []string{"application/octet-stream"}
+			}
+		} else if len(mimes) == 0 {
+			mimes = []string{"text/plain"}
+		}
+	default:
+		return Response{}, fmt.Errorf("jenis response {%s} tidak didukung", match[2])
+	}
+
+	response.Content = make(map[string]MediaType, len(mimes))
+	for _, mime := range mimes {
+		response.Content[mime] = MediaType{Schema: schema}
+	}
+	return response, nil
+}
+
+// formBody membuat body multipart/form-data dari parameter formData
+func formBody(params []annotation) (*RequestBody, error) {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	for _, param := range params {
+		match := paramPattern.FindStringSubmatch(param.value)
+		property, ok := primitiveSchema(match[3])
+		if !ok {
+			return nil, fmt.Errorf("type parameter formData %q tidak didukung", match[3])
+		}
+		property.Description = match[5]
+		schema.Properties[match[1]] = property
+		if match[4] == "true" {
+			schema.Required = append(schema.Required, match[1])
+		}
+	}
+	sort.Strings(schema.Required)
+	return &RequestBody{
+		Required: len(schema.Required) > 0,
+		Content:  map[string]MediaType{"multipart/form-data": {Schema: schema}},
+	}, nil
+}
@@ -0,0 +1,221 @@
+package openapi
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// generateExample membuat dokumen dari module contoh di testdata/example
+func generateExample(t *testing.T) *Document {
+	t.Helper()
+	doc, err := Generate("testdata/example", Sources{General: "main.go", Dirs: []string{"api"}})
+	if err != nil {
+		t.Fatalf("Generate error: %v", err)
+	}
+	return doc
+}
+
+func TestGenerateInfoAndSecurity(t *testing.T) {
+	doc := generateExample(t)
+
+	want := Info{Title: "League API", Version: "2.1", Description: "Contoh API untuk test generator."}
+	if doc.OpenAPI != Version || doc.Info != want {
+		t.Errorf("openapi = %q, info = %+v", doc.OpenAPI, doc.Info)
+	}
+	scheme := doc.Components.SecuritySchemes["BearerAuth"]
+	if scheme.Type != "http" || scheme.Scheme != "bearer" || scheme.BearerFormat != "JWT" {
+		t.Errorf("security scheme = %+v", scheme)
+	}
+}
+
+func TestGenerateOperations(t *testing.T) {
+	doc := generateExample(t)
+
+	if len(doc.Paths) != 3 {
+		t.Fatalf("paths = %v", doc.Paths)
+	}
+
+	list := doc.Paths["/clubs"]["get"]
+	if list == nil || list.OperationID != "ListClubs" || list.Summary != "Daftar klub" {
+		t.Fatalf("GET /clubs = %+v", list)
+	}
+	if list.Description != "Baris pertama.\nBaris kedua." || !reflect.DeepEqual(list.Tags, []string{"Clubs"}) {
+		t.Errorf("description = %q, tags = %v", list.Description, list.Tags)
+	}
+	if len(list.Security) != 1 || list.Security[0]["BearerAuth"] == nil {
+		t.Errorf("security = %v", list.Security)
+	}
+	if len(list.Parameters) != 1 {
+		t.Fatalf("parameters = %+v", list.Parameters)
+	}
+	level := list.Parameters[0]
+	if level.In != "query" || level.Required || !reflect.DeepEqual(level.Schema.Enum, []interface{}{"pro", "amateur"}) {
+		t.Errorf("parameter level = %+v, schema = %+v", level, level.Schema)
+	}
+	if got := list.Responses["200"].Content["application/json"].Schema.Ref; got != RefPrefix+"api.ClubPage" {
+		t.Errorf("200 schema = %q", got)
+	}
+	failure := list.Responses["400"]
+	if failure.Description != "Parameter salah" || failure.Content["application/json"].Schema.AdditionalProperties.Type != "string" {
+		t.Errorf("400 = %+v", failure)
+	}
+
+	create := doc.Paths["/clubs/{id}"]["post"]
+	if create == nil || !create.Parameters[0].Required || create.Parameters[0].Schema.Type != "integer" {
+		t.Fatalf("POST /clubs/{id} = %+v", create)
+	}
+	if body := create.RequestBody; !body.Required || body.Content["application/json"].Schema.Ref != RefPrefix+"model.Club" {
+		t.Errorf("request body = %+v", body)
+	}
+	created := create.Responses["201"]
+	if created.Description != "Created" || created.Content["application/json"].Schema.Items.Ref != RefPrefix+"model.Club" {
+		t.Errorf("201 = %+v", created)
+	}
+
+	export := doc.Paths["/clubs/export"]["post"]
+	form := export.RequestBody.Content["multipart/form-data"].Schema
+	if form.Properties["file"].Format != "binary" || !reflect.DeepEqual(form.Required, []string{"file"}) {
+		t.Errorf("form = %+v", form)
+	}
+	if file := export.Responses["200"].Content["text/csv"].Schema; file == nil || file.Format != "binary" {
+		t.Errorf("200 = %+v", export.Responses["200"])
+	}
+	if _, ok := export.Responses["500"].Content["application/json"]; !ok {
+		t.Errorf("500 harus JSON: %+v", export.Responses["500"])
+	}
+}
+
+func TestGenerateSchemas(t *testing.T) {
+	doc := generateExample(t)
+
+	club := doc.Components.Schemas["model.Club"]
+	if club == nil {
+		t.Fatalf("schemas = %v", doc.Components.Schemas)
+	}
+	if club.Description != "Club adalah klub peserta liga" {
+		t.Errorf("description = %q", club.Description)
+	}
+
+	var names []string
+	for name := range club.Properties {
+		names = append(names, name)
+	}
+	for _, name := range []string{"id", "created_at", "name", "level", "tier", "coach", "rival", "tags"} {
+		if club.Properties[name] == nil {
+			t.Errorf("property %s tidak ada (ada: %v)", name, names)
+		}
+	}
+	if len(club.Properties) != 8 {
+		t.Errorf("properties = %v (field tanpa json atau tidak diekspor harus dilewati)", names)
+	}
+	if !reflect.DeepEqual(club.Required, []string{"name", "tags"}) {
+		t.Errorf("required = %v", club.Required)
+	}
+
+	if created := club.Properties["created_at"]; created.Type != "string" || created.Format != "date-time" {
+		t.Errorf("created_at = %+v", created)
+	}
+	if name := club.Properties["name"]; name.MinLength == nil || *name.MinLength != 3 {
+		t.Errorf("name = %+v", name)
+	}
+	if level := club.Properties["level"]; level.Type != "string" || !reflect.DeepEqual(level.Enum, []interface{}{"pro", "amateur"}) {
+		t.Errorf("level = %+v", level)
+	}
+	if tier := club.Properties["tier"]; !reflect.DeepEqual(tier.Enum, []interface{}{"pro"}) || tier.Description != "Tier hanya menerima sebagian nilai Level" {
+		t.Errorf("tier = %+v", tier)
+	}
+	if coach := club.Properties["coach"]; !coach.Nullable || coach.Type != "string" {
+		t.Errorf("coach = %+v", coach)
+	}
+	if rival := club.Properties["rival"]; rival.Ref != RefPrefix+"model.Club" {
+		t.Errorf("rival = %+v", rival)
+	}
+	if got := club.Properties["rival"]; got.Description != "" {
+		t.Errorf("$ref tidak boleh memiliki field lain: %+v", got)
+	}
+
+	tags := club.Properties["tags"]
+	if tags.MinItems == nil || *tags.MinItems != 1 || !reflect.DeepEqual(tags.Items.Enum, []interface{}{"north", "south", "far east"}) {
+		t.Errorf("tags = %+v, items = %+v", tags, tags.Items)
+	}
+	if !reflect.DeepEqual(tags.Example, []interface{}{"north", "south"}) {
+		t.Errorf("tags example = %v", tags.Example)
+	}
+
+	page := doc.Components.Schemas["api.ClubPage"]
+	if total := page.Properties["total"]; total.Type != "integer" || total.Format != "int64" {
+		t.Errorf("total = %+v", total)
+	}
+}
+
+func TestGenerateDeterministic(t *testing.T) {
+	first, err := generateExample(t).MarshalIndent()
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := generateExample(t).MarshalIndent()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(first) != string(second) {
+		t.Error("hasil generate berbeda antar pemanggilan")
+	}
+	if !json.Valid(first) || !strings.HasSuffix(string(first), "}\n") {
+		t.Error("output bukan JSON yang diakhiri newline")
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   string
+	}{
+		{
+			name:   "unknown type",
+			source: "// @Success 200 {object} Missing\n// @Router /x [get]\nfunc X() {}\n",
+			want:   "type api.Missing tidak ditemukan",
+		},
+		{
+			name:   "invalid param",
+			source: "// @Param id path\n// @Success 200 {object} int\n// @Router /x [get]\nfunc X() {}\n",
+			want:   "format @Param tidak valid",
+		},
+		{
+			name:   "duplicate route",
+			source: "// @Success 200 {string} string\n// @Router /x [get]\nfunc X() {}\n\n// @Success 200 {string} string\n// @Router /x [get]\nfunc Y() {}\n",
+			want:   "get /x sudah didefinisikan",
+		},
+		{
+			name:   "no responses",
+			source: "// @Router /x [get]\nfunc X() {}\n",
+			want:   "tidak memiliki @Success atau @Failure",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			writeFile(t, filepath.Join(root, "go.mod"), "module example.com/broken\n")
+			writeFile(t, filepath.Join(root, "api", "api.go"), "package api\n\n"+tt.source)
+
+			_, err := Generate(root, Sources{Dirs: []string{"api"}})
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func writeFile(t *testing.T, name, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(name, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,562 @@
+package openapi
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// goPackage adalah package Go di dalam module yang sudah di-parse
+type goPackage struct {
+	path  string
+	name  string
+	types map[string]typeDecl
+	// enums berisi nilai konstanta per nama type (mis. MatchStatus -> scheduled, completed, ...)
+	enums map[string][]interface{}
+	files []*ast.File
+}
+
+// typeDecl adalah deklarasi type beserta file tempat import-nya dibaca
+type typeDecl struct {
+	spec *ast.TypeSpec
+	file *ast.File
+}
+
+// externalTypes adalah type di luar module yang dikenal generator
+var externalTypes = map[string]func() *Schema{
+	"time.Time":                 func() *Schema { return &Schema{Type: "string", Format: "date-time"} },
+	"time.Duration":             func() *Schema { return &Schema{Type: "integer", Format: "int64"} },
+	"encoding/json.RawMessage":  func() *Schema { return &Schema{} },
+	"gorm.io/gorm.DeletedAt":    func() *Schema { return &Schema{Type: "string", Format: "date-time", Nullable: true} },
+	"mime/multipart.FileHeader": func() *Schema { return &Schema{Type: "string", Format: "binary"} },
+}
+
+// basicSchema mengembalikan schema untuk type bawaan Go
+func basicSchema(name string) (*Schema, bool) {
+	switch name {
+	case "string":
+		return &Schema{Type: "string"}, true
+	case "bool":
+		return &Schema{Type: "boolean"}, true
+	case "int", "int8", "int16", "int32", "uint", "uint8", "uint16", "uint32":
+		return &Schema{Type: "integer"}, true
+	case "int64", "uint64":
+		return &Schema{Type: "integer", Format: "int64"}, true
+	case "float32":
+		return &Schema{Type: "number", Format: "float"}, true
+	case "float64":
+		return &Schema{Type: "number", Format: "double"}, true
+	case "any":
+		return &Schema{}, true
+	}
+	return nil, false
+}
+
+// loadPackage mem-parse package module dengan import path tertentu (sekali per path)
+func (g *generator) loadPackage(importPath string) (*goPackage, error) {
+	if pkg, ok := g.packages[importPath]; ok {
+		return pkg, nil
+	}
+
+	rel := strings.TrimPrefix(strings.TrimPrefix(importPath, g.module), "/")
+	dir := filepath.Join(g.root, filepath.FromSlash(rel))
+	notTest := func(info os.FileInfo) bool { return !strings.HasSuffix(info.Name(), "_test.go") }
+	parsed, err := parser.ParseDir(g.fset, dir, notTest, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	if len(parsed) != 1 {
+		return nil, fmt.Errorf("direktori %s harus berisi tepat satu package", dir)
+	}
+
+	pkg := &goPackage{path: importPath, types: make(map[string]typeDecl), enums: make(map[string][]interface{})}
+	for name, astPkg := range parsed {
+		pkg.name = name
+		fileNames := make([]string, 0, len(astPkg.Files))
+		for fileName := range astPkg.Files {
+			fileNames = append(fileNames, fileName)
+		}
+		// Urutan file tetap agar hasil generate deterministik
+		sort.Strings(fileNames)
+		for _, fileName := range fileNames {
+			pkg.addFile(astPkg.Files[fileName])
+		}
+	}
+	g.packages[importPath] = pkg
+	return pkg, nil
+}
+
+// addFile mencatat deklarasi type dan konstanta bertipe dari satu file
+func (p *goPackage) addFile(file *ast.File) {
+	p.files = append(p.files, file)
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			switch s := spec.(type) {
+			case *ast.TypeSpec:
+				p.types[s.Name.Name] = typeDecl{spec: s, file: file}
+			case *ast.ValueSpec:
+				if gen.Tok != token.CONST {
+					continue
+				}
+				typeName, ok := s.Type.(*ast.Ident)
+				if !ok {
+					continue
+				}
+				for _, value := range s.Values {
+					if lit, ok := value.(*ast.BasicLit); ok {
+						p.enums[typeName.Name] = append(p.enums[typeName.Name], literalValue(lit))
+					}
+				}
+			}
+		}
+	}
+}
+
+// literalValue mengubah literal Go menjadi nilai JSON
+func literalValue(lit *ast.BasicLit) interface{} {
+	switch lit.Kind {
+	case token.STRING:
+		if s, err := strconv.Unquote(lit.Value); err == nil {
+			return s
+		}
+	case token.INT:
+		if n, err := strconv.ParseInt(lit.Value, 0, 64); err == nil {
+			return n
+		}
+	case token.FLOAT:
+		if f, err := strconv.ParseFloat(lit.Value, 64); err == nil {
+			return f
+		}
+	}
+	return lit.Value
+}
+
+// lookupImport mencari import path untuk nama package yang dipakai di file
+func lookupImport(file *ast.File, name string) (string, bool) {
+	for _, imp := range file.Imports {
+		importPath, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			continue
+		}
+		alias := path.Base(importPath)
+		if imp.Name != nil {
+			alias = imp.Name.Name
+		}
+		if alias == name {
+			return importPath, true
+		}
+	}
+	return "", false
+}
+
+// inModule melaporkan apakah import path berada di dalam module
+func (g *generator) inModule(importPath string) bool {
+	return importPath == g.module || strings.HasPrefix(importPath, g.module+"/")
+}
+
+// typeRef mengubah referensi type di anotasi (mis. model.Team, []ImportRowError) menjadi schema
+func (g *generator) typeRef(pkg *goPackage, file *ast.File, ref string) (*Schema, error) {
+	expr, err := parser.ParseExpr(ref)
+	if err != nil {
+		return nil, fmt.Errorf("type %q tidak valid: %w", ref, err)
+	}
+	return g.schemaOf(pkg, file, expr)
+}
+
+// schemaOf mengubah ekspresi type Go menjadi schema
+func (g *generator) schemaOf(pkg *goPackage, file *ast.File, expr ast.Expr) (*Schema, error) {
+	switch e := expr.(type) {
+	case *ast.StarExpr:
+		schema, err := g.schemaOf(pkg, file, e.X)
+		if err != nil || schema.Ref != "" {
+			return schema, err
+		}
+		schema.Nullable = true
+		return schema, nil
+	case *ast.ArrayType:
+		if ident, ok := e.Elt.(*ast.Ident); ok && ident.Name == "byte" {
+			return &Schema{Type: "string", Format: "byte"}, nil
+		}
+		items, err := g.schemaOf(pkg, file, e.Elt)
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "array", Items: items}, nil
+	case *ast.MapType:
+		values, err := g.schemaOf(pkg, file, e.Value)
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "object", AdditionalProperties: values}, nil
+	case *ast.InterfaceType:
+		return &Schema{}, nil
+	case *ast.StructType:
+		return g.objectSchema(pkg, file, e)
+	case *ast.Ident:
+		if schema, ok := basicSchema(e.Name); ok {
+			return schema, nil
+		}
+		return g.namedSchema(pkg, e.Name)
+	case *ast.SelectorExpr:
+		pkgName, ok := e.X.(*ast.Ident)
+		if !ok {
+			break
+		}
+		importPath, ok := lookupImport(file, pkgName.Name)
+		if !ok {
+			if pkgName.Name == pkg.name {
+				// Referensi anotasi boleh menulis nama package sendiri (mis. handler.ImportResponse)
+				return g.namedSchema(pkg, e.Sel.Name)
+			}
+			return nil, fmt.Errorf("package %s tidak di-import", pkgName.Name)
+		}
+		if !g.inModule(importPath) {
+			if external, ok := externalTypes[importPath+"."+e.Sel.Name]; ok {
+				return external(), nil
+			}
+			return nil, fmt.Errorf("type %s.%s tidak didukung", importPath, e.Sel.Name)
+		}
+		target, err := g.loadPackage(importPath)
+		if err != nil {
+			return nil, err
+		}
+		return g.namedSchema(target, e.Sel.Name)
+	}
+	return nil, fmt.Errorf("ekspresi type %T tidak didukung", expr)
+}
+
+// namedSchema mengembalikan $ref untuk struct bernama (didaftarkan ke components sekali)
+// atau schema type dasarnya untuk type lain, lengkap dengan enum dari konstanta bertipe
+func (g *generator) namedSchema(pkg *goPackage, name string) (*Schema, error) {
+	decl, ok := pkg.types[name]
+	if !ok {
+		return nil, fmt.Errorf("type %s.%s tidak ditemukan", pkg.name, name)
+	}
+	if decl.spec.TypeParams != nil {
+		return nil, fmt.Errorf("type generik %s.%s tidak didukung", pkg.name, name)
+	}
+
+	st, ok := decl.spec.Type.(*ast.StructType)
+	if !ok {
+		schema, err := g.schemaOf(pkg, decl.file, decl.spec.Type)
+		if err != nil {
+			return nil, err
+		}
+		schema.Enum = pkg.enums[name]
+		return schema, nil
+	}
+
+	key := pkg.name + "." + name
+	if _, ok := g.doc.Components.Schemas[key]; !ok {
+		// Didaftarkan sebelum field dibaca agar struct yang saling merujuk tidak berulang tanpa henti
+		placeholder := &Schema{}
+		g.doc.Components.Schemas[key] = placeholder
+		schema, err := g.objectSchema(pkg, decl.file, st)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+		schema.Description = docText(typeDoc(decl.spec, pkg, name))
+		*placeholder = *schema
+	}
+	return &Schema{Ref: RefPrefix + key}, nil
+}
+
+// typeDoc mengembalikan komentar dokumentasi type (di TypeSpec atau GenDecl-nya)
+func typeDoc(spec *ast.TypeSpec, pkg *goPackage, name string) *ast.CommentGroup {
+	if spec.Doc != nil {
+		return spec.Doc
+	}
+	for _, file := range pkg.files {
+		for _, decl := range file.Decls {
+			if gen, ok := decl.(*ast.GenDecl); ok && len(gen.Specs) == 1 && gen.Specs[0] == spec {
+				return gen.Doc
+			}
+		}
+	}
+	return nil
+}
+
+// docText mengambil baris pertama komentar sebagai deskripsi
+func docText(group *ast.CommentGroup) string {
+	if group == nil {
+		return ""
+	}
+	text, _, _ := strings.Cut(strings.TrimSpace(group.Text()), "\n")
+	return text
+}
+
+// structOf mencari deklarasi struct untuk field embedded
+func (g *generator) structOf(pkg *goPackage, file *ast.File, expr ast.Expr) (*goPackage, *ast.File, *ast.StructType, error) {
+	switch e := expr.(type) {
+	case *ast.StarExpr:
+		return g.structOf(pkg, file, e.X)
+	case *ast.Ident:
+		if decl, ok := pkg.types[e.Name]; ok {
+			if st, ok := decl.spec.Type.(*ast.StructType); ok {
+				return pkg, decl.file, st, nil
+			}
+		}
+	case *ast.SelectorExpr:
+		if pkgName, ok := e.X.(*ast.Ident); ok {
+			if importPath, ok := lookupImport(file, pkgName.Name); ok && g.inModule(importPath) {
+				target, err := g.loadPackage(importPath)
+				if err != nil {
+					return nil, nil, nil, err
+				}
+				return g.structOf(target, file, e.Sel)
+			}
+		}
+	}
+	return nil, nil, nil, fmt.Errorf("field embedded %s bukan struct di dalam module", exprString(expr))
+}
+
+// exprString menuliskan ekspresi type untuk pesan error
+func exprString(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.SelectorExpr:
+		return exprString(e.X) + "." + e.Sel.Name
+	case *ast.StarExpr:
+		return "*" + exprString(e.X)
+	}
+	return fmt.Sprintf("%T", expr)
+}
+
+// objectSchema membuat schema object dari field struct: nama dari tag json, batasan dari tag binding,
+// contoh dari tag example, dan deskripsi dari komentar field
+func (g *generator) objectSchema(pkg *goPackage, file *ast.File, st *ast.StructType) (*Schema, error) {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	for _, field := range st.Fields.List {
+		var tag reflect.StructTag
+		if field.Tag != nil {
+			unquoted, err := strconv.Unquote(field.Tag.Value)
+			if err != nil {
+				return nil, err
+			}
+			tag = reflect.StructTag(unquoted)
+		}
+		jsonName, jsonOpts, _ := strings.Cut(tag.Get("json"), ",")
+		if jsonName == "-" && jsonOpts == "" {
+			continue
+		}
+
+		if len(field.Names) == 0 && jsonName == "" {
+			// Field embedded tanpa nama json digabung ke object induk
+			embeddedPkg, embeddedFile, embedded, err := g.structOf(pkg, file, field.Type)
+			if err != nil {
+				return nil, err
+			}
+			inner, err := g.objectSchema(embeddedPkg, embeddedFile, embedded)
+			if err != nil {
+				return nil, err
+			}
+			for name, property := range inner.Properties {
+				schema.Properties[name] = property
+			}
+			schema.Required = append(schema.Required, inner.Required...)
+			continue
+		}
+
+		name := jsonName
+		if len(field.Names) > 0 {
+			if !field.Names[0].IsExported() {
+				continue
+			}
+			if name == "" {
+				name = field.Names[0].Name
+			}
+		}
+
+		property, err := g.fieldSchema(pkg, file, field.Type, tag)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", name, err)
+		}
+		if property.Ref == "" {
+			property.Description = docText(field.Doc)
+			if property.Description == "" {
+				property.Description = docText(field.Comment)
+			}
+		}
+		required, err := applyBinding(property, tag.Get("binding"))
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", name, err)
+		}
+		if required {
+			schema.Required = append(schema.Required, name)
+		}
+		schema.Properties[name] = property
+	}
+	return schema, nil
+}
+
+// fieldSchema membuat schema field dengan memperhitungkan tag swaggertype, enums, dan example
+func (g *generator) fieldSchema(pkg *goPackage, file *ast.File, expr ast.Expr, tag reflect.StructTag) (*Schema, error) {
+	var schema *Schema
+	if override := tag.Get("swaggertype"); override != "" {
+		kind, elem, isArray := strings.Cut(override, ",")
+		if isArray && kind == "array" {
+			items, ok := primitiveSchema(elem)
+			if !ok {
+				return nil, fmt.Errorf("swaggertype %q tidak didukung", override)
+			}
+			schema = &Schema{Type: "array", Items: items}
+		} else if s, ok := primitiveSchema(override); ok {
+			schema = s
+		} else {
+			return nil, fmt.Errorf("swaggertype %q tidak didukung", override)
+		}
+	} else {
+		var err error
+		if schema, err = g.schemaOf(pkg, file, expr); err != nil {
+			return nil, err
+		}
+	}
+
+	if enums, ok := tag.Lookup("enums"); ok && schema.Ref == "" {
+		// Tag enums membatasi nilai yang diterima field (mis. hanya sebagian konstanta type-nya)
+		target := schema
+		if target.Type == "array" {
+			target = target.Items
+		}
+		target.Enum = nil
+		for _, value := range strings.Split(enums, ",") {
+			target.Enum = append(target.Enum, exampleValue(target, strings.TrimSpace(value)))
+		}
+	}
+	if example, ok := tag.Lookup("example"); ok && schema.Ref == "" {
+		schema.Example = exampleValue(schema, example)
+	}
+	return schema, nil
+}
+
+// primitiveSchema mengubah nama type anotasi (int, string, bool, number, object, file) menjadi schema
+func primitiveSchema(name string) (*Schema, bool) {
+	switch name {
+	case "int", "integer":
+		return &Schema{Type: "integer"}, true
+	case "number":
+		return &Schema{Type: "number"}, true
+	case "bool", "boolean":
+		return &Schema{Type: "boolean"}, true
+	case "string":
+		return &Schema{Type: "string"}, true
+	case "object":
+		return &Schema{Type: "object"}, true
+	case "file":
+		return &Schema{Type: "string", Format: "binary"}, true
+	}
+	return nil, false
+}
+
+// exampleValue mengubah tag example menjadi nilai sesuai type; array ditulis dipisah koma seperti swag
+func exampleValue(schema *Schema, example string) interface{} {
+	switch schema.Type {
+	case "array":
+		values := make([]interface{}, 0)
+		for _, item := range strings.Split(example, ",") {
+			values = append(values, exampleValue(schema.Items, strings.TrimSpace(item)))
+		}
+		return values
+	case "integer":
+		if n, err := strconv.ParseInt(example, 10, 64); err == nil {
+			return n
+		}
+	case "number":
+		if f, err := strconv.ParseFloat(example, 64); err == nil {
+			return f
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(example); err == nil {
+			return b
+		}
+	}
+	return example
+}
+
+// applyBinding menerapkan aturan validator (tag binding) ke schema dan melaporkan apakah field wajib.
+// Aturan setelah dive berlaku untuk elemen slice.
+func applyBinding(schema *Schema, binding string) (bool, error) {
+	required := false
+	target := schema
+	for _, rule := range strings.Split(binding, ",") {
+		name, param, _ := strings.Cut(rule, "=")
+		switch name {
+		case "required":
+			required = true
+		case "dive":
+			if target.Items == nil {
+				return false, fmt.Errorf("aturan dive pada field bukan array")
+			}
+			target = target.Items
+		case "min", "max":
+			value, err := strconv.ParseFloat(param, 64)
+			if err != nil {
+				return false, fmt.Errorf("aturan %s tidak valid: %w", rule, err)
+			}
+			setBound(target, name == "min", value)
+		case "oneof":
+			target.Enum = nil
+			for _, option := range oneOfOptions(param) {
+				target.Enum = append(target.Enum, exampleValue(target, option))
+			}
+		}
+	}
+	return required, nil
+}
+
+// setBound menulis batas min/max sesuai type schema (nilai, panjang string, atau jumlah item)
+func setBound(schema *Schema, isMin bool, value float64) {
+	switch schema.Type {
+	case "string":
+		n := int(value)
+		if isMin {
+			schema.MinLength = &n
+		} else {
+			schema.MaxLength = &n
+		}
+	case "array":
+		n := int(value)
+		if isMin {
+			schema.MinItems = &n
+		} else {
+			schema.MaxItems = &n
+		}
+	default:
+		if isMin {
+			schema.Minimum = &value
+		} else {
+			schema.Maximum = &value
+		}
+	}
+}
+
+// oneOfOptions memecah parameter oneof; nilai yang mengandung spasi ditulis dalam tanda kutip tunggal
+func oneOfOptions(param string) []string {
+	var options []string
+	for param = strings.TrimSpace(param); param != ""; param = strings.TrimSpace(param) {
+		if strings.HasPrefix(param, "'") {
+			if end := strings.Index(param[1:], "'"); end >= 0 {
+				options = append(options, param[1:end+1])
+				param = param[end+2:]
+				continue
+			}
+		}
+		option, rest, _ := strings.Cut(param, " ")
+		options = append(options, option)
+		param = rest
+	}
+	return options
+}
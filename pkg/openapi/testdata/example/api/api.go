@@ -0,0 +1,46 @@
+package api
+
+import "example.com/league/model"
+
+// ClubPage adalah satu halaman klub
+type ClubPage struct {
+	Items []model.Club `json:"items"`
+	Total int64        `json:"total"`
+}
+
+// ListClubs menangani GET /clubs
+// @Summary Daftar klub
+// @Description Baris pertama.
+// @Description Baris kedua.
+// @Tags Clubs
+// @Produce json
+// @Security BearerAuth
+// @Param level query string false "Tingkat" Enums(pro, amateur)
+// @Success 200 {object} ClubPage
+// @Failure 400 {object} map[string]string "Parameter salah"
+// @Router /clubs [get]
+func ListClubs() {}
+
+// CreateClub menangani POST /clubs/{id}
+// @Summary Membuat klub
+// @Tags Clubs
+// @Accept json
+// @Produce json
+// @Param id path int true "Club ID"
+// @Param body body model.Club true "Data klub"
+// @Success 201 {array} model.Club
+// @Router /clubs/{id} [post]
+func CreateClub() {}
+
+// ExportClubs menangani GET /clubs/export
+// @Tags Clubs
+// @Accept mpfd
+// @Produce text/csv
+// @Param file formData file true "File CSV"
+// @Success 200 {file} file
+// @Failure 500 {object} ClubPage
+// @Router /clubs/export [post]
+func ExportClubs() {}
+
+// helper tidak memiliki @Router sehingga diabaikan
+func helper() {}
@@ -0,0 +1,8 @@
+package main
+
+// @title League API
+// @version 2.1
+// @description Contoh API untuk test generator.
+// @securityDefinitions.bearer BearerAuth
+// @bearerFormat JWT
+func main() {}
@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+// Level adalah tingkat liga
+type Level string
+
+const (
+	LevelPro     Level = "pro"
+	LevelAmateur Level = "amateur"
+)
+
+// Base berisi field bersama
+type Base struct {
+	ID        uint      `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Club adalah klub peserta liga
+type Club struct {
+	Base
+	Name  string `json:"name" binding:"required,min=3"`
+	Level Level  `json:"level"`
+	// Tier hanya menerima sebagian nilai Level
+	Tier  Level   `json:"tier" enums:"pro"`
+	Coach *string `json:"coach,omitempty"`
+	// Rival adalah klub rival (boleh kosong)
+	Rival  *Club    `json:"rival,omitempty"`
+	Tags   []string `json:"tags" binding:"required,min=1,dive,oneof=north south 'far east'" example:"north,south"`
+	secret string
+	Hidden string `json:"-"`
+}
@@ -0,0 +1,268 @@
+// Package pdf membuat dokumen PDF sederhana (teks, tabel, dan garis) tanpa dependency eksternal.
+// Dokumen memakai font standar Helvetica yang tersedia di setiap PDF viewer sehingga font
+// tidak perlu di-embed; teks di luar WinAnsiEncoding (Latin-1) diganti dengan "?".
+//
+// Konten ditulis dengan kursor dari atas ke bawah; halaman baru dibuat otomatis saat kursor
+// mencapai margin bawah.
+package pdf
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Ukuran halaman A4 dan margin dalam point (1/72 inci)
+const (
+	PageWidth  = 595.0
+	PageHeight = 842.0
+	Margin     = 50.0
+
+	// ContentWidth adalah lebar area tulis di antara margin kiri dan kanan
+	ContentWidth = PageWidth - 2*Margin
+)
+
+// ContentType adalah media type untuk response PDF
+const ContentType = "application/pdf"
+
+// Ukuran font dan jarak baris default
+const (
+	fontSizeTitle   = 16.0
+	fontSizeHeading = 12.0
+	fontSizeText    = 10.0
+	lineSpacing     = 1.5
+)
+
+// Column adalah satu kolom tabel: judul dan lebarnya dalam point
+type Column struct {
+	Title string
+	Width float64
+}
+
+// Document adalah dokumen PDF yang sedang disusun
+type Document struct {
+	title string
+	pages []*bytes.Buffer
+	y     float64
+}
+
+// New membuat dokumen kosong dengan satu halaman. Title disimpan di metadata dokumen.
+func New(title string) *Document {
+	d := &Document{title: title}
+	d.AddPage()
+	return d
+}
+
+// AddPage memulai halaman baru dan mengembalikan kursor ke margin atas
+func (d *Document) AddPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+	d.y = PageHeight - Margin
+}
+
+// Title menulis judul dokumen dengan huruf tebal
+func (d *Document) Title(text string) {
+	d.textLine(Margin, text, fontSizeTitle, true)
+}
+
+// Heading menulis judul bagian dengan huruf tebal
+func (d *Document) Heading(text string) {
+	d.Space(fontSizeHeading / 2)
+	d.textLine(Margin, text, fontSizeHeading, true)
+}
+
+// Text menulis satu baris teks biasa
+func (d *Document) Text(text string) {
+	d.textLine(Margin, text, fontSizeText, false)
+}
+
+// Field menulis pasangan label tebal dan nilai dalam satu baris
+func (d *Document) Field(label, value string) {
+	const labelWidth = 120
+	d.ensureSpace(fontSizeText * lineSpacing)
+	d.write(Margin, d.y, fontSizeText, true, truncate(label, labelWidth, fontSizeText, true))
+	d.write(Margin+labelWidth, d.y, fontSizeText, false, truncate(value, ContentWidth-labelWidth, fontSizeText, false))
+	d.y -= fontSizeText * lineSpacing
+}
+
+// Table menulis header tabel (tebal, dengan garis bawah) lalu semua baris
+func (d *Document) Table(columns []Column, rows [][]string) {
+	titles := make([]string, len(columns))
+	for i, column := range columns {
+		titles[i] = column.Title
+	}
+	d.Row(columns, titles, true)
+	d.Rule()
+	for _, row := range rows {
+		d.Row(columns, row, false)
+	}
+}
+
+// Row menulis satu baris tabel; sel dipotong jika lebih lebar dari kolomnya
+func (d *Document) Row(columns []Column, cells []string, bold bool) {
+	d.ensureSpace(fontSizeText * lineSpacing)
+	x := Margin
+	for i, column := range columns {
+		if i < len(cells) {
+			d.write(x, d.y, fontSizeText, bold, truncate(cells[i], column.Width, fontSizeText, bold))
+		}
+		x += column.Width
+	}
+	d.y -= fontSizeText * lineSpacing
+}
+
+// Rule menggambar garis horizontal selebar area tulis di posisi kursor
+func (d *Document) Rule() {
+	d.ensureSpace(fontSizeText / 2)
+	d.y -= fontSizeText / 4
+	fmt.Fprintf(d.page(), "0.5 w %.2f %.2f m %.2f %.2f l S\n", Margin, d.y, PageWidth-Margin, d.y)
+	d.y -= fontSizeText / 4
+}
+
+// Space menggeser kursor ke bawah
+func (d *Document) Space(height float64) {
+	d.y -= height
+}
+
+// Signatures menulis kolom tanda tangan berdampingan: garis kosong dengan label di bawahnya
+func (d *Document) Signatures(labels ...string) {
+	const height = 60
+	if len(labels) == 0 {
+		return
+	}
+	d.ensureSpace(height + fontSizeText*lineSpacing)
+	d.y -= height
+
+	width := ContentWidth / float64(len(labels))
+	for i, label := range labels {
+		x := Margin + float64(i)*width
+		fmt.Fprintf(d.page(), "0.5 w %.2f %.2f m %.2f %.2f l S\n", x, d.y, x+width-20, d.y)
+		d.write(x, d.y-fontSizeText/2, fontSizeText, false, label)
+	}
+	d.y -= fontSizeText * (lineSpacing + 0.5)
+}
+
+// WriteTo menulis dokumen PDF lengkap ke w
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	out := &countingWriter{w: bufio.NewWriter(w)}
+	var offsets []int64
+	object := func(body string) {
+		offsets = append(offsets, out.n)
+		fmt.Fprintf(out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// Objek 1-5 tetap; setiap halaman memakai dua objek (page dan content stream) mulai dari 6
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 6+2*i)
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	object(fmt.Sprintf("<< /Title (%s) /Producer (xyz-football-api) >>", escape(d.title)))
+	for i, content := range d.pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			PageWidth, PageHeight, 7+2*i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String()))
+	}
+
+	xref := out.n
+	fmt.Fprintf(out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(out, "trailer\n<< /Size %d /Root 1 0 R /Info 5 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	if out.err != nil {
+		return out.n, out.err
+	}
+	return out.n, out.w.Flush()
+}
+
+// textLine menulis satu baris teks pada x dan memindahkan kursor ke baris berikutnya
+func (d *Document) textLine(x float64, text string, size float64, bold bool) {
+	d.ensureSpace(size * lineSpacing)
+	d.write(x, d.y, size, bold, truncate(text, PageWidth-Margin-x, size, bold))
+	d.y -= size * lineSpacing
+}
+
+// write menggambar teks dengan baseline satu ukuran font di bawah top
+func (d *Document) write(x, top, size float64, bold bool, text string) {
+	font := "F1"
+	if bold {
+		font = "F2"
+	}
+	fmt.Fprintf(d.page(), "BT /%s %.1f Tf %.2f %.2f Td (%s) Tj ET\n", font, size, x, top-size, escape(text))
+}
+
+// ensureSpace membuat halaman baru jika sisa ruang di atas margin bawah kurang dari height
+func (d *Document) ensureSpace(height float64) {
+	if d.y-height < Margin {
+		d.AddPage()
+	}
+}
+
+// page mengembalikan content stream halaman aktif
+func (d *Document) page() *bytes.Buffer {
+	return d.pages[len(d.pages)-1]
+}
+
+// truncate memotong teks dengan "..." agar muat di lebar kolom (dengan sedikit jarak antar kolom)
+func truncate(text string, width, size float64, bold bool) string {
+	limit := width - 6
+	if textWidth(text, size, bold) <= limit {
+		return text
+	}
+	runes := []rune(text)
+	for len(runes) > 0 && textWidth(string(runes)+"...", size, bold) > limit {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "..."
+}
+
+// escape mengubah teks menjadi isi string literal PDF dalam WinAnsiEncoding
+func escape(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\n' || r == '\r' || r == '\t':
+			b.WriteByte(' ')
+		case r >= 0x20 && r < 0x7f:
+			b.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			// Latin-1 sama dengan WinAnsiEncoding untuk rentang ini
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
+
+// countingWriter menghitung byte yang ditulis untuk tabel xref dan menyimpan error pertama
+type countingWriter struct {
+	w   *bufio.Writer
+	n   int64
+	err error
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	c.err = err
+	return n, err
+}
+
+func (c *countingWriter) WriteString(s string) {
+	c.Write([]byte(s))
+}
@@ -0,0 +1,80 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestDocumentWriteTo(t *testing.T) {
+	doc := New("Laporan (uji)")
+	doc.Title("Laporan Pertandingan")
+	doc.Field("Skor Akhir", "2-1")
+	rows := make([][]string, 100)
+	for i := range rows {
+		rows[i] = []string{strconv.Itoa(i + 1), fmt.Sprintf("Pemain %d", i+1)}
+	}
+	doc.Table([]Column{{"No", 40}, {"Nama", 200}}, rows)
+	doc.Signatures("Wasit", "Manajer")
+
+	var buf bytes.Buffer
+	n, err := doc.WriteTo(&buf)
+	if err != nil {
+		t.Fatalf("WriteTo error: %v", err)
+	}
+	out := buf.String()
+	if n != int64(buf.Len()) {
+		t.Errorf("n = %d, want %d", n, buf.Len())
+	}
+
+	if !strings.HasPrefix(out, "%PDF-1.4\n") || !strings.HasSuffix(out, "%%EOF\n") {
+		t.Fatalf("header/trailer salah:\n%s", out)
+	}
+	if !strings.Contains(out, "/Count 3 >>") {
+		t.Errorf("100 baris tabel seharusnya menjadi 3 halaman")
+	}
+	if !strings.Contains(out, `/Title (Laporan \(uji\))`) {
+		t.Errorf("metadata title tidak di-escape")
+	}
+
+	// Setiap offset di tabel xref harus menunjuk ke awal objek yang sesuai
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindStringSubmatch(out)
+	if startxref == nil {
+		t.Fatal("startxref tidak ditemukan")
+	}
+	xref, _ := strconv.Atoi(startxref[1])
+	if !strings.HasPrefix(out[xref:], "xref\n") {
+		t.Fatalf("startxref %d tidak menunjuk ke tabel xref", xref)
+	}
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllStringSubmatch(out[xref:], -1)
+	if len(entries) != 5+2*3 {
+		t.Fatalf("len(xref) = %d, want %d", len(entries), 5+2*3)
+	}
+	for i, entry := range entries {
+		offset, _ := strconv.Atoi(entry[1])
+		if want := fmt.Sprintf("%d 0 obj\n", i+1); !strings.HasPrefix(out[offset:], want) {
+			t.Errorf("xref objek %d menunjuk ke %q", i+1, out[offset:offset+10])
+		}
+	}
+}
+
+func TestEscape(t *testing.T) {
+	got := escape("Budi (c) \\ é ✓\n")
+	if want := `Budi \(c\) \\ \351 ? `; got != want {
+		t.Errorf("escape = %q, want %q", got, want)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	if got := truncate("Budi", 100, 10, false); got != "Budi" {
+		t.Errorf("truncate pendek = %q", got)
+	}
+	long := strings.Repeat("Nama Sangat Panjang ", 10)
+	got := truncate(long, 100, 10, true)
+	if !strings.HasSuffix(got, "...") || textWidth(got, 10, true) > 94 {
+		t.Errorf("truncate = %q (lebar %.1f)", got, textWidth(got, 10, true))
+	}
+}
@@ -0,0 +1,237 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// Read membaca seluruh isi file dengan format tertentu
+func Read(format Format, r io.Reader) (*Table, error) {
+	switch format {
+	case FormatCSV:
+		return readCSV(r)
+	case FormatXLSX:
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		return readXLSX(data)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+// readCSV membaca CSV dengan pemisah koma atau titik koma (default Excel untuk locale Indonesia).
+// Pemisah ditentukan dari baris header. Awalan ' yang ditambahkan csvWriter di depan
+// karakter formula dibuang sehingga hasil ekspor bisa diimpor kembali apa adanya.
+func readCSV(r io.Reader) (*Table, error) {
+	buffered := bufio.NewReader(r)
+	header, err := buffered.Peek(buffered.Size())
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, err
+	}
+	header, _, _ = bytes.Cut(header, []byte("\n"))
+
+	reader := csv.NewReader(buffered)
+	reader.FieldsPerRecord = -1
+	if bytes.Count(header, []byte(";")) > bytes.Count(header, []byte(",")) {
+		reader.Comma = ';'
+	}
+
+	var records [][]string
+	var lines []int
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		for i, cell := range record {
+			record[i] = unescapeFormula(cell)
+		}
+		records = append(records, record)
+		lines = append(lines, line)
+	}
+
+	// BOM dari "Save as CSV UTF-8" di Excel
+	if len(records) > 0 && len(records[0]) > 0 {
+		records[0][0] = strings.TrimPrefix(records[0][0], "\ufeff")
+	}
+	return newTable(records, lines), nil
+}
+
+// xlsxText adalah teks rich text/inline string: <t> langsung atau beberapa run <r><t>
+type xlsxText struct {
+	T    string `xml:"t"`
+	Runs []struct {
+		T string `xml:"t"`
+	} `xml:"r"`
+}
+
+// String menggabungkan isi teks dari semua run
+func (t xlsxText) String() string {
+	if len(t.Runs) == 0 {
+		return t.T
+	}
+	var b strings.Builder
+	for _, run := range t.Runs {
+		b.WriteString(run.T)
+	}
+	return b.String()
+}
+
+type xlsxWorkbook struct {
+	Sheets []struct {
+		ID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+type xlsxSharedStrings struct {
+	Items []xlsxText `xml:"si"`
+}
+
+type xlsxWorksheet struct {
+	Rows []struct {
+		R     int `xml:"r,attr"`
+		Cells []struct {
+			R      string   `xml:"r,attr"`
+			T      string   `xml:"t,attr"`
+			V      string   `xml:"v"`
+			Inline xlsxText `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// readXLSX membaca sheet pertama dari workbook XLSX
+func readXLSX(data []byte) (*Table, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("file xlsx tidak valid: %w", err)
+	}
+
+	sheetPath, err := firstSheetPath(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	var shared xlsxSharedStrings
+	if err := decodeZipXML(archive, "xl/sharedStrings.xml", &shared); err != nil && !errors.Is(err, errZipEntryMissing) {
+		return nil, err
+	}
+
+	var sheet xlsxWorksheet
+	if err := decodeZipXML(archive, sheetPath, &sheet); err != nil {
+		return nil, err
+	}
+
+	var records [][]string
+	var lines []int
+	for i, row := range sheet.Rows {
+		var record []string
+		for j, cell := range row.Cells {
+			column := columnIndex(cell.R)
+			if column < 0 {
+				column = j
+			}
+			for len(record) <= column {
+				record = append(record, "")
+			}
+
+			switch cell.T {
+			case "s":
+				index, err := strconv.Atoi(cell.V)
+				if err != nil || index < 0 || index >= len(shared.Items) {
+					return nil, fmt.Errorf("shared string %q tidak valid", cell.V)
+				}
+				record[column] = shared.Items[index].String()
+			case "inlineStr":
+				record[column] = cell.Inline.String()
+			default:
+				record[column] = cell.V
+			}
+		}
+
+		line := row.R
+		if line == 0 {
+			line = i + 1
+		}
+		records = append(records, record)
+		lines = append(lines, line)
+	}
+	return newTable(records, lines), nil
+}
+
+// firstSheetPath mencari lokasi file sheet pertama lewat workbook.xml dan relasinya
+func firstSheetPath(archive *zip.Reader) (string, error) {
+	var workbook xlsxWorkbook
+	if err := decodeZipXML(archive, "xl/workbook.xml", &workbook); err != nil {
+		return "", err
+	}
+	if len(workbook.Sheets) == 0 {
+		return "", fmt.Errorf("workbook tidak memiliki sheet")
+	}
+
+	var rels xlsxRelationships
+	if err := decodeZipXML(archive, "xl/_rels/workbook.xml.rels", &rels); err != nil {
+		return "", err
+	}
+	for _, rel := range rels.Relationships {
+		if rel.ID != workbook.Sheets[0].ID {
+			continue
+		}
+		if strings.HasPrefix(rel.Target, "/") {
+			return strings.TrimPrefix(rel.Target, "/"), nil
+		}
+		return path.Join("xl", rel.Target), nil
+	}
+	return "", fmt.Errorf("sheet %s tidak ditemukan", workbook.Sheets[0].ID)
+}
+
+// errZipEntryMissing dikembalikan jika file tidak ada di arsip xlsx
+var errZipEntryMissing = errors.New("entry tidak ditemukan di arsip xlsx")
+
+// decodeZipXML men-decode satu file XML di dalam arsip
+func decodeZipXML(archive *zip.Reader, name string, v interface{}) error {
+	file, err := archive.Open(name)
+	if err != nil {
+		return errZipEntryMissing
+	}
+	defer file.Close()
+
+	if err := xml.NewDecoder(file).Decode(v); err != nil {
+		return fmt.Errorf("gagal membaca %s: %w", name, err)
+	}
+	return nil
+}
+
+// columnIndex mengubah referensi sel seperti "C12" menjadi indeks kolom 0-based (2);
+// -1 jika referensi kosong
+func columnIndex(ref string) int {
+	index := 0
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		index = index*26 + int(r-'A'+1)
+	}
+	return index - 1
+}
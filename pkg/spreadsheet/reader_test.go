@@ -0,0 +1,110 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestReadCSV(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "comma", input: "Name,Jersey_Number\nBudi Santoso,9\n\n\"Ahmad \"\"Dede\"\" Dahlan\",11\n"},
+		{name: "semicolon with bom", input: "\ufeffname;jersey_number\r\nBudi Santoso;9\r\n;\r\n\"Ahmad \"\"Dede\"\" Dahlan\";11\r\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table, err := Read(FormatCSV, strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("Read: %v", err)
+			}
+			if !table.HasColumn("name") || !table.HasColumn("jersey_number") {
+				t.Fatalf("columns = %q", table.Columns)
+			}
+			if len(table.Rows) != 2 {
+				t.Fatalf("rows = %d, want 2", len(table.Rows))
+			}
+			if got := table.Rows[0]; got.Line != 2 || got.Get("name") != "Budi Santoso" || got.Get("jersey_number") != "9" {
+				t.Errorf("row 0 = %+v", got)
+			}
+			if got := table.Rows[1]; got.Line != 4 || got.Get("name") != `Ahmad "Dede" Dahlan` {
+				t.Errorf("row 1 = %+v", got)
+			}
+		})
+	}
+}
+
+func TestReadXLSX(t *testing.T) {
+	files := map[string]string{
+		"xl/workbook.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="Players" sheetId="1" r:id="rId1"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<?xml version="1.0" encoding="UTF-8"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>
+</Relationships>`,
+		"xl/sharedStrings.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<si><t>name</t></si><si><t>position</t></si><si><t>jersey_number</t></si>
+<si><r><t>Budi </t></r><r><t>Santoso</t></r></si></sst>`,
+		"xl/worksheets/sheet1.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c><c r="C1" t="s"><v>2</v></c></row>
+<row r="3"><c r="A3" t="s"><v>3</v></c><c r="C3"><v>9</v></c></row>
+<row r="4"><c r="A4" t="inlineStr"><is><t>Rudi Hartono</t></is></c><c r="B4" t="inlineStr"><is><t>gelandang</t></is></c><c r="C4"><v>10</v></c></row>
+</sheetData></worksheet>`,
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := archive.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	table, err := Read(FormatXLSX, &buf)
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if strings.Join(table.Columns, ",") != "name,position,jersey_number" {
+		t.Fatalf("columns = %q", table.Columns)
+	}
+	if len(table.Rows) != 2 {
+		t.Fatalf("rows = %d, want 2", len(table.Rows))
+	}
+	if got := table.Rows[0]; got.Line != 3 || got.Get("name") != "Budi Santoso" || got.Get("position") != "" || got.Get("jersey_number") != "9" {
+		t.Errorf("row 0 = %+v", got)
+	}
+	if got := table.Rows[1]; got.Line != 4 || got.Get("name") != "Rudi Hartono" || got.Get("position") != "gelandang" {
+		t.Errorf("row 1 = %+v", got)
+	}
+}
+
+func TestReadInvalidXLSX(t *testing.T) {
+	if _, err := Read(FormatXLSX, strings.NewReader("name,position")); err == nil {
+		t.Error("expected error for non-zip xlsx")
+	}
+}
+
+func TestFormatFromFilename(t *testing.T) {
+	for name, want := range map[string]Format{"players.CSV": FormatCSV, "teams.xlsx": FormatXLSX} {
+		if got, err := FormatFromFilename(name); err != nil || got != want {
+			t.Errorf("FormatFromFilename(%q) = %q, %v", name, got, err)
+		}
+	}
+	if _, err := FormatFromFilename("teams.xls"); err != ErrUnsupportedFormat {
+		t.Errorf("err = %v, want ErrUnsupportedFormat", err)
+	}
+}
@@ -0,0 +1,113 @@
+// Package spreadsheet membaca (CSV, XLSX) dan menulis (CSV, JSON Lines, XLSX) file tabel
+// tanpa dependency eksternal. XLSX dibaca langsung dari arsip zip-nya dan hanya sheet pertama yang dipakai.
+package spreadsheet
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+)
+
+// Format adalah jenis file tabel
+type Format string
+
+const (
+	FormatCSV   Format = "csv"
+	FormatJSONL Format = "jsonl"
+	FormatXLSX  Format = "xlsx"
+)
+
+// contentTypes adalah MIME type untuk setiap format
+var contentTypes = map[Format]string{
+	FormatCSV:   "text/csv",
+	FormatJSONL: "application/x-ndjson",
+	FormatXLSX:  "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+// ContentType mengembalikan MIME type format
+func (f Format) ContentType() string {
+	return contentTypes[f]
+}
+
+// FormatFromContentType mencari format dari MIME type
+func FormatFromContentType(contentType string) (Format, error) {
+	for format, ct := range contentTypes {
+		if ct == contentType {
+			return format, nil
+		}
+	}
+	return "", ErrUnsupportedFormat
+}
+
+// ErrUnsupportedFormat dikembalikan jika format, ekstensi, atau MIME type tidak didukung
+var ErrUnsupportedFormat = errors.New("format file tidak didukung")
+
+// FormatFromFilename menentukan format file yang bisa dibaca (CSV atau XLSX) dari ekstensi nama file
+func FormatFromFilename(name string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".csv":
+		return FormatCSV, nil
+	case ".xlsx":
+		return FormatXLSX, nil
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
+
+// Table adalah isi file: nama kolom dari baris header dan baris-baris data
+type Table struct {
+	Columns []string
+	Rows    []Row
+}
+
+// Row adalah satu baris data beserta nomor barisnya di file (header = baris 1)
+type Row struct {
+	Line   int
+	Values map[string]string
+}
+
+// Get mengembalikan nilai kolom tanpa spasi di awal/akhir; kosong jika kolom tidak ada
+func (r Row) Get(column string) string {
+	return strings.TrimSpace(r.Values[column])
+}
+
+// HasColumn memeriksa apakah tabel memiliki kolom tertentu
+func (t *Table) HasColumn(column string) bool {
+	for _, c := range t.Columns {
+		if c == column {
+			return true
+		}
+	}
+	return false
+}
+
+// newTable membangun Table dari record mentah. Record pertama adalah header; nama kolom
+// dinormalisasi menjadi huruf kecil dan baris yang seluruh selnya kosong dilewati.
+func newTable(records [][]string, lines []int) *Table {
+	table := &Table{}
+	if len(records) == 0 {
+		return table
+	}
+
+	for _, name := range records[0] {
+		table.Columns = append(table.Columns, strings.ToLower(strings.TrimSpace(name)))
+	}
+
+	for i, record := range records[1:] {
+		row := Row{Line: lines[i+1], Values: make(map[string]string, len(table.Columns))}
+		empty := true
+		for j, column := range table.Columns {
+			if j >= len(record) || column == "" {
+				continue
+			}
+			row.Values[column] = record[j]
+			if strings.TrimSpace(record[j]) != "" {
+				empty = false
+			}
+		}
+		if !empty {
+			table.Rows = append(table.Rows, row)
+		}
+	}
+	return table
+}
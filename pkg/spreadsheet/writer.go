@@ -0,0 +1,309 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Writer menulis tabel baris demi baris sehingga data besar bisa di-stream tanpa ditampung di memory.
+// Nilai sel boleh berupa string, angka, bool, time.Time, pointer ke tipe tersebut, atau nil (sel kosong).
+type Writer interface {
+	// WriteHeader menulis nama kolom; harus dipanggil sekali sebelum WriteRow
+	WriteHeader(columns []string) error
+	WriteRow(values []interface{}) error
+	// Flush mengirim baris yang masih di-buffer ke writer tujuan
+	Flush() error
+	// Close menutup dokumen (misalnya arsip XLSX). Writer tujuan tidak ditutup.
+	Close() error
+}
+
+// NewWriter membuat Writer untuk format tertentu
+func NewWriter(format Format, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	case FormatJSONL:
+		return &jsonlWriter{w: bufio.NewWriter(w)}, nil
+	case FormatXLSX:
+		return &xlsxWriter{archive: zip.NewWriter(w)}, nil
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+// cellValue mengubah nilai sel menjadi nilai dasar: nil, string, bool, int64, uint64, atau float64
+func cellValue(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	if t, ok := v.Interface().(time.Time); ok {
+		return t.Format(time.RFC3339)
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint()
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+}
+
+// cellText mengubah nilai sel menjadi teks untuk CSV dan sel string XLSX
+func cellText(value interface{}) string {
+	switch v := cellValue(value).(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// formulaPrefixes adalah karakter awal yang membuat Excel/LibreOffice menganggap sel CSV sebagai formula
+const formulaPrefixes = "=+-@\t\r"
+
+// escapeFormula menambahkan tanda kutip tunggal di depan teks yang diawali karakter formula
+// (CSV injection), sehingga spreadsheet menampilkannya sebagai teks biasa
+func escapeFormula(text string) string {
+	if text != "" && strings.ContainsRune(formulaPrefixes, rune(text[0])) {
+		return "'" + text
+	}
+	return text
+}
+
+// unescapeFormula membalik escapeFormula saat CSV hasil ekspor diimpor kembali
+func unescapeFormula(text string) string {
+	if len(text) > 1 && text[0] == '\'' && strings.ContainsRune(formulaPrefixes, rune(text[1])) {
+		return text[1:]
+	}
+	return text
+}
+
+// csvWriter menulis CSV dengan pemisah koma. Sel teks yang diawali =, +, -, @, tab, atau
+// carriage return diberi awalan ' agar tidak dieksekusi sebagai formula saat dibuka di spreadsheet;
+// angka (termasuk angka negatif) ditulis apa adanya.
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func (w *csvWriter) WriteHeader(columns []string) error {
+	return w.w.Write(columns)
+}
+
+func (w *csvWriter) WriteRow(values []interface{}) error {
+	record := make([]string, len(values))
+	for i, value := range values {
+		if text, ok := cellValue(value).(string); ok {
+			record[i] = escapeFormula(text)
+			continue
+		}
+		record[i] = cellText(value)
+	}
+	return w.w.Write(record)
+}
+
+func (w *csvWriter) Flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+func (w *csvWriter) Close() error {
+	return w.Flush()
+}
+
+// jsonlWriter menulis JSON Lines: satu object per baris dengan key sesuai nama kolom
+type jsonlWriter struct {
+	w       *bufio.Writer
+	columns [][]byte
+}
+
+func (w *jsonlWriter) WriteHeader(columns []string) error {
+	w.columns = make([][]byte, len(columns))
+	for i, column := range columns {
+		key, err := marshalJSON(column)
+		if err != nil {
+			return err
+		}
+		w.columns[i] = key
+	}
+	return nil
+}
+
+func (w *jsonlWriter) WriteRow(values []interface{}) error {
+	// Object ditulis manual agar urutan key sama dengan urutan kolom
+	var line bytes.Buffer
+	line.WriteByte('{')
+	for i, value := range values {
+		if i > 0 {
+			line.WriteByte(',')
+		}
+		encoded, err := marshalJSON(cellValue(value))
+		if err != nil {
+			return err
+		}
+		line.Write(w.columns[i])
+		line.WriteByte(':')
+		line.Write(encoded)
+	}
+	line.WriteString("}\n")
+
+	_, err := w.w.Write(line.Bytes())
+	return err
+}
+
+// marshalJSON seperti json.Marshal tetapi tanpa escape karakter HTML (<, >, &)
+func marshalJSON(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+func (w *jsonlWriter) Flush() error {
+	return w.w.Flush()
+}
+
+func (w *jsonlWriter) Close() error {
+	return w.Flush()
+}
+
+// xlsxWriter menulis workbook XLSX dengan satu sheet. Sel teks ditulis sebagai inline string
+// sehingga tidak perlu tabel shared string dan baris bisa langsung di-stream ke arsip.
+type xlsxWriter struct {
+	archive *zip.Writer
+	sheet   *bufio.Writer
+	row     int
+}
+
+// xlsxParts adalah file pendukung workbook minimal yang ditulis sebelum sheet
+var xlsxParts = []struct{ name, content string }{
+	{"[Content_Types].xml", xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`},
+	{"_rels/.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`},
+	{"xl/workbook.xml", xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets></workbook>`},
+	{"xl/_rels/workbook.xml.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`</Relationships>`},
+}
+
+func (w *xlsxWriter) WriteHeader(columns []string) error {
+	for _, part := range xlsxParts {
+		f, err := w.archive.Create(part.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(f, part.content); err != nil {
+			return err
+		}
+	}
+
+	// Sheet harus menjadi entry terakhir karena zip hanya bisa menulis satu entry dalam satu waktu
+	f, err := w.archive.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return err
+	}
+	w.sheet = bufio.NewWriter(f)
+	w.sheet.WriteString(xml.Header + `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+
+	values := make([]interface{}, len(columns))
+	for i, column := range columns {
+		values[i] = column
+	}
+	return w.WriteRow(values)
+}
+
+func (w *xlsxWriter) WriteRow(values []interface{}) error {
+	w.row++
+	fmt.Fprintf(w.sheet, `<row r="%d">`, w.row)
+	for i, value := range values {
+		ref := columnName(i) + strconv.Itoa(w.row)
+		switch v := cellValue(value).(type) {
+		case nil:
+			continue
+		case int64, uint64, float64:
+			fmt.Fprintf(w.sheet, `<c r="%s"><v>%s</v></c>`, ref, cellText(v))
+		case bool:
+			b := 0
+			if v {
+				b = 1
+			}
+			fmt.Fprintf(w.sheet, `<c r="%s" t="b"><v>%d</v></c>`, ref, b)
+		default:
+			fmt.Fprintf(w.sheet, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">`, ref)
+			xml.EscapeText(w.sheet, []byte(cellText(v)))
+			w.sheet.WriteString(`</t></is></c>`)
+		}
+	}
+	_, err := w.sheet.WriteString(`</row>`)
+	return err
+}
+
+func (w *xlsxWriter) Flush() error {
+	if err := w.sheet.Flush(); err != nil {
+		return err
+	}
+	return w.archive.Flush()
+}
+
+func (w *xlsxWriter) Close() error {
+	if w.sheet == nil {
+		if err := w.WriteHeader(nil); err != nil {
+			return err
+		}
+	}
+	w.sheet.WriteString(`</sheetData></worksheet>`)
+	if err := w.sheet.Flush(); err != nil {
+		return err
+	}
+	return w.archive.Close()
+}
+
+// columnName mengubah indeks kolom 0-based menjadi nama kolom spreadsheet (0 -> A, 26 -> AA)
+func columnName(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+	return name
+}
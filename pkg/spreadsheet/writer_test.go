@@ -0,0 +1,121 @@
+package spreadsheet
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeTable menulis header dan rows dengan format tertentu
+func writeTable(t *testing.T, format Format, columns []string, rows ...[]interface{}) *bytes.Buffer {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w, err := NewWriter(format, &buf)
+	if err != nil {
+		t.Fatalf("NewWriter: %v", err)
+	}
+	if err := w.WriteHeader(columns); err != nil {
+		t.Fatalf("WriteHeader: %v", err)
+	}
+	for _, row := range rows {
+		if err := w.WriteRow(row); err != nil {
+			t.Fatalf("WriteRow: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	return &buf
+}
+
+func TestWriter(t *testing.T) {
+	height := 180
+	var weight *int
+	kickoff := time.Date(2025, 3, 1, 19, 0, 0, 0, time.FixedZone("WIB", 7*3600))
+	columns := []string{"name", "height_cm", "weight_kg", "kickoff", "active"}
+	row := []interface{}{`Budi "Bude" <Santoso>`, &height, weight, kickoff, true}
+
+	t.Run("csv", func(t *testing.T) {
+		got := writeTable(t, FormatCSV, columns, row).String()
+		want := "name,height_cm,weight_kg,kickoff,active\n\"Budi \"\"Bude\"\" <Santoso>\",180,,2025-03-01T19:00:00+07:00,true\n"
+		if got != want {
+			t.Errorf("csv = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("jsonl keeps column order and types", func(t *testing.T) {
+		got := writeTable(t, FormatJSONL, columns, row, []interface{}{"Ahmad", nil, nil, nil, false}).String()
+		want := `{"name":"Budi \"Bude\" <Santoso>","height_cm":180,"weight_kg":null,"kickoff":"2025-03-01T19:00:00+07:00","active":true}` + "\n" +
+			`{"name":"Ahmad","height_cm":null,"weight_kg":null,"kickoff":null,"active":false}` + "\n"
+		if got != want {
+			t.Errorf("jsonl = %s, want %s", got, want)
+		}
+	})
+
+	t.Run("xlsx round trip", func(t *testing.T) {
+		buf := writeTable(t, FormatXLSX, columns, row)
+		table, err := Read(FormatXLSX, buf)
+		if err != nil {
+			t.Fatalf("Read: %v", err)
+		}
+		if strings.Join(table.Columns, ",") != strings.Join(columns, ",") {
+			t.Fatalf("columns = %q", table.Columns)
+		}
+		if len(table.Rows) != 1 {
+			t.Fatalf("rows = %d, want 1", len(table.Rows))
+		}
+		got := table.Rows[0]
+		if got.Get("name") != `Budi "Bude" <Santoso>` || got.Get("height_cm") != "180" || got.Get("weight_kg") != "" ||
+			got.Get("kickoff") != "2025-03-01T19:00:00+07:00" || got.Get("active") != "1" {
+			t.Errorf("row = %+v", got.Values)
+		}
+	})
+}
+
+func TestWriterCSVFormulaInjection(t *testing.T) {
+	score := -2
+	columns := []string{"name", "note"}
+	rows := [][]interface{}{
+		{"=HYPERLINK(\"http://evil.example\",\"klik\")", "+62 812"},
+		{"-1+1", "@SUM(A1:A2)"},
+		{"\tTab", "\rCR"},
+		{"Budi = Bude", &score},
+	}
+
+	got := writeTable(t, FormatCSV, columns, rows...).String()
+	want := "name,note\n" +
+		"\"'=HYPERLINK(\"\"http://evil.example\"\",\"\"klik\"\")\",'+62 812\n" +
+		"'-1+1,'@SUM(A1:A2)\n" +
+		"'\tTab,\"'\rCR\"\n" +
+		"Budi = Bude,-2\n"
+	if got != want {
+		t.Fatalf("csv = %q, want %q", got, want)
+	}
+
+	t.Run("read back", func(t *testing.T) {
+		table, err := Read(FormatCSV, strings.NewReader(got))
+		if err != nil {
+			t.Fatalf("Read: %v", err)
+		}
+		for i, row := range rows {
+			for j, column := range columns {
+				if text := table.Rows[i].Values[column]; text != cellText(row[j]) {
+					t.Errorf("row %d %s = %q, want %q", i, column, text, cellText(row[j]))
+				}
+			}
+		}
+	})
+}
+
+func TestColumnName(t *testing.T) {
+	for index, want := range map[int]string{0: "A", 25: "Z", 26: "AA", 27: "AB", 701: "ZZ", 702: "AAA"} {
+		if got := columnName(index); got != want {
+			t.Errorf("columnName(%d) = %q, want %q", index, got, want)
+		}
+		if got := columnIndex(want + "1"); got != index {
+			t.Errorf("columnIndex(%q) = %d, want %d", want+"1", got, index)
+		}
+	}
+}
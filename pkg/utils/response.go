@@ -1,10 +1,24 @@
 package utils
 
-import "github.com/gin-gonic/gin"
+import (
+	"net/http"
+	"xyz-football-api/pkg/i18n"
 
-// ErrorResponse merepresentasikan struktur response error
+	"github.com/gin-gonic/gin"
+)
+
+// ErrorResponse merepresentasikan struktur response error.
+// Code adalah identifier yang stabil untuk dibaca mesin; Fields berisi detail per field untuk error validasi.
 type ErrorResponse struct {
-	Error string `json:"error"`
+	Error  string       `json:"error"`
+	Code   string       `json:"code,omitempty"`
+	Fields []FieldError `json:"fields,omitempty"`
+}
+
+// FieldError merepresentasikan kesalahan pada satu field request
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
 }
 
 // SuccessResponse merepresentasikan struktur response sukses dengan message
@@ -12,9 +26,38 @@ type SuccessResponse struct {
 	Message string `json:"message"`
 }
 
-// RespondError mengirimkan response error dengan format standar
-func RespondError(c *gin.Context, statusCode int, message string) {
-	c.JSON(statusCode, ErrorResponse{Error: message})
+// statusCodes adalah code default untuk error yang dikirim tanpa code spesifik
+var statusCodes = map[int]string{
+	http.StatusBadRequest:          "bad_request",
+	http.StatusUnauthorized:        "unauthorized",
+	http.StatusForbidden:           "forbidden",
+	http.StatusNotFound:            "not_found",
+	http.StatusNotAcceptable:       "not_acceptable",
+	http.StatusConflict:            "conflict",
+	http.StatusInternalServerError: "internal_error",
+}
+
+// Language mengembalikan bahasa response yang dipilih dari header Accept-Language
+func Language(c *gin.Context) string {
+	return i18n.Negotiate(c.GetHeader("Accept-Language"))
+}
+
+// Translate menerjemahkan message ID ke bahasa request lalu memformatnya dengan args
+func Translate(c *gin.Context, message string, args ...interface{}) string {
+	return i18n.Translate(Language(c), message, args...)
+}
+
+// RespondError mengirimkan response error dengan format standar dan code default sesuai status.
+// message adalah message ID katalog i18n dan diterjemahkan sesuai Accept-Language.
+func RespondError(c *gin.Context, statusCode int, message string, args ...interface{}) {
+	RespondErrorDetail(c, statusCode, ErrorResponse{Error: Translate(c, message, args...), Code: statusCodes[statusCode]})
+}
+
+// RespondErrorDetail mengirimkan response error terstruktur (code dan detail field).
+// Pesan di body harus sudah diterjemahkan.
+func RespondErrorDetail(c *gin.Context, statusCode int, body ErrorResponse) {
+	c.Header("Content-Language", Language(c))
+	c.JSON(statusCode, body)
 }
 
 // RespondSuccess mengirimkan response sukses dengan data
@@ -22,7 +65,8 @@ func RespondSuccess(c *gin.Context, statusCode int, data interface{}) {
 	c.JSON(statusCode, data)
 }
 
-// RespondMessage mengirimkan response sukses dengan message
-func RespondMessage(c *gin.Context, statusCode int, message string) {
-	c.JSON(statusCode, SuccessResponse{Message: message})
+// RespondMessage mengirimkan response sukses dengan message yang diterjemahkan sesuai Accept-Language
+func RespondMessage(c *gin.Context, statusCode int, message string, args ...interface{}) {
+	c.Header("Content-Language", Language(c))
+	c.JSON(statusCode, SuccessResponse{Message: Translate(c, message, args...)})
 }
@@ -47,11 +47,20 @@ if errorlevel 1 (
 echo [OK] Dependencies ready
 echo.
 
+echo [INFO] Running database migrations...
+go run ./cmd/api migrate up
+if errorlevel 1 (
+    echo [ERROR] Migrasi database gagal! Cek koneksi database di file .env
+    pause
+    exit /b 1
+)
+echo.
+
 echo [INFO] Starting server...
 echo ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
 echo.
 
-go run cmd/api/main.go
+go run ./cmd/api
 
 if errorlevel 1 (
     echo.
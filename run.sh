@@ -44,12 +44,21 @@ fi
 echo "[OK] Dependencies ready"
 echo ""
 
+# Run database migrations
+echo "[INFO] Running database migrations..."
+go run ./cmd/api migrate up
+if [ $? -ne 0 ]; then
+    echo "[ERROR] Migrasi database gagal! Cek koneksi database di file .env"
+    exit 1
+fi
+echo ""
+
 # Start server
 echo "[INFO] Starting server..."
 echo "━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━"
 echo ""
 
-go run cmd/api/main.go
+go run ./cmd/api
 
 if [ $? -ne 0 ]; then
     echo ""